@@ -149,6 +149,41 @@ func (xcc *XchainCryptoClient) VerifyPDP(sigma, mu, randV, randU, pubkey []byte,
 	return pdp.Verify(param)
 }
 
+// CalculateDynamicPDPSigmaI 为修改过的数据块生成证明辅助信息
+// - content 该数据块的内容
+// - index 数据块对于原始数据的的索引
+// - version 数据块的版本，每次修改后加1，未修改过的数据块为0
+// - randomV 小于椭圆曲线阶order的随机数
+// - randomU 小于椭圆曲线阶order的随机数
+// - privkey 副本保持证明私钥
+func (xcc *XchainCryptoClient) CalculateDynamicPDPSigmaI(content, index, version, randomV, randomU, privkey []byte) ([]byte, error) {
+	param := pdp.CalculateSigmaIParamsFromBytes(content, index, randomV, randomU, privkey)
+	param.Version = new(big.Int).SetBytes(version)
+	sigma, err := pdp.CalculateSigmaI(param)
+	if err != nil {
+		return nil, err
+	}
+	return pdp.G1ToByte(sigma), nil
+}
+
+// VerifyDynamicPDP 挑战验证信息，支持修改过的数据块
+// - sigma 证明生成的应答信息
+// - mu 证明生成的应答信息
+// - randV 验证者生成的随机数
+// - randU 验证者生成的随机数
+// - pubkey 验证者的副本保持证明公钥
+// - indices 要验证的索引列表
+// - versions 要验证的索引对应的数据块版本列表
+// - randVs 调整生成的随机数列表
+func (xcc *XchainCryptoClient) VerifyDynamicPDP(sigma, mu, randV, randU, pubkey []byte, indices, versions, randVs [][]byte) (bool, error) {
+	param, err := pdp.VerifyParamsFromBytes(sigma, mu, randV, randU, pubkey, indices, randVs)
+	if err != nil {
+		return false, err
+	}
+	param.Versions = pdp.IntListFromBytes(versions)
+	return pdp.Verify(param)
+}
+
 // --- PDP 副本保持证明相关 end ---
 
 // --- Paillier 加法同态相关 start ---
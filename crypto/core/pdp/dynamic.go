@@ -0,0 +1,73 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pdp
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cloudflare/bn256"
+)
+
+// Dynamic PDP allows the client to append segments to or modify segments of a file already stored on a server,
+// without recalculating sigma_i of other segments:
+// - appended segments take indices after the last one, their sigma_i are calculated as usual
+// - a modified segment keeps its index, its version is increased by 1 and its sigma_i is bound to the new version,
+// so that the server could not pass the verification using the old content and sigma_i
+// XuperDB only appends, modifying is provided for callers able to overwrite a segment on the server
+
+// AppendSigmaIs calculate sigma_i for segments appended after lastIndex, indices of new segments are returned
+func AppendSigmaIs(contents [][]byte, lastIndex, randomV, randomU *big.Int, privkey *PrivateKey) (
+	[]*big.Int, []*bn256.G1, error) {
+	if lastIndex == nil || lastIndex.Sign() < 0 {
+		return nil, nil, fmt.Errorf("invalid last index: %v", lastIndex)
+	}
+
+	var indices []*big.Int
+	var sigmas []*bn256.G1
+	index := new(big.Int).Set(lastIndex)
+	for _, content := range contents {
+		index = new(big.Int).Add(index, big.NewInt(1))
+		sigma, err := CalculateSigmaI(CalculateSigmaIParams{
+			Content: content,
+			Index:   index,
+			RandomV: randomV,
+			RandomU: randomU,
+			Privkey: privkey,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		indices = append(indices, index)
+		sigmas = append(sigmas, sigma)
+	}
+	return indices, sigmas, nil
+}
+
+// ModifySigmaI calculate sigma_i for the new content of a segment, param.Version is the current version of the segment,
+// and the new version is returned along with sigma_i
+func ModifySigmaI(param CalculateSigmaIParams) (*big.Int, *bn256.G1, error) {
+	version := new(big.Int)
+	if param.Version != nil {
+		version.Set(param.Version)
+	}
+	version = new(big.Int).Add(version, big.NewInt(1))
+
+	param.Version = version
+	sigma, err := CalculateSigmaI(param)
+	if err != nil {
+		return nil, nil, err
+	}
+	return version, sigma, nil
+}
@@ -14,6 +14,7 @@
 package pdp
 
 import (
+	"bytes"
 	"crypto/rand"
 	"io"
 	"io/ioutil"
@@ -121,6 +122,163 @@ func TestPDP(t *testing.T) {
 	}
 }
 
+func TestDynamicPDP(t *testing.T) {
+	sk, pk, err := GenRandomKeyPair()
+	if err != nil {
+		t.Fatalf("failed to generate random client keypair, err: %v", err)
+	}
+	randomU, err := RandomWithinOrder()
+	if err != nil {
+		t.Fatalf("failed to generate random U, err: %v", err)
+	}
+	randomV, err := RandomWithinOrder()
+	if err != nil {
+		t.Fatalf("failed to generate random V, err: %v", err)
+	}
+
+	// 1. calculate sigmas of the original file, then append to it
+	contents := randomContents(t, 3)
+	indices, sigmas, err := AppendSigmaIs(contents, big.NewInt(0), randomV, randomU, sk)
+	if err != nil {
+		t.Fatalf("failed to calculate sigmas, err: %v", err)
+	}
+	appended := randomContents(t, 2)
+	newIndices, newSigmas, err := AppendSigmaIs(appended, indices[len(indices)-1], randomV, randomU, sk)
+	if err != nil {
+		t.Fatalf("failed to calculate sigmas of appended segments, err: %v", err)
+	}
+	if newIndices[0].Int64() != 4 || newIndices[1].Int64() != 5 {
+		t.Fatalf("unexpected indices of appended segments: %v", newIndices)
+	}
+	contents = append(contents, appended...)
+	indices = append(indices, newIndices...)
+	sigmas = append(sigmas, newSigmas...)
+	versions := []*big.Int{new(big.Int), new(big.Int), new(big.Int), new(big.Int), new(big.Int)}
+
+	verify := func(contents [][]byte, sigmas []*bn256.G1, versions []*big.Int) bool {
+		_, vs, err := GenerateChallenge([]int{1, 2, 3, 4, 5})
+		if err != nil {
+			t.Fatalf("failed to generate challenge, err: %v", err)
+		}
+		sigma, mu, err := Prove(ProofParams{
+			Content:  contents,
+			Indices:  indices,
+			RandomVs: vs,
+			Sigmas:   sigmas,
+		})
+		if err != nil {
+			t.Fatalf("failed to generate proof, err: %v", err)
+		}
+		v, err := Verify(VerifyParams{
+			Sigma:    sigma,
+			Mu:       mu,
+			RandomV:  randomV,
+			RandomU:  randomU,
+			Indices:  indices,
+			RandomVs: vs,
+			Pubkey:   pk,
+			Versions: versions,
+		})
+		if err != nil {
+			t.Fatalf("failed to verify, err: %v", err)
+		}
+		return v
+	}
+	if !verify(contents, sigmas, versions) {
+		t.Fatal("verification of appended file failed")
+	}
+	if !verify(contents, sigmas, nil) {
+		t.Fatal("verification of appended file without versions failed")
+	}
+
+	// 2. modify the second segment
+	modified := randomContents(t, 1)[0]
+	version, sigma, err := ModifySigmaI(CalculateSigmaIParams{
+		Content: modified,
+		Index:   indices[1],
+		RandomV: randomV,
+		RandomU: randomU,
+		Privkey: sk,
+		Version: versions[1],
+	})
+	if err != nil {
+		t.Fatalf("failed to calculate sigma of modified segment, err: %v", err)
+	}
+	if version.Int64() != 1 {
+		t.Fatalf("unexpected version of modified segment: %v", version)
+	}
+	newContents := append([][]byte{}, contents...)
+	newContents[1] = modified
+	newSigmas = append([]*bn256.G1{}, sigmas...)
+	newSigmas[1] = sigma
+	newVersions := append([]*big.Int{}, versions...)
+	newVersions[1] = version
+	if !verify(newContents, newSigmas, newVersions) {
+		t.Fatal("verification of modified file failed")
+	}
+
+	// 3. old content and sigma could not pass the verification any more
+	if verify(contents, sigmas, newVersions) {
+		t.Fatal("verification passed with old content")
+	}
+	if verify(newContents, newSigmas, versions) {
+		t.Fatal("verification passed with old versions")
+	}
+}
+
+func TestHashIndexVersionCollision(t *testing.T) {
+	sk, _, err := GenRandomKeyPair()
+	if err != nil {
+		t.Fatalf("failed to generate key pair, err: %v", err)
+	}
+	randomV, err := RandomWithinOrder()
+	if err != nil {
+		t.Fatalf("failed to generate random v, err: %v", err)
+	}
+	randomU, err := RandomWithinOrder()
+	if err != nil {
+		t.Fatalf("failed to generate random u, err: %v", err)
+	}
+	content := randomContents(t, 1)[0]
+
+	modified, err := CalculateSigmaI(CalculateSigmaIParams{
+		Content: content,
+		Index:   big.NewInt(1),
+		RandomV: randomV,
+		RandomU: randomU,
+		Privkey: sk,
+		Version: big.NewInt(2),
+	})
+	if err != nil {
+		t.Fatalf("failed to calculate sigma of modified segment, err: %v", err)
+	}
+	unmodified, err := CalculateSigmaI(CalculateSigmaIParams{
+		Content: content,
+		Index:   big.NewInt(12),
+		RandomV: randomV,
+		RandomU: randomU,
+		Privkey: sk,
+	})
+	if err != nil {
+		t.Fatalf("failed to calculate sigma of unmodified segment, err: %v", err)
+	}
+	if bytes.Equal(modified.Marshal(), unmodified.Marshal()) {
+		t.Fatal("sigma of index 1 version 2 equals sigma of index 12")
+	}
+}
+
+func randomContents(t *testing.T, n int) [][]byte {
+	var contents [][]byte
+	for i := 0; i < n; i++ {
+		data := make([]byte, 1024)
+		if _, err := io.ReadFull(rand.Reader, data); err != nil {
+			t.Fatalf("failed to read random bytes: %v", err)
+		}
+		contents = append(contents, data)
+	}
+	return contents
+}
+
 func createFiles(t *testing.T, fileNames []string) {
 	for _, fileName := range fileNames {
 		data := make([]byte, 102400)
@@ -16,6 +16,7 @@ package pdp
 import (
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"math/big"
 
@@ -64,15 +65,44 @@ func concatBigInt(list []*big.Int, modulus *big.Int) (*big.Int, error) {
 	return ret, nil
 }
 
+// dynamicDomain separates H(v||i||ver) of modified segments from H(v||i) of the others
+var dynamicDomain = []byte("pdp-dynamic")
+
+// hashIndex calculate H(v||i) for a segment never modified, or H(v||i||ver) for a modified one,
+// so that sigma_i of an old version could not pass the verification after the segment is modified.
+// Each of v, i and ver is length-prefixed in H(v||i||ver), otherwise (i=1, ver=2) and i=12 would collide
+func hashIndex(randomV, index, version *big.Int) (*bn256.G1, error) {
+	if version == nil || version.Sign() <= 0 {
+		vi, err := concatBigInt([]*big.Int{randomV, index}, bn256.Order)
+		if err != nil {
+			return nil, err
+		}
+		return hashToG1(vi), nil
+	}
+	if randomV.Sign() < 0 || index.Sign() < 0 {
+		return nil, fmt.Errorf("invalid negative random v or index")
+	}
+
+	h := sha256.New()
+	h.Write(dynamicDomain)
+	for _, n := range []*big.Int{randomV, index, version} {
+		b := n.Bytes()
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(b)))
+		h.Write(l[:])
+		h.Write(b)
+	}
+	return hashToG1(new(big.Int).SetBytes(h.Sum(nil))), nil
+}
+
 // CalculateSigmaI calculate sigma_i using each segment and private key
 // sigma_i = sk * ( H(v||i) + mi*u*g1 )
 func CalculateSigmaI(param CalculateSigmaIParams) (*bn256.G1, error) {
 	// 1. H(v||i)
-	vi, err := concatBigInt([]*big.Int{param.RandomV, param.Index}, bn256.Order)
+	hvi, err := hashIndex(param.RandomV, param.Index, param.Version)
 	if err != nil {
 		return nil, err
 	}
-	hvi := hashToG1(vi)
 
 	// 2. mi mod order
 	miInt := new(big.Int).SetBytes(param.Content)
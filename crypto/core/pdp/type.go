@@ -36,6 +36,7 @@ type CalculateSigmaIParams struct {
 	RandomV *big.Int    // a random V
 	RandomU *big.Int    // a random U
 	Privkey *PrivateKey // client private key
+	Version *big.Int    // segment version, nil or 0 for a segment never modified
 }
 
 // ProofParams parameters required to generate proof
@@ -55,6 +56,7 @@ type VerifyParams struct {
 	Indices  []*big.Int // {i} index list
 	RandomVs []*big.Int // {v_i} random challenge number list
 	Pubkey   *PublicKey // client public key
+	Versions []*big.Int // {ver_i} version list of indices, empty if no segment modified
 }
 
 // PrivateKeyToByte convert PDP private key to byes
@@ -23,18 +23,26 @@ import (
 
 // Verify verify the proof
 // e(sigma, g2) = e( (v1*H(v||index_1) + ... + vc*H(v||index_c)) + u*mu, pk)
+// H(v||index_i||ver_i) is used instead for segments modified
 func Verify(param VerifyParams) (bool, error) {
 	g2 := new(bn256.G2).ScalarBaseMult(new(big.Int).SetInt64(1))
 	left := bn256.Pair(param.Sigma, g2)
 
+	if len(param.Versions) != 0 && len(param.Versions) != len(param.Indices) {
+		return false, fmt.Errorf("invalid versions: %d versions for %d indices", len(param.Versions), len(param.Indices))
+	}
+
 	vh := new(bn256.G1)
 	for i := 0; i < len(param.Indices); i++ {
-		vi, err := concatBigInt([]*big.Int{param.RandomV, param.Indices[i]}, bn256.Order)
+		var version *big.Int
+		if len(param.Versions) != 0 {
+			version = param.Versions[i]
+		}
+		hi, err := hashIndex(param.RandomV, param.Indices[i], version)
 		if err != nil {
 			return false, fmt.Errorf("failed to concat %v and %v, err: %v", param.RandomV, param.Indices[i], err)
 		}
 
-		hi := hashToG1(vi)
 		vhi := new(bn256.G1).ScalarMult(hi, param.RandomVs[i])
 		if i == 0 {
 			vh = vhi
@@ -0,0 +1,42 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package embedded provides a blockchain running in process for offline development,
+//  contract methods are the same as XuperChain's and run on the ledger of XuperDB's embedded blockchain
+package embedded
+
+import (
+	xdbembedded "github.com/PaddlePaddle/PaddleDTX/xdb/blockchain/embedded"
+	xdataconfig "github.com/PaddlePaddle/PaddleDTX/xdb/config"
+
+	"github.com/PaddlePaddle/PaddleDTX/dai/blockchain/xchain"
+	"github.com/PaddlePaddle/PaddleDTX/dai/blockchain/xchain/contract/core"
+	"github.com/PaddlePaddle/PaddleDTX/dai/config"
+)
+
+// New creates a XChain client whose contract runs in process,
+//  the ledger is kept in memory if conf is nil or DBPath is empty
+func New(conf *config.EmbeddedConf) (*xchain.XChain, error) {
+	econf := &xdataconfig.EmbeddedConf{}
+	if conf != nil {
+		econf.DBPath = conf.DBPath
+	}
+	c, err := xdbembedded.NewContract(new(core.Xdata), econf)
+	if err != nil {
+		return nil, err
+	}
+	x := &xchain.XChain{Contract: c}
+	x.ContractName = "paddlempc"
+	x.ChainName = "embedded"
+	return x, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embedded
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+
+	"github.com/PaddlePaddle/PaddleDTX/dai/blockchain"
+)
+
+func TestDataNodes(t *testing.T) {
+	chain, err := New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := blockchain.DataNode{
+		ID:      pubkey[:],
+		Name:    "executor1",
+		Address: "127.0.0.1:8184",
+	}
+	s, _ := json.Marshal(node)
+	sig, err := ecdsa.Sign(privkey, hash.Hash(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt := &blockchain.AddNodeOptions{Node: node, Signature: sig[:]}
+
+	ctx := context.Background()
+	if err := chain.RegisterDataNode(ctx, opt); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.RegisterDataNode(ctx, opt); !errorx.Is(err, errorx.ErrCodeAlreadyExists) {
+		t.Fatalf("duplicated node should be rejected, got %v", err)
+	}
+
+	nodes, err := chain.ListDataNodes(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 1 || nodes[0].Name != node.Name {
+		t.Fatalf("unexpected nodes %v", nodes)
+	}
+
+	// file methods of XuperDB run in the same contract
+	if _, err := chain.GetFileByID(ctx, "not-exist"); !errorx.Is(err, errorx.ErrCodeNotFound) {
+		t.Fatalf("file should not be found, got %v", err)
+	}
+}
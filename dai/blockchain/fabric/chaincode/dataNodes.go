@@ -0,0 +1,117 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+
+	"github.com/PaddlePaddle/PaddleDTX/dai/blockchain"
+)
+
+// RegisterDataNode registers Executor node
+func (x *xdata) RegisterDataNode(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting AddNodeOptions")
+	}
+
+	// unmarshal opt
+	var opt blockchain.AddNodeOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to unmarshal AddNodeOptions").Error())
+	}
+	// get node
+	node := opt.Node
+	// marshal node
+	s, err := json.Marshal(node)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to marshal Node").Error())
+	}
+	// verify sig
+	if err := x.checkSign(opt.Signature, node.ID, s); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// put index-node on fabric, judge if index exists
+	index := packNodeIndex(node.ID)
+	if resp := x.getValue(stub, []string{index}); len(resp.Payload) != 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeAlreadyExists,
+			"duplicated nodeID").Error())
+	}
+	if resp := x.setValue(stub, []string{index, string(s)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"fail to put index-Node on fabric: %s", resp.Message).Error())
+	}
+
+	// put listIndex-node on fabric
+	index = packNodeListIndex(node)
+	if resp := x.setValue(stub, []string{index, string(s)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"fail to put listIndex-Node on fabric: %s", resp.Message).Error())
+	}
+	return shim.Success([]byte("added"))
+}
+
+// ListDataNodes gets all Executor nodes
+func (x *xdata) ListDataNodes(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	var nodes blockchain.DataNodes
+
+	// get data nodes by list_prefix
+	iterator, err := stub.GetStateByPartialCompositeKey(prefixNodeListIndex, []string{})
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	defer iterator.Close()
+
+	for iterator.HasNext() {
+		queryResponse, err := iterator.Next()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		var node blockchain.DataNode
+		if err := json.Unmarshal(queryResponse.Value, &node); err != nil {
+			return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+				"fail to unmarshal node").Error())
+		}
+		nodes = append(nodes, node)
+	}
+	// marshal nodes
+	s, err := json.Marshal(nodes)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to marshal nodes").Error())
+	}
+	return shim.Success(s)
+}
+
+// GetDataNodeByID gets Executor node by ID
+func (x *xdata) GetDataNodeByID(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting nodeID")
+	}
+
+	// get node by index
+	index := packNodeIndex([]byte(args[0]))
+	resp := x.getValue(stub, []string{index})
+	if len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound, "node not found: %s", resp.Message).Error())
+	}
+	return shim.Success(resp.Payload)
+}
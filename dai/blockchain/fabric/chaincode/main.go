@@ -0,0 +1,91 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
+// keyXuperDBChaincode stores the chaincode name of XuperDB on the same channel,
+//  which is set when the chaincode is instantiated
+const keyXuperDBChaincode = "config_xuperdb_chaincode"
+
+type xdata struct{}
+
+// Init expects the chaincode name of XuperDB as the only argument,
+//  files used by tasks are checked by invoking it
+func (x *xdata) Init(stub shim.ChaincodeStubInterface) pb.Response {
+	fmt.Println("init paddlempc chaincode")
+	_, args := stub.GetFunctionAndParameters()
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting chaincode name of XuperDB")
+	}
+	return x.setValue(stub, []string{keyXuperDBChaincode, args[0]})
+}
+
+func (x *xdata) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
+	fn, args := stub.GetFunctionAndParameters()
+	switch fn {
+	case "RegisterDataNode":
+		return x.RegisterDataNode(stub, args)
+	case "ListDataNodes":
+		return x.ListDataNodes(stub, args)
+	case "GetDataNodeByID":
+		return x.GetDataNodeByID(stub, args)
+	case "PublishTask":
+		return x.PublishTask(stub, args)
+	case "ListTask":
+		return x.ListTask(stub, args)
+	case "GetTaskById":
+		return x.GetTaskById(stub, args)
+	case "ConfirmTask":
+		return x.ConfirmTask(stub, args)
+	case "RejectTask":
+		return x.RejectTask(stub, args)
+	case "StartTask":
+		return x.StartTask(stub, args)
+	case "ExecuteTask":
+		return x.ExecuteTask(stub, args)
+	case "FinishTask":
+		return x.FinishTask(stub, args)
+	default:
+		return shim.Error("Invalid invoke function name.")
+	}
+}
+
+func (x *xdata) setValue(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	err := stub.PutState(args[0], []byte(args[1]))
+	if err != nil {
+		return shim.Error("set right fail " + err.Error())
+	}
+	return shim.Success(nil)
+}
+
+func (x *xdata) getValue(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	result, err := stub.GetState(args[0])
+	if err != nil {
+		return shim.Error("query" + args[0] + " fail:" + err.Error())
+	}
+	return shim.Success(result)
+}
+
+func main() {
+	err := shim.Start(new(xdata))
+	if err != nil {
+		fmt.Printf("failed to start paddlempc chaincode: %s", err)
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+
+	"github.com/PaddlePaddle/PaddleDTX/dai/blockchain"
+	pbTask "github.com/PaddlePaddle/PaddleDTX/dai/protos/task"
+)
+
+// PublishTask publishes task
+func (x *xdata) PublishTask(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting PublishFLTaskOptions")
+	}
+
+	// unmarshal opt
+	var opt blockchain.PublishFLTaskOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to unmarshal PublishFLTaskOptions").Error())
+	}
+	// get fltask
+	t := opt.FLTask
+	// marshal fltask
+	s, err := json.Marshal(t)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to marshal FLTask").Error())
+	}
+	if err := x.checkSign(opt.Signature, t.Requester, s); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	t.Status = blockchain.TaskConfirming
+	// marshal fltask
+	s, err = json.Marshal(t)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to marshal FLTask").Error())
+	}
+
+	// put index-fltask on fabric, judge if index exists
+	index := packFlTaskIndex(t.ID)
+	if resp := x.getValue(stub, []string{index}); len(resp.Payload) != 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeAlreadyExists,
+			"duplicated taskID").Error())
+	}
+	if resp := x.setValue(stub, []string{index, string(s)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"fail to put index-flTask on fabric: %s", resp.Message).Error())
+	}
+
+	// put requester listIndex-fltask on fabric
+	index = packFlTaskListIndex(t)
+	if resp := x.setValue(stub, []string{index, t.ID}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"fail to put requester listIndex-fltask on fabric: %s", resp.Message).Error())
+	}
+	// put executor listIndex-fltask on fabric
+	for _, ds := range t.DataSets {
+		index := packExecutorTaskListIndex(ds.Owner, t)
+		if resp := x.setValue(stub, []string{index, t.ID}); resp.Status == shim.ERROR {
+			return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+				"fail to put executor listIndex-fltask on fabric: %s", resp.Message).Error())
+		}
+	}
+	return shim.Success([]byte("added"))
+}
+
+// ListTask lists tasks
+func (x *xdata) ListTask(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting ListFLTaskOptions")
+	}
+
+	// unmarshal opt
+	var opt blockchain.ListFLTaskOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to unmarshal ListFLTaskOptions").Error())
+	}
+
+	var tasks blockchain.FLTasks
+
+	// get fltasks by list_prefix
+	prefix, attr := packFlTaskFilter(opt.PubKey)
+	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	defer iterator.Close()
+
+	for iterator.HasNext() {
+		if opt.Limit > 0 && uint64(len(tasks)) >= opt.Limit {
+			break
+		}
+		queryResponse, err := iterator.Next()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		t, err := x.getTaskById(stub, string(queryResponse.Value))
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		if t.PublishTime < opt.TimeStart || t.PublishTime > opt.TimeEnd ||
+			(opt.Status != "" && t.Status != opt.Status) {
+			continue
+		}
+		tasks = append(tasks, t)
+	}
+	// marshal tasks
+	s, err := json.Marshal(tasks)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to marshal tasks").Error())
+	}
+	return shim.Success(s)
+}
+
+// GetTaskById gets task by id
+func (x *xdata) GetTaskById(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting taskID")
+	}
+
+	// get fltask by index
+	index := packFlTaskIndex(args[0])
+	resp := x.getValue(stub, []string{index})
+	if len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound, "task not found: %s", resp.Message).Error())
+	}
+	return shim.Success(resp.Payload)
+}
+
+// ConfirmTask is called when Executor confirms task
+func (x *xdata) ConfirmTask(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	return x.setTaskConfirmStatus(stub, args, true)
+}
+
+// RejectTask is called when Executor rejects task
+func (x *xdata) RejectTask(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	return x.setTaskConfirmStatus(stub, args, false)
+}
+
+// setTaskConfirmStatus sets task status as Confirmed or Rejected
+func (x *xdata) setTaskConfirmStatus(stub shim.ChaincodeStubInterface, args []string, isConfirm bool) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting FLTaskConfirmOptions")
+	}
+
+	var opt blockchain.FLTaskConfirmOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to unmarshal FLTaskConfirmOptions").Error())
+	}
+	// verify sig
+	m := fmt.Sprintf("%x,%s,%d", opt.Owner, opt.TaskID, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.Owner, []byte(m)); err != nil {
+		return shim.Error(err.Error())
+	}
+	t, err := x.getTaskById(stub, opt.TaskID)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	// check status
+	if t.Status != blockchain.TaskConfirming {
+		return shim.Error(errorx.New(errorx.ErrCodeParam,
+			"confirm task error, taskStatus is not Confirming, taskId: %s, taskStatus: %s", t.ID, t.Status).Error())
+	}
+	isAllConfirm := true
+	for index, ds := range t.DataSets {
+		if bytes.Equal(ds.Owner, opt.Owner) {
+			// judge sample file exists
+			if !x.fileExists(stub, ds.DataID, opt.CurrentTime) {
+				return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param:taskId, dataId not exist").Error())
+			}
+			// judge task is confirmed
+			if ds.ConfirmedAt > 0 || ds.RejectedAt > 0 {
+				return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param:taskId, task already confirmed").Error())
+			}
+			if isConfirm {
+				t.DataSets[index].ConfirmedAt = opt.CurrentTime
+			} else {
+				t.DataSets[index].RejectedAt = opt.CurrentTime
+			}
+		} else {
+			if ds.ConfirmedAt == 0 {
+				isAllConfirm = false
+			}
+		}
+	}
+	// if all dataSets owner confirm, task status is ready
+	if isAllConfirm {
+		t.Status = blockchain.TaskReady
+	}
+	// if one of dataSets owner reject, task status is rejected
+	if !isConfirm {
+		t.Status = blockchain.TaskRejected
+	}
+	return x.putTask(stub, t, "fail to confirm index-flTask on fabric")
+}
+
+// StartTask is called when Requester starts task after Executors confirmed
+//  args = {taskId, signature}
+func (x *xdata) StartTask(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 2 {
+		return shim.Error("invalid arguments. expecting taskId and signature")
+	}
+	taskId := args[0]
+	t, err := x.getTaskById(stub, taskId)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	mes := fmt.Sprintf("%s,%x", taskId, t.Requester)
+	if err := x.checkSign([]byte(args[1]), t.Requester, []byte(mes)); err != nil {
+		return shim.Error(err.Error())
+	}
+	if t.Status != blockchain.TaskReady && t.Status != blockchain.TaskFailed {
+		return shim.Error(errorx.New(errorx.ErrCodeParam,
+			"start task error, task status is not Ready or Failed, taskId: %s, taskStatus: %s", t.ID, t.Status).Error())
+	}
+	// update task status
+	t.Status = blockchain.TaskToProcess
+	return x.putTask(stub, t, "fail to start index-flTask on fabric")
+}
+
+// ExecuteTask is called when Executor run task
+func (x *xdata) ExecuteTask(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	return x.setTaskExecuteStatus(stub, args, false)
+}
+
+// FinishTask is called when task execution finished
+func (x *xdata) FinishTask(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	return x.setTaskExecuteStatus(stub, args, true)
+}
+
+func (x *xdata) setTaskExecuteStatus(stub shim.ChaincodeStubInterface, args []string, isFinish bool) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting FLTaskExeStatusOptions")
+	}
+
+	var opt blockchain.FLTaskExeStatusOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to unmarshal FLTaskExeStatusOptions").Error())
+	}
+	// verify sig
+	m := fmt.Sprintf("%x,%s,%d", opt.Executor, opt.TaskID, opt.CurrentTime)
+	if isFinish {
+		m += fmt.Sprintf("%s,%x", opt.ErrMessage, opt.Result)
+	}
+	if err := x.checkSign(opt.Signature, opt.Executor, []byte(m)); err != nil {
+		return shim.Error(err.Error())
+	}
+	t, err := x.getTaskById(stub, opt.TaskID)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// executor validity check
+	if ok := x.checkExecutor(opt.Executor, t.DataSets); !ok {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param:executor").Error())
+	}
+	if isFinish {
+		if t.Status != blockchain.TaskProcessing {
+			return shim.Error(errorx.New(errorx.ErrCodeParam,
+				"finish task error, task status is not Processing, taskId: %s, taskStatus: %s", t.ID, t.Status).Error())
+		}
+
+		t.Status = blockchain.TaskFinished
+		t.EndTime = opt.CurrentTime
+		t.Result = opt.Result
+
+		if opt.ErrMessage != "" {
+			t.Status = blockchain.TaskFailed
+			t.ErrMessage = opt.ErrMessage
+		}
+	} else {
+		if t.Status != blockchain.TaskToProcess {
+			return shim.Error(errorx.New(errorx.ErrCodeParam,
+				"execute task error, task status is not ToProcess, taskId: %s, taskStatus: %s", t.ID, t.Status).Error())
+		}
+		t.Status = blockchain.TaskProcessing
+		t.StartTime = opt.CurrentTime
+	}
+	return x.putTask(stub, t, "fail to set task execute status on fabric")
+}
+
+// putTask updates index-fltask on fabric
+func (x *xdata) putTask(stub shim.ChaincodeStubInterface, t blockchain.FLTask, errMsg string) pb.Response {
+	s, err := json.Marshal(t)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "fail to marshal FLTask").Error())
+	}
+	index := packFlTaskIndex(t.ID)
+	if resp := x.setValue(stub, []string{index, string(s)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain, "%s: %s", errMsg, resp.Message).Error())
+	}
+	return shim.Success([]byte("OK"))
+}
+
+func (x *xdata) getTaskById(stub shim.ChaincodeStubInterface, taskID string) (t blockchain.FLTask, err error) {
+	index := packFlTaskIndex(taskID)
+	resp := x.getValue(stub, []string{index})
+	if len(resp.Payload) == 0 {
+		return t, errorx.New(errorx.ErrCodeNotFound, "the task[%s] not found: %s", taskID, resp.Message)
+	}
+
+	if err = json.Unmarshal(resp.Payload, &t); err != nil {
+		return t, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to unmarshal FlTask")
+	}
+	return t, nil
+}
+
+// fileExists checks whether the file is published and unexpired on XuperDB's chaincode of the same channel
+func (x *xdata) fileExists(stub shim.ChaincodeStubInterface, fileID string, ctime int64) bool {
+	resp := x.getValue(stub, []string{keyXuperDBChaincode})
+	if len(resp.Payload) == 0 {
+		return false
+	}
+	args := [][]byte{[]byte("GetFileByID"), []byte(fileID), []byte(strconv.FormatInt(ctime, 10))}
+	resp = stub.InvokeChaincode(string(resp.Payload), args, "")
+	return resp.Status == shim.OK
+}
+
+func (x *xdata) checkSign(sign, owner, mes []byte) (err error) {
+	// verify sig
+	if len(sign) != ecdsa.SignatureLength {
+		return errorx.New(errorx.ErrCodeParam, "bad param:signature")
+	}
+	var pubkey [ecdsa.PublicKeyLength]byte
+	var sig [ecdsa.SignatureLength]byte
+	copy(pubkey[:], owner)
+	copy(sig[:], sign)
+	if err := ecdsa.Verify(pubkey, hash.Hash(mes), sig); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeBadSignature, "failed to verify signature")
+	}
+	return nil
+}
+
+func (x *xdata) checkExecutor(executor []byte, dataSets []*pbTask.DataForTask) bool {
+	for _, ds := range dataSets {
+		if bytes.Equal(ds.Owner, executor) {
+			return true
+		}
+	}
+	return false
+}
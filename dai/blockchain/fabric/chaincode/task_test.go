@@ -0,0 +1,232 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+
+	"github.com/PaddlePaddle/PaddleDTX/dai/blockchain"
+	pbTask "github.com/PaddlePaddle/PaddleDTX/dai/protos/task"
+)
+
+// fileChaincode mocks the chaincode of XuperDB, which keeps expire time of files by id
+type fileChaincode struct {
+	files map[string]int64
+}
+
+func (c *fileChaincode) Init(stub shim.ChaincodeStubInterface) pb.Response {
+	return shim.Success(nil)
+}
+
+func (c *fileChaincode) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
+	fn, args := stub.GetFunctionAndParameters()
+	if fn != "GetFileByID" || len(args) < 2 {
+		return shim.Error("Invalid invoke function name.")
+	}
+	ctime, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	expire, ok := c.files[args[0]]
+	if !ok {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound, "file not found").Error())
+	}
+	if expire < ctime {
+		return shim.Error(errorx.New(errorx.ErrCodeExpired, "file already expire").Error())
+	}
+	return shim.Success([]byte(args[0]))
+}
+
+// invoke runs a chaincode method in a new transaction
+func invoke(stub *shim.MockStub, fn string, args ...interface{}) pb.Response {
+	bs := [][]byte{[]byte(fn)}
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case string:
+			bs = append(bs, []byte(v))
+		case []byte:
+			bs = append(bs, v)
+		default:
+			s, _ := json.Marshal(v)
+			bs = append(bs, s)
+		}
+	}
+	return stub.MockInvoke(strconv.FormatInt(time.Now().UnixNano(), 10), bs)
+}
+
+func checkOK(t *testing.T, resp pb.Response) {
+	t.Helper()
+	if resp.Status != shim.OK {
+		t.Fatalf("unexpected error: %s", resp.Message)
+	}
+}
+
+func checkErrCode(t *testing.T, resp pb.Response, code string) {
+	t.Helper()
+	c, _, _ := errorx.TryParseFromString(resp.Message)
+	if resp.Status != shim.ERROR || c != code {
+		t.Fatalf("expected error %s, got %d %s", code, resp.Status, resp.Message)
+	}
+}
+
+func sign(t *testing.T, privkey ecdsa.PrivateKey, mes []byte) []byte {
+	t.Helper()
+	sig, err := ecdsa.Sign(privkey, hash.Hash(mes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig[:]
+}
+
+func TestTaskLifecycle(t *testing.T) {
+	files := &fileChaincode{files: make(map[string]int64)}
+	fileStub := shim.NewMockStub("xdb", files)
+	stub := shim.NewMockStub("paddlempc", new(xdata))
+	stub.MockPeerChaincode("xdb", fileStub)
+	checkOK(t, stub.MockInit("init", [][]byte{[]byte("init"), []byte("xdb")}))
+
+	reqPriv, reqPub, err := ecdsa.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	privs := make([]ecdsa.PrivateKey, 2)
+	pubs := make([]ecdsa.PublicKey, 2)
+	for i := range privs {
+		if privs[i], pubs[i], err = ecdsa.GenerateKeyPair(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Now().UnixNano()
+	files.files["data1"] = now + time.Hour.Nanoseconds()
+
+	publish := func(id string) {
+		task := &pbTask.FLTask{
+			ID:          id,
+			Name:        id,
+			Requester:   reqPub[:],
+			PublishTime: now,
+			DataSets: []*pbTask.DataForTask{
+				{Owner: pubs[0][:], DataID: "data1"},
+				{Owner: pubs[1][:], DataID: "data2"},
+			},
+		}
+		s, err := json.Marshal(task)
+		if err != nil {
+			t.Fatal(err)
+		}
+		opt := blockchain.PublishFLTaskOptions{FLTask: task, Signature: sign(t, reqPriv, s)}
+		checkOK(t, invoke(stub, "PublishTask", opt))
+		checkErrCode(t, invoke(stub, "PublishTask", opt), errorx.ErrCodeAlreadyExists)
+	}
+	confirm := func(fn, id string, i int, ctime int64) pb.Response {
+		m := fmt.Sprintf("%x,%s,%d", pubs[i][:], id, ctime)
+		return invoke(stub, fn, blockchain.FLTaskConfirmOptions{Owner: pubs[i][:], TaskID: id,
+			CurrentTime: ctime, Signature: sign(t, privs[i], []byte(m))})
+	}
+	status := func(id string) string {
+		resp := invoke(stub, "GetTaskById", id)
+		checkOK(t, resp)
+		var task pbTask.FLTask
+		if err := json.Unmarshal(resp.Payload, &task); err != nil {
+			t.Fatal(err)
+		}
+		return task.Status
+	}
+
+	// a task is ready once all executors confirmed with their files published on XuperDB
+	publish("task1")
+	if s := status("task1"); s != blockchain.TaskConfirming {
+		t.Fatalf("unexpected status %s", s)
+	}
+	checkOK(t, confirm("ConfirmTask", "task1", 0, now+1))
+	checkErrCode(t, confirm("ConfirmTask", "task1", 0, now+2), errorx.ErrCodeParam)
+	checkErrCode(t, confirm("ConfirmTask", "task1", 1, now+3), errorx.ErrCodeParam)
+	files.files["data2"] = now
+	checkErrCode(t, confirm("ConfirmTask", "task1", 1, now+4), errorx.ErrCodeParam)
+	files.files["data2"] = now + time.Hour.Nanoseconds()
+	if s := status("task1"); s != blockchain.TaskConfirming {
+		t.Fatalf("unexpected status %s", s)
+	}
+	checkOK(t, confirm("ConfirmTask", "task1", 1, now+5))
+	if s := status("task1"); s != blockchain.TaskReady {
+		t.Fatalf("unexpected status %s", s)
+	}
+
+	// only the requester starts the task
+	mes := []byte(fmt.Sprintf("%s,%x", "task1", reqPub[:]))
+	checkErrCode(t, invoke(stub, "StartTask", "task1", sign(t, privs[0], mes)), errorx.ErrCodeBadSignature)
+	checkOK(t, invoke(stub, "StartTask", "task1", sign(t, reqPriv, mes)))
+	if s := status("task1"); s != blockchain.TaskToProcess {
+		t.Fatalf("unexpected status %s", s)
+	}
+
+	// executors run and finish the task
+	execute := func(fn string, i int, ctime int64, result string) pb.Response {
+		m := fmt.Sprintf("%x,%s,%d", pubs[i][:], "task1", ctime)
+		if fn == "FinishTask" {
+			m += fmt.Sprintf("%s,%x", "", result)
+		}
+		return invoke(stub, fn, blockchain.FLTaskExeStatusOptions{Executor: pubs[i][:], TaskID: "task1",
+			CurrentTime: ctime, Result: result, Signature: sign(t, privs[i], []byte(m))})
+	}
+	checkErrCode(t, execute("FinishTask", 0, now+6, "result"), errorx.ErrCodeParam)
+	checkOK(t, execute("ExecuteTask", 0, now+7, ""))
+	if s := status("task1"); s != blockchain.TaskProcessing {
+		t.Fatalf("unexpected status %s", s)
+	}
+	checkOK(t, execute("FinishTask", 1, now+8, "result"))
+	resp := invoke(stub, "GetTaskById", "task1")
+	checkOK(t, resp)
+	var task pbTask.FLTask
+	if err := json.Unmarshal(resp.Payload, &task); err != nil {
+		t.Fatal(err)
+	}
+	if task.Status != blockchain.TaskFinished || task.Result != "result" || task.EndTime != now+8 {
+		t.Fatalf("unexpected task %v", task)
+	}
+
+	// a task rejected by one of the executors could not be started
+	publish("task2")
+	checkOK(t, confirm("RejectTask", "task2", 0, now+9))
+	if s := status("task2"); s != blockchain.TaskRejected {
+		t.Fatalf("unexpected status %s", s)
+	}
+	checkErrCode(t, confirm("ConfirmTask", "task2", 1, now+10), errorx.ErrCodeParam)
+	mes = []byte(fmt.Sprintf("%s,%x", "task2", reqPub[:]))
+	checkErrCode(t, invoke(stub, "StartTask", "task2", sign(t, reqPriv, mes)), errorx.ErrCodeParam)
+
+	// tasks are listed for the requester and executors
+	for _, pubkey := range [][]byte{reqPub[:], pubs[0][:]} {
+		resp := invoke(stub, "ListTask", blockchain.ListFLTaskOptions{PubKey: pubkey, TimeEnd: now + 1})
+		checkOK(t, resp)
+		var tasks blockchain.FLTasks
+		if err := json.Unmarshal(resp.Payload, &tasks); err != nil {
+			t.Fatal(err)
+		}
+		if len(tasks) != 2 {
+			t.Fatalf("unexpected tasks %v", tasks)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fabric
+
+import (
+	"context"
+	"encoding/json"
+
+	xdatachain "github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+
+	"github.com/PaddlePaddle/PaddleDTX/dai/blockchain"
+)
+
+// RegisterDataNode registers Executor node to fabric
+func (f *Fabric) RegisterDataNode(ctx context.Context,
+	opt *blockchain.AddNodeOptions) error {
+	opts, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to marshal AddNodeOptions")
+	}
+	if _, err = f.InvokeContract([][]byte{opts}, "RegisterDataNode"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListDataNodes gets all Executor nodes from fabric
+func (f *Fabric) ListDataNodes(ctx context.Context) (blockchain.DataNodes, error) {
+	var nodes blockchain.DataNodes
+	s, err := f.QueryContract([][]byte{}, "ListDataNodes")
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(s, &nodes); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to unmarshal data nodes")
+	}
+	return nodes, nil
+}
+
+// GetDataNodeByID gets Executor node by ID
+func (f *Fabric) GetDataNodeByID(ctx context.Context, id []byte) (node blockchain.DataNode, err error) {
+	s, err := f.QueryContract([][]byte{id}, "GetDataNodeByID")
+	if err != nil {
+		return node, err
+	}
+	if err = json.Unmarshal(s, &node); err != nil {
+		return node, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to unmarshal data nodes")
+	}
+	return node, err
+}
+
+// GetFileByID gets file stored in XuperDB by id from the chaincode of XuperDB
+func (f *Fabric) GetFileByID(ctx context.Context, id string) (xdatachain.File, error) {
+	return f.files.GetFileByID(ctx, id)
+}
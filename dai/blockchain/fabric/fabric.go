@@ -0,0 +1,73 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fabric
+
+import (
+	"github.com/sirupsen/logrus"
+
+	fabricblockchain "github.com/PaddlePaddle/PaddleDTX/xdb/blockchain/fabric"
+	xdataconfig "github.com/PaddlePaddle/PaddleDTX/xdb/config"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+
+	"github.com/PaddlePaddle/PaddleDTX/dai/config"
+)
+
+var logger = logrus.WithField("module", "fabric")
+
+// Fabric requests the chaincode of Distributed AI, and the chaincode of XuperDB
+//  on the same channel for files, so storage and computing share a single network
+type Fabric struct {
+	chain *fabricblockchain.Fabric // chain requests the chaincode of Distributed AI
+	files *fabricblockchain.Fabric // files requests the chaincode of XuperDB with the same sdk
+}
+
+// New creates a Fabric client used for connecting and requesting blockchain
+func New(conf *config.FabricConf) (*Fabric, error) {
+	if len(conf.XuperDBChaincode) == 0 {
+		return nil, errorx.New(errorx.ErrCodeConfig, "missing chaincode of XuperDB")
+	}
+	fc, err := fabricblockchain.New(&xdataconfig.FabricConf{
+		ConfigFile: conf.ConfigFile,
+		ChannelId:  conf.ChannelId,
+		Chaincode:  conf.Chaincode,
+		UserName:   conf.UserName,
+		OrgName:    conf.OrgName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files := *fc
+	filesConfig := *fc.Config
+	filesConfig.ChaincodeID = conf.XuperDBChaincode
+	files.Config = &filesConfig
+
+	return &Fabric{chain: fc, files: &files}, nil
+}
+
+// InvokeContract invokes the chaincode of Distributed AI
+func (f *Fabric) InvokeContract(args [][]byte, mName string) ([]byte, error) {
+	return f.chain.InvokeContract(args, mName)
+}
+
+// QueryContract queries the chaincode of Distributed AI
+func (f *Fabric) QueryContract(args [][]byte, mName string) ([]byte, error) {
+	return f.chain.QueryContract(args, mName)
+}
+
+// Close closes client
+func (f *Fabric) Close() {
+	f.chain.Sdk.Close()
+	logger.Info("close fabric client")
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fabric
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+
+	"github.com/PaddlePaddle/PaddleDTX/dai/blockchain"
+)
+
+// PublishTask publishes task on fabric
+func (f *Fabric) PublishTask(ctx context.Context,
+	opt *blockchain.PublishFLTaskOptions) error {
+	opts, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to marshal PublishFLTaskOptions")
+	}
+	if _, err = f.InvokeContract([][]byte{opts}, "PublishTask"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListTask lists tasks from fabric
+func (f *Fabric) ListTask(ctx context.Context, opt *blockchain.ListFLTaskOptions) (
+	blockchain.FLTasks, error) {
+	var ts blockchain.FLTasks
+
+	opts, err := json.Marshal(*opt)
+	if err != nil {
+		return ts, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to marshal ListFLTaskOptions")
+	}
+	s, err := f.QueryContract([][]byte{opts}, "ListTask")
+	if err != nil {
+		return ts, err
+	}
+	if err = json.Unmarshal(s, &ts); err != nil {
+		return ts, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to unmarshal FLTasks")
+	}
+	return ts, nil
+}
+
+// GetTaskById gets task by id
+func (f *Fabric) GetTaskById(ctx context.Context, id string) (blockchain.FLTask, error) {
+	var t blockchain.FLTask
+	s, err := f.QueryContract([][]byte{[]byte(id)}, "GetTaskById")
+	if err != nil {
+		return t, err
+	}
+	if err = json.Unmarshal(s, &t); err != nil {
+		return t, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to unmarshal FLTask")
+	}
+	return t, nil
+}
+
+// ConfirmTask is called when Executor confirms task
+func (f *Fabric) ConfirmTask(ctx context.Context, opt *blockchain.FLTaskConfirmOptions) error {
+	return f.setTaskConfirmStatus(ctx, opt, true)
+}
+
+// RejectTask is called when Executor rejects task
+func (f *Fabric) RejectTask(ctx context.Context, opt *blockchain.FLTaskConfirmOptions) error {
+	return f.setTaskConfirmStatus(ctx, opt, false)
+}
+
+func (f *Fabric) setTaskConfirmStatus(ctx context.Context, opt *blockchain.FLTaskConfirmOptions, isConfirm bool) error {
+	opts, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to marshal FLTaskConfirmOptions")
+	}
+	mName := "RejectTask"
+	if isConfirm {
+		mName = "ConfirmTask"
+	}
+	if _, err := f.InvokeContract([][]byte{opts}, mName); err != nil {
+		return err
+	}
+	return nil
+}
+
+// StartTask is called when Requester starts task after all Executors confirmed
+func (f *Fabric) StartTask(ctx context.Context, id string, sig []byte) error {
+	if _, err := f.InvokeContract([][]byte{[]byte(id), sig}, "StartTask"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ExecuteTask is called when Executor run task
+func (f *Fabric) ExecuteTask(ctx context.Context, opt *blockchain.FLTaskExeStatusOptions) error {
+	return f.setTaskExecuteStatus(ctx, opt, false)
+}
+
+// FinishTask is called when task execution finished
+func (f *Fabric) FinishTask(ctx context.Context, opt *blockchain.FLTaskExeStatusOptions) error {
+	return f.setTaskExecuteStatus(ctx, opt, true)
+}
+
+func (f *Fabric) setTaskExecuteStatus(ctx context.Context, opt *blockchain.FLTaskExeStatusOptions, isFinish bool) error {
+	opts, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"fail to marshal FLTaskExeStatusOptions")
+	}
+	mName := "ExecuteTask"
+	if isFinish {
+		mName = "FinishTask"
+	}
+	if _, err := f.InvokeContract([][]byte{opts}, mName); err != nil {
+		return err
+	}
+	return nil
+}
@@ -14,18 +14,33 @@
 package xchain
 
 import (
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
 	"github.com/sirupsen/logrus"
 
+	xdatachain "github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
 	xchainblockchain "github.com/PaddlePaddle/PaddleDTX/xdb/blockchain/xchain"
 	xdataconfig "github.com/PaddlePaddle/PaddleDTX/xdb/config"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
 
 	"github.com/PaddlePaddle/PaddleDTX/dai/config"
 )
 
 var logger = logrus.WithField("module", "xchain")
 
+// Contract runs methods of the contract and returns the response body
+type Contract interface {
+	Invoke(args map[string]string, mName string) ([]byte, error)
+	Query(args map[string]string, mName string) ([]byte, error)
+}
+
 type XChain struct {
 	xchainblockchain.XChain
+	Contract Contract // Contract runs the contract in process instead of requesting blockchain if set
 }
 
 // New creates a XChain client used for connecting and requesting blockchain
@@ -41,11 +56,54 @@ func New(conf *config.XchainConf) (*XChain, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &XChain{*xc}, nil
+	return &XChain{XChain: *xc}, nil
+}
+
+// InvokeContract invokes the contract
+func (x *XChain) InvokeContract(args map[string]string, mName string) ([]byte, error) {
+	if x.Contract != nil {
+		return x.Contract.Invoke(args, mName)
+	}
+	return x.XChain.InvokeContract(args, mName)
+}
+
+// QueryContract queries the contract
+func (x *XChain) QueryContract(args map[string]string, mName string) ([]byte, error) {
+	if x.Contract != nil {
+		return x.Contract.Query(args, mName)
+	}
+	return x.XChain.QueryContract(args, mName)
+}
+
+// GetFileByID gets file by id from blockchain, which also works with the embedded contract
+func (x *XChain) GetFileByID(ctx context.Context, id string) (xdatachain.File, error) {
+	var f xdatachain.File
+	args := map[string]string{
+		"id":          id,
+		"currentTime": strconv.FormatInt(time.Now().UnixNano(), 10),
+	}
+	s, err := x.QueryContract(args, "GetFileByID")
+	if err != nil {
+		return f, err
+	}
+	if err = json.Unmarshal(s, &f); err != nil {
+		return f, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal File")
+	}
+	return f, nil
 }
 
 // Close closes client
 func (x *XChain) Close() {
+	if x.Contract != nil {
+		if c, ok := x.Contract.(io.Closer); ok {
+			if err := c.Close(); err != nil {
+				logger.WithError(err).Error("failed to close embedded contract")
+			}
+		}
+		logger.Info("close embedded xchain")
+		return
+	}
 	if err := x.XChain.XchainClient.XchainConn.Close(); err != nil {
 		logger.WithError(err).Error("failed to close xchain client")
 	}
@@ -8,9 +8,9 @@ executorClientBinary=executor-cli
 requesterClientBinary=requester-cli
 #使用临时容器编译私有化产出，使得工作镜像更精简
 docker run -it --rm \
-    -v ${PWD}:/workspace \
+    -v ${PWD}/..:/workspace \
     -v ~/.ssh:/root/.ssh \
-    -w /workspace \
+    -w /workspace/dai \
     -e GONOSUMDB=* \
     -e GOPROXY=https://goproxy.cn \
     -e GO111MODULE=on \
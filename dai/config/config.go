@@ -31,6 +31,7 @@ type ExecutorConf struct {
 	Mpc           *ExecutorMpcConf
 	Storage       *ExecutorStorageConf
 	Blockchain    *ExecutorBlockchainConf
+	Metrics       *MetricsConf
 }
 
 type ExecutorMpcConf struct {
@@ -54,8 +55,10 @@ type XuperDBConf struct {
 }
 
 type ExecutorBlockchainConf struct {
-	Type   string
-	Xchain *XchainConf
+	Type     string
+	Xchain   *XchainConf
+	Fabric   *FabricConf
+	Embedded *EmbeddedConf
 }
 
 type XchainConf struct {
@@ -66,6 +69,25 @@ type XchainConf struct {
 	ChainName       string
 }
 
+// FabricConf is the configuration of fabric, the chaincode of XuperDB must be on the same channel
+type FabricConf struct {
+	ConfigFile       string
+	ChannelId        string
+	Chaincode        string
+	XuperDBChaincode string // chaincode of XuperDB which stores files used by tasks
+	UserName         string
+	OrgName          string
+}
+
+// EmbeddedConf is the configuration of the in-process blockchain used for offline development
+type EmbeddedConf struct {
+	DBPath string // BoltDB file to persist the ledger, the ledger is kept in memory if empty
+}
+
+type MetricsConf struct {
+	ListenAddress string
+}
+
 type Log struct {
 	Level string
 	Path  string
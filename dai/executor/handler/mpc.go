@@ -33,6 +33,7 @@ import (
 	reModel "github.com/PaddlePaddle/PaddleDTX/dai/crypto/vl/common"
 	"github.com/PaddlePaddle/PaddleDTX/dai/errcodes"
 	"github.com/PaddlePaddle/PaddleDTX/dai/executor/storage"
+	"github.com/PaddlePaddle/PaddleDTX/dai/metrics"
 	"github.com/PaddlePaddle/PaddleDTX/dai/mpc"
 	"github.com/PaddlePaddle/PaddleDTX/dai/mpc/cluster"
 	"github.com/PaddlePaddle/PaddleDTX/dai/p2p"
@@ -160,6 +161,7 @@ func (m *MpcModelHandler) addTaskIntoMpcHandler(task blockchain.FLTask) error {
 		FLTask:      *task,
 		ExpiredTime: time.Now().UnixNano() + m.MpcTaskMaxExecTime.Nanoseconds(),
 	}
+	metrics.ExecutingTasks.WithLabelValues(task.AlgoParam.TaskType.String()).Inc()
 	return nil
 }
 
@@ -232,6 +234,9 @@ func (m *MpcModelHandler) updateTaskStatusAndStopLocalMpc(taskID, executeErr, ex
 // stopLocalMpcTask stops mpc task
 func (m *MpcModelHandler) stopLocalMpcTask(taskId string) {
 	m.Lock()
+	if task, ok := m.MpcTasks[taskId]; ok {
+		metrics.ExecutingTasks.WithLabelValues(task.AlgoParam.TaskType.String()).Dec()
+	}
 	delete(m.MpcTasks, taskId)
 	m.Unlock()
 	//notify MPC of stop
@@ -331,6 +336,11 @@ func (m *MpcModelHandler) UpdateTaskFinishStatus(taskId, taskErr, taskResult str
 	if err := m.Chain.FinishTask(context.TODO(), execTaskOptions); err != nil {
 		return err
 	}
+	status := blockchain.TaskFinished
+	if taskErr != "" {
+		status = blockchain.TaskFailed
+	}
+	metrics.Tasks.WithLabelValues(task.AlgoParam.TaskType.String(), status).Inc()
 	return nil
 }
 
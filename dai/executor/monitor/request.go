@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/PaddlePaddle/PaddleDTX/dai/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/dai/metrics"
 	pbCom "github.com/PaddlePaddle/PaddleDTX/dai/protos/common"
 )
 
@@ -97,6 +98,7 @@ func (t *TaskMonitor) getToProcessTaskAndStart(ctx context.Context) error {
 		if err := t.updateTaskExecStatus(task.ID); err != nil {
 			continue
 		}
+		metrics.Tasks.WithLabelValues(task.AlgoParam.TaskType.String(), blockchain.TaskProcessing).Inc()
 		// 4. prepare resources before starting local MPC task
 		startRequest, err := t.MpcHandler.TaskStartPrepare(task)
 		if err != nil {
@@ -8,11 +8,20 @@ require (
 	github.com/docker/go-metrics v0.0.1 // indirect
 	github.com/golang/protobuf v1.5.2
 	github.com/google/uuid v1.2.0
+	github.com/hyperledger/fabric v1.4.4
 	github.com/lestrrat-go/file-rotatelogs v2.4.0+incompatible
+	github.com/prometheus/client_golang v1.1.0
 	github.com/sirupsen/logrus v1.8.1
 	github.com/spf13/cobra v1.1.3
 	github.com/spf13/viper v1.7.1
 	github.com/xuperchain/xuperchain v0.0.0-20210208123615-2d08ff11de3e
+	go.etcd.io/bbolt v1.3.5
 	golang.org/x/net v0.0.0-20210917221730-978cfadd31cf
 	google.golang.org/grpc v1.41.0
+	google.golang.org/protobuf v1.27.1
 )
+
+replace github.com/go-kit/kit => github.com/go-kit/kit v0.8.0
+
+// the embedded blockchain reuses the ledger of XuperDB in the same repository
+replace github.com/PaddlePaddle/PaddleDTX/xdb => ../xdb
@@ -23,6 +23,7 @@ import (
 
 	"github.com/PaddlePaddle/PaddleDTX/dai/config"
 	"github.com/PaddlePaddle/PaddleDTX/dai/executor/engine"
+	"github.com/PaddlePaddle/PaddleDTX/dai/metrics"
 	pbTask "github.com/PaddlePaddle/PaddleDTX/dai/protos/task"
 	"github.com/PaddlePaddle/PaddleDTX/dai/server"
 	"github.com/PaddlePaddle/PaddleDTX/dai/util/logging"
@@ -70,6 +71,15 @@ func main() {
 	}
 	defer taskEngine.Close()
 
+	// start metrics server if configured
+	if executorConf.Metrics != nil {
+		go func() {
+			if err := metrics.Serve(ctx, executorConf.Metrics.ListenAddress); err != nil && err != context.Canceled {
+				logrus.WithError(err).Error("failed to start metrics server")
+			}
+		}()
+	}
+
 	srv, err := server.New(executorConf)
 	if err != nil {
 		logrus.WithError(err).Error("failed to initiate server")
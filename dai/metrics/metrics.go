@@ -0,0 +1,98 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "dai"
+
+// label values shared by collectors
+const (
+	StatusSuccess = "success"
+	StatusFailed  = "failed"
+
+	// directions of Step RPC payloads
+	DirectionSent     = "sent"
+	DirectionReceived = "received"
+
+	// Paillier operations
+	PaillierKeyGen   = "keygen"
+	PaillierEncrypt  = "encrypt"
+	PaillierEvaluate = "evaluate"
+	PaillierDecrypt  = "decrypt"
+)
+
+var (
+	// Tasks counts task status transitions made by local executor, status is the status on blockchain
+	Tasks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tasks_total",
+		Help:      "Number of tasks whose status was updated onto blockchain by local executor.",
+	}, []string{"type", "status"})
+
+	// ExecutingTasks is the number of tasks in execution pool
+	ExecutingTasks = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "executing_tasks",
+		Help:      "Number of tasks in execution pool.",
+	}, []string{"type"})
+
+	// TrainingRounds counts training rounds finished by learners
+	TrainingRounds = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "training_rounds_total",
+		Help:      "Number of training rounds started by learners.",
+	}, []string{"algorithm"})
+
+	// PaillierDuration observes time spent on Paillier homomorphic operations
+	PaillierDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "paillier_duration_seconds",
+		Help:      "Time spent on Paillier key generation, encryption, homomorphic evaluation and decryption.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16),
+	}, []string{"algorithm", "operation"})
+
+	// StepRPCDuration observes latency of mpc.Cluster Step requests sent to other executors
+	StepRPCDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "step_rpc_duration_seconds",
+		Help:      "Latency of Step RPC sent to other executors.",
+		Buckets:   prometheus.ExponentialBuckets(0.005, 2, 14),
+	}, []string{"type", "status"})
+
+	// StepRPCBytes counts Step RPC payload bytes
+	StepRPCBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "step_rpc_bytes_total",
+		Help:      "Bytes of Step RPC requests sent and responses received.",
+	}, []string{"type", "direction"})
+)
+
+// Status returns the status label value according to err
+func Status(err error) string {
+	if err != nil {
+		return StatusFailed
+	}
+	return StatusSuccess
+}
+
+// Since returns seconds elapsed since start, used to observe histograms
+func Since(start time.Time) float64 {
+	return time.Since(start).Seconds()
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	logger = logrus.WithField("module", "metrics")
+)
+
+// Serve exposes collectors on listenAddress with path "/metrics",
+//  and blocks until ctx is done
+func Serve(ctx context.Context, listenAddress string) error {
+	if listenAddress == "" {
+		return errorx.New(errorx.ErrCodeConfig, "missing config: metrics listenAddress")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	srv := &http.Server{
+		Addr:    listenAddress,
+		Handler: mux,
+	}
+
+	go func() {
+		<-ctx.Done()
+		srv.Close()
+	}()
+
+	logger.WithField("address", listenAddress).Info("metrics server start")
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return errorx.Wrap(err, "failed to serve metrics")
+	}
+	return ctx.Err()
+}
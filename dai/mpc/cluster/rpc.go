@@ -17,10 +17,12 @@ import (
 	"time"
 
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 
 	"github.com/PaddlePaddle/PaddleDTX/dai/errcodes"
+	"github.com/PaddlePaddle/PaddleDTX/dai/metrics"
 	"github.com/PaddlePaddle/PaddleDTX/dai/p2p"
 	pb "github.com/PaddlePaddle/PaddleDTX/dai/protos/mpc"
 )
@@ -81,7 +83,7 @@ func (rc *RpcClient) StepPredict(req *pb.PredictRequest, peerName string) (*pb.P
 			PredictRequest: req,
 		},
 	}
-	stepResp, err := c.Step(ctx, stepReq)
+	stepResp, err := rc.step(ctx, c, stepReq, "predict")
 	if err != nil {
 		logger.Warningf("Step response is error: %s", err.Error())
 		return nil, err
@@ -112,7 +114,7 @@ func (rc *RpcClient) StepTrain(req *pb.TrainRequest, peerName string) (*pb.Train
 			TrainRequest: req,
 		},
 	}
-	stepResp, err := c.Step(ctx, stepReq)
+	stepResp, err := rc.step(ctx, c, stepReq, "train")
 	if err != nil {
 		logger.Warningf("Step response is error: %s", err.Error())
 		return nil, err
@@ -122,6 +124,18 @@ func (rc *RpcClient) StepTrain(req *pb.TrainRequest, peerName string) (*pb.Train
 	return resp, err
 }
 
+// step sends Step request and records its latency and payload size
+func (rc *RpcClient) step(ctx context.Context, c pb.ClusterClient, req *pb.StepRequest, reqType string) (*pb.StepResponse, error) {
+	start := time.Now()
+	resp, err := c.Step(ctx, req)
+	metrics.StepRPCDuration.WithLabelValues(reqType, metrics.Status(err)).Observe(metrics.Since(start))
+	metrics.StepRPCBytes.WithLabelValues(reqType, metrics.DirectionSent).Add(float64(proto.Size(req)))
+	if err == nil {
+		metrics.StepRPCBytes.WithLabelValues(reqType, metrics.DirectionReceived).Add(float64(proto.Size(resp)))
+	}
+	return resp, err
+}
+
 // NewRpcClient returns RpcClient instance
 // timeout eg. 3*time.Second
 // connection releases when timeout elapses
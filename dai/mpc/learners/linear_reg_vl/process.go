@@ -16,6 +16,7 @@ package linear_reg_vl
 import (
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/PaddlePaddle/PaddleDTX/crypto/common/math/homomorphism/paillier"
 	mlCom "github.com/PaddlePaddle/PaddleDTX/crypto/core/machine_learning/common"
@@ -25,6 +26,7 @@ import (
 	vlCom "github.com/PaddlePaddle/PaddleDTX/dai/crypto/vl/common"
 	"github.com/PaddlePaddle/PaddleDTX/dai/crypto/vl/linear"
 	"github.com/PaddlePaddle/PaddleDTX/dai/errcodes"
+	"github.com/PaddlePaddle/PaddleDTX/dai/metrics"
 	pbCom "github.com/PaddlePaddle/PaddleDTX/dai/protos/common"
 )
 
@@ -99,6 +101,7 @@ func (p *process) upRound(newRound uint64) error {
 	}
 
 	p.round++
+	metrics.TrainingRounds.WithLabelValues("linear_reg_vl").Inc()
 
 	p.lastCost = p.cost
 	p.thetas = p.nextThetas
@@ -149,7 +152,9 @@ func (p *process) calLocalGradientAndCost() ([]byte, int, error) {
 		return p.partBytesForOther, p.calLocalGradientAndCostTimes, nil
 	}
 
+	start := time.Now()
 	rawPart, otherPartBytes, newSet, err := linear.CalLocalGradientAndCost(p.trainDataSet, p.thetas, *p.params, &p.homoPriv.PublicKey, int(p.round))
+	metrics.PaillierDuration.WithLabelValues("linear_reg_vl", metrics.PaillierEncrypt).Observe(metrics.Since(start))
 	if err != nil {
 		return []byte{}, p.calLocalGradientAndCostTimes, errorx.New(errcodes.ErrCodeInternal, "mistake[%s] happened when linear_reg_vl calLocalGradientAndCost", err.Error())
 	}
@@ -196,7 +201,9 @@ func (p *process) calEncGradientAndCost() ([]byte, []byte, int, error) {
 		return []byte{}, []byte{}, p.calEncGradientAndCostTimes, nil
 	}
 
+	start := time.Now()
 	encGradForOther, encCostForOther, gradientNoise, costNoise, err := linear.CalEncGradientAndCost(p.rawPart, p.partBytesFromOther, p.trainDataSet, *p.params, p.homoPubOfOther, p.thetas, int(p.round))
+	metrics.PaillierDuration.WithLabelValues("linear_reg_vl", metrics.PaillierEvaluate).Observe(metrics.Since(start))
 	if err != nil {
 		return []byte{}, []byte{}, p.calEncGradientAndCostTimes, errorx.New(errcodes.ErrCodeInternal, "mistake[%s] happened when linear_reg_vl calEncGradientAndCost", err.Error())
 	}
@@ -237,7 +244,9 @@ func (p *process) decGradientAndCost() ([]byte, []byte, int, error) {
 		return p.gradBytesForOther, p.costBytesForOther, p.decGradientAndCostTimes, nil
 	}
 
+	start := time.Now()
 	gradBytesForOther, costBytesForOther, err := linear.DecGradientAndCost(p.encGradFromOther, p.encCostFromOther, p.homoPriv)
+	metrics.PaillierDuration.WithLabelValues("linear_reg_vl", metrics.PaillierDecrypt).Observe(metrics.Since(start))
 	if err != nil {
 		return []byte{}, []byte{}, p.decGradientAndCostTimes, errorx.New(errcodes.ErrCodeInternal, "mistake[%s] happened when linear_reg_vl decGradientAndCost", err.Error())
 	}
@@ -15,6 +15,7 @@ package logic_reg_vl
 
 import (
 	"sync"
+	"time"
 
 	"github.com/PaddlePaddle/PaddleDTX/crypto/common/math/homomorphism/paillier"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
@@ -23,6 +24,7 @@ import (
 
 	crypCom "github.com/PaddlePaddle/PaddleDTX/dai/crypto/vl/common"
 	"github.com/PaddlePaddle/PaddleDTX/dai/errcodes"
+	"github.com/PaddlePaddle/PaddleDTX/dai/metrics"
 	"github.com/PaddlePaddle/PaddleDTX/dai/mpc/psi"
 	pbCom "github.com/PaddlePaddle/PaddleDTX/dai/protos/common"
 	pb "github.com/PaddlePaddle/PaddleDTX/dai/protos/mpc"
@@ -591,7 +593,9 @@ func NewLearner(id string, address string, params *pbCom.TrainParams, samplesFil
 		return nil, err
 	}
 
+	start := time.Now()
 	homoPriv, homoPub, err := crypCom.GenerateHomoKeyPair()
+	metrics.PaillierDuration.WithLabelValues("logic_reg_vl", metrics.PaillierKeyGen).Observe(metrics.Since(start))
 	if err != nil {
 		return nil, err
 	}
@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
-// source: common/common.proto
+// source: common/dai_common.proto
 
 package common
 
@@ -43,7 +43,7 @@ func (x Algorithm) String() string {
 }
 
 func (Algorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{0}
+	return fileDescriptor_3ba1e23467ae2d28, []int{0}
 }
 
 // TaskType defines types of task
@@ -69,7 +69,7 @@ func (x TaskType) String() string {
 }
 
 func (TaskType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{1}
+	return fileDescriptor_3ba1e23467ae2d28, []int{1}
 }
 
 // RegMode regulation mode for training
@@ -98,7 +98,7 @@ func (x RegMode) String() string {
 }
 
 func (RegMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{2}
+	return fileDescriptor_3ba1e23467ae2d28, []int{2}
 }
 
 // TrainParams lists all the parameters for training
@@ -122,7 +122,7 @@ func (m *TrainParams) Reset()         { *m = TrainParams{} }
 func (m *TrainParams) String() string { return proto.CompactTextString(m) }
 func (*TrainParams) ProtoMessage()    {}
 func (*TrainParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{0}
+	return fileDescriptor_3ba1e23467ae2d28, []int{0}
 }
 
 func (m *TrainParams) XXX_Unmarshal(b []byte) error {
@@ -230,7 +230,7 @@ func (m *TrainModels) Reset()         { *m = TrainModels{} }
 func (m *TrainModels) String() string { return proto.CompactTextString(m) }
 func (*TrainModels) ProtoMessage()    {}
 func (*TrainModels) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{1}
+	return fileDescriptor_3ba1e23467ae2d28, []int{1}
 }
 
 func (m *TrainModels) XXX_Unmarshal(b []byte) error {
@@ -309,7 +309,7 @@ func (m *TaskParams) Reset()         { *m = TaskParams{} }
 func (m *TaskParams) String() string { return proto.CompactTextString(m) }
 func (*TaskParams) ProtoMessage()    {}
 func (*TaskParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{2}
+	return fileDescriptor_3ba1e23467ae2d28, []int{2}
 }
 
 func (m *TaskParams) XXX_Unmarshal(b []byte) error {
@@ -380,7 +380,7 @@ func (m *TrainTaskResult) Reset()         { *m = TrainTaskResult{} }
 func (m *TrainTaskResult) String() string { return proto.CompactTextString(m) }
 func (*TrainTaskResult) ProtoMessage()    {}
 func (*TrainTaskResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{3}
+	return fileDescriptor_3ba1e23467ae2d28, []int{3}
 }
 
 func (m *TrainTaskResult) XXX_Unmarshal(b []byte) error {
@@ -444,7 +444,7 @@ func (m *PredictTaskResult) Reset()         { *m = PredictTaskResult{} }
 func (m *PredictTaskResult) String() string { return proto.CompactTextString(m) }
 func (*PredictTaskResult) ProtoMessage()    {}
 func (*PredictTaskResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{4}
+	return fileDescriptor_3ba1e23467ae2d28, []int{4}
 }
 
 func (m *PredictTaskResult) XXX_Unmarshal(b []byte) error {
@@ -508,7 +508,7 @@ func (m *StartTaskRequest) Reset()         { *m = StartTaskRequest{} }
 func (m *StartTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*StartTaskRequest) ProtoMessage()    {}
 func (*StartTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{5}
+	return fileDescriptor_3ba1e23467ae2d28, []int{5}
 }
 
 func (m *StartTaskRequest) XXX_Unmarshal(b []byte) error {
@@ -570,7 +570,7 @@ func (m *StopTaskRequest) Reset()         { *m = StopTaskRequest{} }
 func (m *StopTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*StopTaskRequest) ProtoMessage()    {}
 func (*StopTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{6}
+	return fileDescriptor_3ba1e23467ae2d28, []int{6}
 }
 
 func (m *StopTaskRequest) XXX_Unmarshal(b []byte) error {
@@ -621,55 +621,55 @@ func init() {
 	proto.RegisterType((*StopTaskRequest)(nil), "common.StopTaskRequest")
 }
 
-func init() { proto.RegisterFile("common/common.proto", fileDescriptor_8f954d82c0b891f6) }
-
-var fileDescriptor_8f954d82c0b891f6 = []byte{
-	// 750 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdb, 0x6a, 0xe3, 0x46,
-	0x18, 0x8e, 0x7c, 0x94, 0x7f, 0xa5, 0x89, 0x32, 0x09, 0xad, 0x30, 0xa5, 0x15, 0x86, 0x82, 0x6b,
-	0x4a, 0x0c, 0x4e, 0x43, 0x93, 0x5e, 0x14, 0x72, 0x30, 0xc1, 0xe0, 0x38, 0x66, 0xec, 0x96, 0xd0,
-	0x9b, 0x30, 0x96, 0x66, 0x65, 0x11, 0xc9, 0x72, 0x34, 0xa3, 0x65, 0xbd, 0x57, 0xfb, 0x40, 0x7b,
-	0xb3, 0x8f, 0xb6, 0x6f, 0xb0, 0xcc, 0xc1, 0x96, 0x12, 0x12, 0x76, 0xc3, 0xde, 0x24, 0xf3, 0xfd,
-	0x87, 0xef, 0x9b, 0xff, 0x30, 0x16, 0xec, 0x7b, 0x49, 0x1c, 0x27, 0x8b, 0xae, 0xfa, 0x77, 0xb8,
-	0x4c, 0x13, 0x9e, 0xa0, 0x9a, 0x42, 0xad, 0x8f, 0x25, 0xb0, 0xa6, 0x29, 0x09, 0x17, 0x63, 0x92,
-	0x92, 0x98, 0xa1, 0x03, 0xa8, 0x46, 0x64, 0x46, 0x23, 0xc7, 0x70, 0x8d, 0x76, 0x03, 0x2b, 0x80,
-	0x7e, 0x86, 0x86, 0x3c, 0x8c, 0x48, 0x4c, 0x9d, 0x92, 0xf4, 0xe4, 0x06, 0xf4, 0x3b, 0xd4, 0x53,
-	0x1a, 0x5c, 0x27, 0x3e, 0x75, 0xca, 0xae, 0xd1, 0xde, 0xe9, 0xed, 0x1e, 0x6a, 0x2d, 0xac, 0xcc,
-	0x78, 0xed, 0x47, 0x4d, 0x30, 0x53, 0x1a, 0x48, 0x2d, 0xa7, 0xe2, 0x1a, 0x6d, 0x03, 0x6f, 0xb0,
-	0x90, 0x26, 0xd1, 0x72, 0x4e, 0x9c, 0xaa, 0x74, 0x28, 0x20, 0xa4, 0x49, 0xbc, 0x8c, 0x42, 0x9e,
-	0xf9, 0xd4, 0xa9, 0x49, 0x4f, 0x6e, 0x10, 0x7c, 0xc4, 0xf3, 0xb2, 0x94, 0x78, 0x2b, 0xa7, 0xee,
-	0x1a, 0xed, 0x32, 0xde, 0x60, 0x91, 0x19, 0xb2, 0x29, 0x11, 0xec, 0xdc, 0x31, 0x5d, 0xa3, 0x6d,
-	0xe2, 0xdc, 0x80, 0x7e, 0x84, 0x5a, 0xe8, 0xcb, 0x7a, 0x1a, 0xb2, 0x1e, 0x8d, 0x44, 0xd6, 0x39,
-	0xe1, 0xde, 0x7c, 0x12, 0xbe, 0xa7, 0x0e, 0x48, 0xca, 0xdc, 0xd0, 0xfa, 0x54, 0xd6, 0xed, 0x12,
-	0xd5, 0x44, 0x0c, 0xfd, 0x05, 0x35, 0x3e, 0xa7, 0x9c, 0x30, 0xc7, 0x70, 0xcb, 0x6d, 0xab, 0xf7,
-	0xeb, 0xba, 0xf2, 0x42, 0xd0, 0xe1, 0x54, 0x46, 0xf4, 0x17, 0x3c, 0x5d, 0x61, 0x1d, 0x8e, 0xfe,
-	0x84, 0xea, 0xbb, 0x19, 0x49, 0x99, 0x53, 0x92, 0x79, 0xbf, 0x3c, 0x97, 0x77, 0x2b, 0x02, 0x54,
-	0x9a, 0x0a, 0x16, 0x72, 0x2c, 0x0c, 0x62, 0xc2, 0x9c, 0xf2, 0xcb, 0x72, 0x13, 0x19, 0xa1, 0xe5,
-	0x54, 0x78, 0x3e, 0xd6, 0xca, 0x93, 0xb1, 0xe6, 0x1d, 0xaa, 0xbe, 0xdc, 0xa1, 0x5a, 0xb1, 0x43,
-	0xcd, 0x53, 0xb0, 0x0a, 0x15, 0x21, 0x1b, 0xca, 0xf7, 0x74, 0xa5, 0xf7, 0x45, 0x1c, 0x85, 0xd8,
-	0x5b, 0x12, 0x65, 0x6a, 0x53, 0x0c, 0xac, 0xc0, 0xdf, 0xa5, 0x13, 0xa3, 0x79, 0x02, 0x90, 0x17,
-	0xf5, 0xaa, 0xcc, 0x53, 0xb0, 0x0a, 0x75, 0xbd, 0x26, 0xb5, 0xf5, 0xd9, 0x00, 0x98, 0x12, 0x76,
-	0xaf, 0x37, 0xfc, 0x37, 0xa8, 0x90, 0x28, 0x48, 0x64, 0xee, 0x4e, 0x6f, 0x6f, 0xdd, 0xc1, 0xb3,
-	0x28, 0x48, 0xd2, 0x90, 0xcf, 0x63, 0x2c, 0xdd, 0xe8, 0x0f, 0x30, 0x39, 0x61, 0xf7, 0xd3, 0xd5,
-	0x52, 0x51, 0xee, 0xf4, 0xec, 0x4d, 0xb3, 0xb5, 0x1d, 0x6f, 0x22, 0xd0, 0x31, 0x58, 0x3c, 0x7f,
-	0x45, 0xf2, 0x19, 0x58, 0xbd, 0xfd, 0x47, 0xd3, 0x51, 0x2e, 0x5c, 0x8c, 0x43, 0x2e, 0x58, 0xb1,
-	0x18, 0x9a, 0x60, 0x1c, 0x5c, 0xea, 0xe1, 0x14, 0x4d, 0x82, 0x58, 0x42, 0x4d, 0x5c, 0x7d, 0x86,
-	0x58, 0x8d, 0x1d, 0x17, 0xe3, 0x5a, 0x0f, 0xb0, 0x2b, 0x7d, 0x82, 0x05, 0x53, 0x96, 0x45, 0x72,
-	0x9c, 0x5c, 0xc9, 0xa8, 0xae, 0x69, 0x84, 0x1c, 0xa8, 0xb3, 0xcc, 0xf3, 0x28, 0x63, 0xb2, 0x4e,
-	0x13, 0xaf, 0xa1, 0x68, 0xa9, 0xe4, 0x94, 0xe5, 0x6c, 0x63, 0x05, 0x04, 0x0f, 0x4d, 0xd3, 0x6b,
-	0x16, 0xe8, 0xeb, 0x6a, 0xd4, 0x5a, 0xc1, 0xde, 0x38, 0xa5, 0x7e, 0xe8, 0xf1, 0xef, 0x12, 0x6d,
-	0x82, 0x99, 0x64, 0xdc, 0x4b, 0x62, 0xca, 0xb4, 0xee, 0x06, 0xbf, 0x28, 0xfd, 0xc1, 0x00, 0x7b,
-	0xc2, 0x49, 0xaa, 0x95, 0x1f, 0x32, 0xca, 0x8a, 0xd2, 0xa5, 0x47, 0xd2, 0x08, 0x2a, 0x6f, 0xc2,
-	0x88, 0x6a, 0x72, 0x79, 0x16, 0x95, 0xce, 0x13, 0xc6, 0x99, 0x53, 0x71, 0xcb, 0xe2, 0x79, 0x48,
-	0x80, 0x3a, 0x50, 0x5b, 0x16, 0xdb, 0x8e, 0x8a, 0x0b, 0xa0, 0xc7, 0xa9, 0x23, 0x5a, 0xff, 0xc2,
-	0xee, 0x84, 0x27, 0xcb, 0x6f, 0xb9, 0x40, 0x4e, 0x5b, 0xf9, 0x1a, 0x6d, 0xe7, 0x1f, 0x68, 0x6c,
-	0x16, 0x13, 0x39, 0x70, 0x30, 0x1c, 0x8c, 0xfa, 0x67, 0xf8, 0x0e, 0xf7, 0xaf, 0x70, 0x7f, 0x32,
-	0x19, 0xdc, 0x8c, 0xee, 0xfe, 0x1b, 0xda, 0x5b, 0xe8, 0x27, 0xd8, 0x1f, 0xde, 0x5c, 0x0d, 0x2e,
-	0x9e, 0x38, 0x8c, 0x4e, 0x0b, 0xcc, 0xf5, 0xb6, 0xa2, 0x06, 0x54, 0x87, 0xfd, 0x33, 0x3c, 0xb2,
-	0xb7, 0x90, 0x05, 0xf5, 0x31, 0xee, 0x5f, 0x0e, 0x2e, 0xa6, 0xb6, 0xd1, 0x39, 0x86, 0xba, 0xfe,
-	0x9d, 0x46, 0xdb, 0x60, 0x62, 0x1a, 0xdc, 0x8d, 0x92, 0x05, 0xb5, 0xb7, 0xd0, 0x0f, 0xd0, 0x10,
-	0x68, 0x48, 0x18, 0x4b, 0x6c, 0x63, 0x0d, 0x71, 0xe8, 0x07, 0xd4, 0x2e, 0x9d, 0x1f, 0xff, 0x7f,
-	0x14, 0x84, 0x7c, 0x9e, 0xcd, 0xc4, 0xf5, 0xbb, 0x63, 0xe2, 0xfb, 0x11, 0x55, 0x7f, 0x35, 0xb8,
-	0x9c, 0xde, 0x76, 0x7d, 0x12, 0x76, 0xe5, 0x07, 0x87, 0xe9, 0xcf, 0xcf, 0xac, 0x26, 0xe1, 0xd1,
-	0x97, 0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0xb8, 0x4b, 0x20, 0x96, 0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("common/dai_common.proto", fileDescriptor_3ba1e23467ae2d28) }
+
+var fileDescriptor_3ba1e23467ae2d28 = []byte{
+	// 737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa5, 0x55, 0xdb, 0x6a, 0x13, 0x41,
+	0x18, 0x76, 0xb3, 0x39, 0x6c, 0xfe, 0xd4, 0x36, 0x9d, 0x16, 0xbb, 0x14, 0xd1, 0x12, 0x10, 0x6a,
+	0x91, 0x06, 0x52, 0x8b, 0xd5, 0x0b, 0xa1, 0x87, 0x50, 0x02, 0x31, 0x0d, 0x93, 0x28, 0xc5, 0x9b,
+	0x30, 0xd9, 0x8c, 0xc9, 0xd2, 0xdd, 0x6c, 0xba, 0x33, 0x2b, 0xc6, 0x2b, 0x1f, 0xc8, 0x1b, 0x1f,
+	0xcd, 0x37, 0x70, 0x4e, 0xc9, 0x6e, 0x4b, 0x8b, 0x16, 0x6f, 0xc2, 0x7c, 0xff, 0xe1, 0xfb, 0xe6,
+	0x3f, 0x4c, 0x16, 0xb6, 0xbc, 0x28, 0x0c, 0xa3, 0x69, 0x7d, 0x44, 0xfc, 0x81, 0x3e, 0xee, 0xcf,
+	0xe2, 0x88, 0x47, 0xa8, 0xa8, 0x51, 0xed, 0x67, 0x0e, 0x2a, 0xfd, 0x98, 0xf8, 0xd3, 0x2e, 0x89,
+	0x49, 0xc8, 0xd0, 0x26, 0x14, 0x02, 0x32, 0xa4, 0x81, 0x6b, 0xed, 0x58, 0xbb, 0x65, 0xac, 0x01,
+	0x7a, 0x0a, 0x65, 0x75, 0xe8, 0x90, 0x90, 0xba, 0x39, 0xe5, 0x49, 0x0d, 0xe8, 0x25, 0x94, 0x62,
+	0x3a, 0xfe, 0x10, 0x8d, 0xa8, 0x6b, 0x0b, 0xdf, 0x6a, 0x63, 0x6d, 0xdf, 0x68, 0x61, 0x6d, 0xc6,
+	0x0b, 0x3f, 0xda, 0x06, 0x47, 0x1c, 0x95, 0x96, 0x9b, 0x17, 0xb1, 0x16, 0x5e, 0x62, 0x29, 0x4d,
+	0x82, 0xd9, 0x84, 0xb8, 0x05, 0xe5, 0xd0, 0x40, 0x4a, 0x93, 0x70, 0x16, 0xf8, 0x3c, 0x11, 0xf4,
+	0x45, 0xe5, 0x49, 0x0d, 0x92, 0x8f, 0x78, 0x5e, 0x12, 0x13, 0x6f, 0xee, 0x96, 0x84, 0xd3, 0xc6,
+	0x4b, 0x2c, 0x33, 0x7d, 0xd6, 0x27, 0x92, 0x9d, 0xbb, 0x8e, 0x70, 0x3a, 0x38, 0x35, 0xa0, 0x27,
+	0x50, 0xf4, 0x47, 0xaa, 0x9e, 0xb2, 0xaa, 0xc7, 0x20, 0x99, 0x75, 0x42, 0xb8, 0x37, 0xe9, 0xf9,
+	0xdf, 0xa9, 0x0b, 0x8a, 0x32, 0x35, 0xd4, 0x7e, 0xd9, 0xa6, 0x5d, 0xb2, 0x9a, 0x80, 0xa1, 0x37,
+	0x50, 0xe4, 0x13, 0xca, 0x09, 0x13, 0xfd, 0xb2, 0x77, 0x2b, 0x8d, 0xe7, 0x8b, 0xca, 0x33, 0x41,
+	0xfb, 0x7d, 0x15, 0xd1, 0x9c, 0xf2, 0x78, 0x8e, 0x4d, 0x38, 0x7a, 0x0d, 0x85, 0x6f, 0x43, 0x12,
+	0x33, 0xd1, 0x4d, 0x99, 0xf7, 0xec, 0xae, 0xbc, 0x4b, 0x19, 0xa0, 0xd3, 0x74, 0xb0, 0x94, 0x63,
+	0xfe, 0x38, 0x14, 0x72, 0xf6, 0xfd, 0x72, 0x3d, 0x15, 0x61, 0xe4, 0x74, 0x78, 0x3a, 0xd6, 0xfc,
+	0xad, 0xb1, 0xa6, 0x1d, 0x2a, 0xdc, 0xdf, 0xa1, 0x62, 0xb6, 0x43, 0xdb, 0x6f, 0x45, 0x0b, 0xd2,
+	0x8a, 0x50, 0x15, 0xec, 0x2b, 0x3a, 0x37, 0xfb, 0x22, 0x8f, 0x52, 0xec, 0x2b, 0x09, 0x12, 0xbd,
+	0x29, 0x62, 0x90, 0x0a, 0xbc, 0xcb, 0x1d, 0x59, 0xdb, 0x47, 0x00, 0x69, 0x51, 0x0f, 0xca, 0x14,
+	0xa2, 0x99, 0xba, 0x1e, 0x92, 0x5a, 0xfb, 0x6d, 0x01, 0xf4, 0x09, 0xbb, 0x32, 0x1b, 0xfe, 0x02,
+	0xf2, 0x24, 0x18, 0x47, 0x2a, 0x77, 0xb5, 0xb1, 0xbe, 0xe8, 0xe0, 0xb1, 0xb0, 0xc5, 0x3e, 0x9f,
+	0x84, 0x58, 0xb9, 0xd1, 0x2b, 0x70, 0x44, 0x89, 0x57, 0xfd, 0xf9, 0x4c, 0x53, 0xae, 0x36, 0xaa,
+	0xcb, 0x66, 0x1b, 0x3b, 0x5e, 0x46, 0xa0, 0x43, 0xa8, 0xf0, 0xf4, 0x15, 0xa9, 0x67, 0x50, 0x69,
+	0x6c, 0xdc, 0x98, 0x8e, 0x76, 0xe1, 0x6c, 0x1c, 0xda, 0x81, 0x4a, 0x28, 0x87, 0x26, 0x19, 0x5b,
+	0x67, 0x66, 0x38, 0x59, 0x93, 0x24, 0x56, 0xd0, 0x10, 0x17, 0xee, 0x20, 0xd6, 0x63, 0xc7, 0xd9,
+	0xb8, 0xda, 0x35, 0xac, 0x29, 0x9f, 0x64, 0xc1, 0x94, 0x25, 0x81, 0x1a, 0x27, 0xd7, 0x32, 0xba,
+	0x6b, 0x06, 0x21, 0x17, 0x4a, 0x2c, 0xf1, 0x3c, 0xca, 0x98, 0xaa, 0xd3, 0xc1, 0x0b, 0x28, 0x5b,
+	0xaa, 0x38, 0x55, 0x39, 0x2b, 0x58, 0x03, 0xc9, 0x43, 0xe3, 0xf8, 0x03, 0x1b, 0x9b, 0xeb, 0x1a,
+	0x54, 0x9b, 0xc3, 0x7a, 0x37, 0xa6, 0x23, 0xdf, 0xe3, 0xff, 0x25, 0x2a, 0x5e, 0x74, 0x94, 0x70,
+	0x51, 0x1f, 0x65, 0x46, 0x77, 0x89, 0xef, 0x95, 0xfe, 0x61, 0x41, 0xb5, 0xc7, 0xc5, 0xce, 0x6a,
+	0xe5, 0xeb, 0x84, 0xb2, 0xac, 0x74, 0xee, 0x86, 0x34, 0x82, 0xfc, 0x17, 0x3f, 0xa0, 0x86, 0x5c,
+	0x9d, 0x65, 0xa5, 0x93, 0x88, 0x71, 0x26, 0x78, 0x6d, 0xf9, 0x3c, 0x14, 0x40, 0x7b, 0x50, 0x9c,
+	0x65, 0xdb, 0x8e, 0xb2, 0x0b, 0x60, 0xc6, 0x69, 0x22, 0x6a, 0x1f, 0x61, 0xad, 0xc7, 0xa3, 0xd9,
+	0xbf, 0x5c, 0x20, 0xa5, 0xcd, 0xff, 0x8d, 0x76, 0xef, 0x3d, 0x94, 0x97, 0x8b, 0x29, 0x9a, 0xb6,
+	0xd9, 0x6e, 0x75, 0x9a, 0xc7, 0x78, 0x80, 0x9b, 0xe7, 0xb8, 0xd9, 0xeb, 0xb5, 0x2e, 0x3a, 0x83,
+	0x4f, 0xed, 0xea, 0x23, 0xb4, 0x05, 0x1b, 0xed, 0x8b, 0xf3, 0xd6, 0xe9, 0x2d, 0x87, 0xb5, 0x57,
+	0x03, 0x67, 0xb1, 0xad, 0xa8, 0x0c, 0x85, 0xb6, 0x48, 0xee, 0x88, 0xf8, 0x0a, 0x94, 0xba, 0xb8,
+	0x79, 0xd6, 0x3a, 0xed, 0x8b, 0x98, 0x43, 0x28, 0x99, 0xff, 0x69, 0xb4, 0x02, 0x8e, 0x38, 0x0e,
+	0x3a, 0xd1, 0x94, 0x8a, 0xa8, 0xc7, 0x50, 0x96, 0xa8, 0x4d, 0x18, 0x8b, 0xaa, 0xd6, 0x02, 0x62,
+	0x7f, 0x34, 0xa6, 0xd5, 0xdc, 0xc9, 0xe1, 0xe7, 0x83, 0xb1, 0xb8, 0x56, 0x32, 0x94, 0xd7, 0xaf,
+	0x77, 0xc9, 0x68, 0x14, 0x50, 0xfd, 0x6b, 0xc0, 0x59, 0xff, 0x52, 0x7e, 0x77, 0xea, 0xea, 0x83,
+	0xc3, 0xea, 0xba, 0xc8, 0x61, 0x51, 0xc1, 0x83, 0x3f, 0x7d, 0xac, 0x01, 0x1c, 0x9a, 0x06, 0x00,
+	0x00,
 }
@@ -410,30 +410,30 @@ func init() {
 func init() { proto.RegisterFile("mpc/cluster.proto", fileDescriptor_0aad46b65f84d4a0) }
 
 var fileDescriptor_0aad46b65f84d4a0 = []byte{
-	// 362 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0x41, 0x4b, 0xc3, 0x30,
-	0x14, 0x5e, 0xdd, 0xd8, 0xd8, 0x5b, 0xd7, 0xad, 0x99, 0xc8, 0xd8, 0x69, 0x0c, 0x84, 0x81, 0xd0,
-	0x4a, 0x05, 0x11, 0x04, 0x61, 0x6e, 0x87, 0x79, 0x1b, 0x75, 0x07, 0xf1, 0x22, 0x5d, 0x1a, 0xba,
-	0x62, 0xb3, 0xc4, 0x36, 0x3b, 0xf8, 0x37, 0xbc, 0xf9, 0x6f, 0x65, 0x49, 0xec, 0x52, 0xc1, 0x83,
-	0x07, 0x2f, 0x6d, 0xdf, 0xf7, 0xfa, 0x7d, 0xef, 0xcb, 0x97, 0x07, 0x2e, 0xe5, 0xd8, 0xc7, 0xd9,
-	0xbe, 0x10, 0x24, 0xf7, 0x78, 0xce, 0x04, 0x43, 0x75, 0xca, 0xf1, 0x68, 0x80, 0x19, 0xa5, 0x6c,
-	0xe7, 0xab, 0x97, 0xea, 0x4c, 0x3e, 0x2c, 0xe8, 0x3c, 0x0a, 0xc2, 0x43, 0xf2, 0xb6, 0x27, 0x85,
-	0x40, 0x37, 0xd0, 0x15, 0x79, 0x94, 0xee, 0x5e, 0x72, 0x05, 0x0c, 0x4f, 0xc6, 0xd6, 0xb4, 0x13,
-	0xb8, 0x1e, 0xe5, 0xd8, 0x5b, 0x1f, 0x3a, 0xfa, 0xcf, 0x65, 0x2d, 0xb4, 0x85, 0x51, 0xa3, 0x3b,
-	0xe8, 0xf1, 0x9c, 0xc4, 0x29, 0x16, 0x25, 0xb7, 0x2e, 0xb9, 0x03, 0xc9, 0x5d, 0xa9, 0xde, 0x91,
-	0xed, 0xf0, 0x0a, 0x72, 0xdf, 0x86, 0x16, 0x8f, 0xde, 0x33, 0x16, 0xc5, 0x93, 0x4f, 0x0b, 0x6c,
-	0x65, 0xaa, 0xe0, 0x6c, 0x57, 0x10, 0x74, 0x0b, 0xce, 0xb7, 0x2b, 0x85, 0x68, 0x5b, 0xc8, 0xb4,
-	0xa5, 0x3a, 0xcb, 0x5a, 0xd8, 0x15, 0x26, 0x80, 0x66, 0xd0, 0x3f, 0x1a, 0xd3, 0x74, 0xe5, 0xec,
-	0xb4, 0xea, 0xac, 0x14, 0xe8, 0xf1, 0x2a, 0x64, 0x7a, 0x4b, 0xc0, 0x36, 0x63, 0x40, 0x67, 0xd0,
-	0x14, 0x51, 0xf1, 0xfa, 0xb0, 0x90, 0x96, 0xda, 0xa1, 0xae, 0xd0, 0x39, 0x34, 0xa2, 0x2c, 0x61,
-	0x72, 0x92, 0x13, 0xb8, 0x9e, 0x4e, 0x7d, 0x96, 0x25, 0x2c, 0x4f, 0xc5, 0x96, 0x86, 0xb2, 0x8d,
-	0x86, 0xa5, 0xf2, 0xb0, 0x31, 0xb6, 0xa6, 0x76, 0x58, 0x0e, 0x4a, 0xc1, 0xa9, 0x66, 0xf6, 0x7f,
-	0xa3, 0x66, 0xd0, 0xad, 0x64, 0xf8, 0xeb, 0x24, 0x43, 0xa2, 0x5e, 0x95, 0x98, 0x43, 0xef, 0x47,
-	0x8e, 0x7f, 0x17, 0x09, 0xae, 0xa1, 0x35, 0x57, 0x7b, 0x8b, 0x2e, 0xa0, 0x71, 0xd8, 0x00, 0xd4,
-	0x97, 0x57, 0x64, 0x6c, 0xe8, 0xc8, 0x35, 0x10, 0x7d, 0x3d, 0xc1, 0xf3, 0x65, 0x92, 0x8a, 0xed,
-	0x7e, 0x73, 0x38, 0xba, 0xbf, 0x8a, 0xe2, 0x38, 0x23, 0xea, 0xa9, 0x8b, 0xc5, 0xfa, 0xc9, 0x8f,
-	0xa3, 0xd4, 0x97, 0x3b, 0x5f, 0xf8, 0x94, 0xe3, 0x4d, 0x53, 0x7e, 0x5f, 0x7d, 0x05, 0x00, 0x00,
-	0xff, 0xff, 0xf4, 0xe9, 0x7e, 0x01, 0x2e, 0x03, 0x00, 0x00,
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb5, 0x52, 0x41, 0x4b, 0xc3, 0x30,
+	0x14, 0xb6, 0x6e, 0x6c, 0xec, 0xad, 0xed, 0xb6, 0x28, 0x3a, 0x76, 0x92, 0x81, 0x20, 0x08, 0xab,
+	0x4c, 0x10, 0x41, 0x10, 0xea, 0x76, 0xd0, 0xdb, 0xa8, 0x3b, 0x88, 0x97, 0x91, 0xb5, 0x61, 0x16,
+	0xdb, 0x25, 0xb6, 0xe9, 0xc1, 0xbf, 0xe1, 0xcd, 0x7f, 0x6b, 0x9a, 0xc4, 0x2e, 0x15, 0x3c, 0x78,
+	0xf0, 0x12, 0xf2, 0xbe, 0x97, 0xf7, 0xbd, 0xef, 0x7d, 0x79, 0x30, 0x48, 0x59, 0xe8, 0x85, 0x49,
+	0x91, 0x73, 0x92, 0x4d, 0x58, 0x46, 0x39, 0x45, 0x0d, 0x01, 0x8d, 0x8e, 0x43, 0x9a, 0xa6, 0x74,
+	0xeb, 0x45, 0x38, 0x5e, 0xa9, 0xab, 0xca, 0x8e, 0x3f, 0x2c, 0xe8, 0x3e, 0x72, 0xc2, 0x02, 0xf2,
+	0x56, 0x90, 0x9c, 0xa3, 0x6b, 0x70, 0x78, 0x86, 0xe3, 0xed, 0x2a, 0x53, 0xc0, 0x70, 0xff, 0xc4,
+	0x3a, 0xeb, 0x4e, 0x07, 0x13, 0xc1, 0x32, 0x59, 0x96, 0x19, 0xfd, 0xf2, 0x7e, 0x2f, 0xb0, 0xb9,
+	0x11, 0xa3, 0x5b, 0xe8, 0xb1, 0x8c, 0x44, 0x71, 0xc8, 0xab, 0xda, 0x86, 0xac, 0x3d, 0x90, 0xb5,
+	0x0b, 0x95, 0xdb, 0x55, 0xbb, 0xac, 0x86, 0xdc, 0x75, 0xa0, 0xcd, 0xf0, 0x7b, 0x42, 0x71, 0x34,
+	0xfe, 0xb4, 0xc0, 0x56, 0xa2, 0x72, 0x46, 0xb7, 0x39, 0x41, 0x37, 0xe0, 0x7e, 0xab, 0x52, 0x88,
+	0x96, 0x85, 0x4c, 0x59, 0x2a, 0x23, 0x98, 0x1d, 0x6e, 0x02, 0xc8, 0x87, 0xfe, 0x4e, 0x98, 0x2e,
+	0x57, 0xca, 0x0e, 0xeb, 0xca, 0x2a, 0x82, 0x1e, 0xab, 0x43, 0xa6, 0xb6, 0x0d, 0xd8, 0xa6, 0x0d,
+	0xe8, 0x08, 0x5a, 0x1c, 0xe7, 0xaf, 0x0f, 0x73, 0x29, 0xa9, 0x13, 0xe8, 0x08, 0x9d, 0x42, 0x13,
+	0x27, 0x1b, 0x2a, 0x3b, 0xb9, 0xc2, 0x3f, 0xed, 0xba, 0x2f, 0xb0, 0x2c, 0xe6, 0x2f, 0x69, 0x20,
+	0xd3, 0x68, 0x58, 0x31, 0x0f, 0x9b, 0xe2, 0xa5, 0x1d, 0x54, 0x8d, 0x62, 0x70, 0xeb, 0x9e, 0xfd,
+	0x5f, 0x2b, 0x1f, 0x9c, 0x9a, 0x87, 0xbf, 0x76, 0x32, 0x28, 0x1a, 0x75, 0x8a, 0x19, 0xf4, 0x7e,
+	0xf8, 0xf8, 0x77, 0x92, 0xe9, 0x15, 0xb4, 0x67, 0x6a, 0x77, 0xd1, 0x39, 0x34, 0xcb, 0x0d, 0x40,
+	0x7d, 0xf9, 0x45, 0xc6, 0x86, 0x8e, 0x06, 0x06, 0xa2, 0xbf, 0x67, 0xfa, 0x7c, 0xb1, 0x11, 0x83,
+	0x16, 0xeb, 0x72, 0x74, 0x6f, 0x81, 0xa3, 0x28, 0x21, 0xea, 0xd4, 0xc1, 0x7c, 0xf9, 0x54, 0xae,
+	0xbe, 0x27, 0x77, 0x3e, 0xf7, 0x04, 0xc1, 0xba, 0x25, 0xef, 0x97, 0x5f, 0x0c, 0xf1, 0xb3, 0x24,
+	0x32, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -608,50 +608,49 @@ func init() {
 func init() { proto.RegisterFile("task/task.proto", fileDescriptor_8e8f2b86464a95fe) }
 
 var fileDescriptor_8e8f2b86464a95fe = []byte{
-	// 677 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x4f, 0x1b, 0x3d,
-	0x10, 0xd6, 0xe6, 0x7b, 0x67, 0xf3, 0xc2, 0x8b, 0x4b, 0x91, 0x15, 0x55, 0x28, 0xda, 0x03, 0xca,
-	0xa5, 0x04, 0xc2, 0xb1, 0xbd, 0x14, 0x52, 0x10, 0x2a, 0x95, 0xd0, 0xc2, 0xa1, 0xed, 0xcd, 0x89,
-	0x87, 0xe0, 0xb2, 0x5f, 0xb5, 0xbd, 0xaa, 0xb8, 0xf4, 0x2f, 0xf4, 0xde, 0xbf, 0xd1, 0x1f, 0xd7,
-	0x6b, 0x65, 0x7b, 0x37, 0xd9, 0x50, 0xe8, 0xa5, 0x97, 0xc4, 0xcf, 0xcc, 0x78, 0xf6, 0x99, 0x67,
-	0x66, 0x0c, 0x9b, 0x9a, 0xa9, 0xbb, 0xb1, 0xf9, 0xd9, 0xcf, 0x65, 0xa6, 0x33, 0xd2, 0x32, 0xe7,
-	0xc1, 0xb3, 0x79, 0x96, 0x24, 0x59, 0x3a, 0x76, 0x7f, 0xce, 0x15, 0x7e, 0x03, 0x72, 0x92, 0xa5,
-	0x37, 0x42, 0x26, 0xd7, 0x4c, 0xdd, 0x45, 0xf8, 0xa5, 0x40, 0xa5, 0xc9, 0x36, 0xb4, 0xb3, 0xaf,
-	0x29, 0x4a, 0xea, 0x0d, 0xbd, 0x51, 0x3f, 0x72, 0x80, 0xec, 0x40, 0xc7, 0x24, 0x3a, 0x9f, 0xd2,
-	0xc6, 0xd0, 0x1b, 0xf9, 0x51, 0x89, 0xc8, 0x0b, 0xf0, 0xb5, 0x48, 0x50, 0x69, 0x96, 0xe4, 0xb4,
-	0x39, 0xf4, 0x46, 0xcd, 0x68, 0x65, 0x30, 0x5e, 0x25, 0x16, 0x29, 0xd3, 0x85, 0x44, 0xda, 0xb2,
-	0xf9, 0x56, 0x86, 0xf0, 0x23, 0x04, 0xff, 0xf4, 0xe1, 0xbf, 0xa4, 0xde, 0x83, 0xbe, 0x4b, 0xad,
-	0xf2, 0x2c, 0x55, 0xf8, 0x54, 0x96, 0xf0, 0xbb, 0x07, 0x9b, 0x17, 0x42, 0xe9, 0x3a, 0x8f, 0x1d,
-	0xe8, 0xe4, 0xc5, 0xec, 0x1d, 0xde, 0x97, 0x44, 0x4a, 0x64, 0xec, 0x4a, 0x33, 0x5d, 0xa8, 0x2a,
-	0x87, 0x43, 0x95, 0x04, 0x57, 0x9a, 0x49, 0x5d, 0x97, 0xc0, 0x1a, 0x08, 0x85, 0xae, 0x01, 0x6f,
-	0x53, 0x6e, 0x59, 0x36, 0xa3, 0x0a, 0x9a, 0x7a, 0x63, 0x91, 0x08, 0x4d, 0xdb, 0x43, 0x6f, 0xd4,
-	0x8a, 0x1c, 0x08, 0x7f, 0x7a, 0x10, 0x4c, 0x99, 0x66, 0xa7, 0x99, 0x34, 0xa4, 0x9e, 0x56, 0x85,
-	0x33, 0xcd, 0x56, 0xf5, 0x38, 0x44, 0x06, 0xd0, 0xcb, 0xaf, 0xce, 0x2f, 0xd8, 0x0c, 0x63, 0x4b,
-	0xc5, 0x8f, 0x96, 0x98, 0x0c, 0x21, 0x98, 0xbb, 0x76, 0x23, 0x7f, 0xa3, 0x4b, 0x36, 0x75, 0x13,
-	0xd9, 0x05, 0x90, 0xf8, 0x19, 0xe7, 0xda, 0x06, 0xb4, 0x6d, 0x40, 0xcd, 0x62, 0x6a, 0x61, 0x9c,
-	0x4b, 0x54, 0x8a, 0x76, 0x6c, 0xf2, 0x0a, 0x86, 0xbf, 0x1a, 0xd0, 0x39, 0xbd, 0xb0, 0x84, 0x37,
-	0xa0, 0x21, 0xa6, 0x96, 0xad, 0x1f, 0x35, 0xc4, 0x94, 0x10, 0x68, 0xa5, 0x2c, 0xc1, 0x92, 0xa8,
-	0x3d, 0x1b, 0x2a, 0x1c, 0xd5, 0x5c, 0x8a, 0x5c, 0x8b, 0x2c, 0x2d, 0x99, 0xd6, 0x4d, 0x46, 0x54,
-	0xe9, 0xfa, 0x81, 0xb2, 0x6a, 0xef, 0xd2, 0x40, 0x5e, 0x42, 0xcf, 0x14, 0x7c, 0x85, 0x5a, 0xd1,
-	0xf6, 0xb0, 0x39, 0x0a, 0x26, 0x5b, 0xfb, 0x76, 0xe6, 0x6b, 0xca, 0x45, 0xcb, 0x10, 0x72, 0x00,
-	0x3e, 0x8b, 0x17, 0xd9, 0x25, 0x93, 0x2c, 0xb1, 0xcc, 0x83, 0x09, 0xd9, 0x2f, 0x57, 0xc1, 0x84,
-	0x5a, 0x87, 0x8a, 0x56, 0x41, 0xb5, 0x5e, 0x77, 0xd7, 0x7a, 0xbd, 0x0b, 0x80, 0x52, 0xbe, 0x47,
-	0xa5, 0xd8, 0x02, 0x69, 0xcf, 0xfa, 0x6a, 0x16, 0x73, 0x4f, 0xa2, 0x2a, 0x62, 0x4d, 0x7d, 0x77,
-	0xcf, 0x21, 0x53, 0x70, 0x5e, 0xcc, 0x62, 0xa1, 0x6e, 0xaf, 0x45, 0x82, 0x14, 0x9c, 0xf6, 0x35,
-	0x93, 0x9d, 0x67, 0x33, 0x30, 0xd6, 0x1f, 0xb8, 0x29, 0x5a, 0x1a, 0x8c, 0xf2, 0x98, 0x72, 0xeb,
-	0xeb, 0xbb, 0x29, 0x2a, 0x61, 0x78, 0x08, 0x5d, 0x27, 0xbc, 0x22, 0x7b, 0xd0, 0xbd, 0x71, 0x47,
-	0xea, 0x59, 0x51, 0xfa, 0x4e, 0x14, 0xe7, 0x8f, 0x2a, 0x67, 0x38, 0x82, 0x8d, 0x33, 0x7c, 0x38,
-	0xf2, 0xe5, 0x7a, 0x78, 0x6b, 0xeb, 0x71, 0x02, 0x9b, 0x97, 0x12, 0xb9, 0x98, 0xeb, 0x47, 0x36,
-	0x69, 0x2d, 0xd4, 0x30, 0xcc, 0xd9, 0x7d, 0x9c, 0x31, 0x6e, 0x3b, 0xdd, 0x8f, 0x2a, 0x38, 0xf9,
-	0xd1, 0x80, 0x96, 0x9d, 0x8c, 0x57, 0x10, 0xd4, 0xde, 0x1b, 0x42, 0x1d, 0xbb, 0x3f, 0x9f, 0xa0,
-	0x01, 0x71, 0x9e, 0xb5, 0x0d, 0x3e, 0x80, 0x5e, 0xb5, 0xa8, 0xe4, 0xb9, 0xf3, 0x3f, 0x58, 0xdc,
-	0xc1, 0x7f, 0xf5, 0x72, 0x15, 0x39, 0x84, 0xa0, 0x2c, 0xf3, 0xf8, 0xfe, 0x9c, 0x93, 0x6d, 0xe7,
-	0x5d, 0xaf, 0x7c, 0xb0, 0x26, 0x11, 0x79, 0x0d, 0xff, 0x9f, 0xa1, 0x5e, 0x95, 0x6c, 0x5a, 0xb7,
-	0x55, 0x27, 0xe3, 0x2e, 0x95, 0xdf, 0x7f, 0x28, 0xcd, 0x04, 0x7c, 0xbb, 0xf3, 0x36, 0xd5, 0x23,
-	0xd7, 0x1e, 0x29, 0xeb, 0xf8, 0xe8, 0xd3, 0xe1, 0x42, 0xe8, 0xdb, 0x62, 0x66, 0xe6, 0x71, 0x7c,
-	0xc9, 0x38, 0x8f, 0xd1, 0xfd, 0x96, 0x60, 0x7a, 0xfd, 0x61, 0xcc, 0x99, 0x18, 0xdb, 0x27, 0x5b,
-	0xd9, 0x97, 0x7d, 0xd6, 0xb1, 0xe0, 0xe8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x50, 0x14,
-	0x7c, 0xed, 0x05, 0x00, 0x00,
+	// 669 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa5, 0x54, 0x4b, 0x6f, 0x13, 0x31,
+	0x10, 0x56, 0xde, 0xd9, 0xd9, 0xd0, 0xb4, 0x16, 0x8f, 0x55, 0x84, 0x50, 0xb5, 0x87, 0x2a, 0x17,
+	0x92, 0x26, 0x3d, 0xc2, 0x85, 0x36, 0x14, 0x45, 0x04, 0x29, 0xda, 0xe4, 0x00, 0x5c, 0x90, 0x93,
+	0x75, 0x53, 0xc3, 0xbe, 0xb0, 0x1d, 0xa1, 0x5e, 0xf8, 0x0b, 0xdc, 0xf9, 0x1b, 0xfc, 0x38, 0xae,
+	0xf8, 0xb5, 0xc9, 0x26, 0xa4, 0x5c, 0xb8, 0xac, 0xfc, 0xcd, 0x8c, 0x67, 0xbf, 0xf9, 0x66, 0xc6,
+	0xd0, 0x16, 0x98, 0x7f, 0xe9, 0xab, 0x4f, 0x2f, 0x63, 0xa9, 0x48, 0x51, 0x55, 0x9d, 0x3b, 0x4f,
+	0x96, 0x69, 0x1c, 0xa7, 0x49, 0x3f, 0xc4, 0xf4, 0x93, 0x39, 0x1a, 0xb7, 0xff, 0x1d, 0xd0, 0x55,
+	0x9a, 0xdc, 0x50, 0x16, 0xcf, 0x65, 0x5c, 0x40, 0xbe, 0xae, 0x09, 0x17, 0xe8, 0x21, 0xd4, 0xd2,
+	0x6f, 0x09, 0x61, 0x5e, 0xe9, 0xb4, 0xd4, 0x6d, 0x05, 0x06, 0xa0, 0xc7, 0x50, 0x57, 0xc9, 0xc6,
+	0x23, 0xaf, 0x2c, 0xcd, 0x4e, 0x60, 0x11, 0x7a, 0x0a, 0x8e, 0xa0, 0xb1, 0xbc, 0x87, 0xe3, 0xcc,
+	0xab, 0x48, 0x57, 0x25, 0xd8, 0x1a, 0x94, 0x97, 0xd3, 0x55, 0x82, 0xc5, 0x9a, 0x11, 0xaf, 0xaa,
+	0xf3, 0x6d, 0x0d, 0xfe, 0x07, 0x70, 0xff, 0xeb, 0xc7, 0xff, 0x48, 0x7d, 0x06, 0x2d, 0x93, 0x9a,
+	0x67, 0x69, 0xc2, 0xc9, 0x7d, 0x59, 0xfc, 0x1f, 0x25, 0x68, 0x4f, 0x28, 0x17, 0x45, 0x1e, 0x32,
+	0x36, 0x5b, 0x2f, 0xde, 0x92, 0x3b, 0x4b, 0xc4, 0x22, 0x65, 0x97, 0x55, 0x89, 0x35, 0xcf, 0x73,
+	0x18, 0x94, 0x4b, 0x30, 0x13, 0x98, 0x89, 0xa2, 0x04, 0xda, 0x80, 0x3c, 0x68, 0x28, 0xf0, 0x3a,
+	0x09, 0x35, 0xcb, 0x4a, 0x90, 0x43, 0x55, 0x6f, 0x44, 0x63, 0x2a, 0xbc, 0x9a, 0xb4, 0x57, 0x03,
+	0x03, 0xfc, 0x5f, 0x25, 0x70, 0x47, 0x58, 0xe0, 0xeb, 0x94, 0x29, 0x52, 0xf7, 0xab, 0x12, 0xca,
+	0xa0, 0x6d, 0x3d, 0x06, 0xa1, 0x0e, 0x34, 0xb3, 0xd9, 0x78, 0x82, 0x17, 0x24, 0xd2, 0x54, 0x9c,
+	0x60, 0x83, 0xd1, 0x29, 0xb8, 0x4b, 0xd3, 0x6e, 0x12, 0xbe, 0x12, 0x96, 0x4d, 0xd1, 0x84, 0x9e,
+	0x01, 0x30, 0xf2, 0x99, 0x2c, 0x85, 0x0e, 0xa8, 0xe9, 0x80, 0x82, 0x45, 0xd5, 0x82, 0xc3, 0x90,
+	0x11, 0xce, 0xbd, 0xba, 0x4e, 0x9e, 0x43, 0xff, 0x77, 0x19, 0xea, 0xd7, 0x13, 0x4d, 0xf8, 0x08,
+	0xca, 0x74, 0xa4, 0xd9, 0x3a, 0x81, 0x3c, 0x21, 0x04, 0xd5, 0x04, 0xc7, 0xc4, 0x12, 0xd5, 0x67,
+	0x45, 0x25, 0x24, 0x7c, 0xc9, 0x68, 0x26, 0x68, 0x9a, 0x58, 0xa6, 0x45, 0x93, 0x12, 0x95, 0x99,
+	0x7e, 0xc8, 0xd2, 0x6d, 0x7b, 0x37, 0x06, 0xf4, 0x1c, 0x9a, 0xaa, 0xe0, 0x19, 0x11, 0x5c, 0xd2,
+	0xac, 0x74, 0xdd, 0xe1, 0x49, 0x4f, 0xcf, 0x7d, 0x41, 0xb9, 0x60, 0x13, 0x82, 0xce, 0xc1, 0xc1,
+	0xd1, 0x2a, 0x9d, 0x62, 0x86, 0x63, 0xcd, 0xdc, 0x1d, 0xa2, 0x9e, 0x5d, 0x05, 0x15, 0xaa, 0x1d,
+	0x3c, 0xd8, 0x06, 0x15, 0x7a, 0xdd, 0xd8, 0xe9, 0xb5, 0x54, 0x88, 0x30, 0xf6, 0x4e, 0x96, 0x8c,
+	0x57, 0xc4, 0x6b, 0x6a, 0x5f, 0xc1, 0xa2, 0xee, 0x49, 0x3d, 0xd6, 0x91, 0xf0, 0x1c, 0x73, 0xcf,
+	0x20, 0x55, 0xb0, 0x9c, 0xa2, 0x88, 0xf2, 0xdb, 0xb9, 0xec, 0xbe, 0x07, 0x46, 0xfb, 0x82, 0x49,
+	0xcf, 0xb3, 0x1a, 0x18, 0xed, 0x77, 0xcd, 0x14, 0x6d, 0x0c, 0x4a, 0x79, 0x92, 0x84, 0xda, 0xd7,
+	0x32, 0x53, 0x64, 0xa1, 0x3f, 0x80, 0x86, 0x11, 0x9e, 0xa3, 0x33, 0x68, 0xdc, 0x98, 0xa3, 0x94,
+	0x5f, 0x89, 0xd2, 0x32, 0xa2, 0x18, 0x7f, 0x90, 0x3b, 0xfd, 0x2e, 0x1c, 0xbd, 0x21, 0xfb, 0x23,
+	0x6f, 0xd7, 0xa3, 0xb4, 0xb3, 0x1e, 0x57, 0xd0, 0x9e, 0x32, 0x12, 0xd2, 0xa5, 0x38, 0xb0, 0x49,
+	0x3b, 0xa1, 0x8a, 0x61, 0x86, 0xef, 0xa2, 0x14, 0x87, 0xba, 0xd3, 0xad, 0x20, 0x87, 0xc3, 0x9f,
+	0x65, 0xa8, 0xea, 0xc9, 0x78, 0x01, 0x6e, 0xe1, 0xbd, 0x41, 0x9e, 0x61, 0xf7, 0xf7, 0x13, 0xd4,
+	0x41, 0xc6, 0xb3, 0xb3, 0xc1, 0xe7, 0xd0, 0xcc, 0x17, 0x15, 0x3d, 0x32, 0xfe, 0xbd, 0xc5, 0xed,
+	0x3c, 0x28, 0x96, 0xcb, 0xd1, 0x00, 0x5c, 0x5b, 0xe6, 0xe5, 0xdd, 0x58, 0xae, 0x9b, 0xf1, 0xee,
+	0x56, 0xde, 0xd9, 0x91, 0x08, 0xbd, 0x84, 0x63, 0xe9, 0xdf, 0x96, 0xac, 0x5a, 0x77, 0x52, 0x24,
+	0x63, 0x2e, 0xd9, 0xff, 0xef, 0x4b, 0x33, 0x04, 0x47, 0xef, 0xbc, 0x4e, 0x75, 0xe0, 0xda, 0x81,
+	0xb2, 0x2e, 0x2f, 0x3e, 0x0e, 0x56, 0x54, 0xdc, 0xae, 0x17, 0x6a, 0x1e, 0xfb, 0x53, 0xb9, 0x4f,
+	0x11, 0x31, 0x5f, 0x0b, 0x46, 0xf3, 0xf7, 0xea, 0xe5, 0xee, 0xeb, 0x27, 0x9b, 0xeb, 0xd7, 0x7d,
+	0x51, 0xd7, 0xe0, 0xe2, 0x0f, 0xee, 0x4a, 0x81, 0xc5, 0xf1, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
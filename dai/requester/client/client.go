@@ -24,6 +24,7 @@ import (
 	"strings"
 	"time"
 
+	xdatachain "github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
@@ -31,6 +32,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/PaddlePaddle/PaddleDTX/dai/blockchain"
+	fabricblockchain "github.com/PaddlePaddle/PaddleDTX/dai/blockchain/fabric"
 	xchainblockchain "github.com/PaddlePaddle/PaddleDTX/dai/blockchain/xchain"
 	"github.com/PaddlePaddle/PaddleDTX/dai/config"
 	"github.com/PaddlePaddle/PaddleDTX/dai/crypto/vl/common/csv"
@@ -39,11 +41,21 @@ import (
 	util "github.com/PaddlePaddle/PaddleDTX/dai/util/strings"
 )
 
+// Blockchain defines some contract methods used by Requester
+type Blockchain interface {
+	PublishTask(ctx context.Context, opt *blockchain.PublishFLTaskOptions) error
+	ListTask(ctx context.Context, opt *blockchain.ListFLTaskOptions) (blockchain.FLTasks, error)
+	GetTaskById(ctx context.Context, id string) (blockchain.FLTask, error)
+	StartTask(ctx context.Context, id string, sig []byte) error
+	GetDataNodeByID(ctx context.Context, id []byte) (blockchain.DataNode, error)
+	GetFileByID(ctx context.Context, id string) (xdatachain.File, error)
+}
+
 type Client struct {
-	XchainClient *xchainblockchain.XChain
+	ChainClient Blockchain
 }
 
-// GetRequestClient returns client for Requester by xchain configuration
+// GetRequestClient returns client for Requester by blockchain configuration
 func GetRequestClient(configPath string) (*Client, error) {
 	// check blockchain config yaml
 	err := checkConfig(configPath)
@@ -54,11 +66,18 @@ func GetRequestClient(configPath string) (*Client, error) {
 	log.SetOutput(ioutil.Discard)
 
 	// get blockchain client
-	xchainClient, err := xchainblockchain.New(config.GetCliConf().Xchain)
+	var chainClient Blockchain
+	conf := config.GetCliConf()
+	switch conf.Type {
+	case "xchain":
+		chainClient, err = xchainblockchain.New(conf.Xchain)
+	case "fabric":
+		chainClient, err = fabricblockchain.New(conf.Fabric)
+	}
 	if err != nil {
 		return nil, err
 	}
-	return &Client{XchainClient: xchainClient}, nil
+	return &Client{ChainClient: chainClient}, nil
 }
 
 // PublishOptions define parameters used to publishing a task
@@ -116,7 +135,7 @@ func (c *Client) checkPublishTaskOptions(opt PublishOptions) ([]*pbTask.DataForT
 	var fileOwners []string
 	isLabelExist := 0
 	for index, fileID := range fileIDs {
-		file, err := c.XchainClient.GetFileByID(context.TODO(), fileID)
+		file, err := c.ChainClient.GetFileByID(context.TODO(), fileID)
 		if err != nil {
 			return nil, err
 		}
@@ -147,7 +166,7 @@ func (c *Client) checkPublishTaskOptions(opt PublishOptions) ([]*pbTask.DataForT
 		}
 		// get dataID address
 		fileOwners = append(fileOwners, fmt.Sprintf("%x", file.Owner))
-		dataNode, err := c.XchainClient.GetDataNodeByID(context.TODO(), file.Owner)
+		dataNode, err := c.ChainClient.GetDataNodeByID(context.TODO(), file.Owner)
 		if err != nil {
 			return nil, err
 		}
@@ -205,7 +224,7 @@ func (c *Client) Publish(ctx context.Context, opt PublishOptions) (taskId string
 		Signature: sig[:],
 	}
 
-	if err := c.XchainClient.PublishTask(ctx, pubOpt); err != nil {
+	if err := c.ChainClient.PublishTask(ctx, pubOpt); err != nil {
 		return taskId, err
 	}
 	return task.ID, nil
@@ -213,7 +232,7 @@ func (c *Client) Publish(ctx context.Context, opt PublishOptions) (taskId string
 
 // GetTaskById gets task by taskID
 func (c *Client) GetTaskById(ctx context.Context, id string) (t blockchain.FLTask, err error) {
-	t, err = c.XchainClient.GetTaskById(ctx, id)
+	t, err = c.ChainClient.GetTaskById(ctx, id)
 	if err != nil {
 		return t, err
 	}
@@ -232,7 +251,7 @@ func (c *Client) ListTask(ctx context.Context, pubkeyStr, status string, start,
 		return tasks, errorx.Wrap(err, "failed to decode public key")
 	}
 
-	tasks, err = c.XchainClient.ListTask(ctx, &blockchain.ListFLTaskOptions{
+	tasks, err = c.ChainClient.ListTask(ctx, &blockchain.ListFLTaskOptions{
 		PubKey:    pubkey[:],
 		TimeStart: start,
 		TimeEnd:   end,
@@ -254,7 +273,7 @@ func (c *Client) StartTask(ctx context.Context, privateKey, id string) (err erro
 	if err != nil {
 		return errorx.Wrap(err, "failed to sign fl task")
 	}
-	err = c.XchainClient.StartTask(ctx, id, sig[:])
+	err = c.ChainClient.StartTask(ctx, id, sig[:])
 	return err
 }
 
@@ -267,7 +286,7 @@ func (c *Client) GetPredictResult(ctx context.Context, privateKey, taskID, outpu
 	}
 
 	// get task
-	task, err := c.XchainClient.GetTaskById(ctx, taskID)
+	task, err := c.ChainClient.GetTaskById(ctx, taskID)
 	if err != nil {
 		return err
 	}
@@ -277,7 +296,7 @@ func (c *Client) GetPredictResult(ctx context.Context, privateKey, taskID, outpu
 	}
 
 	// get result file and owner's host
-	resultFile, err := c.XchainClient.GetFileByID(ctx, task.Result)
+	resultFile, err := c.ChainClient.GetFileByID(ctx, task.Result)
 	if err != nil {
 		return errorx.Wrap(err, "failed to get predict fileId")
 	}
@@ -14,8 +14,17 @@
 package blockchain
 
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
 	"time"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/merkle"
 )
 
 const (
@@ -43,6 +52,12 @@ const (
 	FileRetainPeriod = 7 * 24 * time.Hour
 
 	ContractMessageMaxSize = 4 * 1024 * 1024
+
+	MaxFileTagsNum = 16
+	MaxFileTagLen  = 128
+
+	MaxQueryFilesLimit     = 100  // max number of files returned by a page of QueryFiles
+	MaxListNodeSlicesLimit = 1000 // max number of files whose slices are returned by a page of ListNodeSlices
 )
 
 // PublicSliceMeta public, description of a slice stored on a specific node
@@ -60,6 +75,7 @@ type PublicSliceMeta struct {
 type PrivateSliceMeta struct {
 	SliceID   string // slice ID
 	PlainHash []byte // hash of plain text
+	Segment   int    // encrypted separately, 0 for data written at first and 1,2... for data appended later
 }
 
 type FileStructure []PrivateSliceMeta
@@ -72,6 +88,22 @@ func (f *FileStructure) Parse(bs []byte) error {
 	return json.Unmarshal(bs, f)
 }
 
+// Segments returns number of segments of file, data appended to a file makes a new segment
+func (f FileStructure) Segments() int {
+	segments := 0
+	for _, s := range f {
+		if s.Segment+1 > segments {
+			segments = s.Segment + 1
+		}
+	}
+	return segments
+}
+
+// SegmentEnd checks if the i-th slice is the last one of its segment
+func (f FileStructure) SegmentEnd(i int) bool {
+	return i == len(f)-1 || f[i+1].Segment != f[i].Segment
+}
+
 // File public information stored on chain
 type File struct {
 	ID          string            // file ID, generate by engine
@@ -93,6 +125,9 @@ type File struct {
 
 	// extension
 	Ext []byte
+
+	// searchable key/value tags, user input (optional)
+	Tags map[string]string
 }
 
 type FileH struct {
@@ -136,6 +171,95 @@ type ListFileOptions struct {
 	TimeEnd     int64
 	CurrentTime int64
 	Limit       uint64 // file number limit
+	Cursor      string // continuation cursor returned by the previous page
+}
+
+// QueryFileOptions options for querying files by metadata, zero values are not used as filters
+type QueryFileOptions struct {
+	Owner      []byte            // file owner
+	Namespace  string            // file namespace
+	Tags       map[string]string // files must have all the tags
+	NamePrefix string            // prefix of file name
+
+	MinSize uint64 // plain text length range
+	MaxSize uint64
+
+	ExpireTimeStart int64 // file expire time range
+	ExpireTimeEnd   int64
+
+	CurrentTime int64  // files expired before current time are not returned
+	Limit       uint64 // file number limit, MaxQueryFilesLimit if zero or larger
+	Cursor      string // continuation cursor returned by the previous page
+}
+
+// Match checks if file meets all conditions of the query
+func (opt *QueryFileOptions) Match(f File) bool {
+	if f.ExpireTime <= opt.CurrentTime {
+		return false
+	}
+	if len(opt.Namespace) > 0 && f.Namespace != opt.Namespace {
+		return false
+	}
+	if !strings.HasPrefix(f.Name, opt.NamePrefix) {
+		return false
+	}
+	if f.Length < opt.MinSize || (opt.MaxSize > 0 && f.Length > opt.MaxSize) {
+		return false
+	}
+	if f.ExpireTime < opt.ExpireTimeStart || (opt.ExpireTimeEnd > 0 && f.ExpireTime > opt.ExpireTimeEnd) {
+		return false
+	}
+	for k, v := range opt.Tags {
+		if tv, ok := f.Tags[k]; !ok || tv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// IndexTag returns the tag used to look up the tag index when querying, empty if no tags
+//  the smallest key is chosen so that all contracts iterate the same index
+func (opt *QueryFileOptions) IndexTag() (key, value string) {
+	for k := range opt.Tags {
+		if len(key) == 0 || k < key {
+			key = k
+		}
+	}
+	if len(key) == 0 {
+		return "", ""
+	}
+	return key, opt.Tags[key]
+}
+
+// IndexNamePrefix checks if NamePrefix could be used to narrow the index when querying,
+//  file names are indexed under namespaces, so it requires a namespace
+func (opt *QueryFileOptions) IndexNamePrefix() bool {
+	return len(opt.Namespace) > 0 && len(opt.NamePrefix) > 0
+}
+
+// PageLimit returns the number of files returned by a page, which is bounded by MaxQueryFilesLimit
+func (opt *QueryFileOptions) PageLimit() uint64 {
+	if opt.Limit == 0 || opt.Limit > MaxQueryFilesLimit {
+		return MaxQueryFilesLimit
+	}
+	return opt.Limit
+}
+
+// CheckFileTags checks if tags can be indexed on chain
+func CheckFileTags(tags map[string]string) error {
+	if len(tags) > MaxFileTagsNum {
+		return fmt.Errorf("too many tags, should be no more than %d", MaxFileTagsNum)
+	}
+	for k, v := range tags {
+		if len(k) == 0 || len(k) > MaxFileTagLen || len(v) > MaxFileTagLen {
+			return fmt.Errorf("bad tag %s, length of key should be in [1, %d] and value no more than %d",
+				k, MaxFileTagLen, MaxFileTagLen)
+		}
+		if strings.ContainsAny(k, "=/\x00") || strings.ContainsAny(v, "/\x00") {
+			return fmt.Errorf("bad tag %s, '/' and '\\x00' are not allowed, and '=' is not allowed in key", k)
+		}
+	}
+	return nil
 }
 
 type ListChallengeOptions struct {
@@ -147,6 +271,7 @@ type ListChallengeOptions struct {
 	TimeStart int64 // challenge time period
 	TimeEnd   int64
 	Limit     uint64 // challenge limit
+	Cursor    string // continuation cursor returned by the previous page
 }
 
 type ChallengeRequestOptions struct {
@@ -194,6 +319,10 @@ type Node struct {
 	Online   bool  // whether node is online or offline
 	RegTime  int64 // node register time
 	UpdateAt int64 // node recent update time
+
+	Draining    bool  // whether node is draining, a draining node takes no new slices
+	DrainTime   int64 // time when node started draining
+	DrainedTime int64 // time when draining node was found holding no live slices, it's removable since then
 }
 
 type NodeH struct {
@@ -228,6 +357,18 @@ type UpdateFilePSMOptions struct {
 	Signature []byte
 }
 
+// AppendFileOptions options for appending data to a file, signed by file owner
+type AppendFileOptions struct {
+	FileID      string
+	Owner       []byte
+	Slices      []PublicSliceMeta // slices of appended data
+	Structure   []byte            // encrypted FileStructure of the whole file
+	Length      uint64            // plain text length of appended data
+	MerkleRoot  []byte            // merkle root of all slices
+	CurrentTime int64
+	Signature   []byte
+}
+
 type UpdateNsReplicaOptions struct {
 	Owner       []byte
 	Name        string
@@ -236,21 +377,402 @@ type UpdateNsReplicaOptions struct {
 	Signature   []byte
 }
 
+// UpdateNsQuotaOptions options for updating namespace quota, signed by namespace owner
+type UpdateNsQuotaOptions struct {
+	Owner       []byte
+	Name        string
+	Quota       NsQuota
+	CurrentTime int64
+	Signature   []byte
+}
+
+// UpdateNsRenewalOptions options for updating namespace renewal policy, signed by namespace owner
+type UpdateNsRenewalOptions struct {
+	Owner       []byte
+	Name        string
+	Renewal     NsRenewal
+	CurrentTime int64
+	Signature   []byte
+}
+
 type ListNodeSliceOptions struct {
 	Target []byte
 
 	StartTime int64
 	EndTime   int64
 	Limit     uint64
+	Cursor    string // continuation cursor returned by the previous page, used by ListNodeSlices
+}
+
+// PageLimit returns the number of files whose slices are returned by a page of ListNodeSlices,
+//  which is bounded by MaxListNodeSlicesLimit
+func (opt *ListNodeSliceOptions) PageLimit() uint64 {
+	if opt.Limit == 0 || opt.Limit > MaxListNodeSlicesLimit {
+		return MaxListNodeSlicesLimit
+	}
+	return opt.Limit
 }
 
 type NodeSliceMigrateOptions ListNodeSliceOptions
 
+// NodeSlice a slice stored on a storage node, with the file it belongs to
+type NodeSlice struct {
+	FileID     string
+	ExpireTime int64 // expire time of the file
+	PublicSliceMeta
+}
+
+// CorruptedSlice a slice reported corrupted by the storage node holding it
+type CorruptedSlice struct {
+	FileID     string
+	SliceID    string
+	NodeID     []byte
+	ReportTime int64
+}
+
+// ReportCorruptedSliceOptions signed by the storage node holding the slice
+type ReportCorruptedSliceOptions struct {
+	FileID    string
+	SliceID   string
+	NodeID    []byte
+	Nonce     int64 // report time
+	Signature []byte
+}
+
+// NodeDrainStatus draining progress of a storage node
+//  a draining node is removable once it's recorded on chain holding no live slices
+type NodeDrainStatus struct {
+	NodeID         string
+	Draining       bool
+	DrainTime      int64
+	LiveSlices     int // slices of unexpired files still on the node
+	MigratedSlices int // slices migrated away from the node since draining started
+	Removable      bool
+}
+
+// HealthPolicy scoring policy of storage node health, set on chain by the network admin,
+//  a node scores ChallengeWeight*provedRate + HeartbeatWeight*heartbeatRate + LatencyWeight*latencyRate
+//  over the last Window days, and it's green if score >= GoodBound, red if score < MediumBound
+type HealthPolicy struct {
+	Version int64 // increased by one each update, 0 for the default policy
+	Window  int   // days of statistics
+
+	ChallengeWeight float64
+	HeartbeatWeight float64
+	LatencyWeight   float64 // optional, 0 to disable
+
+	GoodBound   float64
+	MediumBound float64
+
+	DefaultChallengeRate float64 // used if no challenge in window
+	DefaultHeartbeatRate float64 // used if no heartbeat expected in window
+	MaxLatency           int64   // nanoseconds, average answer latency of proved challenges scoring 0
+
+	UpdateTime int64
+}
+
+// DefaultHealthPolicy returns the policy used before any policy set on chain
+func DefaultHealthPolicy() HealthPolicy {
+	return HealthPolicy{
+		Window:               NodeHealthTimeDur,
+		ChallengeWeight:      NodeHealthChallProp,
+		HeartbeatWeight:      NodeHealthHeartBeatProp,
+		GoodBound:            NodeHealthBoundGood,
+		MediumBound:          NodeHealthBoundMedium,
+		DefaultChallengeRate: DefaultChallProvedRate,
+		DefaultHeartbeatRate: DefaultHearBeatRate,
+	}
+}
+
+// Valid checks if the policy is well-formed, weights should sum up to 1
+func (p *HealthPolicy) Valid() error {
+	if p.Window < 1 || p.Window > 90 {
+		return fmt.Errorf("window should be in [1, 90] days")
+	}
+	for _, v := range []float64{p.ChallengeWeight, p.HeartbeatWeight, p.LatencyWeight,
+		p.GoodBound, p.MediumBound, p.DefaultChallengeRate, p.DefaultHeartbeatRate} {
+		if v < 0 || v > 1 {
+			return fmt.Errorf("weights, bounds and default rates should be in [0, 1]")
+		}
+	}
+	if sum := p.ChallengeWeight + p.HeartbeatWeight + p.LatencyWeight; sum < 0.999 || sum > 1.001 {
+		return fmt.Errorf("weights should sum up to 1, got %g", sum)
+	}
+	if p.MediumBound > p.GoodBound {
+		return fmt.Errorf("medium bound should be no greater than good bound")
+	}
+	if p.LatencyWeight > 0 && p.MaxLatency <= 0 {
+		return fmt.Errorf("max latency is required by latency weight")
+	}
+	return nil
+}
+
+// Message returns the message signed by the network admin to set the policy
+func (p *HealthPolicy) Message(ctime int64) string {
+	return fmt.Sprintf("%d,%d,%g,%g,%g,%g,%g,%g,%g,%d,%d", p.Version, p.Window, p.ChallengeWeight, p.HeartbeatWeight,
+		p.LatencyWeight, p.GoodBound, p.MediumBound, p.DefaultChallengeRate, p.DefaultHeartbeatRate, p.MaxLatency, ctime)
+}
+
+// NodeHealthStats statistics of a storage node in the window of health policy
+type NodeHealthStats struct {
+	ChallengeNum   uint64
+	ProvedNum      uint64
+	HeartbeatNum   int
+	HeartbeatMax   int
+	LatencySamples int   // number of proved challenges whose latency is counted
+	AvgLatency     int64 // nanoseconds, average answer latency of proved challenges
+}
+
+// Score returns health score of a node in [0, 1]
+func (p *HealthPolicy) Score(s NodeHealthStats) float64 {
+	provedRate := p.DefaultChallengeRate
+	if s.ChallengeNum > 0 {
+		provedRate = float64(s.ProvedNum) / float64(s.ChallengeNum)
+	}
+	heartbeatRate := p.DefaultHeartbeatRate
+	if s.HeartbeatMax > 0 {
+		heartbeatRate = math.Min(float64(s.HeartbeatNum)/float64(s.HeartbeatMax), 1)
+	}
+	// no latency penalty if no challenge proved, which the challenge rate already accounts for
+	latencyRate := 1.0
+	if p.LatencyWeight > 0 && p.MaxLatency > 0 && s.LatencySamples > 0 {
+		latencyRate = math.Max(1-float64(s.AvgLatency)/float64(p.MaxLatency), 0)
+	}
+	return p.ChallengeWeight*provedRate + p.HeartbeatWeight*heartbeatRate + p.LatencyWeight*latencyRate
+}
+
+// Health returns health status of a node scoring score
+func (p *HealthPolicy) Health(score float64) string {
+	if score >= p.GoodBound {
+		return NodeHealthGood
+	}
+	if score < p.MediumBound {
+		return NodeHealthBad
+	}
+	return NodeHealthMedium
+}
+
+// UpdateHealthPolicyOptions signed by the network admin, Policy.Version should be the current version plus one
+type UpdateHealthPolicyOptions struct {
+	Policy      HealthPolicy
+	CurrentTime int64
+	Signature   []byte
+}
+
+// SignedHeartbeat a heartbeat signed by storage node, signature is the same as sent to chain one by one
+type SignedHeartbeat struct {
+	Timestamp int64
+	Signature []byte
+}
+
+// Leaf returns the merkle leaf of the heartbeat
+func (h SignedHeartbeat) Leaf() []byte {
+	return hash.Hash([]byte(fmt.Sprintf("%d,%x", h.Timestamp, h.Signature)))
+}
+
+// HeartbeatCommit aggregated heartbeats of a storage node posted on chain instead of each heartbeat,
+//  Root is the merkle root of heartbeats from Start to End kept by the node, so that they could be verified later
+type HeartbeatCommit struct {
+	NodeID     []byte
+	Start      int64 // time of the first heartbeat
+	End        int64 // time of the last heartbeat
+	Count      int
+	Root       []byte
+	CommitTime int64
+}
+
+// NewHeartbeatCommit aggregates heartbeats sorted by time
+func NewHeartbeatCommit(nodeID []byte, beats []SignedHeartbeat, ctime int64) HeartbeatCommit {
+	leaves := make([][]byte, 0, len(beats))
+	for _, b := range beats {
+		leaves = append(leaves, b.Leaf())
+	}
+	return HeartbeatCommit{
+		NodeID:     nodeID,
+		Start:      beats[0].Timestamp,
+		End:        beats[len(beats)-1].Timestamp,
+		Count:      len(beats),
+		Root:       merkle.GetMerkleRoot(leaves),
+		CommitTime: ctime,
+	}
+}
+
+// Message returns the message signed by storage node to post the commit
+func (c *HeartbeatCommit) Message() string {
+	return fmt.Sprintf("%s,%d,%d,%d,%x,%d", c.NodeID, c.Start, c.End, c.Count, c.Root, c.CommitTime)
+}
+
+// MaxCount returns the max number of heartbeats between Start and End,
+//  heartbeats are no closer than half of HeartBeatFreq considering jitters
+func (c *HeartbeatCommit) MaxCount() int {
+	return int((c.End-c.Start)/int64(HeartBeatFreq/2)) + 1
+}
+
+// Verify checks heartbeats kept by storage node against the commit
+func (c *HeartbeatCommit) Verify(beats []SignedHeartbeat) error {
+	if len(beats) != c.Count {
+		return fmt.Errorf("got %d heartbeats, committed %d", len(beats), c.Count)
+	}
+	pk, err := hex.DecodeString(string(c.NodeID))
+	if err != nil || len(pk) != ecdsa.PublicKeyLength {
+		return fmt.Errorf("bad node id")
+	}
+	var pubkey ecdsa.PublicKey
+	copy(pubkey[:], pk)
+
+	leaves := make([][]byte, 0, len(beats))
+	for i, b := range beats {
+		if b.Timestamp < c.Start || b.Timestamp > c.End {
+			return fmt.Errorf("heartbeat at %d out of committed range", b.Timestamp)
+		}
+		if i > 0 && b.Timestamp-beats[i-1].Timestamp < int64(HeartBeatFreq/2) {
+			return fmt.Errorf("heartbeat at %d too close to the previous one", b.Timestamp)
+		}
+		var sig ecdsa.Signature
+		copy(sig[:], b.Signature)
+		m := fmt.Sprintf("%s,%d", c.NodeID, b.Timestamp)
+		if err := ecdsa.Verify(pubkey, hash.Hash([]byte(m)), sig); err != nil {
+			return fmt.Errorf("bad signature of heartbeat at %d", b.Timestamp)
+		}
+		leaves = append(leaves, b.Leaf())
+	}
+	if len(leaves) == 0 || !bytes.Equal(merkle.GetMerkleRoot(leaves), c.Root) {
+		return fmt.Errorf("merkle root mismatched")
+	}
+	return nil
+}
+
+// CommitHeartbeatsOptions signed by storage node, heartbeats committed should be in the day beginning at DayBegin
+type CommitHeartbeatsOptions struct {
+	Commit    HeartbeatCommit
+	DayBegin  int64
+	Signature []byte
+}
+
 type AddNsOptions struct {
 	Namespace Namespace
 	Signature []byte
 }
 
+// roles of namespace members, an admin could also write and read, and a writer could also read
+const (
+	NsRoleAdmin  = "admin"
+	NsRoleWriter = "writer"
+	NsRoleReader = "reader"
+)
+
+var nsRoleLevels = map[string]int{NsRoleReader: 1, NsRoleWriter: 2, NsRoleAdmin: 3}
+
+// NsRoleAllows checks if role has all permissions of the required role
+func NsRoleAllows(role, required string) bool {
+	return nsRoleLevels[role] > 0 && nsRoleLevels[role] >= nsRoleLevels[required]
+}
+
+// NsMember member of a namespace
+//  the namespace owner is not a member but has all permissions
+type NsMember struct {
+	Owner     []byte
+	Namespace string
+	Member    []byte
+	Role      string
+	AddTime   int64
+}
+
+// operations on namespace members, signed in NsMemberOptions so that options signed for one
+//  operation could not be submitted as the other
+const (
+	NsMemberAdd    = "add"
+	NsMemberRemove = "remove"
+)
+
+// NsMemberOptions options for adding or removing a namespace member, signed by namespace owner
+type NsMemberOptions struct {
+	Owner       []byte
+	Namespace   string
+	Member      []byte
+	Role        string // empty if removing
+	CurrentTime int64
+	Signature   []byte
+}
+
+// status of an ownership transfer
+const (
+	TransferProposed  = "Proposed"
+	TransferAccepted  = "Accepted"
+	TransferCompleted = "Completed"
+	TransferCanceled  = "Canceled"
+)
+
+// Transfer ownership transfer of a file or a whole namespace between dataOwners,
+//  proposed by the owner and accepted by the new owner. The new owner then fetches files from the
+//  old dataOwner-node and re-uploads them into NewNamespace, files of the old owner expire once replaced
+type Transfer struct {
+	ID           string
+	Owner        []byte
+	Namespace    string
+	FileID       string // empty if transferring the whole namespace
+	NewOwner     []byte
+	NewNamespace string
+	Status       string
+	ProposeTime  int64
+	AcceptTime   int64
+	CompleteTime int64
+	CancelTime   int64
+	Files        map[string]string // replaced files, ID of old file => ID of new file
+}
+
+// Covers checks if a file of the old owner is included in transfer
+func (t *Transfer) Covers(f File) bool {
+	return bytes.Equal(t.Owner, f.Owner) && t.Namespace == f.Namespace && (t.FileID == "" || t.FileID == f.ID)
+}
+
+// ProposeTransferOptions signed by the owner
+type ProposeTransferOptions struct {
+	ID           string
+	Owner        []byte
+	Namespace    string
+	FileID       string
+	NewOwner     []byte
+	NewNamespace string
+	CurrentTime  int64
+	Signature    []byte
+}
+
+// AcceptTransferOptions signed by the new owner, NewNamespace must already exist
+type AcceptTransferOptions struct {
+	ID          string
+	CurrentTime int64
+	Signature   []byte
+}
+
+// CancelTransferOptions signed by the owner or the new owner, a transfer could be canceled until it completes,
+//  files already replaced stay with the new owner
+type CancelTransferOptions struct {
+	ID          string
+	User        []byte
+	CurrentTime int64
+	Signature   []byte
+}
+
+// CompleteTransferOptions signed by the new owner, records that file FileID has been replaced by NewFileID,
+//  an empty FileID finishes a namespace transfer once all its files are replaced. Receipt is signed by
+//  the old owner over ContentHash, hash of the plaintext handed over to the new owner
+type CompleteTransferOptions struct {
+	ID          string
+	FileID      string
+	NewFileID   string
+	ContentHash []byte
+	Receipt     []byte
+	CurrentTime int64
+	Signature   []byte
+}
+
+// ListTransferOptions lists transfers proposed by or to User
+type ListTransferOptions struct {
+	User  []byte
+	Limit uint64
+}
+
 type Namespace struct {
 	Name          string
 	Description   string
@@ -260,6 +782,96 @@ type Namespace struct {
 	FileTotalNum  int64
 	CreateTime    int64
 	UpdateTime    int64
+
+	// quota and usage, files out of retain period are not counted
+	Quota          NsQuota
+	FilesTotalSize uint64 // plain text size of files
+	FileActiveNum  int64  // number of files
+
+	// renewal policy of files, applied by dataOwner-node
+	Renewal NsRenewal
+}
+
+// NsQuota quotas of a namespace, zero means unlimited
+type NsQuota struct {
+	MaxBytes    uint64 // total plain text bytes of files
+	MaxFiles    int64  // number of files
+	MaxFileSize uint64 // plain text bytes of a single file
+}
+
+// QuotaExceeded checks if adding a file of given length exceeds quotas of namespace,
+//  returns the reason or empty if not exceeded
+func (n *Namespace) QuotaExceeded(length uint64) string {
+	if n.Quota.MaxFileSize > 0 && length > n.Quota.MaxFileSize {
+		return fmt.Sprintf("file size %d larger than max file size %d of ns", length, n.Quota.MaxFileSize)
+	}
+	if n.Quota.MaxBytes > 0 && n.FilesTotalSize+length > n.Quota.MaxBytes {
+		return fmt.Sprintf("ns total size would be %d, larger than max bytes %d", n.FilesTotalSize+length, n.Quota.MaxBytes)
+	}
+	if n.Quota.MaxFiles > 0 && n.FileActiveNum+1 > n.Quota.MaxFiles {
+		return fmt.Sprintf("ns already has %d files, reaching max files %d", n.FileActiveNum, n.Quota.MaxFiles)
+	}
+	return ""
+}
+
+// AppendExceeded checks if appending data of given length to a file of fileLength exceeds quotas of namespace,
+//  returns the reason or empty if not exceeded
+func (n *Namespace) AppendExceeded(fileLength, length uint64) string {
+	if n.Quota.MaxFileSize > 0 && fileLength+length > n.Quota.MaxFileSize {
+		return fmt.Sprintf("file size would be %d, larger than max file size %d of ns", fileLength+length, n.Quota.MaxFileSize)
+	}
+	if n.Quota.MaxBytes > 0 && n.FilesTotalSize+length > n.Quota.MaxBytes {
+		return fmt.Sprintf("ns total size would be %d, larger than max bytes %d", n.FilesTotalSize+length, n.Quota.MaxBytes)
+	}
+	return ""
+}
+
+// renewal policies of namespace
+const (
+	NsRenewalNone  = ""      // files expire at expire time
+	NsRenewalUntil = "until" // files are always renewed until a given time
+	NsRenewalTask  = "task"  // files are renewed while referenced by an active dai task
+)
+
+// NsRenewal renewal policy of files in a namespace, dataOwner-node extends expire time of files
+//  about to expire by Days days each time
+type NsRenewal struct {
+	Policy string
+	Days   int   // days to extend by each time
+	Until  int64 // files are not renewed beyond Until if Policy is "until"
+}
+
+// ValidNsRenewal checks if renewal policy is well-formed
+func ValidNsRenewal(r NsRenewal) bool {
+	switch r.Policy {
+	case NsRenewalNone:
+		return r.Days == 0 && r.Until == 0
+	case NsRenewalUntil:
+		return r.Days > 0 && r.Until > 0
+	case NsRenewalTask:
+		return r.Days > 0 && r.Until == 0
+	}
+	return false
+}
+
+// RenewTo returns the new expire time of a file under the renewal policy, or 0 if it should not be renewed,
+//  files are renewed from ctime if they've already expired
+func (r NsRenewal) RenewTo(expireTime, ctime int64) int64 {
+	if r.Policy == NsRenewalNone || r.Days <= 0 {
+		return 0
+	}
+	start := expireTime
+	if start < ctime {
+		start = ctime
+	}
+	to := start + int64(r.Days)*(24*time.Hour).Nanoseconds()
+	if r.Policy == NsRenewalUntil && to > r.Until {
+		to = r.Until
+	}
+	if to <= expireTime || to <= ctime {
+		return 0
+	}
+	return to
 }
 
 type NamespaceH struct {
@@ -305,3 +917,43 @@ type UpdateNsFilesCapOptions struct {
 	CurrentTime int64
 	Signature   []byte
 }
+
+// status of dai tasks referencing files
+const (
+	DaiTaskConfirming = "Confirming"
+	DaiTaskReady      = "Ready"
+	DaiTaskToProcess  = "ToProcess"
+	DaiTaskProcessing = "Processing"
+)
+
+// DaiTaskActive checks if a dai task with the status may still read its data sets
+func DaiTaskActive(status string) bool {
+	switch status {
+	case DaiTaskConfirming, DaiTaskReady, DaiTaskToProcess, DaiTaskProcessing:
+		return true
+	}
+	return false
+}
+
+// ListDaiTaskOptions options for listing tasks of dai published on the same blockchain,
+//  the same as ListFLTaskOptions of dai
+type ListDaiTaskOptions struct {
+	PubKey    []byte // requester or executor's public key
+	Status    string // task status, all if empty
+	TimeStart int64  // task publish time period
+	TimeEnd   int64
+	Limit     uint64
+}
+
+// DaiTask task of dai, only fields used by xdb are decoded
+type DaiTask struct {
+	ID       string        `json:"iD"`
+	Status   string        `json:"status"`
+	DataSets []DaiTaskData `json:"dataSets"`
+}
+
+// DaiTaskData data set of a dai task
+type DaiTaskData struct {
+	Owner  []byte `json:"owner"`
+	DataID string `json:"dataID"` // file id
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockchain
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+)
+
+// FilePage is a page of files returned by listing contracts
+//  Next is the cursor used to fetch the following page, empty if there are no more files
+type FilePage struct {
+	Files []File `json:"files"`
+	Next  string `json:"next,omitempty"`
+}
+
+// NamespacePage is a page of namespaces returned by listing contracts
+type NamespacePage struct {
+	Namespaces []Namespace `json:"namespaces"`
+	Next       string      `json:"next,omitempty"`
+}
+
+// ChallengePage is a page of challenges returned by listing contracts
+type ChallengePage struct {
+	Challenges []Challenge `json:"challenges"`
+	Next       string      `json:"next,omitempty"`
+}
+
+// NodeSlicePage is a page of slices on a storage node returned by listing contracts
+type NodeSlicePage struct {
+	Slices []NodeSlice `json:"slices"`
+	Next   string      `json:"next,omitempty"`
+}
+
+// EncodeCursor packs the last iterated index key into an opaque cursor
+func EncodeCursor(key []byte) string {
+	return base64.RawURLEncoding.EncodeToString(key)
+}
+
+// DecodeCursor unpacks a cursor into the index key iteration stopped at
+//  the key must be under prefix so that a cursor can't be used to read other indexes
+func DecodeCursor(cursor string, prefix []byte) ([]byte, error) {
+	key, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !bytes.HasPrefix(key, prefix) {
+		return nil, errors.New("invalid cursor")
+	}
+	return key, nil
+}
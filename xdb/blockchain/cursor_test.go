@@ -0,0 +1,37 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	prefix := []byte("index_fn_list/owner/ns/")
+	key := append(append([]byte{}, prefix...), "file1"...)
+
+	cursor := EncodeCursor(key)
+	decoded, err := DecodeCursor(cursor, prefix)
+	require.NoError(t, err)
+	require.Equal(t, key, decoded)
+
+	// cursor of another listing is rejected
+	_, err = DecodeCursor(cursor, []byte("index_fn_list/owner/other/"))
+	require.Error(t, err)
+
+	_, err = DecodeCursor("not base64!", prefix)
+	require.Error(t, err)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embedded
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/sirupsen/logrus"
+	"github.com/xuperchain/xuperchain/core/contractsdk/go/code"
+	"github.com/xuperchain/xuperchain/core/contractsdk/go/pb"
+)
+
+var (
+	logger = logrus.WithField("module", "embedded-blockchain")
+
+	errNotSupported = errors.New("not supported by embedded blockchain")
+)
+
+// contractContext implements code.Context on the embedded ledger,
+//  only args and state accessing are supported as the contract needs no more
+type contractContext struct {
+	args map[string][]byte
+	tx   tx
+}
+
+func newContractContext(args map[string]string, t tx) *contractContext {
+	ctx := &contractContext{
+		args: make(map[string][]byte, len(args)),
+		tx:   t,
+	}
+	for k, v := range args {
+		ctx.args[k] = []byte(v)
+	}
+	return ctx
+}
+
+func (c *contractContext) Args() map[string][]byte {
+	return c.args
+}
+
+func (c *contractContext) Caller() string {
+	return ""
+}
+
+func (c *contractContext) Initiator() string {
+	return ""
+}
+
+func (c *contractContext) AuthRequire() []string {
+	return nil
+}
+
+func (c *contractContext) PutObject(key []byte, value []byte) error {
+	return c.tx.put(key, value)
+}
+
+func (c *contractContext) GetObject(key []byte) ([]byte, error) {
+	value := c.tx.get(key)
+	if value == nil {
+		return nil, errors.New("key not found")
+	}
+	return value, nil
+}
+
+func (c *contractContext) DeleteObject(key []byte) error {
+	return c.tx.delete(key)
+}
+
+func (c *contractContext) NewIterator(start, limit []byte) code.Iterator {
+	return c.tx.iterate(start, limit)
+}
+
+func (c *contractContext) QueryTx(txid string) (*pb.Transaction, error) {
+	return nil, errNotSupported
+}
+
+func (c *contractContext) QueryBlock(blockid string) (*pb.Block, error) {
+	return nil, errNotSupported
+}
+
+func (c *contractContext) Transfer(to string, amount *big.Int) error {
+	return errNotSupported
+}
+
+func (c *contractContext) TransferAmount() (*big.Int, error) {
+	return nil, errNotSupported
+}
+
+func (c *contractContext) Call(module, contract, method string, args map[string][]byte) (*code.Response, error) {
+	return nil, errNotSupported
+}
+
+func (c *contractContext) CrossQuery(uri string, args map[string][]byte) (*code.Response, error) {
+	return nil, errNotSupported
+}
+
+func (c *contractContext) EmitEvent(name string, body []byte) error {
+	return nil
+}
+
+func (c *contractContext) EmitJSONEvent(name string, body interface{}) error {
+	return nil
+}
+
+func (c *contractContext) Logf(fmt string, args ...interface{}) {
+	logger.Debugf(fmt, args...)
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package embedded provides a blockchain running in process for offline development,
+//  contract methods are the same as XuperChain's and run on a ledger kept in memory or in a BoltDB file
+package embedded
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/xuperchain/xuperchain/core/contractsdk/go/code"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain/xchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain/xchain/contract/core"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/config"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// Contract runs methods of a contract in process, which implements xchain.Contract
+type Contract struct {
+	contract reflect.Value
+	ledger   ledger
+}
+
+// NewContract creates a Contract running contract on the ledger configured, contract could be any XuperChain
+//  contract such as the one of PaddleDTX-DAI. The ledger is kept in memory if conf is nil or DBPath is empty
+func NewContract(contract code.Contract, conf *config.EmbeddedConf) (*Contract, error) {
+	var l ledger = newMemLedger()
+	if conf != nil && len(conf.DBPath) > 0 {
+		bl, err := newBoltLedger(conf.DBPath)
+		if err != nil {
+			return nil, errorx.NewCode(err, errorx.ErrCodeConfig, "failed to open ledger %s", conf.DBPath)
+		}
+		l = bl
+	}
+	return &Contract{
+		contract: reflect.ValueOf(contract),
+		ledger:   l,
+	}, nil
+}
+
+// New creates a XChain client whose contract runs in process
+func New(conf *config.EmbeddedConf) (*xchain.XChain, error) {
+	c, err := NewContract(new(core.Xdata), conf)
+	if err != nil {
+		return nil, err
+	}
+	// the ledger is initialized like a deployed contract only if network admin is configured
+	if conf != nil && len(conf.Admin) > 0 {
+		args := map[string]string{
+			"creator": "embedded",
+			"admin":   conf.Admin,
+		}
+		if _, err := c.Invoke(args, "Initialize"); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	return &xchain.XChain{
+		ContractName: "xdata",
+		ChainName:    "embedded",
+		Contract:     c,
+	}, nil
+}
+
+// Invoke runs a method in a transaction, changes are discarded if the method fails
+func (c *Contract) Invoke(args map[string]string, mName string) (body []byte, err error) {
+	err = c.ledger.update(func(t tx) error {
+		body, err = c.call(t, args, mName)
+		return err
+	})
+	return body, err
+}
+
+// Query runs a method without changing the ledger
+func (c *Contract) Query(args map[string]string, mName string) (body []byte, err error) {
+	err = c.ledger.view(func(t tx) error {
+		body, err = c.call(t, args, mName)
+		return err
+	})
+	return body, err
+}
+
+// Close closes the ledger
+func (c *Contract) Close() error {
+	return c.ledger.close()
+}
+
+// call finds the method by name and runs it like the contract driver of XuperChain does
+func (c *Contract) call(t tx, args map[string]string, mName string) (body []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errorx.New(errorx.ErrCodeInternal, "contract method %s panicked: %v", mName, r)
+		}
+	}()
+
+	mv := c.contract.MethodByName(strings.Title(mName))
+	if !mv.IsValid() {
+		return nil, errorx.New(errorx.ErrCodeInternal, "bad method %s", mName)
+	}
+	method, ok := mv.Interface().(func(code.Context) code.Response)
+	if !ok {
+		return nil, errorx.New(errorx.ErrCodeInternal, "bad method type %s", mName)
+	}
+
+	resp := method(newContractContext(args, t))
+	if code.IsStatusError(resp.Status) {
+		if ec, em, ok := errorx.TryParseFromString(resp.Message); ok {
+			return nil, errorx.Wrap(errorx.New(ec, em), "failed to run contract method %s", mName)
+		}
+		return nil, errorx.New(errorx.ErrCodeInternal,
+			"failed to run contract method %s: %s", mName, resp.Message)
+	}
+	return resp.Body, nil
+}
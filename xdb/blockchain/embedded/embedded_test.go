@@ -0,0 +1,934 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embedded
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain/xchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/config"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+func newAddNodeOptions(t *testing.T, name string) *blockchain.AddNodeOptions {
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+
+	node := blockchain.Node{
+		ID:      []byte(hex.EncodeToString(pubkey[:])),
+		Name:    name,
+		Address: "127.0.0.1:8122",
+		Online:  true,
+	}
+	s, err := json.Marshal(node)
+	require.NoError(t, err)
+	sig, err := ecdsa.Sign(privkey, hash.Hash(s))
+	require.NoError(t, err)
+	return &blockchain.AddNodeOptions{Node: node, Signature: sig[:]}
+}
+
+func TestNodes(t *testing.T) {
+	confs := map[string]*config.EmbeddedConf{
+		"memory": nil,
+		"bolt":   {DBPath: filepath.Join(t.TempDir(), "ledger.db")},
+	}
+	for name, conf := range confs {
+		t.Run(name, func(t *testing.T) {
+			chain, err := New(conf)
+			require.NoError(t, err)
+			defer chain.Contract.(*Contract).Close()
+
+			ctx := context.Background()
+			opt := newAddNodeOptions(t, "node1")
+			require.NoError(t, chain.AddNode(ctx, opt))
+
+			// errors from contract keep their codes
+			err = chain.AddNode(ctx, opt)
+			require.True(t, errorx.Is(err, errorx.ErrCodeAlreadyExists), err)
+			bad := newAddNodeOptions(t, "node2")
+			bad.Signature = opt.Signature
+			err = chain.AddNode(ctx, bad)
+			require.True(t, errorx.Is(err, errorx.ErrCodeBadSignature), err)
+
+			nodes, err := chain.ListNodes(ctx)
+			require.NoError(t, err)
+			require.Len(t, nodes, 1)
+			node, err := chain.GetNode(ctx, opt.Node.ID)
+			require.NoError(t, err)
+			require.Equal(t, "node1", node.Name)
+		})
+	}
+}
+
+func TestLedger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ledger.db")
+	bl, err := newBoltLedger(path)
+	require.NoError(t, err)
+	ledgers := map[string]ledger{
+		"memory": newMemLedger(),
+		"bolt":   bl,
+	}
+	for name, l := range ledgers {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, l.update(func(txn tx) error {
+				for _, k := range []string{"a/2", "a/1", "b/1"} {
+					if err := txn.put([]byte(k), []byte(k)); err != nil {
+						return err
+					}
+				}
+				return nil
+			}))
+
+			// changes of a failed transaction are discarded
+			err := l.update(func(txn tx) error {
+				txn.put([]byte("a/3"), []byte("a/3"))
+				txn.delete([]byte("a/1"))
+				return errors.New("failed")
+			})
+			require.Error(t, err)
+
+			var keys []string
+			require.NoError(t, l.view(func(txn tx) error {
+				require.Equal(t, errReadOnly, txn.put([]byte("c"), nil))
+				it := txn.iterate([]byte("a/"), []byte("a0"))
+				for it.Next() {
+					keys = append(keys, string(it.Key()))
+				}
+				return nil
+			}))
+			require.Equal(t, []string{"a/1", "a/2"}, keys)
+		})
+	}
+
+	// ledger persists in BoltDB file
+	require.NoError(t, bl.close())
+	bl, err = newBoltLedger(path)
+	require.NoError(t, err)
+	defer bl.close()
+	require.NoError(t, bl.view(func(txn tx) error {
+		if txn.get([]byte("b/1")) == nil {
+			return errors.New("key lost")
+		}
+		return nil
+	}))
+}
+
+func TestHealthPolicy(t *testing.T) {
+	adminPriv, adminPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	chain, err := New(&config.EmbeddedConf{Admin: adminPub.String()})
+	require.NoError(t, err)
+	defer chain.Contract.(*Contract).Close()
+
+	ctx := context.Background()
+	p, err := chain.GetHealthPolicy(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, blockchain.DefaultHealthPolicy(), p)
+
+	newOpt := func(priv ecdsa.PrivateKey, p blockchain.HealthPolicy) *blockchain.UpdateHealthPolicyOptions {
+		sig, err := ecdsa.Sign(priv, hash.Hash([]byte(p.Message(1))))
+		require.NoError(t, err)
+		return &blockchain.UpdateHealthPolicyOptions{Policy: p, CurrentTime: 1, Signature: sig[:]}
+	}
+	p.Version, p.Window = 1, 14
+	p.ChallengeWeight, p.HeartbeatWeight, p.LatencyWeight = 0.6, 0.2, 0.2
+	p.MaxLatency = 100
+
+	// only the network admin sets well-formed policies version by version
+	otherPriv, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	err = chain.UpdateHealthPolicy(ctx, newOpt(otherPriv, p))
+	require.True(t, errorx.Is(err, errorx.ErrCodeBadSignature), err)
+	bad := p
+	bad.MaxLatency = 0
+	err = chain.UpdateHealthPolicy(ctx, newOpt(adminPriv, bad))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	bad = p
+	bad.Version = 2
+	err = chain.UpdateHealthPolicy(ctx, newOpt(adminPriv, bad))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+
+	require.NoError(t, chain.UpdateHealthPolicy(ctx, newOpt(adminPriv, p)))
+	current, err := chain.GetHealthPolicy(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), current.UpdateTime)
+	v1, err := chain.GetHealthPolicy(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, current, v1)
+
+	// no latency penalty without proved challenges, and heartbeat rate is capped
+	stats := blockchain.NodeHealthStats{ChallengeNum: 10, ProvedNum: 5, HeartbeatNum: 30, HeartbeatMax: 20}
+	require.InDelta(t, 0.6*0.5+0.2+0.2, current.Score(stats), 1e-9)
+	stats.LatencySamples, stats.AvgLatency = 5, 50
+	require.InDelta(t, 0.6*0.5+0.2+0.2*0.5, current.Score(stats), 1e-9)
+	require.Equal(t, blockchain.NodeHealthMedium, current.Health(current.Score(stats)))
+
+	// the policy could not be changed without network admin
+	chain2, err := New(nil)
+	require.NoError(t, err)
+	defer chain2.Contract.(*Contract).Close()
+	err = chain2.UpdateHealthPolicy(ctx, newOpt(adminPriv, p))
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotAuthorized), err)
+}
+
+func TestHeartbeatCommits(t *testing.T) {
+	chain, err := New(nil)
+	require.NoError(t, err)
+	defer chain.Contract.(*Contract).Close()
+
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	node := blockchain.Node{ID: []byte(pubkey.String()), Name: "node1", Address: "127.0.0.1:8122", Online: true}
+	s, err := json.Marshal(node)
+	require.NoError(t, err)
+	sig, err := ecdsa.Sign(privkey, hash.Hash(s))
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, chain.AddNode(ctx, &blockchain.AddNodeOptions{Node: node, Signature: sig[:]}))
+
+	day := time.Date(2021, 7, 10, 0, 0, 0, 0, time.Local).UnixNano()
+	var beats []blockchain.SignedHeartbeat
+	for i := 0; i < 3; i++ {
+		ts := day + int64(time.Hour) + int64(i)*int64(blockchain.HeartBeatFreq)
+		sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(fmt.Sprintf("%s,%d", node.ID, ts))))
+		require.NoError(t, err)
+		beats = append(beats, blockchain.SignedHeartbeat{Timestamp: ts, Signature: sig[:]})
+	}
+	newOpt := func(c blockchain.HeartbeatCommit) *blockchain.CommitHeartbeatsOptions {
+		sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(c.Message())))
+		require.NoError(t, err)
+		return &blockchain.CommitHeartbeatsOptions{Commit: c, DayBegin: day, Signature: sig[:]}
+	}
+	c := blockchain.NewHeartbeatCommit(node.ID, beats, beats[2].Timestamp+1)
+
+	// commits claiming more heartbeats than possible or crossing days are rejected
+	bad := c
+	bad.Count = 10
+	err = chain.CommitHeartbeats(ctx, newOpt(bad))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	bad = c
+	bad.End = day + int64(25*time.Hour)
+	bad.CommitTime = bad.End
+	err = chain.CommitHeartbeats(ctx, newOpt(bad))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+
+	require.NoError(t, chain.CommitHeartbeats(ctx, newOpt(c)))
+	err = chain.CommitHeartbeats(ctx, newOpt(c))
+	require.True(t, errorx.Is(err, errorx.ErrCodeAlreadyExists), err)
+
+	// committed heartbeats are counted, and verified against heartbeats kept by the node
+	num, err := chain.GetHeartbeatNum(ctx, node.ID, day)
+	require.NoError(t, err)
+	require.Equal(t, 3, num)
+	last, err := chain.GetLastHeartbeatCommit(ctx, node.ID)
+	require.NoError(t, err)
+	require.Equal(t, c, last)
+	commits, err := chain.GetHeartbeatCommits(ctx, node.ID, day)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	require.NoError(t, commits[0].Verify(beats))
+	require.Error(t, commits[0].Verify(beats[:2]))
+	forged := append([]blockchain.SignedHeartbeat{}, beats...)
+	forged[1].Signature = beats[0].Signature
+	require.Error(t, commits[0].Verify(forged))
+}
+
+func TestAppendFile(t *testing.T) {
+	chain, err := New(nil)
+	require.NoError(t, err)
+	defer chain.Contract.(*Contract).Close()
+
+	ctx := context.Background()
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	now := time.Now().UnixNano()
+
+	ns := blockchain.Namespace{Name: "ns", Owner: pubkey[:], Replica: 1, CreateTime: now}
+	s, err := json.Marshal(ns)
+	require.NoError(t, err)
+	sig, err := ecdsa.Sign(privkey, hash.Hash(s))
+	require.NoError(t, err)
+	require.NoError(t, chain.AddFileNs(ctx, &blockchain.AddNsOptions{Namespace: ns, Signature: sig[:]}))
+
+	file := blockchain.File{
+		ID:          "file1",
+		Name:        "file1",
+		Namespace:   "ns",
+		Owner:       pubkey[:],
+		Length:      100,
+		Slices:      []blockchain.PublicSliceMeta{{ID: "s1", NodeID: []byte("node1"), SliceIdx: 1}},
+		PublishTime: now,
+		ExpireTime:  now + time.Hour.Nanoseconds(),
+	}
+	s, err = json.Marshal(file)
+	require.NoError(t, err)
+	sig, err = ecdsa.Sign(privkey, hash.Hash(s))
+	require.NoError(t, err)
+	require.NoError(t, chain.PublishFile(ctx, &blockchain.PublishFileOptions{File: file, Signature: sig[:]}))
+	before, err := chain.GetNsByName(ctx, pubkey[:], "ns")
+	require.NoError(t, err)
+
+	newOpt := func(priv ecdsa.PrivateKey, sliceID string, length uint64) *blockchain.AppendFileOptions {
+		opt := &blockchain.AppendFileOptions{
+			FileID:      "file1",
+			Owner:       pubkey[:],
+			Slices:      []blockchain.PublicSliceMeta{{ID: sliceID, NodeID: []byte("node1"), SliceIdx: 2}},
+			Structure:   []byte("structure"),
+			Length:      length,
+			MerkleRoot:  []byte("root"),
+			CurrentTime: time.Now().UnixNano(),
+		}
+		ss, err := json.Marshal(opt.Slices)
+		require.NoError(t, err)
+		m := fmt.Sprintf("%s,%x,%x,%d,%x,%d", opt.FileID, hash.Hash(ss), hash.Hash(opt.Structure), opt.Length,
+			opt.MerkleRoot, opt.CurrentTime)
+		sig, err := ecdsa.Sign(priv, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		opt.Signature = sig[:]
+		return opt
+	}
+	require.NoError(t, chain.AppendFile(ctx, newOpt(privkey, "s2", 50)))
+
+	f, err := chain.GetFileByID(ctx, "file1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), f.Length)
+	require.Len(t, f.Slices, 2)
+	require.Equal(t, 2, f.Slices[1].SliceIdx)
+	require.Equal(t, []byte("structure"), f.Structure)
+	require.Equal(t, []byte("root"), f.MerkleRoot)
+	after, err := chain.GetNsByName(ctx, pubkey[:], "ns")
+	require.NoError(t, err)
+	require.Equal(t, before.FilesTotalSize+50, after.FilesTotalSize)
+	require.Equal(t, before.FileTotalNum, after.FileTotalNum)
+	require.True(t, after.FilesStruSize > before.FilesStruSize)
+	slices, _, err := chain.ListNodeSlices(ctx, &blockchain.ListNodeSliceOptions{Target: []byte("node1")})
+	require.NoError(t, err)
+	require.Len(t, slices, 2)
+
+	// slices could only be appended once, by file owner
+	err = chain.AppendFile(ctx, newOpt(privkey, "s2", 50))
+	require.True(t, errorx.Is(err, errorx.ErrCodeAlreadyExists), err)
+	otherPriv, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	err = chain.AppendFile(ctx, newOpt(otherPriv, "s3", 50))
+	require.True(t, errorx.Is(err, errorx.ErrCodeBadSignature), err)
+}
+
+// addTestNs adds a namespace owned by privkey
+func addTestNs(t *testing.T, chain *xchain.XChain, privkey ecdsa.PrivateKey, name string, ctime int64) {
+	pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+	ns := blockchain.Namespace{Name: name, Owner: pubkey[:], Replica: 1, CreateTime: ctime, UpdateTime: ctime}
+	s, err := json.Marshal(ns)
+	require.NoError(t, err)
+	sig, err := ecdsa.Sign(privkey, hash.Hash(s))
+	require.NoError(t, err)
+	require.NoError(t, chain.AddFileNs(context.Background(), &blockchain.AddNsOptions{Namespace: ns, Signature: sig[:]}))
+}
+
+func TestNsMembers(t *testing.T) {
+	chain, err := New(nil)
+	require.NoError(t, err)
+	defer chain.Contract.(*Contract).Close()
+
+	ctx := context.Background()
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	_, member, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	addTestNs(t, chain, privkey, "ns", 1)
+
+	newOpt := func(op, role string, ctime int64) *blockchain.NsMemberOptions {
+		m := fmt.Sprintf("%s,%s,%x,%s,%d", op, "ns", member[:], role, ctime)
+		sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		return &blockchain.NsMemberOptions{Owner: pubkey[:], Namespace: "ns", Member: member[:], Role: role,
+			CurrentTime: ctime, Signature: sig[:]}
+	}
+	grant := newOpt(blockchain.NsMemberAdd, blockchain.NsRoleAdmin, 10)
+	require.NoError(t, chain.AddNsMember(ctx, grant))
+	m, err := chain.GetNsMember(ctx, pubkey[:], "ns", member[:])
+	require.NoError(t, err)
+	require.Equal(t, blockchain.NsRoleAdmin, m.Role)
+
+	// options not later than the last update of member are rejected
+	err = chain.AddNsMember(ctx, newOpt(blockchain.NsMemberAdd, blockchain.NsRoleReader, 10))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	require.NoError(t, chain.RemoveNsMember(ctx, newOpt(blockchain.NsMemberRemove, "", 20)))
+
+	// replaying the old grant could not re-grant the removed member
+	err = chain.AddNsMember(ctx, grant)
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	_, err = chain.GetNsMember(ctx, pubkey[:], "ns", member[:])
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotFound), err)
+
+	// a failed update keeps the last update time
+	err = chain.RemoveNsMember(ctx, newOpt(blockchain.NsMemberRemove, "", 30))
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotFound), err)
+	require.NoError(t, chain.AddNsMember(ctx, newOpt(blockchain.NsMemberAdd, blockchain.NsRoleWriter, 25)))
+
+	// options signed for adding could not be submitted to remove the member
+	err = chain.RemoveNsMember(ctx, newOpt(blockchain.NsMemberAdd, blockchain.NsRoleReader, 40))
+	require.True(t, errorx.Is(err, errorx.ErrCodeBadSignature), err)
+	members, err := chain.ListNsMembers(ctx, pubkey[:], "ns")
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, blockchain.NsRoleWriter, members[0].Role)
+}
+
+// publishTestFile publishes a file of length into namespace owned by privkey, named after its id
+func publishTestFile(t *testing.T, chain *xchain.XChain, privkey ecdsa.PrivateKey, ns, id string,
+	length uint64, ptime int64) error {
+	return publishTestFileAs(t, chain, privkey, ns, id, id, length, ptime)
+}
+
+// publishTestFileAs publishes a file of length and name into namespace owned by privkey
+func publishTestFileAs(t *testing.T, chain *xchain.XChain, privkey ecdsa.PrivateKey, ns, id, name string,
+	length uint64, ptime int64) error {
+	return publishTaggedFile(t, chain, privkey, ns, id, name, nil, length, ptime)
+}
+
+// publishTaggedFile publishes a file of length, name and tags into namespace owned by privkey
+func publishTaggedFile(t *testing.T, chain *xchain.XChain, privkey ecdsa.PrivateKey, ns, id, name string,
+	tags map[string]string, length uint64, ptime int64) error {
+	pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+	file := blockchain.File{
+		ID:          id,
+		Name:        name,
+		Namespace:   ns,
+		Owner:       pubkey[:],
+		Length:      length,
+		Slices:      []blockchain.PublicSliceMeta{{ID: id + "-s1", NodeID: []byte("node1"), SliceIdx: 1}},
+		PublishTime: ptime,
+		ExpireTime:  ptime + time.Hour.Nanoseconds(),
+		Tags:        tags,
+	}
+	s, err := json.Marshal(file)
+	require.NoError(t, err)
+	sig, err := ecdsa.Sign(privkey, hash.Hash(s))
+	require.NoError(t, err)
+	return chain.PublishFile(context.Background(), &blockchain.PublishFileOptions{File: file, Signature: sig[:]})
+}
+
+func TestNsQuota(t *testing.T) {
+	chain, err := New(nil)
+	require.NoError(t, err)
+	defer chain.Contract.(*Contract).Close()
+
+	ctx := context.Background()
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	now := time.Now().UnixNano()
+	addTestNs(t, chain, privkey, "ns", now)
+	require.NoError(t, publishTestFile(t, chain, privkey, "ns", "file1", 100, now+1))
+	require.NoError(t, publishTestFile(t, chain, privkey, "ns", "file2", 100, now+2))
+
+	// namespaces added before quotas are supported have no usage counted
+	require.NoError(t, chain.Contract.(*Contract).ledger.update(func(txn tx) error {
+		it := txn.iterate([]byte("index_fns"), []byte("index_fnt"))
+		legacy := map[string][]byte{}
+		for it.Next() {
+			var n blockchain.Namespace
+			require.NoError(t, json.Unmarshal(it.Value(), &n))
+			n.FilesTotalSize, n.FileActiveNum = 0, 0
+			s, err := json.Marshal(n)
+			require.NoError(t, err)
+			legacy[string(it.Key())] = s
+		}
+		require.Len(t, legacy, 2)
+		for k, v := range legacy {
+			if err := txn.put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	newOpt := func(quota blockchain.NsQuota, ctime int64) *blockchain.UpdateNsQuotaOptions {
+		m := fmt.Sprintf("%s,%d,%d,%d,%d", "ns", quota.MaxBytes, quota.MaxFiles, quota.MaxFileSize, ctime)
+		sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		return &blockchain.UpdateNsQuotaOptions{Owner: pubkey[:], Name: "ns", Quota: quota,
+			CurrentTime: ctime, Signature: sig[:]}
+	}
+	loose := newOpt(blockchain.NsQuota{MaxFiles: 10}, now+10)
+	require.NoError(t, chain.UpdateNsQuota(ctx, loose))
+	ns, err := chain.GetNsByName(ctx, pubkey[:], "ns")
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), ns.FilesTotalSize)
+	require.Equal(t, int64(2), ns.FileActiveNum)
+
+	// usage is enforced against the recounted files
+	require.NoError(t, chain.UpdateNsQuota(ctx, newOpt(blockchain.NsQuota{MaxFiles: 2}, now+20)))
+	err = publishTestFile(t, chain, privkey, "ns", "file3", 100, now+30)
+	require.True(t, errorx.Is(err, errorx.ErrCodeQuotaExceeded), err)
+
+	// an older and looser quota could not be replayed
+	err = chain.UpdateNsQuota(ctx, loose)
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	ns, err = chain.GetNsByName(ctx, pubkey[:], "ns")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), ns.Quota.MaxFiles)
+}
+
+func TestNsRenewal(t *testing.T) {
+	chain, err := New(nil)
+	require.NoError(t, err)
+	defer chain.Contract.(*Contract).Close()
+
+	ctx := context.Background()
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	addTestNs(t, chain, privkey, "ns", 1)
+
+	newOpt := func(r blockchain.NsRenewal, ctime int64) *blockchain.UpdateNsRenewalOptions {
+		m := fmt.Sprintf("%s,%s,%d,%d,%d", "ns", r.Policy, r.Days, r.Until, ctime)
+		sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		return &blockchain.UpdateNsRenewalOptions{Owner: pubkey[:], Name: "ns", Renewal: r,
+			CurrentTime: ctime, Signature: sig[:]}
+	}
+	task := newOpt(blockchain.NsRenewal{Policy: blockchain.NsRenewalTask, Days: 7}, 10)
+	require.NoError(t, chain.UpdateNsRenewal(ctx, task))
+	require.NoError(t, chain.UpdateNsRenewal(ctx, newOpt(blockchain.NsRenewal{}, 20)))
+
+	// the disabled policy could not be reinstated by replaying it
+	err = chain.UpdateNsRenewal(ctx, task)
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	ns, err := chain.GetNsByName(ctx, pubkey[:], "ns")
+	require.NoError(t, err)
+	require.Equal(t, blockchain.NsRenewalNone, ns.Renewal.Policy)
+	require.Equal(t, int64(20), ns.UpdateTime)
+}
+
+func TestTransfer(t *testing.T) {
+	chain, err := New(nil)
+	require.NoError(t, err)
+	defer chain.Contract.(*Contract).Close()
+
+	ctx := context.Background()
+	ownerPriv, ownerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	newPriv, newPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	now := time.Now().UnixNano()
+	addTestNs(t, chain, ownerPriv, "ns", now)
+	addTestNs(t, chain, newPriv, "newns", now)
+	require.NoError(t, publishTaggedFile(t, chain, ownerPriv, "ns", "file1", "file1",
+		map[string]string{"label": "y"}, 100, now+1))
+	require.NoError(t, publishTestFileAs(t, chain, newPriv, "newns", "file2", "file1", 100, now+2))
+	require.NoError(t, publishTestFile(t, chain, ownerPriv, "ns", "file3", 100, now+2))
+	require.NoError(t, publishTestFileAs(t, chain, newPriv, "newns", "file4", "file3", 100, now+2))
+
+	m := fmt.Sprintf("%s,%s,%s,%x,%s,%d", "t1", "ns", "", newPub[:], "newns", now+3)
+	sig, err := ecdsa.Sign(ownerPriv, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	require.NoError(t, chain.ProposeTransfer(ctx, &blockchain.ProposeTransferOptions{ID: "t1", Owner: ownerPub[:],
+		Namespace: "ns", NewOwner: newPub[:], NewNamespace: "newns", CurrentTime: now + 3, Signature: sig[:]}))
+	m = fmt.Sprintf("%s,%d", "t1", now+4)
+	sig, err = ecdsa.Sign(newPriv, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	require.NoError(t, chain.AcceptTransfer(ctx, &blockchain.AcceptTransferOptions{ID: "t1", CurrentTime: now + 4,
+		Signature: sig[:]}))
+
+	contentHash := hash.Hash([]byte("content of file1"))
+	newReceipt := func(signer ecdsa.PrivateKey, fileID string, h []byte) []byte {
+		m := fmt.Sprintf("%s,%s,%x,%d", "t1", fileID, h, 100)
+		sig, err := ecdsa.Sign(signer, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		return sig[:]
+	}
+	newOpt := func(fileID, newFileID string, h, receipt []byte, ctime int64) *blockchain.CompleteTransferOptions {
+		m := fmt.Sprintf("%s,%s,%s,%x,%d", "t1", fileID, newFileID, h, ctime)
+		sig, err := ecdsa.Sign(newPriv, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		return &blockchain.CompleteTransferOptions{ID: "t1", FileID: fileID, NewFileID: newFileID,
+			ContentHash: h, Receipt: receipt, CurrentTime: ctime, Signature: sig[:]}
+	}
+
+	// the new owner alone could not replace the file, a receipt of the old owner over the content is required
+	err = chain.CompleteTransfer(ctx, newOpt("file1", "file2", nil, nil, now+5))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	err = chain.CompleteTransfer(ctx, newOpt("file1", "file2", contentHash, newReceipt(newPriv, "file1", contentHash), now+5))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	otherHash := hash.Hash([]byte("something else"))
+	err = chain.CompleteTransfer(ctx, newOpt("file1", "file2", otherHash, newReceipt(ownerPriv, "file1", contentHash), now+5))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	f, err := chain.GetFileByID(ctx, "file1")
+	require.NoError(t, err)
+	require.Greater(t, f.ExpireTime, now+5)
+
+	require.NoError(t, chain.CompleteTransfer(ctx,
+		newOpt("file1", "file2", contentHash, newReceipt(ownerPriv, "file1", contentHash), now+5)))
+	tr, err := chain.GetTransferByID(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, blockchain.TransferAccepted, tr.Status)
+	require.Equal(t, "file2", tr.Files["file1"])
+	_, err = chain.GetFileByID(ctx, "file1")
+	require.True(t, errorx.Is(err, errorx.ErrCodeExpired), err)
+	require.Equal(t, 0, countTagIndex(t, chain))
+
+	// a namespace transfer is finished without a file, after all files of the namespace are replaced
+	err = chain.CompleteTransfer(ctx, newOpt("", "", nil, nil, now+6))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	require.NoError(t, chain.CompleteTransfer(ctx,
+		newOpt("file3", "file4", contentHash, newReceipt(ownerPriv, "file3", contentHash), now+6)))
+	require.NoError(t, chain.CompleteTransfer(ctx, newOpt("", "", nil, nil, now+7)))
+	tr, err = chain.GetTransferByID(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, blockchain.TransferCompleted, tr.Status)
+}
+
+func TestCancelTransfer(t *testing.T) {
+	chain, err := New(nil)
+	require.NoError(t, err)
+	defer chain.Contract.(*Contract).Close()
+
+	ctx := context.Background()
+	ownerPriv, ownerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	newPriv, newPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	otherPriv, otherPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	now := time.Now().UnixNano()
+	addTestNs(t, chain, ownerPriv, "ns", now)
+	addTestNs(t, chain, newPriv, "newns", now)
+
+	propose := func(id string) {
+		m := fmt.Sprintf("%s,%s,%s,%x,%s,%d", id, "ns", "", newPub[:], "newns", now+1)
+		sig, err := ecdsa.Sign(ownerPriv, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		require.NoError(t, chain.ProposeTransfer(ctx, &blockchain.ProposeTransferOptions{ID: id, Owner: ownerPub[:],
+			Namespace: "ns", NewOwner: newPub[:], NewNamespace: "newns", CurrentTime: now + 1, Signature: sig[:]}))
+	}
+	newOpt := func(id string, signer ecdsa.PrivateKey, ctime int64) *blockchain.CancelTransferOptions {
+		m := fmt.Sprintf("%s,%s,%d", blockchain.TransferCanceled, id, ctime)
+		sig, err := ecdsa.Sign(signer, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		user := ecdsa.PublicKeyFromPrivateKey(signer)
+		return &blockchain.CancelTransferOptions{ID: id, User: user[:], CurrentTime: ctime, Signature: sig[:]}
+	}
+
+	// only the owner or the new owner could cancel a transfer
+	propose("t1")
+	err = chain.CancelTransfer(ctx, newOpt("t1", otherPriv, now+2))
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotAuthorized), err)
+	opt := newOpt("t1", ownerPriv, now+2)
+	opt.User = otherPub[:]
+	err = chain.CancelTransfer(ctx, opt)
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotAuthorized), err)
+
+	// the owner cancels a proposed transfer, which could not be accepted then
+	require.NoError(t, chain.CancelTransfer(ctx, newOpt("t1", ownerPriv, now+2)))
+	tr, err := chain.GetTransferByID(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, blockchain.TransferCanceled, tr.Status)
+	require.Equal(t, now+2, tr.CancelTime)
+	m := fmt.Sprintf("%s,%d", "t1", now+3)
+	sig, err := ecdsa.Sign(newPriv, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	err = chain.AcceptTransfer(ctx, &blockchain.AcceptTransferOptions{ID: "t1", CurrentTime: now + 3, Signature: sig[:]})
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	err = chain.CancelTransfer(ctx, newOpt("t1", newPriv, now+3))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+
+	// the new owner cancels an accepted transfer, which could not be completed then
+	propose("t2")
+	m = fmt.Sprintf("%s,%d", "t2", now+3)
+	sig, err = ecdsa.Sign(newPriv, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	require.NoError(t, chain.AcceptTransfer(ctx, &blockchain.AcceptTransferOptions{ID: "t2", CurrentTime: now + 3,
+		Signature: sig[:]}))
+	require.NoError(t, chain.CancelTransfer(ctx, newOpt("t2", newPriv, now+4)))
+	m = fmt.Sprintf("%s,%s,%s,%x,%d", "t2", "", "", []byte(nil), now+5)
+	sig, err = ecdsa.Sign(newPriv, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	err = chain.CompleteTransfer(ctx, &blockchain.CompleteTransferOptions{ID: "t2", CurrentTime: now + 5,
+		Signature: sig[:]})
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+}
+
+func TestCorruptedSlices(t *testing.T) {
+	chain, err := New(nil)
+	require.NoError(t, err)
+	defer chain.Contract.(*Contract).Close()
+
+	ctx := context.Background()
+	ownerPriv, ownerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	nodePriv, nodePub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	otherPriv, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	nodeID := []byte(nodePub.String())
+	now := time.Now().UnixNano()
+	addTestNs(t, chain, ownerPriv, "ns", now)
+	require.NoError(t, publishTestFile(t, chain, ownerPriv, "ns", "file1", 100, now+1))
+
+	moveSlice := func(to []byte) {
+		slices := []blockchain.PublicSliceMeta{{ID: "file1-s1", NodeID: to, SliceIdx: 1}}
+		s, err := json.Marshal(slices)
+		require.NoError(t, err)
+		sig, err := ecdsa.Sign(ownerPriv, hash.Hash(s))
+		require.NoError(t, err)
+		require.NoError(t, chain.UpdateFilePublicSliceMeta(ctx, &blockchain.UpdateFilePSMOptions{FileID: "file1",
+			Owner: ownerPub[:], Slices: slices, Signature: sig[:]}))
+	}
+	report := func(signer ecdsa.PrivateKey, sliceID string, nonce int64) error {
+		m := fmt.Sprintf("%s,%s,%s,%d", nodeID, "file1", sliceID, nonce)
+		sig, err := ecdsa.Sign(signer, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		return chain.ReportCorruptedSlice(ctx, &blockchain.ReportCorruptedSliceOptions{FileID: "file1",
+			SliceID: sliceID, NodeID: nodeID, Nonce: nonce, Signature: sig[:]})
+	}
+
+	// slices migrated onto the node are listed with their files
+	moveSlice(nodeID)
+	ns, _, err := chain.ListNodeSlices(ctx, &blockchain.ListNodeSliceOptions{Target: nodeID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ns))
+	require.Equal(t, "file1", ns[0].FileID)
+	require.Equal(t, "file1-s1", ns[0].ID)
+	require.Equal(t, now+1+time.Hour.Nanoseconds(), ns[0].ExpireTime)
+
+	// only the node holding the slice reports it, and reports could not be replayed
+	err = report(otherPriv, "file1-s1", now+2)
+	require.True(t, errorx.Is(err, errorx.ErrCodeBadSignature), err)
+	err = report(nodePriv, "file1-s2", now+3)
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotFound), err)
+	require.NoError(t, report(nodePriv, "file1-s1", now+4))
+	err = report(nodePriv, "file1-s1", now+4)
+	require.True(t, errorx.Is(err, errorx.ErrCodeAlreadyExists), err)
+
+	csl, err := chain.ListCorruptedSlices(ctx, ownerPub[:])
+	require.NoError(t, err)
+	require.Equal(t, []blockchain.CorruptedSlice{{FileID: "file1", SliceID: "file1-s1", NodeID: nodeID,
+		ReportTime: now + 4}}, csl)
+
+	// the report is cleared once the slice is migrated away from the node
+	moveSlice([]byte("node2"))
+	csl, err = chain.ListCorruptedSlices(ctx, ownerPub[:])
+	require.NoError(t, err)
+	require.Equal(t, 0, len(csl))
+}
+
+func TestListNodeSlices(t *testing.T) {
+	chain, err := New(nil)
+	require.NoError(t, err)
+	defer chain.Contract.(*Contract).Close()
+
+	ctx := context.Background()
+	privkey, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	now := time.Now().UnixNano()
+	addTestNs(t, chain, privkey, "ns", now)
+	for i, id := range []string{"file1", "file2", "file3"} {
+		require.NoError(t, publishTestFile(t, chain, privkey, "ns", id, 100, now+int64(i)+1))
+	}
+
+	// slices are listed page by page
+	opt := &blockchain.ListNodeSliceOptions{Target: []byte("node1"), Limit: 2}
+	slices, next, err := chain.ListNodeSlices(ctx, opt)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(slices))
+	require.NotEmpty(t, next)
+	opt.Cursor = next
+	more, next, err := chain.ListNodeSlices(ctx, opt)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(more))
+	require.Empty(t, next)
+	var ids []string
+	for _, s := range append(slices, more...) {
+		ids = append(ids, s.ID)
+	}
+	require.ElementsMatch(t, []string{"file1-s1", "file2-s1", "file3-s1"}, ids)
+
+	// cursor must point into the index of the node
+	opt.Target = []byte("node2")
+	_, _, err = chain.ListNodeSlices(ctx, opt)
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+}
+
+// countNodeSliceIndex counts node slice index entries of the node on the ledger
+func countNodeSliceIndex(t *testing.T, chain *xchain.XChain, nodeID []byte) int {
+	var n int
+	prefix := fmt.Sprintf("index_fslice/%s/", nodeID)
+	require.NoError(t, chain.Contract.(*Contract).ledger.view(func(txn tx) error {
+		it := txn.iterate([]byte(prefix), []byte(prefix[:len(prefix)-1]+"0"))
+		for it.Next() {
+			n++
+		}
+		return nil
+	}))
+	return n
+}
+
+func TestNodeDrain(t *testing.T) {
+	chain, err := New(nil)
+	require.NoError(t, err)
+	defer chain.Contract.(*Contract).Close()
+
+	ctx := context.Background()
+	ownerPriv, ownerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	nodePriv, nodePub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	nodeID := []byte(nodePub.String())
+	node := blockchain.Node{ID: nodeID, Name: "node", Address: "127.0.0.1:8122", Online: true}
+	s, err := json.Marshal(node)
+	require.NoError(t, err)
+	sig, err := ecdsa.Sign(nodePriv, hash.Hash(s))
+	require.NoError(t, err)
+	require.NoError(t, chain.AddNode(ctx, &blockchain.AddNodeOptions{Node: node, Signature: sig[:]}))
+
+	now := time.Now().UnixNano()
+	addTestNs(t, chain, ownerPriv, "ns", now)
+	require.NoError(t, publishTestFile(t, chain, ownerPriv, "ns", "file1", 100, now+1))
+
+	moveSlice := func(to []byte) {
+		slices := []blockchain.PublicSliceMeta{{ID: "file1-s1", NodeID: to, SliceIdx: 1}}
+		s, err := json.Marshal(slices)
+		require.NoError(t, err)
+		sig, err := ecdsa.Sign(ownerPriv, hash.Hash(s))
+		require.NoError(t, err)
+		require.NoError(t, chain.UpdateFilePublicSliceMeta(ctx, &blockchain.UpdateFilePSMOptions{FileID: "file1",
+			Owner: ownerPub[:], Slices: slices, Signature: sig[:]}))
+	}
+	drain := func(nonce int64) blockchain.Node {
+		m := fmt.Sprintf("%s,%d", nodeID, nonce)
+		sig, err := ecdsa.Sign(nodePriv, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		require.NoError(t, chain.NodeDrain(ctx, &blockchain.NodeOperateOptions{NodeID: nodeID,
+			Nonce: nonce, Sig: sig[:]}))
+		node, err := chain.GetNode(ctx, nodeID)
+		require.NoError(t, err)
+		return node
+	}
+
+	// node holding live slices is draining but not drained
+	moveSlice(nodeID)
+	require.Equal(t, 1, countNodeSliceIndex(t, chain, nodeID))
+	node = drain(now + 10)
+	require.True(t, node.Draining)
+	require.Equal(t, now+10, node.DrainTime)
+	require.Equal(t, int64(0), node.DrainedTime)
+
+	// index of the node is removed once its slices migrated away
+	moveSlice([]byte("node2"))
+	require.Equal(t, 0, countNodeSliceIndex(t, chain, nodeID))
+	require.Equal(t, 1, countNodeSliceIndex(t, chain, []byte("node2")))
+	ns, _, err := chain.ListNodeSlices(ctx, &blockchain.ListNodeSliceOptions{Target: nodeID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(ns))
+
+	// draining again records the node drained, and only once
+	node = drain(now + 20)
+	require.Equal(t, now+10, node.DrainTime)
+	require.Equal(t, now+20, node.DrainedTime)
+	node = drain(now + 30)
+	require.Equal(t, now+20, node.DrainedTime)
+}
+
+// countTagIndex counts file tag index entries on the ledger
+func countTagIndex(t *testing.T, chain *xchain.XChain) int {
+	var n int
+	require.NoError(t, chain.Contract.(*Contract).ledger.view(func(txn tx) error {
+		it := txn.iterate([]byte("index_ftag/"), []byte("index_ftag0"))
+		for it.Next() {
+			n++
+		}
+		return nil
+	}))
+	return n
+}
+
+func TestQueryFiles(t *testing.T) {
+	chain, err := New(nil)
+	require.NoError(t, err)
+	defer chain.Contract.(*Contract).Close()
+
+	ctx := context.Background()
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	now := time.Now().UnixNano()
+	addTestNs(t, chain, privkey, "ns", now)
+	credit := map[string]string{"feature_set": "credit"}
+	require.NoError(t, publishTaggedFile(t, chain, privkey, "ns", "f1", "credit-b", credit, 100, now+1))
+	require.NoError(t, publishTaggedFile(t, chain, privkey, "ns", "f2", "credit-a",
+		map[string]string{"feature_set": "credit", "source": "bank"}, 100, now+2))
+	require.NoError(t, publishTaggedFile(t, chain, privkey, "ns", "f3", "other", credit, 100, now+3))
+	require.NoError(t, publishTestFileAs(t, chain, privkey, "ns", "f4", "credit-c", 100, now+4))
+	require.Equal(t, 4, countTagIndex(t, chain))
+
+	query := func(opt blockchain.QueryFileOptions) ([]string, string) {
+		opt.Owner = pubkey[:]
+		opt.CurrentTime = now + 10
+		fs, next, err := chain.QueryFiles(ctx, &opt)
+		require.NoError(t, err)
+		var ids []string
+		for _, f := range fs {
+			ids = append(ids, f.ID)
+		}
+		return ids, next
+	}
+
+	// tagged files are listed by name, others by the latest publish time first unless filtered by name prefix
+	ids, _ := query(blockchain.QueryFileOptions{Namespace: "ns", Tags: credit})
+	require.Equal(t, []string{"f2", "f1", "f3"}, ids)
+	ids, _ = query(blockchain.QueryFileOptions{Namespace: "ns", Tags: credit, NamePrefix: "credit-"})
+	require.Equal(t, []string{"f2", "f1"}, ids)
+	ids, _ = query(blockchain.QueryFileOptions{Namespace: "ns", NamePrefix: "credit-"})
+	require.Equal(t, []string{"f2", "f1", "f4"}, ids)
+	ids, _ = query(blockchain.QueryFileOptions{Namespace: "ns"})
+	require.Equal(t, []string{"f4", "f3", "f2", "f1"}, ids)
+	ids, _ = query(blockchain.QueryFileOptions{Tags: map[string]string{"source": "bank", "feature_set": "credit"}})
+	require.Equal(t, []string{"f2"}, ids)
+
+	// pages follow the cursor
+	ids, next := query(blockchain.QueryFileOptions{Namespace: "ns", Tags: credit, Limit: 2})
+	require.Equal(t, []string{"f2", "f1"}, ids)
+	require.NotEmpty(t, next)
+	ids, next = query(blockchain.QueryFileOptions{Namespace: "ns", Tags: credit, Limit: 2, Cursor: next})
+	require.Equal(t, []string{"f3"}, ids)
+	require.Empty(t, next)
+
+	// tag index entries are removed once files are out of retain period
+	ctime := now + time.Hour.Nanoseconds() + blockchain.FileRetainPeriod.Nanoseconds() + 10
+	m := fmt.Sprintf("%s,%d", "ns", ctime)
+	sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	_, err = chain.UpdateNsFilesCap(ctx, &blockchain.UpdateNsFilesCapOptions{Owner: pubkey[:], Name: "ns",
+		CurrentTime: ctime, Signature: sig[:]})
+	require.NoError(t, err)
+	require.Equal(t, 0, countTagIndex(t, chain))
+
+	// or files are overwritten by new files of the same name
+	require.NoError(t, publishTaggedFile(t, chain, privkey, "ns", "f5", "other", credit, 100, ctime+1))
+	require.Equal(t, 1, countTagIndex(t, chain))
+	ptime := ctime + 1 + time.Hour.Nanoseconds() + blockchain.FileRetainPeriod.Nanoseconds() + 1
+	require.NoError(t, publishTestFileAs(t, chain, privkey, "ns", "f6", "other", 100, ptime))
+	require.Equal(t, 0, countTagIndex(t, chain))
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embedded
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/xuperchain/xuperchain/core/contractsdk/go/code"
+	bolt "go.etcd.io/bbolt"
+)
+
+var ledgerBucket = []byte("ledger")
+
+// ledger is the key-value state the contract runs on
+type ledger interface {
+	// update runs fn in a read-write transaction, changes are discarded if fn fails
+	update(fn func(tx) error) error
+	// view runs fn in a read-only transaction
+	view(fn func(tx) error) error
+	close() error
+}
+
+// tx reads and writes the ledger
+type tx interface {
+	get(key []byte) []byte // nil if key not found
+	put(key, value []byte) error
+	delete(key []byte) error
+	// iterate returns an iterator over keys in [start, limit), no upper bound if limit is nil
+	iterate(start, limit []byte) code.Iterator
+}
+
+var errReadOnly = errors.New("ledger is read-only in queries")
+
+// memLedger keeps the ledger in memory, which is lost when process exits
+type memLedger struct {
+	sync.RWMutex
+	kv map[string][]byte
+}
+
+func newMemLedger() *memLedger {
+	return &memLedger{kv: make(map[string][]byte)}
+}
+
+func (l *memLedger) update(fn func(tx) error) error {
+	l.Lock()
+	defer l.Unlock()
+
+	t := &memTx{kv: l.kv, undo: make(map[string][]byte)}
+	if err := fn(t); err != nil {
+		t.rollback()
+		return err
+	}
+	return nil
+}
+
+func (l *memLedger) view(fn func(tx) error) error {
+	l.RLock()
+	defer l.RUnlock()
+
+	return fn(&memTx{kv: l.kv, readOnly: true})
+}
+
+func (l *memLedger) close() error {
+	return nil
+}
+
+type memTx struct {
+	kv       map[string][]byte
+	readOnly bool
+	undo     map[string][]byte // values before the transaction, nil if key didn't exist
+}
+
+func (t *memTx) get(key []byte) []byte {
+	return t.kv[string(key)]
+}
+
+func (t *memTx) put(key, value []byte) error {
+	if t.readOnly {
+		return errReadOnly
+	}
+	t.keep(key)
+	t.kv[string(key)] = append([]byte{}, value...)
+	return nil
+}
+
+func (t *memTx) delete(key []byte) error {
+	if t.readOnly {
+		return errReadOnly
+	}
+	t.keep(key)
+	delete(t.kv, string(key))
+	return nil
+}
+
+// keep saves value of key the first time it's changed in the transaction
+func (t *memTx) keep(key []byte) {
+	if _, ok := t.undo[string(key)]; !ok {
+		t.undo[string(key)] = t.kv[string(key)]
+	}
+}
+
+func (t *memTx) rollback() {
+	for k, v := range t.undo {
+		if v == nil {
+			delete(t.kv, k)
+		} else {
+			t.kv[k] = v
+		}
+	}
+}
+
+func (t *memTx) iterate(start, limit []byte) code.Iterator {
+	var keys []string
+	for k := range t.kv {
+		if inRange([]byte(k), start, limit) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	it := &sliceIterator{index: -1}
+	for _, k := range keys {
+		it.keys = append(it.keys, []byte(k))
+		it.values = append(it.values, t.kv[k])
+	}
+	return it
+}
+
+// boltLedger persists the ledger in a BoltDB file
+type boltLedger struct {
+	db *bolt.DB
+}
+
+func newBoltLedger(path string) (*boltLedger, error) {
+	// the file is locked by one process at a time
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(btx *bolt.Tx) error {
+		_, err := btx.CreateBucketIfNotExists(ledgerBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &boltLedger{db: db}, nil
+}
+
+func (l *boltLedger) update(fn func(tx) error) error {
+	return l.db.Update(func(btx *bolt.Tx) error {
+		return fn(&boltTx{b: btx.Bucket(ledgerBucket)})
+	})
+}
+
+func (l *boltLedger) view(fn func(tx) error) error {
+	return l.db.View(func(btx *bolt.Tx) error {
+		return fn(&boltTx{b: btx.Bucket(ledgerBucket)})
+	})
+}
+
+func (l *boltLedger) close() error {
+	return l.db.Close()
+}
+
+type boltTx struct {
+	b *bolt.Bucket
+}
+
+// get copies the value as it's only valid during the transaction
+func (t *boltTx) get(key []byte) []byte {
+	v := t.b.Get(key)
+	if v == nil {
+		return nil
+	}
+	return append([]byte{}, v...)
+}
+
+func (t *boltTx) put(key, value []byte) error {
+	if !t.b.Writable() {
+		return errReadOnly
+	}
+	return t.b.Put(key, value)
+}
+
+func (t *boltTx) delete(key []byte) error {
+	if !t.b.Writable() {
+		return errReadOnly
+	}
+	return t.b.Delete(key)
+}
+
+// iterate reads all keys in range at once, so that the contract could change the ledger while iterating
+func (t *boltTx) iterate(start, limit []byte) code.Iterator {
+	it := &sliceIterator{index: -1}
+	c := t.b.Cursor()
+	for k, v := c.Seek(start); k != nil && inRange(k, start, limit); k, v = c.Next() {
+		it.keys = append(it.keys, append([]byte{}, k...))
+		it.values = append(it.values, append([]byte{}, v...))
+	}
+	return it
+}
+
+func inRange(key, start, limit []byte) bool {
+	return bytes.Compare(key, start) >= 0 && (limit == nil || bytes.Compare(key, limit) < 0)
+}
+
+// sliceIterator iterates over key-value pairs read from ledger
+type sliceIterator struct {
+	keys   [][]byte
+	values [][]byte
+	index  int
+}
+
+func (it *sliceIterator) Key() []byte {
+	return it.keys[it.index]
+}
+
+func (it *sliceIterator) Value() []byte {
+	return it.values[it.index]
+}
+
+func (it *sliceIterator) Next() bool {
+	if it.index+1 >= len(it.keys) {
+		return false
+	}
+	it.index++
+	return true
+}
+
+func (it *sliceIterator) Error() error {
+	return nil
+}
+
+func (it *sliceIterator) Close() {}
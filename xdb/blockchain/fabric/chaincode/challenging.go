@@ -51,6 +51,11 @@ func (x *xdata) ListChallengeRequests(stub shim.ChaincodeStubInterface, args []s
 
 	// pack prefix
 	prefix, attr := packChallengeFilter(opt.FileOwner, opt.TargetNode)
+	// keys before cursor were returned by previous pages
+	after, err := decodePageCursor(prefix, attr, opt.Cursor)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
 	// get iter by prefix
 	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
 	if err != nil {
@@ -59,15 +64,21 @@ func (x *xdata) ListChallengeRequests(stub shim.ChaincodeStubInterface, args []s
 	defer iterator.Close()
 
 	var cs []blockchain.Challenge
+	var last, next string
 	for iterator.HasNext() {
 		queryResponse, err := iterator.Next()
 		if err != nil {
 			return shim.Error(err.Error())
 		}
+		if queryResponse.Key <= after {
+			continue
+		}
 
 		if opt.Limit > 0 && uint64(len(cs)) >= opt.Limit {
+			next = blockchain.EncodeCursor([]byte(last))
 			break
 		}
+		last = queryResponse.Key
 		index := packChallengeIndex(string(queryResponse.Value))
 		resp := x.getValue(stub, []string{index})
 		if len(resp.Payload) == 0 {
@@ -89,7 +100,7 @@ func (x *xdata) ListChallengeRequests(stub shim.ChaincodeStubInterface, args []s
 		cs = append(cs, c)
 	}
 
-	s, err := json.Marshal(cs)
+	s, err := json.Marshal(blockchain.ChallengePage{Challenges: cs, Next: next})
 	if err != nil {
 		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to marshal Challenges").Error())
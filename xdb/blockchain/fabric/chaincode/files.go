@@ -14,6 +14,7 @@
 package main
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -60,6 +61,9 @@ func (x *xdata) PublishFile(stub shim.ChaincodeStubInterface, args []string) pb.
 		return shim.Error(errorx.New(errorx.ErrCodeParam,
 			"slices is empty when publishing file").Error())
 	}
+	if err := blockchain.CheckFileTags(f.Tags); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeParam, "bad param:tags").Error())
+	}
 	// judge if id exists
 	if resp := x.getValue(stub, []string{opt.File.ID}); len(resp.Payload) != 0 {
 		return shim.Error(errorx.New(errorx.ErrCodeAlreadyExists, "duplicated fileID").Error())
@@ -81,6 +85,9 @@ func (x *xdata) PublishFile(stub shim.ChaincodeStubInterface, args []string) pb.
 		return shim.Error(errorx.New(errorx.ErrCodeParam,
 			"files struct size of ns larger than max, please upload file using new ns").Error())
 	}
+	if reason := ns.QuotaExceeded(f.Length); reason != "" {
+		return shim.Error(errorx.New(errorx.ErrCodeQuotaExceeded, reason).Error())
+	}
 
 	// if there's a expired file with the same name in user's storage, overwrite it with the new one
 	filenameIndex := packFileNameIndex(f.Owner, f.Namespace, f.Name)
@@ -93,6 +100,9 @@ func (x *xdata) PublishFile(stub shim.ChaincodeStubInterface, args []string) pb.
 		if fc.ExpireTime+blockchain.FileRetainPeriod.Nanoseconds() > opt.File.PublishTime {
 			return shim.Error(errorx.New(errorx.ErrCodeAlreadyExists, "duplicated file name").Error())
 		}
+		if err := x.removeFileTagIndex(stub, fc); err != nil {
+			return shim.Error(err.Error())
+		}
 	}
 
 	// set id-file on chain
@@ -112,10 +122,20 @@ func (x *xdata) PublishFile(stub shim.ChaincodeStubInterface, args []string) pb.
 			"failed to set listIndex-id on chain: %s", resp.Message).Error())
 	}
 
+	// set fileTagIndex-id on chain
+	for k, v := range f.Tags {
+		if resp := x.setValue(stub, []string{packFileTagIndex(f.Owner, k, v, f), f.ID}); resp.Status == shim.ERROR {
+			return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+				"failed to set tagIndex-id on chain: %s", resp.Message).Error())
+		}
+	}
+
 	// update file num of fileNsIndex
 	ns.FileTotalNum += 1
 	ns.UpdateTime = f.PublishTime
 	ns.FilesStruSize += len(s)
+	ns.FilesTotalSize += f.Length
+	ns.FileActiveNum += 1
 	nsf, err := json.Marshal(ns)
 	if err != nil {
 		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
@@ -246,6 +266,272 @@ func (x *xdata) UpdateNsReplica(stub shim.ChaincodeStubInterface, args []string)
 	return shim.Success([]byte("OK"))
 }
 
+// UpdateNsQuota updates file namespace quota
+func (x *xdata) UpdateNsQuota(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting UpdateNsQuotaOptions")
+	}
+
+	// unmarshal opt
+	var opt blockchain.UpdateNsQuotaOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal UpdateNsQuotaOptions").Error())
+	}
+	if opt.Quota.MaxFiles < 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param:quota").Error())
+	}
+	// verify sig
+	m := fmt.Sprintf("%s,%d,%d,%d,%d", opt.Name, opt.Quota.MaxBytes, opt.Quota.MaxFiles,
+		opt.Quota.MaxFileSize, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.Owner, []byte(m)); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// get file ns
+	fileNsIndex := packFileNsIndex(opt.Owner, opt.Name)
+	resp := x.getValue(stub, []string{fileNsIndex})
+	if len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound,
+			"file namespace not found: %s", resp.Message).Error())
+	}
+	var n blockchain.Namespace
+	if err := json.Unmarshal(resp.Payload, &n); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal namespace").Error())
+	}
+
+	// an older update could not be replayed to loosen the quota
+	if opt.CurrentTime <= n.UpdateTime {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param: currentTime, request has expired").Error())
+	}
+	// recount usage, namespaces added before quotas are supported have no usage counted
+	var err error
+	if n.FilesStruSize, n.FilesTotalSize, n.FileActiveNum, _, err = x.countNsUsage(stub, n, opt.CurrentTime); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	n.Quota = opt.Quota
+	n.UpdateTime = opt.CurrentTime
+	s, err := json.Marshal(n)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal Namespaces").Error())
+	}
+	// put index-ns on chain
+	if resp := x.setValue(stub, []string{fileNsIndex, string(s)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to update nsindex-quota on chain: %s", resp.Message).Error())
+	}
+	// put listIndex-ns on chain
+	nsListIndex := packFileNsListIndex(n.Owner, n.Name, n.CreateTime)
+	if resp := x.setValue(stub, []string{nsListIndex, string(s)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to update nsListIndex-quota on chain: %s", resp.Message).Error())
+	}
+	return shim.Success([]byte("OK"))
+}
+
+// UpdateNsRenewal updates renewal policy of file namespace
+func (x *xdata) UpdateNsRenewal(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting UpdateNsRenewalOptions")
+	}
+
+	// unmarshal opt
+	var opt blockchain.UpdateNsRenewalOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal UpdateNsRenewalOptions").Error())
+	}
+	if !blockchain.ValidNsRenewal(opt.Renewal) {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param:renewal").Error())
+	}
+	// verify sig
+	m := fmt.Sprintf("%s,%s,%d,%d,%d", opt.Name, opt.Renewal.Policy, opt.Renewal.Days,
+		opt.Renewal.Until, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.Owner, []byte(m)); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// get file ns
+	fileNsIndex := packFileNsIndex(opt.Owner, opt.Name)
+	resp := x.getValue(stub, []string{fileNsIndex})
+	if len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound,
+			"file namespace not found: %s", resp.Message).Error())
+	}
+	var n blockchain.Namespace
+	if err := json.Unmarshal(resp.Payload, &n); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal namespace").Error())
+	}
+
+	// an older policy could not be replayed after the owner changed it
+	if opt.CurrentTime <= n.UpdateTime {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param: currentTime, request has expired").Error())
+	}
+
+	n.Renewal = opt.Renewal
+	n.UpdateTime = opt.CurrentTime
+	s, err := json.Marshal(n)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal Namespaces").Error())
+	}
+	// put index-ns on chain
+	if resp := x.setValue(stub, []string{fileNsIndex, string(s)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to update nsindex-renewal on chain: %s", resp.Message).Error())
+	}
+	// put listIndex-ns on chain
+	nsListIndex := packFileNsListIndex(n.Owner, n.Name, n.CreateTime)
+	if resp := x.setValue(stub, []string{nsListIndex, string(s)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to update nsListIndex-renewal on chain: %s", resp.Message).Error())
+	}
+	return shim.Success([]byte("OK"))
+}
+
+// AddNsMember adds a member into namespace, or updates role of the member
+func (x *xdata) AddNsMember(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	opt, err := x.getNsMemberOptions(stub, args, blockchain.NsMemberAdd)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if !blockchain.NsRoleAllows(opt.Role, blockchain.NsRoleReader) {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param:role").Error())
+	}
+	if string(opt.Member) == string(opt.Owner) {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param:member, owner has all permissions").Error())
+	}
+	// check if namespace exists
+	if resp := x.getValue(stub, []string{packFileNsIndex(opt.Owner, opt.Namespace)}); len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound,
+			"file namespace not found: %s", resp.Message).Error())
+	}
+
+	m := blockchain.NsMember{
+		Owner:     opt.Owner,
+		Namespace: opt.Namespace,
+		Member:    opt.Member,
+		Role:      opt.Role,
+		AddTime:   opt.CurrentTime,
+	}
+	s, err := json.Marshal(m)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal NsMember").Error())
+	}
+	index := packNsMemberIndex(opt.Owner, opt.Namespace, opt.Member)
+	if resp := x.setValue(stub, []string{index, string(s)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to set index-nsmember on chain: %s", resp.Message).Error())
+	}
+	return shim.Success([]byte("OK"))
+}
+
+// RemoveNsMember removes a member from namespace
+func (x *xdata) RemoveNsMember(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	opt, err := x.getNsMemberOptions(stub, args, blockchain.NsMemberRemove)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	index := packNsMemberIndex(opt.Owner, opt.Namespace, opt.Member)
+	if resp := x.getValue(stub, []string{index}); len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound,
+			"namespace member not found: %s", resp.Message).Error())
+	}
+	if err := stub.DelState(index); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain,
+			"failed to delete index-nsmember on chain").Error())
+	}
+	return shim.Success([]byte("OK"))
+}
+
+// GetNsMember gets a member of namespace
+//  args = {owner, ns, member}
+func (x *xdata) GetNsMember(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 3 {
+		return shim.Error("invalid arguments. expecting owner, name and member")
+	}
+	index := packNsMemberIndex([]byte(args[0]), args[1], []byte(args[2]))
+	resp := x.getValue(stub, []string{index})
+	if len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound,
+			"namespace member not found: %s", resp.Message).Error())
+	}
+	return shim.Success(resp.Payload)
+}
+
+// ListNsMembers lists members of namespace
+//  args = {owner, ns}
+func (x *xdata) ListNsMembers(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 2 {
+		return shim.Error("invalid arguments. expecting owner and name")
+	}
+	prefix, attr := packNsMemberFilter([]byte(args[0]), args[1])
+	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	defer iterator.Close()
+
+	var ms []blockchain.NsMember
+	for iterator.HasNext() {
+		queryResponse, err := iterator.Next()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		var m blockchain.NsMember
+		if err := json.Unmarshal(queryResponse.Value, &m); err != nil {
+			return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+				"failed to unmarshal NsMember").Error())
+		}
+		ms = append(ms, m)
+	}
+	s, err := json.Marshal(ms)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal namespace members").Error())
+	}
+	return shim.Success(s)
+}
+
+// getNsMemberOptions gets NsMemberOptions from args and verifies signature of namespace owner on operation op
+func (x *xdata) getNsMemberOptions(stub shim.ChaincodeStubInterface, args []string, op string) (opt blockchain.NsMemberOptions, err error) {
+	if len(args) < 1 {
+		return opt, errorx.New(errorx.ErrCodeParam, "invalid arguments. expecting NsMemberOptions")
+	}
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return opt, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal NsMemberOptions")
+	}
+	m := fmt.Sprintf("%s,%s,%x,%s,%d", op, opt.Namespace, opt.Member, opt.Role, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.Owner, []byte(m)); err != nil {
+		return opt, err
+	}
+	if err := x.checkAndSetNsMemberTime(stub, opt); err != nil {
+		return opt, err
+	}
+	return opt, nil
+}
+
+// checkAndSetNsMemberTime rejects options not later than the last update of the member, so that
+//  an old signed options could not be replayed, e.g. to re-grant a role after the member was removed
+func (x *xdata) checkAndSetNsMemberTime(stub shim.ChaincodeStubInterface, opt blockchain.NsMemberOptions) error {
+	index := packNsMemberTimeIndex(opt.Owner, opt.Namespace, opt.Member)
+	if resp := x.getValue(stub, []string{index}); len(resp.Payload) != 0 {
+		last, err := strconv.ParseInt(string(resp.Payload), 10, 64)
+		if err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to parse last update time of member")
+		}
+		if opt.CurrentTime <= last {
+			return errorx.New(errorx.ErrCodeParam, "bad param:currentTime, not later than last update of member")
+		}
+	}
+	if resp := x.setValue(stub, []string{index, strconv.FormatInt(opt.CurrentTime, 10)}); resp.Status == shim.ERROR {
+		return errorx.New(errorx.ErrCodeWriteBlockchain, "failed to set index-nsmember-time on chain: %s", resp.Message)
+	}
+	return nil
+}
+
 // UpdateFilePublicSliceMeta is used to update file public slice metas
 func (x *xdata) UpdateFilePublicSliceMeta(stub shim.ChaincodeStubInterface, args []string) pb.Response {
 	if len(args) < 1 {
@@ -283,6 +569,7 @@ func (x *xdata) UpdateFilePublicSliceMeta(stub shim.ChaincodeStubInterface, args
 	}
 
 	// update slices
+	oldSlices := f.Slices
 	f.Slices = opt.Slices
 	nfs, err := json.Marshal(f)
 	if err != nil {
@@ -293,9 +580,157 @@ func (x *xdata) UpdateFilePublicSliceMeta(stub shim.ChaincodeStubInterface, args
 		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
 			"failed to set id-file on chain: %s", resp.Message).Error())
 	}
+	if err := x.clearCorruptedSlices(stub, f); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// set node-sliceID-expireTime on chain for nodes which slices migrated to
+	nodeSlice := make(map[string][]string)
+	for _, slice := range f.Slices {
+		nodeSlice[string(slice.NodeID)] = append(nodeSlice[string(slice.NodeID)], slice.ID)
+	}
+	for nodeId, sliceL := range nodeSlice {
+		prefixNodeFileSlice := packNodeSliceIndex(nodeId, f)
+		if resp := x.setValue(stub, []string{prefixNodeFileSlice, strings.Join(sliceL, ",")}); resp.Status == shim.ERROR {
+			return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+				"failed to set index-id on chain: %s", resp.Message).Error())
+		}
+	}
+	// remove node-sliceID-expireTime of nodes which all slices migrated away from,
+	//  slices left on them are no longer referenced and collected by slice gc
+	removed := make(map[string]bool)
+	for _, slice := range oldSlices {
+		nodeId := string(slice.NodeID)
+		if _, ok := nodeSlice[nodeId]; ok || removed[nodeId] {
+			continue
+		}
+		removed[nodeId] = true
+		if err := stub.DelState(packNodeSliceIndex(nodeId, f)); err != nil {
+			return shim.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain,
+				"failed to delete index-id on chain").Error())
+		}
+	}
 	return shim.Success([]byte("OK"))
 }
 
+// AppendFile appends slices of data to an unexpired file, file structure, length and merkle root are updated
+func (x *xdata) AppendFile(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting AppendFileOptions")
+	}
+
+	// unmarshal opt
+	var opt blockchain.AppendFileOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal AppendFileOptions").Error())
+	}
+	if len(opt.Slices) == 0 || opt.Length == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "slices is empty when appending file").Error())
+	}
+	// verify sig
+	ss, err := json.Marshal(opt.Slices)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal slices").Error())
+	}
+	m := fmt.Sprintf("%s,%x,%x,%d,%x,%d", opt.FileID, hash.Hash(ss), hash.Hash(opt.Structure), opt.Length,
+		opt.MerkleRoot, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.Owner, []byte(m)); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// get file from id
+	f, err := x.getFileById(stub, opt.FileID)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if string(f.Owner) != string(opt.Owner) {
+		return shim.Error(errorx.New(errorx.ErrCodeNotAuthorized, "bad param, file owner is wrong").Error())
+	}
+	if f.ExpireTime <= opt.CurrentTime {
+		return shim.Error(errorx.New(errorx.ErrCodeExpired, "file already expired").Error())
+	}
+	existing := make(map[string]bool, len(f.Slices))
+	for _, slice := range f.Slices {
+		existing[slice.ID] = true
+	}
+	for _, slice := range opt.Slices {
+		if existing[slice.ID] {
+			return shim.Error(errorx.New(errorx.ErrCodeAlreadyExists, "duplicated slice %s", slice.ID).Error())
+		}
+	}
+
+	// get file ns
+	fileNsIndex := packFileNsIndex(f.Owner, f.Namespace)
+	resp := x.getValue(stub, []string{fileNsIndex})
+	if len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound,
+			"file namespace not found: %s", resp.Message).Error())
+	}
+	var ns blockchain.Namespace
+	if err = json.Unmarshal(resp.Payload, &ns); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal namespace").Error())
+	}
+	if reason := ns.AppendExceeded(f.Length, opt.Length); reason != "" {
+		return shim.Error(errorx.New(errorx.ErrCodeQuotaExceeded, reason).Error())
+	}
+
+	// update file
+	of, err := json.Marshal(f)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal File").Error())
+	}
+	f.Slices = append(f.Slices, opt.Slices...)
+	f.Structure = opt.Structure
+	f.Length += opt.Length
+	f.MerkleRoot = opt.MerkleRoot
+	nf, err := json.Marshal(f)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal File").Error())
+	}
+	if (ns.FilesStruSize + len(nf) - len(of)) >= blockchain.ContractMessageMaxSize {
+		return shim.Error(errorx.New(errorx.ErrCodeParam,
+			"files struct size of ns larger than max, please upload file using new ns").Error())
+	}
+	if resp := x.setValue(stub, []string{f.ID, string(nf)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to set id-file on chain: %s", resp.Message).Error())
+	}
+
+	// update ns
+	ns.UpdateTime = opt.CurrentTime
+	ns.FilesStruSize += len(nf) - len(of)
+	ns.FilesTotalSize += opt.Length
+	nsf, err := json.Marshal(ns)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal File namespace").Error())
+	}
+	if resp := x.setValue(stub, []string{fileNsIndex, string(nsf)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to update index-ns on chain: %s", resp.Message).Error())
+	}
+	nsListIndex := packFileNsListIndex(ns.Owner, ns.Name, ns.CreateTime)
+	if resp := x.setValue(stub, []string{nsListIndex, string(nsf)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to update listIndex-ns on chain: %s", resp.Message).Error())
+	}
+
+	// set node-sliceID-expireTime on chain for nodes which appended slices are stored on
+	nodeSlice := make(map[string][]string)
+	for _, slice := range f.Slices {
+		nodeSlice[string(slice.NodeID)] = append(nodeSlice[string(slice.NodeID)], slice.ID)
+	}
+	for nodeId, sliceL := range nodeSlice {
+		prefixNodeFileSlice := packNodeSliceIndex(nodeId, f)
+		if resp := x.setValue(stub, []string{prefixNodeFileSlice, strings.Join(sliceL, ",")}); resp.Status == shim.ERROR {
+			return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+				"failed to set index-id on chain: %s", resp.Message).Error())
+		}
+	}
+	return shim.Success(nf)
+}
+
 // GetFileByName gets file by name from fabric
 func (x *xdata) GetFileByName(stub shim.ChaincodeStubInterface, args []string) pb.Response {
 	if len(args) < 4 {
@@ -508,6 +943,101 @@ func (x *xdata) SliceMigrateRecord(stub shim.ChaincodeStubInterface, args []stri
 	return shim.Success([]byte("ok"))
 }
 
+// ReportCorruptedSlice is called by storage node to report a corrupted slice it holds,
+//  the record is removed once the slice is migrated away from the node
+func (x *xdata) ReportCorruptedSlice(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting ReportCorruptedSliceOptions")
+	}
+
+	// unmarshal opt
+	var opt blockchain.ReportCorruptedSliceOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal ReportCorruptedSliceOptions").Error())
+	}
+	// check nonce
+	if err := x.checkAndSetNonce(stub, opt.NodeID, opt.Nonce); err != nil {
+		return shim.Error(err.Error())
+	}
+	// verify sig
+	nodePK, err := hex.DecodeString(string(opt.NodeID))
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to decode nodeID").Error())
+	}
+	msg := fmt.Sprintf("%s,%s,%s,%d", string(opt.NodeID), opt.FileID, opt.SliceID, opt.Nonce)
+	if err := x.checkSign(opt.Signature, nodePK, []byte(msg)); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// check the slice is stored on the node
+	f, err := x.getFileById(stub, opt.FileID)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	found := false
+	for _, slice := range f.Slices {
+		if slice.ID == opt.SliceID && string(slice.NodeID) == string(opt.NodeID) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound, "slice not found on node").Error())
+	}
+
+	cs := blockchain.CorruptedSlice{
+		FileID:     opt.FileID,
+		SliceID:    opt.SliceID,
+		NodeID:     opt.NodeID,
+		ReportTime: opt.Nonce,
+	}
+	b, err := json.Marshal(cs)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal CorruptedSlice").Error())
+	}
+	if resp := x.setValue(stub, []string{packCorruptedSliceIndex(f.Owner, cs), string(b)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to put corrupted slice on chain: %s", resp.Message).Error())
+	}
+	return shim.Success([]byte("OK"))
+}
+
+// ListCorruptedSlices lists corrupted slices of files owned by owner
+//  args = {owner}
+func (x *xdata) ListCorruptedSlices(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting owner")
+	}
+
+	prefix, attr := packCorruptedSliceFilter([]byte(args[0]), "")
+	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	defer iterator.Close()
+
+	var csl []blockchain.CorruptedSlice
+	for iterator.HasNext() {
+		queryResponse, err := iterator.Next()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		var cs blockchain.CorruptedSlice
+		if err := json.Unmarshal(queryResponse.Value, &cs); err != nil {
+			return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+				"failed to unmarshal CorruptedSlice").Error())
+		}
+		csl = append(csl, cs)
+	}
+	s, err := json.Marshal(csl)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal corrupted slices").Error())
+	}
+	return shim.Success(s)
+}
+
 // ListFiles lists files from fabric
 func (x *xdata) ListFiles(stub shim.ChaincodeStubInterface, args []string) pb.Response {
 	if len(args) < 1 {
@@ -523,6 +1053,11 @@ func (x *xdata) ListFiles(stub shim.ChaincodeStubInterface, args []string) pb.Re
 
 	// pack prefix
 	prefix, attr := packFileNameFilter(opt.Owner, opt.Namespace)
+	// keys before cursor were returned by previous pages
+	after, err := decodePageCursor(prefix, attr, opt.Cursor)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
 	// get iter by prefix
 	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
 	if err != nil {
@@ -532,15 +1067,21 @@ func (x *xdata) ListFiles(stub shim.ChaincodeStubInterface, args []string) pb.Re
 
 	// iterate iter
 	var fs []blockchain.File
+	var last, next string
 	for iterator.HasNext() {
 		queryResponse, err := iterator.Next()
 		if err != nil {
 			return shim.Error(err.Error())
 		}
+		if queryResponse.Key <= after {
+			continue
+		}
 
 		if opt.Limit > 0 && uint64(len(fs)) >= opt.Limit {
+			next = blockchain.EncodeCursor([]byte(last))
 			break
 		}
+		last = queryResponse.Key
 		f, err := x.getFileById(stub, string(queryResponse.Value))
 		if err != nil {
 			return shim.Error(err.Error())
@@ -551,7 +1092,85 @@ func (x *xdata) ListFiles(stub shim.ChaincodeStubInterface, args []string) pb.Re
 		fs = append(fs, f)
 	}
 
-	s, err := json.Marshal(fs)
+	s, err := json.Marshal(blockchain.FilePage{Files: fs, Next: next})
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal Files").Error())
+	}
+	return shim.Success(s)
+}
+
+// QueryFiles queries files by tags, name prefix, size range and expire time range
+//  files are looked up by the tag index if any tags given, or by the file name index
+func (x *xdata) QueryFiles(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting QueryFileOptions")
+	}
+
+	// unmarshal opt
+	var opt blockchain.QueryFileOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal QueryFileOptions").Error())
+	}
+
+	// pack prefix, file names are sorted by name instead of publish time when filtered by NamePrefix
+	prefix, attr := packFileNameFilter(opt.Owner, opt.Namespace)
+	if key, value := opt.IndexTag(); len(key) > 0 {
+		prefix, attr = packFileTagFilter(opt.Owner, key, value, opt.Namespace)
+	} else if opt.IndexNamePrefix() {
+		prefix, attr = packFileNamePrefixFilter(opt.Owner, opt.Namespace)
+	}
+	// names follow namespace in keys, composite keys could not be partial in attributes,
+	//  so keys out of the name prefix are skipped instead
+	var namePrefix string
+	if opt.IndexNamePrefix() {
+		namePrefix = createCompositeKey(prefix, attr) + opt.NamePrefix
+	}
+	// keys before cursor were returned by previous pages
+	after, err := decodePageCursor(prefix, attr, opt.Cursor)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	// get iter by prefix
+	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	defer iterator.Close()
+
+	// iterate iter
+	var fs []blockchain.File
+	var last, next string
+	limit := opt.PageLimit()
+	for iterator.HasNext() {
+		queryResponse, err := iterator.Next()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		if queryResponse.Key <= after || queryResponse.Key < namePrefix {
+			continue
+		}
+		if !strings.HasPrefix(queryResponse.Key, namePrefix) {
+			break
+		}
+
+		if uint64(len(fs)) >= limit {
+			next = blockchain.EncodeCursor([]byte(last))
+			break
+		}
+		last = queryResponse.Key
+		f, err := x.getFileById(stub, string(queryResponse.Value))
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		if !opt.Match(f) {
+			continue
+		}
+		fs = append(fs, f)
+	}
+
+	s, err := json.Marshal(blockchain.FilePage{Files: fs, Next: next})
 	if err != nil {
 		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to marshal Files").Error())
@@ -574,6 +1193,11 @@ func (x *xdata) ListExpiredFiles(stub shim.ChaincodeStubInterface, args []string
 
 	// pack prefix
 	prefix, attr := packFileNameFilter(opt.Owner, opt.Namespace)
+	// keys before cursor were returned by previous pages
+	after, err := decodePageCursor(prefix, attr, opt.Cursor)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
 	// get iter by prefix
 	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
 	if err != nil {
@@ -583,15 +1207,21 @@ func (x *xdata) ListExpiredFiles(stub shim.ChaincodeStubInterface, args []string
 
 	// iterate iter
 	var fs []blockchain.File
+	var last, next string
 	for iterator.HasNext() {
 		queryResponse, err := iterator.Next()
 		if err != nil {
 			return shim.Error(err.Error())
 		}
+		if queryResponse.Key <= after {
+			continue
+		}
 
 		if opt.Limit > 0 && uint64(len(fs)) >= opt.Limit {
+			next = blockchain.EncodeCursor([]byte(last))
 			break
 		}
+		last = queryResponse.Key
 		f, err := x.getFileById(stub, string(queryResponse.Value))
 		if err != nil {
 			return shim.Error(err.Error())
@@ -606,7 +1236,7 @@ func (x *xdata) ListExpiredFiles(stub shim.ChaincodeStubInterface, args []string
 		fs = append(fs, f)
 	}
 
-	s, err := json.Marshal(fs)
+	s, err := json.Marshal(blockchain.FilePage{Files: fs, Next: next})
 	if err != nil {
 		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to marshal Files").Error())
@@ -629,6 +1259,11 @@ func (x *xdata) ListFileNs(stub shim.ChaincodeStubInterface, args []string) pb.R
 
 	// pack prefix
 	prefix, attr := packFileNsListFilter(opt.Owner)
+	// keys before cursor were returned by previous pages
+	after, err := decodePageCursor(prefix, attr, opt.Cursor)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
 	// get iter by prefix
 	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
 	if err != nil {
@@ -638,15 +1273,21 @@ func (x *xdata) ListFileNs(stub shim.ChaincodeStubInterface, args []string) pb.R
 
 	// iterate iter
 	var nss []blockchain.Namespace
+	var last, next string
 	for iterator.HasNext() {
 		queryResponse, err := iterator.Next()
 		if err != nil {
 			return shim.Error(err.Error())
 		}
+		if queryResponse.Key <= after {
+			continue
+		}
 
 		if opt.Limit > 0 && uint64(len(nss)) >= opt.Limit {
+			next = blockchain.EncodeCursor([]byte(last))
 			break
 		}
+		last = queryResponse.Key
 		var ns blockchain.Namespace
 		if err := json.Unmarshal(queryResponse.Value, &ns); err != nil {
 			return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
@@ -658,7 +1299,7 @@ func (x *xdata) ListFileNs(stub shim.ChaincodeStubInterface, args []string) pb.R
 		nss = append(nss, ns)
 	}
 
-	s, err := json.Marshal(nss)
+	s, err := json.Marshal(blockchain.NamespacePage{Namespaces: nss, Next: next})
 	if err != nil {
 		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to marshal namespace list").Error())
@@ -700,6 +1341,58 @@ func (x *xdata) getFileById(stub shim.ChaincodeStubInterface, fileID string) (f
 }
 
 // check signature
+// clearCorruptedSlices removes corrupted slice records of the file which are no longer stored on the reporting node
+func (x *xdata) clearCorruptedSlices(stub shim.ChaincodeStubInterface, f blockchain.File) error {
+	prefix, attr := packCorruptedSliceFilter(f.Owner, f.ID)
+	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeReadBlockchain, "failed to get corrupted slices")
+	}
+	defer iterator.Close()
+
+	var staleKeys []string
+	for iterator.HasNext() {
+		queryResponse, err := iterator.Next()
+		if err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeReadBlockchain, "failed to get corrupted slices")
+		}
+		var cs blockchain.CorruptedSlice
+		if err := json.Unmarshal(queryResponse.Value, &cs); err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal CorruptedSlice")
+		}
+		stale := true
+		for _, slice := range f.Slices {
+			if slice.ID == cs.SliceID && string(slice.NodeID) == string(cs.NodeID) {
+				stale = false
+				break
+			}
+		}
+		if stale {
+			staleKeys = append(staleKeys, queryResponse.Key)
+		}
+	}
+	for _, key := range staleKeys {
+		if err := stub.DelState(key); err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to delete corrupted slice on chain")
+		}
+	}
+	return nil
+}
+
+// removeFileTagIndex removes tag index entries of the file, entries already removed are skipped
+func (x *xdata) removeFileTagIndex(stub shim.ChaincodeStubInterface, f blockchain.File) error {
+	for k, v := range f.Tags {
+		index := packFileTagIndex(f.Owner, k, v, f)
+		if resp := x.getValue(stub, []string{index}); len(resp.Payload) == 0 {
+			continue
+		}
+		if err := stub.DelState(index); err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to delete tagIndex-id on chain")
+		}
+	}
+	return nil
+}
+
 func (x *xdata) checkSign(sign, owner, mes []byte) (err error) {
 	if len(sign) != ecdsa.SignatureLength {
 		return errorx.New(errorx.ErrCodeParam, "bad param:signature")
@@ -726,47 +1419,70 @@ func (x *xdata) getNsNewStructSize(stub shim.ChaincodeStubInterface, nsr []byte,
 			"bad param: currentTime, request has expired")
 	}
 
+	nsFileStructSize, nsFilesTotalSize, nsFileActiveNum, retired, err := x.countNsUsage(stub, bns, ctime)
+	if err != nil {
+		return nil, err
+	}
+	if bns.FilesStruSize == nsFileStructSize && bns.FilesTotalSize == nsFilesTotalSize &&
+		bns.FileActiveNum == nsFileActiveNum {
+		return nil, errorx.New(errorx.ErrCodeAlreadyUpdate,
+			"ns-struct-size is already updated, not need to modify again")
+	}
+	// files out of retain period could no longer be renewed, so they are dropped from tag index
+	for _, f := range retired {
+		if err := x.removeFileTagIndex(stub, f); err != nil {
+			return nil, err
+		}
+	}
+	bns.FilesStruSize = nsFileStructSize
+	bns.FilesTotalSize = nsFilesTotalSize
+	bns.FileActiveNum = nsFileActiveNum
+	bns.UpdateTime = ctime
+
+	s, err = json.Marshal(bns)
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal namespaces")
+	}
+	return s, nil
+}
+
+// countNsUsage counts struct size, total size and number of files in namespace,
+//  files out of retain period are not counted, but returned as retired if they have tags
+func (x *xdata) countNsUsage(stub shim.ChaincodeStubInterface, bns blockchain.Namespace, ctime int64) (
+	structSize int, totalSize uint64, activeNum int64, retired []blockchain.File, err error) {
 	// pack prefix
 	prefix, attr := packFileNameFilter(bns.Owner, bns.Name)
 
 	// get iter by prefix
 	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
 	if err != nil {
-		return nil, err
+		return 0, 0, 0, nil, err
 	}
 	defer iterator.Close()
 
 	// iterate iter
-	nsFileStructSize := 0
 	for iterator.HasNext() {
 		queryResponse, err := iterator.Next()
 		if err != nil {
-			return nil, err
+			return 0, 0, 0, nil, err
 		}
 		f, err := x.getFileById(stub, string(queryResponse.Value))
 		if err != nil {
-			return nil, err
+			return 0, 0, 0, nil, err
 		}
 		if f.ExpireTime+blockchain.FileRetainPeriod.Nanoseconds() > ctime {
 			fs, err := json.Marshal(f)
 			if err != nil {
-				return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+				return 0, 0, 0, nil, errorx.NewCode(err, errorx.ErrCodeInternal,
 					"failed to marshal file of get ns-struct-size")
 			}
-			nsFileStructSize += len(fs)
+			structSize += len(fs)
+			totalSize += f.Length
+			activeNum++
+		} else if len(f.Tags) > 0 {
+			retired = append(retired, f)
 		}
 	}
-	if bns.FilesStruSize == nsFileStructSize {
-		return nil, errorx.New(errorx.ErrCodeAlreadyUpdate,
-			"ns-struct-size is already updated, not need to modify again")
-	}
-	bns.FilesStruSize = nsFileStructSize
-	bns.UpdateTime = ctime
-
-	s, err = json.Marshal(bns)
-	if err != nil {
-		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
-			"failed to marshal namespaces")
-	}
-	return s, nil
+	return structSize, totalSize, activeNum, retired, nil
 }
@@ -0,0 +1,204 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+func TestCorruptedSlices(t *testing.T) {
+	stub := shim.NewMockStub("xdata", new(xdata))
+	ownerPriv, ownerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	nodePriv, nodePub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	otherPriv, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	nodeID := []byte(nodePub.String())
+	now := time.Now().UnixNano()
+	addTestNs(t, stub, ownerPriv, "ns", now)
+	publishTestFile(t, stub, ownerPriv, "ns", "file1", "file1", 100, now+1)
+
+	moveSlice := func(to []byte) {
+		slices := []blockchain.PublicSliceMeta{{ID: "file1-s1", NodeID: to, SliceIdx: 1}}
+		s, err := json.Marshal(slices)
+		require.NoError(t, err)
+		sig, err := ecdsa.Sign(ownerPriv, hash.Hash(s))
+		require.NoError(t, err)
+		resp := invoke(stub, "UpdateFilePublicSliceMeta", blockchain.UpdateFilePSMOptions{FileID: "file1",
+			Owner: ownerPub[:], Slices: slices, Signature: sig[:]})
+		require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	}
+	report := func(signer ecdsa.PrivateKey, sliceID string, nonce int64) pb.Response {
+		m := fmt.Sprintf("%s,%s,%s,%d", nodeID, "file1", sliceID, nonce)
+		sig, err := ecdsa.Sign(signer, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		return invoke(stub, "ReportCorruptedSlice", blockchain.ReportCorruptedSliceOptions{FileID: "file1",
+			SliceID: sliceID, NodeID: nodeID, Nonce: nonce, Signature: sig[:]})
+	}
+	listCorrupted := func() []blockchain.CorruptedSlice {
+		resp := invoke(stub, "ListCorruptedSlices", string(ownerPub[:]))
+		require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+		var csl []blockchain.CorruptedSlice
+		require.NoError(t, json.Unmarshal(resp.Payload, &csl))
+		return csl
+	}
+
+	// slices migrated onto the node are listed with their files
+	moveSlice(nodeID)
+	resp := invoke(stub, "ListNodeSlices", blockchain.ListNodeSliceOptions{Target: nodeID})
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	var page blockchain.NodeSlicePage
+	require.NoError(t, json.Unmarshal(resp.Payload, &page))
+	ns := page.Slices
+	require.Equal(t, 1, len(ns))
+	require.Equal(t, "file1", ns[0].FileID)
+	require.Equal(t, "file1-s1", ns[0].ID)
+	require.Equal(t, now+1+time.Hour.Nanoseconds(), ns[0].ExpireTime)
+
+	// only the node holding the slice reports it, and reports could not be replayed
+	requireErrCode(t, report(otherPriv, "file1-s1", now+2), errorx.ErrCodeBadSignature)
+	requireErrCode(t, report(nodePriv, "file1-s2", now+3), errorx.ErrCodeNotFound)
+	resp = report(nodePriv, "file1-s1", now+4)
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	requireErrCode(t, report(nodePriv, "file1-s1", now+4), errorx.ErrCodeAlreadyExists)
+	require.Equal(t, []blockchain.CorruptedSlice{{FileID: "file1", SliceID: "file1-s1", NodeID: nodeID,
+		ReportTime: now + 4}}, listCorrupted())
+
+	// the report is cleared once the slice is migrated away from the node
+	moveSlice([]byte("node2"))
+	require.Equal(t, 0, len(listCorrupted()))
+}
+
+// countTagIndex counts file tag index entries on the ledger
+func countTagIndex(t *testing.T, stub *shim.MockStub) int {
+	iterator, err := stub.GetStateByPartialCompositeKey(prefixFileTagIndex, []string{})
+	require.NoError(t, err)
+	defer iterator.Close()
+	var n int
+	for iterator.HasNext() {
+		_, err := iterator.Next()
+		require.NoError(t, err)
+		n++
+	}
+	return n
+}
+
+func TestQueryFiles(t *testing.T) {
+	stub := shim.NewMockStub("xdata", new(xdata))
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	now := time.Now().UnixNano()
+	addTestNs(t, stub, privkey, "ns", now)
+	credit := map[string]string{"feature_set": "credit"}
+	publishTaggedFile(t, stub, privkey, "ns", "f1", "credit-b", credit, 100, now+1)
+	publishTaggedFile(t, stub, privkey, "ns", "f2", "credit-a",
+		map[string]string{"feature_set": "credit", "source": "bank"}, 100, now+2)
+	publishTaggedFile(t, stub, privkey, "ns", "f3", "other", credit, 100, now+3)
+	publishTestFile(t, stub, privkey, "ns", "f4", "credit-c", 100, now+4)
+	publishTestFile(t, stub, privkey, "ns", "f5", "a-credit", 100, now+5)
+	require.Equal(t, 4, countTagIndex(t, stub))
+
+	query := func(opt blockchain.QueryFileOptions) ([]string, string) {
+		opt.Owner = pubkey[:]
+		opt.CurrentTime = now + 10
+		resp := invoke(stub, "QueryFiles", opt)
+		require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+		var page blockchain.FilePage
+		require.NoError(t, json.Unmarshal(resp.Payload, &page))
+		var ids []string
+		for _, f := range page.Files {
+			ids = append(ids, f.ID)
+		}
+		return ids, page.Next
+	}
+
+	// tagged files are listed by name, others by the latest publish time first unless filtered by name prefix
+	ids, _ := query(blockchain.QueryFileOptions{Namespace: "ns", Tags: credit})
+	require.Equal(t, []string{"f2", "f1", "f3"}, ids)
+	ids, _ = query(blockchain.QueryFileOptions{Namespace: "ns", Tags: credit, NamePrefix: "credit-"})
+	require.Equal(t, []string{"f2", "f1"}, ids)
+	ids, _ = query(blockchain.QueryFileOptions{Namespace: "ns", NamePrefix: "credit-"})
+	require.Equal(t, []string{"f2", "f1", "f4"}, ids)
+	ids, _ = query(blockchain.QueryFileOptions{Namespace: "ns"})
+	require.Equal(t, []string{"f5", "f4", "f3", "f2", "f1"}, ids)
+
+	// pages follow the cursor
+	ids, next := query(blockchain.QueryFileOptions{Namespace: "ns", NamePrefix: "credit-", Limit: 2})
+	require.Equal(t, []string{"f2", "f1"}, ids)
+	require.NotEmpty(t, next)
+	ids, next = query(blockchain.QueryFileOptions{Namespace: "ns", NamePrefix: "credit-", Limit: 2, Cursor: next})
+	require.Equal(t, []string{"f4"}, ids)
+	require.Empty(t, next)
+
+	// tag index entries are removed once files are out of retain period
+	ctime := now + time.Hour.Nanoseconds() + blockchain.FileRetainPeriod.Nanoseconds() + 10
+	m := fmt.Sprintf("%s,%d", "ns", ctime)
+	sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	resp := invoke(stub, "UpdateNsFilesCap", blockchain.UpdateNsFilesCapOptions{Owner: pubkey[:], Name: "ns",
+		CurrentTime: ctime, Signature: sig[:]})
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	require.Equal(t, 0, countTagIndex(t, stub))
+
+	// or files are overwritten by new files of the same name
+	publishTaggedFile(t, stub, privkey, "ns", "f6", "other", credit, 100, ctime+1)
+	require.Equal(t, 1, countTagIndex(t, stub))
+	ptime := ctime + 1 + time.Hour.Nanoseconds() + blockchain.FileRetainPeriod.Nanoseconds() + 1
+	publishTestFile(t, stub, privkey, "ns", "f7", "other", 100, ptime)
+	require.Equal(t, 0, countTagIndex(t, stub))
+}
+
+func TestNsMembers(t *testing.T) {
+	stub := shim.NewMockStub("xdata", new(xdata))
+	ownerPriv, ownerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	_, member, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	now := time.Now().UnixNano()
+	addTestNs(t, stub, ownerPriv, "ns", now)
+
+	newOpt := func(op, role string, ctime int64) blockchain.NsMemberOptions {
+		m := fmt.Sprintf("%s,%s,%x,%s,%d", op, "ns", member[:], role, ctime)
+		sig, err := ecdsa.Sign(ownerPriv, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		return blockchain.NsMemberOptions{Owner: ownerPub[:], Namespace: "ns", Member: member[:], Role: role,
+			CurrentTime: ctime, Signature: sig[:]}
+	}
+	resp := invoke(stub, "AddNsMember", newOpt(blockchain.NsMemberAdd, blockchain.NsRoleWriter, now+1))
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+
+	// options signed for adding could not be submitted to remove the member
+	resp = invoke(stub, "RemoveNsMember", newOpt(blockchain.NsMemberAdd, blockchain.NsRoleReader, now+2))
+	requireErrCode(t, resp, errorx.ErrCodeBadSignature)
+	resp = invoke(stub, "GetNsMember", string(ownerPub[:]), "ns", string(member[:]))
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+
+	resp = invoke(stub, "RemoveNsMember", newOpt(blockchain.NsMemberRemove, "", now+3))
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	resp = invoke(stub, "GetNsMember", string(ownerPub[:]), "ns", string(member[:]))
+	requireErrCode(t, resp, errorx.ErrCodeNotFound)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// UpdateHealthPolicy sets a new version of node health policy, signed by the network admin
+func (x *xdata) UpdateHealthPolicy(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting UpdateHealthPolicyOptions")
+	}
+	var opt blockchain.UpdateHealthPolicyOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal UpdateHealthPolicyOptions").Error())
+	}
+	resp := x.getValue(stub, []string{keyNetworkAdmin})
+	if len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotAuthorized, "network admin not configured").Error())
+	}
+	adminPub, err := hex.DecodeString(string(resp.Payload))
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "bad network admin").Error())
+	}
+	// verify sig
+	p := opt.Policy
+	if err := x.checkSign(opt.Signature, adminPub, []byte(p.Message(opt.CurrentTime))); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := p.Valid(); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeParam, "bad param:policy").Error())
+	}
+	current := blockchain.DefaultHealthPolicy()
+	if resp := x.getValue(stub, []string{keyHealthPolicy}); len(resp.Payload) != 0 {
+		if err := json.Unmarshal(resp.Payload, &current); err != nil {
+			return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal HealthPolicy").Error())
+		}
+	}
+	if p.Version != current.Version+1 {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param:version, should be %d", current.Version+1).Error())
+	}
+
+	p.UpdateTime = opt.CurrentTime
+	ps, err := json.Marshal(p)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal HealthPolicy").Error())
+	}
+	if resp := x.setValue(stub, []string{keyHealthPolicy, string(ps)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to set health policy on chain: %s", resp.Message).Error())
+	}
+	index := packHealthPolicyIndex(strconv.FormatInt(p.Version, 10))
+	if resp := x.setValue(stub, []string{index, string(ps)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to set index-hpolicy on chain: %s", resp.Message).Error())
+	}
+	return shim.Success(ps)
+}
+
+// GetHealthPolicy gets node health policy of a version, or the current one if version is not given
+func (x *xdata) GetHealthPolicy(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	key := keyHealthPolicy
+	if len(args) > 0 {
+		if _, err := strconv.ParseInt(args[0], 10, 64); err != nil {
+			return shim.Error(errorx.NewCode(err, errorx.ErrCodeParam, "bad param:version").Error())
+		}
+		key = packHealthPolicyIndex(args[0])
+	}
+	resp := x.getValue(stub, []string{key})
+	if len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound, "health policy not found: %s", resp.Message).Error())
+	}
+	return shim.Success(resp.Payload)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// CommitHeartbeats posts aggregated heartbeats of a day instead of each heartbeat, signed by the node,
+//  commits of a node should not overlap, and heartbeats are counted by GetHeartbeatNum as well
+func (x *xdata) CommitHeartbeats(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting CommitHeartbeatsOptions")
+	}
+	var opt blockchain.CommitHeartbeatsOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal CommitHeartbeatsOptions").Error())
+	}
+	c := opt.Commit
+	if c.Count < 1 || c.Start > c.End || c.Count > c.MaxCount() || c.CommitTime < c.End {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param:commit").Error())
+	}
+	if c.Start < opt.DayBegin || c.End >= opt.DayBegin+int64(24*time.Hour) {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param:heartbeats should be in one day").Error())
+	}
+	// verify sig
+	nodePK, err := hex.DecodeString(string(c.NodeID))
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeParam, "failed to decode nodeID").Error())
+	}
+	if err := x.checkSign(opt.Signature, nodePK, []byte(c.Message())); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	index := packNodeIndex(c.NodeID)
+	resp := x.getValue(stub, []string{index})
+	if len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound, "node not found: %s", resp.Message).Error())
+	}
+	var node blockchain.Node
+	if err := json.Unmarshal(resp.Payload, &node); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal node").Error())
+	}
+	if resp := x.getValue(stub, []string{packHeartbeatLastIndex(c.NodeID)}); len(resp.Payload) != 0 {
+		var lc blockchain.HeartbeatCommit
+		if err := json.Unmarshal(resp.Payload, &lc); err != nil {
+			return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+				"failed to unmarshal HeartbeatCommit").Error())
+		}
+		if c.Start <= lc.End {
+			return shim.Error(errorx.New(errorx.ErrCodeAlreadyExists, "heartbeats overlap the last commit").Error())
+		}
+	}
+
+	commits, err := x.getHeartbeatCommits(stub, c.NodeID, opt.DayBegin)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	commits = append(commits, c)
+	cs, err := json.Marshal(commits)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal HeartbeatCommits").Error())
+	}
+	if resp := x.setValue(stub, []string{packHeartbeatCommitIndex(c.NodeID, opt.DayBegin), string(cs)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to set index-hbcommit on chain: %s", resp.Message).Error())
+	}
+	lc, err := json.Marshal(c)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal HeartbeatCommit").Error())
+	}
+	if resp := x.setValue(stub, []string{packHeartbeatLastIndex(c.NodeID), string(lc)}); resp.Status == shim.ERROR {
+		return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+			"failed to set index-hblast on chain: %s", resp.Message).Error())
+	}
+
+	// update node heartbeat time
+	if c.End > node.UpdateAt {
+		node.UpdateAt = c.End
+		newNode, err := json.Marshal(node)
+		if err != nil {
+			return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal node").Error())
+		}
+		if resp := x.setValue(stub, []string{index, string(newNode)}); resp.Status == shim.ERROR {
+			return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+				"failed to put index-Node on chain: %s", resp.Message).Error())
+		}
+		if resp := x.setValue(stub, []string{packNodeListIndex(node), string(newNode)}); resp.Status == shim.ERROR {
+			return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+				"failed to put listIndex-Node on chain: %s", resp.Message).Error())
+		}
+	}
+	return shim.Success(nil)
+}
+
+// GetHeartbeatCommits gets heartbeat commits of a node in the day beginning at the time given
+func (x *xdata) GetHeartbeatCommits(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 2 {
+		return shim.Error("invalid arguments. expecting nodeID and timestamp")
+	}
+	ctime, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeParam, "failed to parseInt currentTime").Error())
+	}
+	commits, err := x.getHeartbeatCommits(stub, []byte(args[0]), ctime)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	s, err := json.Marshal(commits)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal HeartbeatCommits").Error())
+	}
+	return shim.Success(s)
+}
+
+// GetLastHeartbeatCommit gets the last heartbeat commit of a node
+func (x *xdata) GetLastHeartbeatCommit(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting nodeID")
+	}
+	resp := x.getValue(stub, []string{packHeartbeatLastIndex([]byte(args[0]))})
+	if len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound, "heartbeat commit not found: %s", resp.Message).Error())
+	}
+	return shim.Success(resp.Payload)
+}
+
+func (x *xdata) getHeartbeatCommits(stub shim.ChaincodeStubInterface, nodeID []byte, dayBegin int64) (
+	[]blockchain.HeartbeatCommit, error) {
+	var commits []blockchain.HeartbeatCommit
+	resp := x.getValue(stub, []string{packHeartbeatCommitIndex(nodeID, dayBegin)})
+	if len(resp.Payload) == 0 {
+		return commits, nil
+	}
+	if err := json.Unmarshal(resp.Payload, &commits); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal HeartbeatCommits")
+	}
+	return commits, nil
+}
@@ -20,11 +20,12 @@ import (
 	"strings"
 
 	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
 )
 
 const (
 	compositeKeyNamespace = "\x00"
-	minUnicodeRuneValue   = 0
+	minUnicodeRuneValue   = rune(0)
 
 	prefixFilenameIndex         = "index_fn"
 	prefixFilenameListIndex     = "index_fn_list"
@@ -39,6 +40,18 @@ const (
 	prefixNodeSliceMigrateIndex = "index_slicemigrate"
 	prefixNodeFileSlice         = "index_fslice"
 	prefixNodeNonceIndex        = "index_ndnonce"
+	prefixCorruptedSliceIndex   = "index_corrupt"
+	prefixNsMemberIndex         = "index_nsmember"
+	prefixNsMemberTimeIndex     = "index_nsmtime"
+	prefixFileTagIndex          = "index_ftag"
+	prefixTransferIndex         = "index_transfer"
+	prefixTransferListIndex     = "index_transfer_list"
+	prefixHealthPolicyIndex     = "index_hpolicy"
+	prefixHeartbeatCommitIndex  = "index_hbcommit"
+	prefixHeartbeatLastIndex    = "index_hblast"
+
+	keyNetworkAdmin = "network_admin"
+	keyHealthPolicy = "health_policy"
 )
 
 func packNodeIndex(nodeID []byte) string {
@@ -58,6 +71,15 @@ func packHeartBeatIndex(nodeID []byte, ctime int64) string {
 	return createCompositeKey(prefixNodeHeartbeatIndex, attr)
 }
 
+func packHeartbeatCommitIndex(nodeID []byte, dayBegin int64) string {
+	attr := []string{fmt.Sprintf("%x", nodeID), fmt.Sprintf("%d", dayBegin)}
+	return createCompositeKey(prefixHeartbeatCommitIndex, attr)
+}
+
+func packHeartbeatLastIndex(nodeID []byte) string {
+	return createCompositeKey(prefixHeartbeatLastIndex, []string{fmt.Sprintf("%x", nodeID)})
+}
+
 func packNonceIndex(node []byte, nonce int64) string {
 	//return fmt.Sprintf("%s/%x/%d", prefixNodeNonceIndex, node, nonce)
 	return createCompositeKey(prefixNodeNonceIndex, []string{fmt.Sprintf("%x", node), fmt.Sprintf("%d", nonce)})
@@ -83,6 +105,15 @@ func getNodeSliceFileId(key []byte) int64 {
 	return expireTime
 }
 
+// getNodeSliceFile gets file id from node slice index
+func getNodeSliceFile(key []byte) string {
+	str_arr := strings.Split(string(key), string(minUnicodeRuneValue))
+	if len(str_arr) < 5 {
+		return ""
+	}
+	return str_arr[4]
+}
+
 func packNodeSliceMigrateIndex(target string, ctime int64) string {
 	//return fmt.Sprintf("%s/%s/%d", prefixNodeSliceMigrateIndex, target, subByInt64Max(ctime))
 	attributes := []string{target, fmt.Sprintf("%d", subByInt64Max(ctime))}
@@ -103,6 +134,19 @@ func getNodeSliceMigrateTime(key []byte) int64 {
 	return subByInt64Max(expireTime)
 }
 
+func packCorruptedSliceIndex(owner []byte, cs blockchain.CorruptedSlice) string {
+	attributes := []string{fmt.Sprintf("%x", owner), cs.FileID, cs.SliceID, string(cs.NodeID)}
+	return createCompositeKey(prefixCorruptedSliceIndex, attributes)
+}
+
+func packCorruptedSliceFilter(owner []byte, fileID string) (prefix string, attr []string) {
+	attr = []string{fmt.Sprintf("%x", owner)}
+	if len(fileID) > 0 {
+		attr = append(attr, fileID)
+	}
+	return prefixCorruptedSliceIndex, attr
+}
+
 func packFileNameIndex(owner []byte, ns, name string) string {
 	//return fmt.Sprintf("%s/%x/%s/%s", prefixFilenameIndex, owner, ns,  name)
 	attributes := []string{fmt.Sprintf("%x", owner), ns, name}
@@ -133,6 +177,55 @@ func packFileNsListFilter(owner []byte) (prefix string, attr []string) {
 	return prefixFileNsListIndex, []string{fmt.Sprintf("%x", owner)}
 }
 
+func packNsMemberIndex(owner []byte, ns string, member []byte) string {
+	attributes := []string{fmt.Sprintf("%x", owner), ns, fmt.Sprintf("%x", member)}
+	return createCompositeKey(prefixNsMemberIndex, attributes)
+}
+
+func packNsMemberTimeIndex(owner []byte, ns string, member []byte) string {
+	attributes := []string{fmt.Sprintf("%x", owner), ns, fmt.Sprintf("%x", member)}
+	return createCompositeKey(prefixNsMemberTimeIndex, attributes)
+}
+
+func packNsMemberFilter(owner []byte, ns string) (prefix string, attr []string) {
+	return prefixNsMemberIndex, []string{fmt.Sprintf("%x", owner), ns}
+}
+
+func packFileTagIndex(owner []byte, key, value string, f blockchain.File) string {
+	attributes := []string{fmt.Sprintf("%x", owner), key + "=" + value, f.Namespace, f.Name, f.ID}
+	return createCompositeKey(prefixFileTagIndex, attributes)
+}
+
+func packFileTagFilter(owner []byte, key, value, ns string) (prefix string, attr []string) {
+	attr = []string{fmt.Sprintf("%x", owner), key + "=" + value}
+	if len(ns) > 0 {
+		attr = append(attr, ns)
+	}
+	return prefixFileTagIndex, attr
+}
+
+func packTransferIndex(id string) string {
+	return createCompositeKey(prefixTransferIndex, []string{id})
+}
+
+func packTransferListIndex(user []byte, t blockchain.Transfer) string {
+	attributes := []string{fmt.Sprintf("%x", user), fmt.Sprintf("%d", subByInt64Max(t.ProposeTime)), t.ID}
+	return createCompositeKey(prefixTransferListIndex, attributes)
+}
+
+func packTransferListFilter(user []byte) (prefix string, attr []string) {
+	return prefixTransferListIndex, []string{fmt.Sprintf("%x", user)}
+}
+
+func packHealthPolicyIndex(version string) string {
+	return createCompositeKey(prefixHealthPolicyIndex, []string{version})
+}
+
+// packFileNamePrefixFilter packs filter of file name index under namespace, which is sorted by file name
+func packFileNamePrefixFilter(owner []byte, ns string) (prefix string, attr []string) {
+	return prefixFilenameIndex, []string{fmt.Sprintf("%x", owner), ns}
+}
+
 func packFileNameFilter(owner []byte, ns string) (prefix string, attr []string) {
 	prefix = prefixFilenameListIndex
 	attr = []string{fmt.Sprintf("%x", owner)}
@@ -182,6 +275,18 @@ func createCompositeKey(objectType string, attributes []string) string {
 	return ck
 }
 
+// decodePageCursor returns the composite key a listing continues after, empty if no cursor given
+func decodePageCursor(prefix string, attr []string, cursor string) (string, error) {
+	if len(cursor) == 0 {
+		return "", nil
+	}
+	key, err := blockchain.DecodeCursor(cursor, []byte(createCompositeKey(prefix, attr)))
+	if err != nil {
+		return "", errorx.NewCode(err, errorx.ErrCodeParam, "bad param:cursor")
+	}
+	return string(key), nil
+}
+
 // return maxInt64 - N
 func subByInt64Max(n int64) int64 {
 	return math.MaxInt64 - n
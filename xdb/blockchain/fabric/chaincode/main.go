@@ -24,14 +24,19 @@ type xdata struct{}
 
 func (x *xdata) Init(stub shim.ChaincodeStubInterface) pb.Response {
 	fmt.Println("init xdata chaincode")
+	// network admin is optional, it's a hex public key allowed to set health policy,
+	//  set when the chaincode is instantiated or upgraded
+	if _, args := stub.GetFunctionAndParameters(); len(args) > 0 && len(args[0]) > 0 {
+		if resp := x.setValue(stub, []string{keyNetworkAdmin, args[0]}); resp.Status == shim.ERROR {
+			return resp
+		}
+	}
 	return shim.Success(nil)
 }
 
 func (x *xdata) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 	fn, args := stub.GetFunctionAndParameters()
 	switch fn {
-	case "setValue":
-		return x.setValue(stub, args)
 	case "getValue":
 		return x.getValue(stub, args)
 	case "AddNode":
@@ -44,10 +49,22 @@ func (x *xdata) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 		return x.NodeOffline(stub, args)
 	case "NodeOnline":
 		return x.NodeOnline(stub, args)
+	case "NodeDrain":
+		return x.NodeDrain(stub, args)
+	case "GetNodeSliceNum":
+		return x.GetNodeSliceNum(stub, args)
+	case "ListNodeSlices":
+		return x.ListNodeSlices(stub, args)
 	case "Heartbeat":
 		return x.Heartbeat(stub, args)
 	case "GetHeartbeatNum":
 		return x.GetHeartbeatNum(stub, args)
+	case "CommitHeartbeats":
+		return x.CommitHeartbeats(stub, args)
+	case "GetHeartbeatCommits":
+		return x.GetHeartbeatCommits(stub, args)
+	case "GetLastHeartbeatCommit":
+		return x.GetLastHeartbeatCommit(stub, args)
 	case "ListNodesExpireSlice":
 		return x.ListNodesExpireSlice(stub, args)
 	case "GetSliceMigrateRecords":
@@ -58,8 +75,38 @@ func (x *xdata) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 		return x.AddFileNs(stub, args)
 	case "UpdateNsReplica":
 		return x.UpdateNsReplica(stub, args)
+	case "UpdateNsQuota":
+		return x.UpdateNsQuota(stub, args)
+	case "UpdateNsRenewal":
+		return x.UpdateNsRenewal(stub, args)
+	case "AddNsMember":
+		return x.AddNsMember(stub, args)
+	case "RemoveNsMember":
+		return x.RemoveNsMember(stub, args)
+	case "GetNsMember":
+		return x.GetNsMember(stub, args)
+	case "ListNsMembers":
+		return x.ListNsMembers(stub, args)
+	case "UpdateHealthPolicy":
+		return x.UpdateHealthPolicy(stub, args)
+	case "GetHealthPolicy":
+		return x.GetHealthPolicy(stub, args)
+	case "ProposeTransfer":
+		return x.ProposeTransfer(stub, args)
+	case "AcceptTransfer":
+		return x.AcceptTransfer(stub, args)
+	case "CancelTransfer":
+		return x.CancelTransfer(stub, args)
+	case "CompleteTransfer":
+		return x.CompleteTransfer(stub, args)
+	case "GetTransferByID":
+		return x.GetTransferByID(stub, args)
+	case "ListTransfers":
+		return x.ListTransfers(stub, args)
 	case "UpdateFilePublicSliceMeta":
 		return x.UpdateFilePublicSliceMeta(stub, args)
+	case "AppendFile":
+		return x.AppendFile(stub, args)
 	case "GetFileByName":
 		return x.GetFileByName(stub, args)
 	case "GetFileByID":
@@ -70,8 +117,14 @@ func (x *xdata) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 		return x.UpdateNsFilesCap(stub, args)
 	case "SliceMigrateRecord":
 		return x.SliceMigrateRecord(stub, args)
+	case "ReportCorruptedSlice":
+		return x.ReportCorruptedSlice(stub, args)
+	case "ListCorruptedSlices":
+		return x.ListCorruptedSlices(stub, args)
 	case "ListFiles":
 		return x.ListFiles(stub, args)
+	case "QueryFiles":
+		return x.QueryFiles(stub, args)
 	case "ListExpiredFiles":
 		return x.ListExpiredFiles(stub, args)
 	case "ListFileNs":
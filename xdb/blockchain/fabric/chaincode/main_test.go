@@ -0,0 +1,46 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+)
+
+func TestReservedKeys(t *testing.T) {
+	stub := shim.NewMockStub("xdata", new(xdata))
+	_, admin, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	resp := stub.MockInit("init", [][]byte{[]byte("init"), []byte(admin.String())})
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+
+	// values are only written by chaincode methods, so clients could not overwrite
+	//  the network admin or any record guarded by them
+	_, other, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	for _, key := range []string{keyNetworkAdmin, keyHealthPolicy, "key"} {
+		resp = invoke(stub, "setValue", key, other.String())
+		require.Equal(t, int32(shim.ERROR), resp.Status)
+	}
+	resp = invoke(stub, "getValue", keyNetworkAdmin)
+	require.Equal(t, admin.String(), string(resp.Payload))
+	resp = invoke(stub, "getValue", keyHealthPolicy)
+	require.Empty(t, resp.Payload)
+	resp = invoke(stub, "getValue", "key")
+	require.Empty(t, resp.Payload)
+}
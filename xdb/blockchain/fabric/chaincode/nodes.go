@@ -141,8 +141,45 @@ func (x *xdata) NodeOnline(stub shim.ChaincodeStubInterface, args []string) pb.R
 	return x.setNodeOnlineStatus(stub, args, true)
 }
 
+// NodeDrain sets node draining, node is recorded drained once it holds no live slices,
+//  so calling it again on a draining node checks and records the progress
+//  args = {opt}
+func (x *xdata) NodeDrain(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting NodeOperateOptions")
+	}
+	return x.updateNodeStatus(stub, args, func(node *blockchain.Node, opt blockchain.NodeOperateOptions) bool {
+		changed := false
+		if !node.Draining {
+			node.Draining = true
+			node.DrainTime = opt.Nonce
+			changed = true
+		}
+		if node.DrainedTime == 0 {
+			if num, err := x.countNodeSlices(stub, string(opt.NodeID), opt.Nonce); err == nil && num == 0 {
+				node.DrainedTime = opt.Nonce
+				changed = true
+			}
+		}
+		return changed
+	})
+}
+
 // setNodeOnlineStatus sets node status
 func (x *xdata) setNodeOnlineStatus(stub shim.ChaincodeStubInterface, args []string, online bool) pb.Response {
+	return x.updateNodeStatus(stub, args, func(node *blockchain.Node, opt blockchain.NodeOperateOptions) bool {
+		if node.Online == online {
+			return false
+		}
+		node.Online = online
+		return true
+	})
+}
+
+// updateNodeStatus verifies NodeOperateOptions signed by node and updates node with the given function,
+//  node is saved only if the function returns true
+func (x *xdata) updateNodeStatus(stub shim.ChaincodeStubInterface, args []string,
+	update func(node *blockchain.Node, opt blockchain.NodeOperateOptions) bool) pb.Response {
 	// unmarshal opt
 	var opt blockchain.NodeOperateOptions
 	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
@@ -177,9 +214,7 @@ func (x *xdata) setNodeOnlineStatus(stub shim.ChaincodeStubInterface, args []str
 			"failed to unmarshal node").Error())
 	}
 
-	if node.Online != online {
-		// change status
-		node.Online = online
+	if update(&node, opt) {
 		// marshal new node
 		newn, err := json.Marshal(node)
 		if err != nil {
@@ -302,18 +337,163 @@ func (x *xdata) GetHeartbeatNum(stub shim.ChaincodeStubInterface, args []string)
 		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to parseInt currentTime").Error())
 	}
 
+	// heartbeats are sent one by one, or aggregated in commits
+	commits, err := x.getHeartbeatCommits(stub, nodeID, ctime)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	num := 0
+	for _, c := range commits {
+		num += c.Count
+	}
+
 	// get heart beat by index
 	hindex := packHeartBeatIndex(nodeID, ctime)
 	resp := x.getValue(stub, []string{hindex})
 
 	var hb []int64
 	if len(resp.Payload) == 0 {
+		if len(commits) > 0 {
+			return shim.Success([]byte(strconv.Itoa(num)))
+		}
 		return shim.Error(errorx.New(errorx.ErrCodeNotFound, "heartbeat not found: %s", resp.Message).Error())
 	}
 	if err := json.Unmarshal(resp.Payload, &hb); err != nil {
 		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal node").Error())
 	}
-	return shim.Success([]byte(strconv.Itoa(len(hb))))
+	return shim.Success([]byte(strconv.Itoa(num + len(hb))))
+}
+
+// GetNodeSliceNum counts slices of unexpired files on the node
+//  args = {nodeID, currentTime}
+func (x *xdata) GetNodeSliceNum(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 2 {
+		return shim.Error("invalid arguments. expecting nodeID and currentTime")
+	}
+
+	nodeID := args[0]
+	ctime, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to parseInt currentTime").Error())
+	}
+	num, err := x.countNodeSlices(stub, nodeID, ctime)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success([]byte(strconv.Itoa(num)))
+}
+
+// countNodeSlices counts slices of files unexpired at ctime on the node
+func (x *xdata) countNodeSlices(stub shim.ChaincodeStubInterface, nodeID string, ctime int64) (int, error) {
+	// pack prefix
+	prefix, attr := packNodeSliceFilter(nodeID)
+	// iterate
+	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
+	if err != nil {
+		return 0, err
+	}
+	defer iterator.Close()
+
+	// index may be stale after slices migrated or expire time updated, so check files
+	num := 0
+	checked := make(map[string]bool)
+	for iterator.HasNext() {
+		queryResponse, err := iterator.Next()
+		if err != nil {
+			return 0, err
+		}
+		fileID := getNodeSliceFile([]byte(queryResponse.Key))
+		if len(fileID) == 0 || checked[fileID] {
+			continue
+		}
+		checked[fileID] = true
+		f, err := x.getFileById(stub, fileID)
+		if err != nil || f.ExpireTime <= ctime {
+			continue
+		}
+		for _, slice := range f.Slices {
+			if string(slice.NodeID) == nodeID {
+				num++
+			}
+		}
+	}
+	return num, nil
+}
+
+// ListNodeSlices lists a page of slices stored on the node, which are referenced by files on chain,
+//  a page holds slices of at most opt.PageLimit() files, and slices of a file whose expire time
+//  was updated may be listed again in following pages
+//  args = {opt}
+//  returns a json blockchain.NodeSlicePage, carrying id and expire time of the file each slice belongs to
+func (x *xdata) ListNodeSlices(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting ListNodeSliceOptions")
+	}
+	// unmarshal opt
+	var opt blockchain.ListNodeSliceOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal ListNodeSliceOptions").Error())
+	}
+	nodeID := string(opt.Target)
+
+	// pack prefix
+	prefix, attr := packNodeSliceFilter(nodeID)
+	// keys before cursor were returned by previous pages
+	after, err := decodePageCursor(prefix, attr, opt.Cursor)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	// iterate
+	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	defer iterator.Close()
+
+	// index may be stale after slices migrated, so check files
+	var sl []blockchain.NodeSlice
+	var last, next string
+	var num uint64
+	limit := opt.PageLimit()
+	checked := make(map[string]bool)
+	for iterator.HasNext() {
+		queryResponse, err := iterator.Next()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		if queryResponse.Key <= after {
+			continue
+		}
+		if num >= limit {
+			next = blockchain.EncodeCursor([]byte(last))
+			break
+		}
+		last = queryResponse.Key
+		num++
+		fileID := getNodeSliceFile([]byte(queryResponse.Key))
+		if len(fileID) == 0 || checked[fileID] {
+			continue
+		}
+		checked[fileID] = true
+		f, err := x.getFileById(stub, fileID)
+		if err != nil {
+			continue
+		}
+		for _, slice := range f.Slices {
+			if string(slice.NodeID) == nodeID {
+				sl = append(sl, blockchain.NodeSlice{FileID: f.ID, ExpireTime: f.ExpireTime,
+					PublicSliceMeta: slice})
+			}
+		}
+	}
+
+	rs, err := json.Marshal(blockchain.NodeSlicePage{Slices: sl, Next: next})
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal slices").Error())
+	}
+	return shim.Success(rs)
 }
 
 // ListFiles lists files from fabric
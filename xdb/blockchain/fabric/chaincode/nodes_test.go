@@ -0,0 +1,147 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+// countNodeSliceIndex counts node slice index entries of the node on the ledger
+func countNodeSliceIndex(t *testing.T, stub *shim.MockStub, nodeID []byte) int {
+	prefix, attr := packNodeSliceFilter(string(nodeID))
+	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
+	require.NoError(t, err)
+	defer iterator.Close()
+	var n int
+	for iterator.HasNext() {
+		_, err := iterator.Next()
+		require.NoError(t, err)
+		n++
+	}
+	return n
+}
+
+func TestNodeDrain(t *testing.T) {
+	stub := shim.NewMockStub("xdata", new(xdata))
+	ownerPriv, ownerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	nodePriv, nodePub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	nodeID := []byte(nodePub.String())
+	node := blockchain.Node{ID: nodeID, Name: "node", Address: "127.0.0.1:8122", Online: true}
+	s, err := json.Marshal(node)
+	require.NoError(t, err)
+	sig, err := ecdsa.Sign(nodePriv, hash.Hash(s))
+	require.NoError(t, err)
+	resp := invoke(stub, "AddNode", blockchain.AddNodeOptions{Node: node, Signature: sig[:]})
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+
+	now := time.Now().UnixNano()
+	addTestNs(t, stub, ownerPriv, "ns", now)
+	publishTestFile(t, stub, ownerPriv, "ns", "file1", "file1", 100, now+1)
+
+	moveSlice := func(to []byte) {
+		slices := []blockchain.PublicSliceMeta{{ID: "file1-s1", NodeID: to, SliceIdx: 1}}
+		s, err := json.Marshal(slices)
+		require.NoError(t, err)
+		sig, err := ecdsa.Sign(ownerPriv, hash.Hash(s))
+		require.NoError(t, err)
+		resp := invoke(stub, "UpdateFilePublicSliceMeta", blockchain.UpdateFilePSMOptions{FileID: "file1",
+			Owner: ownerPub[:], Slices: slices, Signature: sig[:]})
+		require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	}
+	drain := func(nonce int64) blockchain.Node {
+		m := fmt.Sprintf("%s,%d", nodeID, nonce)
+		sig, err := ecdsa.Sign(nodePriv, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		resp := invoke(stub, "NodeDrain", blockchain.NodeOperateOptions{NodeID: nodeID, Nonce: nonce, Sig: sig[:]})
+		require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+		resp = invoke(stub, "GetNode", string(nodeID))
+		require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+		var node blockchain.Node
+		require.NoError(t, json.Unmarshal(resp.Payload, &node))
+		return node
+	}
+
+	// node holding live slices is draining but not drained
+	moveSlice(nodeID)
+	require.Equal(t, 1, countNodeSliceIndex(t, stub, nodeID))
+	node = drain(now + 10)
+	require.True(t, node.Draining)
+	require.Equal(t, now+10, node.DrainTime)
+	require.Equal(t, int64(0), node.DrainedTime)
+
+	// index of the node is removed once its slices migrated away
+	moveSlice([]byte("node2"))
+	require.Equal(t, 0, countNodeSliceIndex(t, stub, nodeID))
+	require.Equal(t, 1, countNodeSliceIndex(t, stub, []byte("node2")))
+	resp = invoke(stub, "GetNodeSliceNum", string(nodeID), fmt.Sprint(now+15))
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	require.Equal(t, "0", string(resp.Payload))
+
+	// draining again records the node drained, and only once
+	node = drain(now + 20)
+	require.Equal(t, now+10, node.DrainTime)
+	require.Equal(t, now+20, node.DrainedTime)
+	node = drain(now + 30)
+	require.Equal(t, now+20, node.DrainedTime)
+}
+
+func TestListNodeSlices(t *testing.T) {
+	stub := shim.NewMockStub("xdata", new(xdata))
+	privkey, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	now := time.Now().UnixNano()
+	addTestNs(t, stub, privkey, "ns", now)
+	for i, id := range []string{"file1", "file2", "file3"} {
+		publishTestFile(t, stub, privkey, "ns", id, id, 100, now+int64(i)+1)
+	}
+	list := func(opt blockchain.ListNodeSliceOptions) blockchain.NodeSlicePage {
+		resp := invoke(stub, "ListNodeSlices", opt)
+		require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+		var page blockchain.NodeSlicePage
+		require.NoError(t, json.Unmarshal(resp.Payload, &page))
+		return page
+	}
+
+	// slices are listed page by page
+	opt := blockchain.ListNodeSliceOptions{Target: []byte("node1"), Limit: 2}
+	page := list(opt)
+	require.Equal(t, 2, len(page.Slices))
+	require.NotEmpty(t, page.Next)
+	opt.Cursor = page.Next
+	more := list(opt)
+	require.Equal(t, 1, len(more.Slices))
+	require.Empty(t, more.Next)
+	var ids []string
+	for _, s := range append(page.Slices, more.Slices...) {
+		ids = append(ids, s.ID)
+	}
+	require.ElementsMatch(t, []string{"file1-s1", "file2-s1", "file3-s1"}, ids)
+
+	// cursor must point into the index of the node
+	opt.Target = []byte("node2")
+	requireErrCode(t, invoke(stub, "ListNodeSlices", opt), errorx.ErrCodeParam)
+}
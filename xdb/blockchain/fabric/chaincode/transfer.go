@@ -0,0 +1,351 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// ProposeTransfer proposes an ownership transfer of a file or a namespace, signed by the owner
+func (x *xdata) ProposeTransfer(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting ProposeTransferOptions")
+	}
+	var opt blockchain.ProposeTransferOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal ProposeTransferOptions").Error())
+	}
+	if len(opt.ID) == 0 || len(opt.NewNamespace) == 0 || len(opt.NewOwner) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeParam,
+			"bad param:id, new owner and new namespace are required").Error())
+	}
+	if bytes.Equal(opt.Owner, opt.NewOwner) {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param:new owner should differ from owner").Error())
+	}
+	// verify sig
+	m := fmt.Sprintf("%s,%s,%s,%x,%s,%d", opt.ID, opt.Namespace, opt.FileID, opt.NewOwner, opt.NewNamespace, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.Owner, []byte(m)); err != nil {
+		return shim.Error(err.Error())
+	}
+	if resp := x.getValue(stub, []string{packTransferIndex(opt.ID)}); len(resp.Payload) != 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeAlreadyExists, "duplicated transfer id").Error())
+	}
+	if resp := x.getValue(stub, []string{packFileNsIndex(opt.Owner, opt.Namespace)}); len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound,
+			"file namespace not found: %s", resp.Message).Error())
+	}
+	t := blockchain.Transfer{
+		ID:           opt.ID,
+		Owner:        opt.Owner,
+		Namespace:    opt.Namespace,
+		FileID:       opt.FileID,
+		NewOwner:     opt.NewOwner,
+		NewNamespace: opt.NewNamespace,
+		Status:       blockchain.TransferProposed,
+		ProposeTime:  opt.CurrentTime,
+		Files:        make(map[string]string),
+	}
+	if len(opt.FileID) > 0 {
+		f, err := x.getFileById(stub, opt.FileID)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		if !t.Covers(f) || f.ExpireTime <= opt.CurrentTime {
+			return shim.Error(errorx.New(errorx.ErrCodeParam,
+				"file not found in namespace or already expired").Error())
+		}
+	}
+	if err := x.putTransfer(stub, t); err != nil {
+		return shim.Error(err.Error())
+	}
+	for _, user := range [][]byte{t.Owner, t.NewOwner} {
+		if resp := x.setValue(stub, []string{packTransferListIndex(user, t), t.ID}); resp.Status == shim.ERROR {
+			return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+				"failed to set index-transfer-list on chain: %s", resp.Message).Error())
+		}
+	}
+	return shim.Success([]byte("OK"))
+}
+
+// AcceptTransfer accepts a proposed transfer, signed by the new owner
+func (x *xdata) AcceptTransfer(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting AcceptTransferOptions")
+	}
+	var opt blockchain.AcceptTransferOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal AcceptTransferOptions").Error())
+	}
+	t, err := x.getTransfer(stub, opt.ID)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	// verify sig
+	m := fmt.Sprintf("%s,%d", opt.ID, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, t.NewOwner, []byte(m)); err != nil {
+		return shim.Error(err.Error())
+	}
+	if t.Status != blockchain.TransferProposed {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "transfer is %s, not proposed", t.Status).Error())
+	}
+	if resp := x.getValue(stub, []string{packFileNsIndex(t.NewOwner, t.NewNamespace)}); len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound,
+			"new namespace not found: %s", resp.Message).Error())
+	}
+	t.Status = blockchain.TransferAccepted
+	t.AcceptTime = opt.CurrentTime
+	if err := x.putTransfer(stub, t); err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success([]byte("OK"))
+}
+
+// CancelTransfer cancels a transfer not completed yet, signed by the owner or the new owner
+func (x *xdata) CancelTransfer(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting CancelTransferOptions")
+	}
+	var opt blockchain.CancelTransferOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal CancelTransferOptions").Error())
+	}
+	t, err := x.getTransfer(stub, opt.ID)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if !bytes.Equal(opt.User, t.Owner) && !bytes.Equal(opt.User, t.NewOwner) {
+		return shim.Error(errorx.New(errorx.ErrCodeNotAuthorized,
+			"only the owner or the new owner could cancel transfer").Error())
+	}
+	// verify sig, the status is signed so that a signature of accepting could not cancel the transfer
+	m := fmt.Sprintf("%s,%s,%d", blockchain.TransferCanceled, opt.ID, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.User, []byte(m)); err != nil {
+		return shim.Error(err.Error())
+	}
+	if t.Status != blockchain.TransferProposed && t.Status != blockchain.TransferAccepted {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "transfer is %s, could not be canceled", t.Status).Error())
+	}
+	t.Status = blockchain.TransferCanceled
+	t.CancelTime = opt.CurrentTime
+	if err := x.putTransfer(stub, t); err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success([]byte("OK"))
+}
+
+// CompleteTransfer records that a file of the old owner has been replaced by a file of the new owner,
+//  and expires the old file. An empty file id finishes a namespace transfer
+func (x *xdata) CompleteTransfer(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting CompleteTransferOptions")
+	}
+	var opt blockchain.CompleteTransferOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal CompleteTransferOptions").Error())
+	}
+	t, err := x.getTransfer(stub, opt.ID)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	// verify sig
+	m := fmt.Sprintf("%s,%s,%s,%x,%d", opt.ID, opt.FileID, opt.NewFileID, opt.ContentHash, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, t.NewOwner, []byte(m)); err != nil {
+		return shim.Error(err.Error())
+	}
+	if t.Status != blockchain.TransferAccepted {
+		return shim.Error(errorx.New(errorx.ErrCodeParam, "transfer is %s, not accepted", t.Status).Error())
+	}
+
+	if len(opt.FileID) == 0 {
+		if len(t.FileID) > 0 {
+			return shim.Error(errorx.New(errorx.ErrCodeParam,
+				"bad param:file id is required by file transfer").Error())
+		}
+		// a namespace transfer completes only after every live file of the namespace is replaced
+		n, err := x.countUntransferred(stub, t, opt.CurrentTime)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		if n > 0 {
+			return shim.Error(errorx.New(errorx.ErrCodeParam,
+				"%d files of namespace not transferred yet", n).Error())
+		}
+	} else {
+		if _, ok := t.Files[opt.FileID]; ok {
+			return shim.Error(errorx.New(errorx.ErrCodeAlreadyExists, "file already transferred").Error())
+		}
+		of, err := x.getFileById(stub, opt.FileID)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		nf, err := x.getFileById(stub, opt.NewFileID)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		if !t.Covers(of) || of.ExpireTime <= opt.CurrentTime {
+			return shim.Error(errorx.New(errorx.ErrCodeParam,
+				"file not included in transfer or already expired").Error())
+		}
+		if !bytes.Equal(nf.Owner, t.NewOwner) || nf.Namespace != t.NewNamespace ||
+			nf.Name != of.Name || nf.Length != of.Length || nf.ExpireTime <= opt.CurrentTime {
+			return shim.Error(errorx.New(errorx.ErrCodeParam,
+				"new file does not match the transferred file").Error())
+		}
+		// the old owner signs a receipt over hash and length of the plaintext it handed over, so only files
+		//  handed over by the old owner could be completed. Content of the new file is not bound to the hash
+		//  on chain, the new owner checks the plaintext against the hash before re-uploading it
+		rm := fmt.Sprintf("%s,%s,%x,%d", t.ID, of.ID, opt.ContentHash, of.Length)
+		if len(opt.ContentHash) == 0 || x.checkSign(opt.Receipt, t.Owner, []byte(rm)) != nil {
+			return shim.Error(errorx.New(errorx.ErrCodeParam, "bad param:receipt of old owner").Error())
+		}
+		// expire the old file, slices of it are cleaned by storage nodes after retain period
+		of.ExpireTime = opt.CurrentTime
+		s, err := json.Marshal(of)
+		if err != nil {
+			return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal File").Error())
+		}
+		if resp := x.setValue(stub, []string{of.ID, string(s)}); resp.Status == shim.ERROR {
+			return shim.Error(errorx.New(errorx.ErrCodeWriteBlockchain,
+				"failed to set id-file on chain: %s", resp.Message).Error())
+		}
+		if err := x.removeFileTagIndex(stub, of); err != nil {
+			return shim.Error(err.Error())
+		}
+		if t.Files == nil {
+			t.Files = make(map[string]string)
+		}
+		t.Files[opt.FileID] = opt.NewFileID
+	}
+	if len(opt.FileID) == 0 || len(t.FileID) > 0 {
+		t.Status = blockchain.TransferCompleted
+		t.CompleteTime = opt.CurrentTime
+	}
+	if err := x.putTransfer(stub, t); err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success([]byte("OK"))
+}
+
+// GetTransferByID gets a transfer by id
+//  args = {id}
+func (x *xdata) GetTransferByID(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting id")
+	}
+	resp := x.getValue(stub, []string{packTransferIndex(args[0])})
+	if len(resp.Payload) == 0 {
+		return shim.Error(errorx.New(errorx.ErrCodeNotFound, "transfer not found: %s", resp.Message).Error())
+	}
+	return shim.Success(resp.Payload)
+}
+
+// ListTransfers lists transfers proposed by or to a user, latest first
+func (x *xdata) ListTransfers(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return shim.Error("invalid arguments. expecting ListTransferOptions")
+	}
+	var opt blockchain.ListTransferOptions
+	if err := json.Unmarshal([]byte(args[0]), &opt); err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal ListTransferOptions").Error())
+	}
+	prefix, attr := packTransferListFilter(opt.User)
+	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	defer iterator.Close()
+
+	var ts []blockchain.Transfer
+	for iterator.HasNext() {
+		if opt.Limit > 0 && uint64(len(ts)) >= opt.Limit {
+			break
+		}
+		queryResponse, err := iterator.Next()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		t, err := x.getTransfer(stub, string(queryResponse.Value))
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		ts = append(ts, t)
+	}
+	s, err := json.Marshal(ts)
+	if err != nil {
+		return shim.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal transfers").Error())
+	}
+	return shim.Success(s)
+}
+
+// countUntransferred counts files of the old owner in namespace of transfer, not expired and not replaced yet
+func (x *xdata) countUntransferred(stub shim.ChaincodeStubInterface, t blockchain.Transfer, currentTime int64) (int, error) {
+	prefix, attr := packFileNameFilter(t.Owner, t.Namespace)
+	iterator, err := stub.GetStateByPartialCompositeKey(prefix, attr)
+	if err != nil {
+		return 0, err
+	}
+	defer iterator.Close()
+
+	n := 0
+	for iterator.HasNext() {
+		queryResponse, err := iterator.Next()
+		if err != nil {
+			return 0, err
+		}
+		f, err := x.getFileById(stub, string(queryResponse.Value))
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := t.Files[f.ID]; ok || f.ExpireTime <= currentTime {
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (x *xdata) getTransfer(stub shim.ChaincodeStubInterface, id string) (t blockchain.Transfer, err error) {
+	resp := x.getValue(stub, []string{packTransferIndex(id)})
+	if len(resp.Payload) == 0 {
+		return t, errorx.New(errorx.ErrCodeNotFound, "transfer[%s] not found", id)
+	}
+	if err = json.Unmarshal(resp.Payload, &t); err != nil {
+		return t, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal Transfer")
+	}
+	return t, nil
+}
+
+func (x *xdata) putTransfer(stub shim.ChaincodeStubInterface, t blockchain.Transfer) error {
+	s, err := json.Marshal(t)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal Transfer")
+	}
+	if resp := x.setValue(stub, []string{packTransferIndex(t.ID), string(s)}); resp.Status == shim.ERROR {
+		return errorx.New(errorx.ErrCodeWriteBlockchain, "failed to set index-transfer on chain: %s", resp.Message)
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+// invoke runs a chaincode method in a new transaction
+func invoke(stub *shim.MockStub, fn string, args ...interface{}) pb.Response {
+	bs := [][]byte{[]byte(fn)}
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case string:
+			bs = append(bs, []byte(v))
+		default:
+			s, _ := json.Marshal(v)
+			bs = append(bs, s)
+		}
+	}
+	return stub.MockInvoke(strconv.FormatInt(time.Now().UnixNano(), 10), bs)
+}
+
+// requireErrCode checks that a chaincode invocation failed with code
+func requireErrCode(t *testing.T, resp pb.Response, code string) {
+	require.Equal(t, int32(shim.ERROR), resp.Status)
+	c, _, _ := errorx.TryParseFromString(resp.Message)
+	require.Equal(t, code, c, resp.Message)
+}
+
+// addTestNs adds a namespace owned by privkey
+func addTestNs(t *testing.T, stub *shim.MockStub, privkey ecdsa.PrivateKey, name string, ctime int64) {
+	pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+	ns := blockchain.Namespace{Name: name, Owner: pubkey[:], Replica: 1, CreateTime: ctime, UpdateTime: ctime}
+	s, err := json.Marshal(ns)
+	require.NoError(t, err)
+	sig, err := ecdsa.Sign(privkey, hash.Hash(s))
+	require.NoError(t, err)
+	resp := invoke(stub, "AddFileNs", blockchain.AddNsOptions{Namespace: ns, Signature: sig[:]})
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+}
+
+// publishTestFile publishes a file of length and name into namespace owned by privkey
+func publishTestFile(t *testing.T, stub *shim.MockStub, privkey ecdsa.PrivateKey, ns, id, name string,
+	length uint64, ptime int64) {
+	publishTaggedFile(t, stub, privkey, ns, id, name, nil, length, ptime)
+}
+
+// publishTaggedFile publishes a file of length, name and tags into namespace owned by privkey
+func publishTaggedFile(t *testing.T, stub *shim.MockStub, privkey ecdsa.PrivateKey, ns, id, name string,
+	tags map[string]string, length uint64, ptime int64) {
+	pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+	file := blockchain.File{
+		ID:          id,
+		Name:        name,
+		Namespace:   ns,
+		Owner:       pubkey[:],
+		Length:      length,
+		Slices:      []blockchain.PublicSliceMeta{{ID: id + "-s1", NodeID: []byte("node1"), SliceIdx: 1}},
+		PublishTime: ptime,
+		ExpireTime:  ptime + time.Hour.Nanoseconds(),
+		Tags:        tags,
+	}
+	s, err := json.Marshal(file)
+	require.NoError(t, err)
+	sig, err := ecdsa.Sign(privkey, hash.Hash(s))
+	require.NoError(t, err)
+	resp := invoke(stub, "PublishFile", blockchain.PublishFileOptions{File: file, Signature: sig[:]})
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+}
+
+func TestCompleteTransfer(t *testing.T) {
+	stub := shim.NewMockStub("xdata", new(xdata))
+	ownerPriv, ownerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	newPriv, newPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	now := time.Now().UnixNano()
+	addTestNs(t, stub, ownerPriv, "ns", now)
+	addTestNs(t, stub, newPriv, "newns", now)
+	publishTaggedFile(t, stub, ownerPriv, "ns", "file1", "file1", map[string]string{"label": "y"}, 100, now+1)
+	publishTestFile(t, stub, newPriv, "newns", "file2", "file1", 100, now+2)
+
+	m := fmt.Sprintf("%s,%s,%s,%x,%s,%d", "t1", "ns", "file1", newPub[:], "newns", now+3)
+	sig, err := ecdsa.Sign(ownerPriv, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	resp := invoke(stub, "ProposeTransfer", blockchain.ProposeTransferOptions{ID: "t1", Owner: ownerPub[:],
+		Namespace: "ns", FileID: "file1", NewOwner: newPub[:], NewNamespace: "newns", CurrentTime: now + 3,
+		Signature: sig[:]})
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	m = fmt.Sprintf("%s,%d", "t1", now+4)
+	sig, err = ecdsa.Sign(newPriv, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	resp = invoke(stub, "AcceptTransfer", blockchain.AcceptTransferOptions{ID: "t1", CurrentTime: now + 4,
+		Signature: sig[:]})
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+
+	contentHash := hash.Hash([]byte("content of file1"))
+	newReceipt := func(signer ecdsa.PrivateKey, h []byte) []byte {
+		m := fmt.Sprintf("%s,%s,%x,%d", "t1", "file1", h, 100)
+		sig, err := ecdsa.Sign(signer, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		return sig[:]
+	}
+	newOpt := func(h, receipt []byte, ctime int64) blockchain.CompleteTransferOptions {
+		m := fmt.Sprintf("%s,%s,%s,%x,%d", "t1", "file1", "file2", h, ctime)
+		sig, err := ecdsa.Sign(newPriv, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		return blockchain.CompleteTransferOptions{ID: "t1", FileID: "file1", NewFileID: "file2",
+			ContentHash: h, Receipt: receipt, CurrentTime: ctime, Signature: sig[:]}
+	}
+
+	// a receipt of the old owner over the content is required
+	requireErrCode(t, invoke(stub, "CompleteTransfer", newOpt(nil, nil, now+5)), errorx.ErrCodeParam)
+	requireErrCode(t, invoke(stub, "CompleteTransfer", newOpt(contentHash, newReceipt(newPriv, contentHash), now+5)),
+		errorx.ErrCodeParam)
+	otherHash := hash.Hash([]byte("something else"))
+	requireErrCode(t, invoke(stub, "CompleteTransfer", newOpt(otherHash, newReceipt(ownerPriv, contentHash), now+5)),
+		errorx.ErrCodeParam)
+	resp = invoke(stub, "GetFileByID", "file1", strconv.FormatInt(now+5, 10))
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+
+	resp = invoke(stub, "CompleteTransfer", newOpt(contentHash, newReceipt(ownerPriv, contentHash), now+5))
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	resp = invoke(stub, "GetTransferByID", "t1")
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	var tr blockchain.Transfer
+	require.NoError(t, json.Unmarshal(resp.Payload, &tr))
+	require.Equal(t, blockchain.TransferCompleted, tr.Status)
+	require.Equal(t, "file2", tr.Files["file1"])
+	requireErrCode(t, invoke(stub, "GetFileByID", "file1", strconv.FormatInt(now+6, 10)), errorx.ErrCodeExpired)
+	require.Equal(t, 0, countTagIndex(t, stub))
+}
+
+func TestNamespaceTransfer(t *testing.T) {
+	stub := shim.NewMockStub("xdata", new(xdata))
+	ownerPriv, ownerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	newPriv, newPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	now := time.Now().UnixNano()
+	addTestNs(t, stub, ownerPriv, "ns", now)
+	addTestNs(t, stub, newPriv, "newns", now)
+	publishTestFile(t, stub, ownerPriv, "ns", "file1", "file1", 100, now+1)
+	publishTestFile(t, stub, ownerPriv, "ns", "file3", "file3", 100, now+1)
+	publishTestFile(t, stub, newPriv, "newns", "file2", "file1", 100, now+2)
+	publishTestFile(t, stub, newPriv, "newns", "file4", "file3", 100, now+2)
+
+	m := fmt.Sprintf("%s,%s,%s,%x,%s,%d", "t1", "ns", "", newPub[:], "newns", now+3)
+	sig, err := ecdsa.Sign(ownerPriv, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	resp := invoke(stub, "ProposeTransfer", blockchain.ProposeTransferOptions{ID: "t1", Owner: ownerPub[:],
+		Namespace: "ns", NewOwner: newPub[:], NewNamespace: "newns", CurrentTime: now + 3, Signature: sig[:]})
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	m = fmt.Sprintf("%s,%d", "t1", now+4)
+	sig, err = ecdsa.Sign(newPriv, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	resp = invoke(stub, "AcceptTransfer", blockchain.AcceptTransferOptions{ID: "t1", CurrentTime: now + 4,
+		Signature: sig[:]})
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+
+	contentHash := hash.Hash([]byte("content"))
+	newOpt := func(fileID, newFileID string, ctime int64) blockchain.CompleteTransferOptions {
+		var h, receipt []byte
+		if len(fileID) > 0 {
+			h = contentHash
+			rm := fmt.Sprintf("%s,%s,%x,%d", "t1", fileID, h, 100)
+			rs, err := ecdsa.Sign(ownerPriv, hash.Hash([]byte(rm)))
+			require.NoError(t, err)
+			receipt = rs[:]
+		}
+		m := fmt.Sprintf("%s,%s,%s,%x,%d", "t1", fileID, newFileID, h, ctime)
+		sig, err := ecdsa.Sign(newPriv, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		return blockchain.CompleteTransferOptions{ID: "t1", FileID: fileID, NewFileID: newFileID,
+			ContentHash: h, Receipt: receipt, CurrentTime: ctime, Signature: sig[:]}
+	}
+
+	// the namespace transfer could not finish until all files of the namespace are replaced
+	resp = invoke(stub, "CompleteTransfer", newOpt("file1", "file2", now+5))
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	requireErrCode(t, invoke(stub, "CompleteTransfer", newOpt("", "", now+6)), errorx.ErrCodeParam)
+	resp = invoke(stub, "CompleteTransfer", newOpt("file3", "file4", now+6))
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	resp = invoke(stub, "CompleteTransfer", newOpt("", "", now+7))
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+
+	resp = invoke(stub, "GetTransferByID", "t1")
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	var tr blockchain.Transfer
+	require.NoError(t, json.Unmarshal(resp.Payload, &tr))
+	require.Equal(t, blockchain.TransferCompleted, tr.Status)
+	require.Equal(t, map[string]string{"file1": "file2", "file3": "file4"}, tr.Files)
+}
+
+func TestCancelTransfer(t *testing.T) {
+	stub := shim.NewMockStub("xdata", new(xdata))
+	ownerPriv, ownerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	newPriv, newPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	otherPriv, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	now := time.Now().UnixNano()
+	addTestNs(t, stub, ownerPriv, "ns", now)
+	addTestNs(t, stub, newPriv, "newns", now)
+
+	m := fmt.Sprintf("%s,%s,%s,%x,%s,%d", "t1", "ns", "", newPub[:], "newns", now+1)
+	sig, err := ecdsa.Sign(ownerPriv, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	resp := invoke(stub, "ProposeTransfer", blockchain.ProposeTransferOptions{ID: "t1", Owner: ownerPub[:],
+		Namespace: "ns", NewOwner: newPub[:], NewNamespace: "newns", CurrentTime: now + 1, Signature: sig[:]})
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	m = fmt.Sprintf("%s,%d", "t1", now+2)
+	sig, err = ecdsa.Sign(newPriv, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	accept := blockchain.AcceptTransferOptions{ID: "t1", CurrentTime: now + 2, Signature: sig[:]}
+	resp = invoke(stub, "AcceptTransfer", accept)
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+
+	newOpt := func(signer ecdsa.PrivateKey, ctime int64) blockchain.CancelTransferOptions {
+		m := fmt.Sprintf("%s,%s,%d", blockchain.TransferCanceled, "t1", ctime)
+		sig, err := ecdsa.Sign(signer, hash.Hash([]byte(m)))
+		require.NoError(t, err)
+		user := ecdsa.PublicKeyFromPrivateKey(signer)
+		return blockchain.CancelTransferOptions{ID: "t1", User: user[:], CurrentTime: ctime, Signature: sig[:]}
+	}
+
+	// the signature of accepting could not cancel the transfer, nor could others
+	requireErrCode(t, invoke(stub, "CancelTransfer", blockchain.CancelTransferOptions{ID: "t1", User: newPub[:],
+		CurrentTime: now + 2, Signature: accept.Signature}), errorx.ErrCodeBadSignature)
+	requireErrCode(t, invoke(stub, "CancelTransfer", newOpt(otherPriv, now+3)), errorx.ErrCodeNotAuthorized)
+
+	resp = invoke(stub, "CancelTransfer", newOpt(ownerPriv, now+3))
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	resp = invoke(stub, "GetTransferByID", "t1")
+	require.Equal(t, int32(shim.OK), resp.Status, resp.Message)
+	var tr blockchain.Transfer
+	require.NoError(t, json.Unmarshal(resp.Payload, &tr))
+	require.Equal(t, blockchain.TransferCanceled, tr.Status)
+	require.Equal(t, now+3, tr.CancelTime)
+	requireErrCode(t, invoke(stub, "CancelTransfer", newOpt(newPriv, now+4)), errorx.ErrCodeParam)
+}
@@ -34,6 +34,8 @@ type Config struct {
 	ChaincodeID string // ChaincodeID indicates name of ChainCode installed on Channel
 	UserName    string // UserName is name of user used to request fabric network
 	OrgName     string // OrgName indicates the organization User belongs to, with which to request fabric network
+
+	DaiChaincodeID string // DaiChaincodeID indicates name of dai ChainCode installed on Channel, optional
 }
 
 type Fabric struct {
@@ -73,6 +75,8 @@ func New(conf *conf.FabricConf) (*Fabric, error) {
 		ChaincodeID: conf.Chaincode,
 		UserName:    conf.UserName,
 		OrgName:     conf.OrgName,
+
+		DaiChaincodeID: conf.DaiChaincode,
 	}
 	fabricDriver := &Fabric{Config: fabricConfig}
 
@@ -130,8 +134,13 @@ func (f *Fabric) InvokeContract(args [][]byte, mName string) ([]byte, error) {
 
 // QueryContract queries the contract
 func (f *Fabric) QueryContract(args [][]byte, mName string) ([]byte, error) {
+	return f.queryContract(f.Config.ChaincodeID, args, mName)
+}
+
+// queryContract queries the chaincode named ccID on the channel
+func (f *Fabric) queryContract(ccID string, args [][]byte, mName string) ([]byte, error) {
 	channelReq := channel.Request{
-		ChaincodeID: f.Config.ChaincodeID,
+		ChaincodeID: ccID,
 		Fcn:         mName,
 		Args:        args,
 	}
@@ -132,6 +132,32 @@ func (f *Fabric) UpdateNsReplica(ctx context.Context, opt *blockchain.UpdateNsRe
 	return nil
 }
 
+// UpdateNsQuota updates file namespace quota
+func (f *Fabric) UpdateNsQuota(ctx context.Context, opt *blockchain.UpdateNsQuotaOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal UpdateNsQuotaOptions")
+	}
+
+	if _, err := f.InvokeContract([][]byte{s}, "UpdateNsQuota"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateNsRenewal updates renewal policy of file namespace
+func (f *Fabric) UpdateNsRenewal(ctx context.Context, opt *blockchain.UpdateNsRenewalOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal UpdateNsRenewalOptions")
+	}
+
+	if _, err := f.InvokeContract([][]byte{s}, "UpdateNsRenewal"); err != nil {
+		return err
+	}
+	return nil
+}
+
 // UpdateFilePublicSliceMeta is used to update file public slice metas
 func (f *Fabric) UpdateFilePublicSliceMeta(ctx context.Context, opt *blockchain.UpdateFilePSMOptions) error {
 	s, err := json.Marshal(*opt)
@@ -146,6 +172,48 @@ func (f *Fabric) UpdateFilePublicSliceMeta(ctx context.Context, opt *blockchain.
 	return nil
 }
 
+// AppendFile appends slices of data to a file
+func (f *Fabric) AppendFile(ctx context.Context, opt *blockchain.AppendFileOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal AppendFileOptions")
+	}
+
+	if _, err := f.InvokeContract([][]byte{s}, "AppendFile"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReportCorruptedSlice is used by storage node to report a corrupted slice it holds
+func (f *Fabric) ReportCorruptedSlice(ctx context.Context, opt *blockchain.ReportCorruptedSliceOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal ReportCorruptedSliceOptions")
+	}
+
+	if _, err := f.InvokeContract([][]byte{s}, "ReportCorruptedSlice"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListCorruptedSlices lists corrupted slices of files owned by owner
+func (f *Fabric) ListCorruptedSlices(ctx context.Context, owner []byte) ([]blockchain.CorruptedSlice, error) {
+	var csl []blockchain.CorruptedSlice
+	resp, err := f.QueryContract([][]byte{owner}, "ListCorruptedSlices")
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(resp, &csl); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal corrupted slices")
+	}
+	return csl, nil
+}
+
 // SliceMigrateRecord is used by node to slice migration record
 func (f *Fabric) SliceMigrateRecord(ctx context.Context, id, sig []byte, fid, sid string, ctime int64) error {
 	args := [][]byte{id, []byte(fid), []byte(sid), sig, []byte(strconv.FormatInt(ctime, 10))}
@@ -156,22 +224,23 @@ func (f *Fabric) SliceMigrateRecord(ctx context.Context, id, sig []byte, fid, si
 }
 
 // ListFileNs lists file namespaces by owner
-func (f *Fabric) ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) ([]blockchain.Namespace, error) {
-	var ns []blockchain.Namespace
+func (f *Fabric) ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) (
+	[]blockchain.Namespace, string, error) {
+	var page blockchain.NamespacePage
 	opts, err := json.Marshal(*opt)
 	if err != nil {
-		return ns, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal ListNsOptions")
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal ListNsOptions")
 	}
 
 	resp, err := f.QueryContract([][]byte{opts}, "ListFileNs")
 	if err != nil {
-		return ns, err
+		return nil, "", err
 	}
-	if err = json.Unmarshal(resp, &ns); err != nil {
-		return ns, errorx.NewCode(err, errorx.ErrCodeInternal,
-			"failed to unmarshal File")
+	if err = json.Unmarshal(resp, &page); err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal namespaces")
 	}
-	return ns, nil
+	return page.Namespaces, page.Next, nil
 }
 
 // GetNsByName gets namespace by nsName from fabric
@@ -190,46 +259,118 @@ func (f *Fabric) GetNsByName(ctx context.Context, owner []byte, name string) (bl
 	return ns, nil
 }
 
+// AddNsMember adds a member into namespace, or updates role of the member
+func (f *Fabric) AddNsMember(ctx context.Context, opt *blockchain.NsMemberOptions) error {
+	return f.invokeNsMember(opt, "AddNsMember")
+}
+
+// RemoveNsMember removes a member from namespace
+func (f *Fabric) RemoveNsMember(ctx context.Context, opt *blockchain.NsMemberOptions) error {
+	return f.invokeNsMember(opt, "RemoveNsMember")
+}
+
+func (f *Fabric) invokeNsMember(opt *blockchain.NsMemberOptions, name string) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal NsMemberOptions")
+	}
+	if _, err := f.InvokeContract([][]byte{s}, name); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetNsMember gets a member of namespace from fabric
+func (f *Fabric) GetNsMember(ctx context.Context, owner []byte, ns string, member []byte) (blockchain.NsMember, error) {
+	var m blockchain.NsMember
+	s, err := f.QueryContract([][]byte{owner, []byte(ns), member}, "GetNsMember")
+	if err != nil {
+		return m, err
+	}
+	if err = json.Unmarshal(s, &m); err != nil {
+		return m, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal NsMember")
+	}
+	return m, nil
+}
+
+// ListNsMembers lists members of namespace from fabric
+func (f *Fabric) ListNsMembers(ctx context.Context, owner []byte, ns string) ([]blockchain.NsMember, error) {
+	var ms []blockchain.NsMember
+	s, err := f.QueryContract([][]byte{owner, []byte(ns)}, "ListNsMembers")
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(s, &ms); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal namespace members")
+	}
+	return ms, nil
+}
+
 // ListFiles lists files from fabric
 func (f *Fabric) ListFiles(ctx context.Context, opt *blockchain.ListFileOptions) (
-	[]blockchain.File, error) {
-	var fs []blockchain.File
+	[]blockchain.File, string, error) {
+	var page blockchain.FilePage
 
 	opts, err := json.Marshal(*opt)
 	if err != nil {
-		return fs, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal ListFileOptions")
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal ListFileOptions")
 	}
 
 	s, err := f.QueryContract([][]byte{opts}, "ListFiles")
 	if err != nil {
-		return fs, err
+		return nil, "", err
+	}
+	if err = json.Unmarshal(s, &page); err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal Files")
+	}
+
+	return page.Files, page.Next, nil
+}
+
+// QueryFiles queries files by tags, name prefix, size range and expire time range
+func (f *Fabric) QueryFiles(ctx context.Context, opt *blockchain.QueryFileOptions) (
+	[]blockchain.File, string, error) {
+	var page blockchain.FilePage
+
+	opts, err := json.Marshal(*opt)
+	if err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal QueryFileOptions")
+	}
+
+	s, err := f.QueryContract([][]byte{opts}, "QueryFiles")
+	if err != nil {
+		return nil, "", err
 	}
-	if err = json.Unmarshal(s, &fs); err != nil {
-		return fs, errorx.NewCode(err, errorx.ErrCodeInternal,
+	if err = json.Unmarshal(s, &page); err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to unmarshal Files")
 	}
 
-	return fs, nil
+	return page.Files, page.Next, nil
 }
 
 // ListExpiredFiles lists expired but valid files
 func (f *Fabric) ListExpiredFiles(ctx context.Context, opt *blockchain.ListFileOptions) (
-	[]blockchain.File, error) {
-	var fs []blockchain.File
+	[]blockchain.File, string, error) {
+	var page blockchain.FilePage
 
 	opts, err := json.Marshal(*opt)
 	if err != nil {
-		return fs, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal ListFileOptions")
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal ListFileOptions")
 	}
 
 	s, err := f.QueryContract([][]byte{opts}, "ListExpiredFiles")
 	if err != nil {
-		return fs, err
+		return nil, "", err
 	}
-	if err = json.Unmarshal(s, &fs); err != nil {
-		return fs, errorx.NewCode(err, errorx.ErrCodeInternal,
+	if err = json.Unmarshal(s, &page); err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to unmarshal Files")
 	}
 
-	return fs, nil
+	return page.Files, page.Next, nil
 }
@@ -0,0 +1,58 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fabric
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// UpdateHealthPolicy sets a new version of node health policy on fabric
+func (f *Fabric) UpdateHealthPolicy(ctx context.Context, opt *blockchain.UpdateHealthPolicyOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal UpdateHealthPolicyOptions")
+	}
+	if _, err := f.InvokeContract([][]byte{s}, "UpdateHealthPolicy"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetHealthPolicy gets node health policy of a version from fabric, version 0 means the current one,
+//  the default policy is returned if no policy has been set on chain
+func (f *Fabric) GetHealthPolicy(ctx context.Context, version int64) (blockchain.HealthPolicy, error) {
+	var args [][]byte
+	if version > 0 {
+		args = append(args, []byte(strconv.FormatInt(version, 10)))
+	}
+	s, err := f.QueryContract(args, "GetHealthPolicy")
+	if err != nil {
+		if version == 0 && errorx.Is(err, errorx.ErrCodeNotFound) {
+			return blockchain.DefaultHealthPolicy(), nil
+		}
+		return blockchain.HealthPolicy{}, err
+	}
+	var p blockchain.HealthPolicy
+	if err = json.Unmarshal(s, &p); err != nil {
+		return p, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal HealthPolicy")
+	}
+	return p, nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fabric
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/common"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// CommitHeartbeats posts aggregated heartbeats of a node on fabric
+func (f *Fabric) CommitHeartbeats(ctx context.Context, opt *blockchain.CommitHeartbeatsOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal CommitHeartbeatsOptions")
+	}
+	if _, err := f.InvokeContract([][]byte{s}, "CommitHeartbeats"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetHeartbeatCommits gets heartbeat commits of a node in the day of timestamp from fabric
+func (f *Fabric) GetHeartbeatCommits(ctx context.Context, id []byte, timestamp int64) (
+	[]blockchain.HeartbeatCommit, error) {
+	args := [][]byte{id, []byte(strconv.FormatInt(common.TodayBeginning(timestamp), 10))}
+	s, err := f.QueryContract(args, "GetHeartbeatCommits")
+	if err != nil {
+		return nil, err
+	}
+	var commits []blockchain.HeartbeatCommit
+	if err = json.Unmarshal(s, &commits); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal HeartbeatCommits")
+	}
+	return commits, nil
+}
+
+// GetLastHeartbeatCommit gets the last heartbeat commit of a node from fabric
+func (f *Fabric) GetLastHeartbeatCommit(ctx context.Context, id []byte) (blockchain.HeartbeatCommit, error) {
+	var c blockchain.HeartbeatCommit
+	s, err := f.QueryContract([][]byte{id}, "GetLastHeartbeatCommit")
+	if err != nil {
+		return c, err
+	}
+	if err = json.Unmarshal(s, &c); err != nil {
+		return c, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal HeartbeatCommit")
+	}
+	return c, nil
+}
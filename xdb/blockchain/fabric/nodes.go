@@ -94,6 +94,53 @@ func (f *Fabric) NodeOnline(ctx context.Context, opt *blockchain.NodeOperateOpti
 	return f.setNodeOnlineStatus(ctx, opt, true)
 }
 
+// NodeDrain set node status on chain to draining
+func (f *Fabric) NodeDrain(ctx context.Context, opt *blockchain.NodeOperateOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal NodeOperateOptions")
+	}
+	if _, err := f.InvokeContract([][]byte{s}, "NodeDrain"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetNodeSliceNum gets number of slices of unexpired files on the node
+func (f *Fabric) GetNodeSliceNum(ctx context.Context, id []byte, timestamp int64) (int, error) {
+	args := [][]byte{id, []byte(strconv.FormatInt(timestamp, 10))}
+	number, err := f.QueryContract(args, "GetNodeSliceNum")
+	if err != nil {
+		return 0, err
+	}
+	num, err := strconv.Atoi(string(number))
+	if err != nil {
+		return 0, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal slice number")
+	}
+	return num, nil
+}
+
+// ListNodeSlices lists a page of slices on the node which are referenced by files on chain,
+//  along with id and expire time of the file each slice belongs to, and the cursor of the next page
+func (f *Fabric) ListNodeSlices(ctx context.Context, opt *blockchain.ListNodeSliceOptions) (
+	[]blockchain.NodeSlice, string, error) {
+	var page blockchain.NodeSlicePage
+	opts, err := json.Marshal(*opt)
+	if err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal ListNodeSliceOptions")
+	}
+	s, err := f.QueryContract([][]byte{opts}, "ListNodeSlices")
+	if err != nil {
+		return nil, "", err
+	}
+	if err = json.Unmarshal(s, &page); err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal slices")
+	}
+	return page.Slices, page.Next, nil
+}
+
 // Heartbeat updates heartbeat of node
 func (f *Fabric) Heartbeat(ctx context.Context, id, sig []byte, timestamp int64) error {
 	args := [][]byte{id, sig, []byte(strconv.FormatInt(timestamp, 10)), []byte(
@@ -156,55 +203,20 @@ func (f *Fabric) ListNodesExpireSlice(ctx context.Context, opt *blockchain.ListN
 	return strings.Split(arrs, ","), nil
 }
 
-// GetNodeHealth gets node health status
+// GetNodeHealth gets node health status scored by the current health policy
 func (f *Fabric) GetNodeHealth(ctx context.Context, id []byte) (string, error) {
 	now := time.Now().UnixNano()
-	node, err := f.GetNode(ctx, id)
+	policy, err := f.GetHealthPolicy(ctx, 0)
 	if err != nil {
 		return "", err
 	}
-	// get heartbeat start and end time
-	start, end := common.GetHeartBeatStats(now, node.RegTime)
-
-	// get proved challenges ratio
-	numOpt := blockchain.GetChallengeNumOptions{
-		TargetNode: id,
-		TimeStart:  start,
-		TimeEnd:    end,
-	}
-	all, err := f.GetChallengeNum(ctx, &numOpt)
+	node, err := f.GetNode(ctx, id)
 	if err != nil {
 		return "", err
 	}
-	provedRation := blockchain.DefaultChallProvedRate
-	if all != 0 {
-		numOpt.Status = blockchain.ChallengeProved
-		proved, err := f.GetChallengeNum(ctx, &numOpt)
-		if err != nil {
-			return "", err
-		}
-
-		provedRation = float64(proved) / float64(all)
-	}
-	// get heartbeat rate
-	heartBeatMax := common.GetHeartbeatMaxNum(start, end, node.RegTime)
-
-	heartBeatRate := blockchain.DefaultHearBeatRate
-	if heartBeatMax != 0 {
-		hearBeatTotal, err := common.GetHeartBeatTotalNumByTime(ctx, f, id, start, end)
-		if err != nil {
-			return "", err
-		}
-
-		heartBeatRate = float64(hearBeatTotal) / float64(heartBeatMax)
-	}
-	// calculate health index
-	health := blockchain.NodeHealthChallProp*provedRation + blockchain.NodeHealthHeartBeatProp*heartBeatRate
-	if health >= blockchain.NodeHealthBoundGood {
-		return blockchain.NodeHealthGood, nil
-	}
-	if health < blockchain.NodeHealthBoundMedium {
-		return blockchain.NodeHealthBad, nil
+	stats, err := common.GetNodeHealthStats(ctx, f, node, policy, now)
+	if err != nil {
+		return "", err
 	}
-	return blockchain.NodeHealthMedium, nil
+	return policy.Health(policy.Score(stats)), nil
 }
@@ -0,0 +1,85 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fabric
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// ProposeTransfer proposes an ownership transfer on fabric
+func (f *Fabric) ProposeTransfer(ctx context.Context, opt *blockchain.ProposeTransferOptions) error {
+	return f.invokeTransfer(opt, "ProposeTransfer")
+}
+
+// AcceptTransfer accepts an ownership transfer on fabric
+func (f *Fabric) AcceptTransfer(ctx context.Context, opt *blockchain.AcceptTransferOptions) error {
+	return f.invokeTransfer(opt, "AcceptTransfer")
+}
+
+// CancelTransfer cancels an ownership transfer on fabric
+func (f *Fabric) CancelTransfer(ctx context.Context, opt *blockchain.CancelTransferOptions) error {
+	return f.invokeTransfer(opt, "CancelTransfer")
+}
+
+// CompleteTransfer records a transferred file, or finishes a namespace transfer on fabric
+func (f *Fabric) CompleteTransfer(ctx context.Context, opt *blockchain.CompleteTransferOptions) error {
+	return f.invokeTransfer(opt, "CompleteTransfer")
+}
+
+// GetTransferByID gets an ownership transfer from fabric
+func (f *Fabric) GetTransferByID(ctx context.Context, id string) (blockchain.Transfer, error) {
+	var t blockchain.Transfer
+	s, err := f.QueryContract([][]byte{[]byte(id)}, "GetTransferByID")
+	if err != nil {
+		return t, err
+	}
+	if err = json.Unmarshal(s, &t); err != nil {
+		return t, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal Transfer")
+	}
+	return t, nil
+}
+
+// ListTransfers lists ownership transfers proposed by or to a user from fabric
+func (f *Fabric) ListTransfers(ctx context.Context, opt *blockchain.ListTransferOptions) ([]blockchain.Transfer, error) {
+	var ts []blockchain.Transfer
+	opts, err := json.Marshal(*opt)
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal ListTransferOptions")
+	}
+	s, err := f.QueryContract([][]byte{opts}, "ListTransfers")
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(s, &ts); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal transfers")
+	}
+	return ts, nil
+}
+
+func (f *Fabric) invokeTransfer(opt interface{}, fcn string) error {
+	s, err := json.Marshal(opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal options of %s", fcn)
+	}
+	if _, err := f.InvokeContract([][]byte{s}, fcn); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockchain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryFileOptionsMatch(t *testing.T) {
+	f := File{
+		Name:       "sample-credit",
+		Namespace:  "ns1",
+		Length:     100,
+		ExpireTime: 1000,
+		Tags:       map[string]string{"feature_set": "credit", "source": "bank"},
+	}
+	cases := []struct {
+		name  string
+		opt   QueryFileOptions
+		match bool
+	}{
+		{"empty", QueryFileOptions{}, true},
+		{"all", QueryFileOptions{Namespace: "ns1", Tags: map[string]string{"source": "bank"}, NamePrefix: "sample",
+			MinSize: 100, MaxSize: 100, ExpireTimeStart: 1000, ExpireTimeEnd: 1000, CurrentTime: 999}, true},
+		{"expired", QueryFileOptions{CurrentTime: 1000}, false},
+		{"namespace", QueryFileOptions{Namespace: "ns2"}, false},
+		{"name prefix", QueryFileOptions{NamePrefix: "credit"}, false},
+		{"tag value", QueryFileOptions{Tags: map[string]string{"source": "hospital"}}, false},
+		{"tag missing", QueryFileOptions{Tags: map[string]string{"feature_set": "credit", "label": ""}}, false},
+		{"min size", QueryFileOptions{MinSize: 101}, false},
+		{"max size", QueryFileOptions{MaxSize: 99}, false},
+		{"expire start", QueryFileOptions{ExpireTimeStart: 1001}, false},
+		{"expire end", QueryFileOptions{ExpireTimeEnd: 999}, false},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.match, c.opt.Match(f), c.name)
+	}
+}
+
+func TestQueryFileOptionsIndex(t *testing.T) {
+	opt := QueryFileOptions{}
+	key, value := opt.IndexTag()
+	require.Equal(t, "", key)
+	require.Equal(t, "", value)
+
+	// the smallest key is chosen whatever the map order is
+	opt.Tags = map[string]string{"source": "bank", "feature_set": "credit", "label": "y"}
+	for i := 0; i < 10; i++ {
+		key, value = opt.IndexTag()
+		require.Equal(t, "feature_set", key)
+		require.Equal(t, "credit", value)
+	}
+
+	// name prefix narrows the index only under a namespace
+	require.False(t, (&QueryFileOptions{NamePrefix: "sample"}).IndexNamePrefix())
+	require.False(t, (&QueryFileOptions{Namespace: "ns1"}).IndexNamePrefix())
+	require.True(t, (&QueryFileOptions{Namespace: "ns1", NamePrefix: "sample"}).IndexNamePrefix())
+
+	require.Equal(t, uint64(MaxQueryFilesLimit), (&QueryFileOptions{}).PageLimit())
+	require.Equal(t, uint64(MaxQueryFilesLimit), (&QueryFileOptions{Limit: MaxQueryFilesLimit + 1}).PageLimit())
+	require.Equal(t, uint64(10), (&QueryFileOptions{Limit: 10}).PageLimit())
+}
+
+func TestCheckFileTags(t *testing.T) {
+	require.NoError(t, CheckFileTags(nil))
+	require.NoError(t, CheckFileTags(map[string]string{"feature_set": "credit", "label": "", "expr": "a=b"}))
+
+	long := strings.Repeat("a", MaxFileTagLen+1)
+	tooMany := make(map[string]string)
+	for i := 0; i <= MaxFileTagsNum; i++ {
+		tooMany[strings.Repeat("k", i+1)] = "v"
+	}
+	for _, tags := range []map[string]string{
+		tooMany,
+		{"": "v"},
+		{long: "v"},
+		{"k": long},
+		{"a=b": "v"},
+		{"a/b": "v"},
+		{"k": "a/b"},
+		{"k\x00": "v"},
+		{"k": "v\x00"},
+	} {
+		require.Error(t, CheckFileTags(tags), tags)
+	}
+}
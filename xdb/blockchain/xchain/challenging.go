@@ -24,11 +24,11 @@ import (
 
 // ListChallengeRequests lists all challenge requests on blockchain
 func (x *XChain) ListChallengeRequests(ctx context.Context,
-	opt *blockchain.ListChallengeOptions) ([]blockchain.Challenge, error) {
+	opt *blockchain.ListChallengeOptions) ([]blockchain.Challenge, string, error) {
 
 	opts, err := json.Marshal(*opt)
 	if err != nil {
-		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to marshal ListChallengeOptions")
 	}
 	args := map[string]string{
@@ -37,14 +37,14 @@ func (x *XChain) ListChallengeRequests(ctx context.Context,
 	mName := "ListChallengeRequests"
 	s, err := x.QueryContract(args, mName)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	var cs []blockchain.Challenge
-	if err = json.Unmarshal(s, &cs); err != nil {
-		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+	var page blockchain.ChallengePage
+	if err = json.Unmarshal(s, &page); err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to unmarshal Challenges")
 	}
-	return cs, nil
+	return page.Challenges, page.Next, nil
 }
 
 // ChallengeRequest sets a challenge request on chain
@@ -51,15 +51,22 @@ func (x *Xdata) ListChallengeRequests(ctx code.Context) code.Response {
 	// pack prefix
 	prefix := packChallengeFilter(opt.FileOwner, opt.TargetNode)
 
-	// get iter by prefix
-	iter := ctx.NewIterator(code.PrefixRange([]byte(prefix)))
+	// get iter by prefix, continuing from cursor
+	iter, err := newPageIterator(ctx, prefix, opt.Cursor)
+	if err != nil {
+		return code.Error(err)
+	}
 	defer iter.Close()
 
 	var cs []blockchain.Challenge
+	var last []byte
+	var next string
 	for iter.Next() {
 		if opt.Limit > 0 && uint64(len(cs)) >= opt.Limit {
+			next = blockchain.EncodeCursor(last)
 			break
 		}
+		last = iter.Key()
 		index := packChallengeIndex(string(iter.Value()))
 		s, err := ctx.GetObject([]byte(index))
 		if err != nil {
@@ -81,7 +88,7 @@ func (x *Xdata) ListChallengeRequests(ctx code.Context) code.Response {
 		cs = append(cs, c)
 	}
 
-	s, err := json.Marshal(cs)
+	s, err = json.Marshal(blockchain.ChallengePage{Challenges: cs, Next: next})
 	if err != nil {
 		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to marshal Challenges"))
@@ -14,6 +14,7 @@
 package core
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -59,6 +60,9 @@ func (x *Xdata) PublishFile(ctx code.Context) code.Response {
 		return code.Error(errorx.New(errorx.ErrCodeParam,
 			"slices is empty when publishing file"))
 	}
+	if err := blockchain.CheckFileTags(f.Tags); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeParam, "bad param:tags"))
+	}
 	// judge if id exists
 	if _, err := ctx.GetObject([]byte(opt.File.ID)); err == nil {
 		return code.Error(errorx.New(errorx.ErrCodeAlreadyExists, "duplicated fileID"))
@@ -79,6 +83,9 @@ func (x *Xdata) PublishFile(ctx code.Context) code.Response {
 		return code.Error(errorx.New(errorx.ErrCodeParam,
 			"files struct size of ns larger than max, please upload file using new ns"))
 	}
+	if reason := ns.QuotaExceeded(f.Length); reason != "" {
+		return code.Error(errorx.New(errorx.ErrCodeQuotaExceeded, reason))
+	}
 
 	// judge if filenameIndex exists
 	filenameIndex := packFileNameIndex(f.Owner, f.Namespace, f.Name)
@@ -90,6 +97,9 @@ func (x *Xdata) PublishFile(ctx code.Context) code.Response {
 		if fc.ExpireTime+blockchain.FileRetainPeriod.Nanoseconds() > opt.File.PublishTime {
 			return code.Error(errorx.New(errorx.ErrCodeAlreadyExists, "duplicated file name"))
 		}
+		if err := x.removeFileTagIndex(ctx, fc); err != nil {
+			return code.Error(err)
+		}
 	}
 
 	// if there's already a file with the same name in user's storage, overwrite it with the new one directly
@@ -107,10 +117,19 @@ func (x *Xdata) PublishFile(ctx code.Context) code.Response {
 		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set file listIndex-id on chain"))
 	}
 
+	// set fileTagIndex-id on chain
+	for k, v := range f.Tags {
+		if err := ctx.PutObject([]byte(packFileTagIndex(f.Owner, k, v, f)), []byte(f.ID)); err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set tagIndex-id on chain"))
+		}
+	}
+
 	// update file num of fileNsIndex
 	ns.FileTotalNum += 1
 	ns.UpdateTime = f.PublishTime
 	ns.FilesStruSize += len(s)
+	ns.FilesTotalSize += f.Length
+	ns.FileActiveNum += 1
 	nsf, err := json.Marshal(ns)
 	if err != nil {
 		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal File namespace"))
@@ -235,6 +254,269 @@ func (x *Xdata) UpdateNsReplica(ctx code.Context) code.Response {
 	return code.OK([]byte("OK"))
 }
 
+// UpdateNsQuota updates file namespace quota
+func (x *Xdata) UpdateNsQuota(ctx code.Context) code.Response {
+	// get opt
+	o, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	// unmarshal opt
+	var opt blockchain.UpdateNsQuotaOptions
+	if err := json.Unmarshal(o, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal UpdateNsQuotaOptions"))
+	}
+	if opt.Quota.MaxFiles < 0 {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "bad param:quota"))
+	}
+	// verify sig
+	m := fmt.Sprintf("%s,%d,%d,%d,%d", opt.Name, opt.Quota.MaxBytes, opt.Quota.MaxFiles,
+		opt.Quota.MaxFileSize, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.Owner, []byte(m)); err != nil {
+		return code.Error(err)
+	}
+
+	//get file ns
+	fileNsIndex := packFileNsIndex(opt.Owner, opt.Name)
+	nsr, err := ctx.GetObject([]byte(fileNsIndex))
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "file namespace not found"))
+	}
+	var n blockchain.Namespace
+	if err = json.Unmarshal(nsr, &n); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal namespace"))
+	}
+
+	// an older update could not be replayed to loosen the quota
+	if opt.CurrentTime <= n.UpdateTime {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "bad param: currentTime, request has expired"))
+	}
+	// recount usage, namespaces added before quotas are supported have no usage counted
+	if n.FilesStruSize, n.FilesTotalSize, n.FileActiveNum, _, err = x.countNsUsage(ctx, n, opt.CurrentTime); err != nil {
+		return code.Error(err)
+	}
+
+	n.Quota = opt.Quota
+	n.UpdateTime = opt.CurrentTime
+	s, err := json.Marshal(n)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal Namespaces"))
+	}
+	// put index-ns on chain
+	if err := ctx.PutObject([]byte(fileNsIndex), s); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to update nsindex-quota on chain"))
+	}
+	// put listIndex-ns on chain
+	nsListIndex := packFileNsListIndex(n.Owner, n.Name, n.CreateTime)
+	if err := ctx.PutObject([]byte(nsListIndex), s); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to update nsListIndex-quota on chain"))
+	}
+	return code.OK([]byte("OK"))
+}
+
+// UpdateNsRenewal updates renewal policy of file namespace
+func (x *Xdata) UpdateNsRenewal(ctx code.Context) code.Response {
+	// get opt
+	o, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	// unmarshal opt
+	var opt blockchain.UpdateNsRenewalOptions
+	if err := json.Unmarshal(o, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal UpdateNsRenewalOptions"))
+	}
+	if !blockchain.ValidNsRenewal(opt.Renewal) {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "bad param:renewal"))
+	}
+	// verify sig
+	m := fmt.Sprintf("%s,%s,%d,%d,%d", opt.Name, opt.Renewal.Policy, opt.Renewal.Days,
+		opt.Renewal.Until, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.Owner, []byte(m)); err != nil {
+		return code.Error(err)
+	}
+
+	//get file ns
+	fileNsIndex := packFileNsIndex(opt.Owner, opt.Name)
+	nsr, err := ctx.GetObject([]byte(fileNsIndex))
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "file namespace not found"))
+	}
+	var n blockchain.Namespace
+	if err = json.Unmarshal(nsr, &n); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal namespace"))
+	}
+
+	// an older policy could not be replayed after the owner changed it
+	if opt.CurrentTime <= n.UpdateTime {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "bad param: currentTime, request has expired"))
+	}
+
+	n.Renewal = opt.Renewal
+	n.UpdateTime = opt.CurrentTime
+	s, err := json.Marshal(n)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal Namespaces"))
+	}
+	// put index-ns on chain
+	if err := ctx.PutObject([]byte(fileNsIndex), s); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to update nsindex-renewal on chain"))
+	}
+	// put listIndex-ns on chain
+	nsListIndex := packFileNsListIndex(n.Owner, n.Name, n.CreateTime)
+	if err := ctx.PutObject([]byte(nsListIndex), s); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to update nsListIndex-renewal on chain"))
+	}
+	return code.OK([]byte("OK"))
+}
+
+// AddNsMember adds a member into namespace, or updates role of the member
+func (x *Xdata) AddNsMember(ctx code.Context) code.Response {
+	opt, err := x.getNsMemberOptions(ctx, blockchain.NsMemberAdd)
+	if err != nil {
+		return code.Error(err)
+	}
+	if !blockchain.NsRoleAllows(opt.Role, blockchain.NsRoleReader) {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "bad param:role"))
+	}
+	if string(opt.Member) == string(opt.Owner) {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "bad param:member, owner has all permissions"))
+	}
+	// check if namespace exists
+	if _, err := ctx.GetObject([]byte(packFileNsIndex(opt.Owner, opt.Namespace))); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "file namespace not found"))
+	}
+
+	m := blockchain.NsMember{
+		Owner:     opt.Owner,
+		Namespace: opt.Namespace,
+		Member:    opt.Member,
+		Role:      opt.Role,
+		AddTime:   opt.CurrentTime,
+	}
+	s, err := json.Marshal(m)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal NsMember"))
+	}
+	if err := ctx.PutObject([]byte(packNsMemberIndex(opt.Owner, opt.Namespace, opt.Member)), s); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set index-nsmember on chain"))
+	}
+	return code.OK([]byte("OK"))
+}
+
+// RemoveNsMember removes a member from namespace
+func (x *Xdata) RemoveNsMember(ctx code.Context) code.Response {
+	opt, err := x.getNsMemberOptions(ctx, blockchain.NsMemberRemove)
+	if err != nil {
+		return code.Error(err)
+	}
+	index := packNsMemberIndex(opt.Owner, opt.Namespace, opt.Member)
+	if _, err := ctx.GetObject([]byte(index)); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "namespace member not found"))
+	}
+	if err := ctx.DeleteObject([]byte(index)); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to delete index-nsmember on chain"))
+	}
+	return code.OK([]byte("OK"))
+}
+
+// GetNsMember gets a member of namespace
+func (x *Xdata) GetNsMember(ctx code.Context) code.Response {
+	// get owner
+	owner, ok := ctx.Args()["owner"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:owner"))
+	}
+	// get ns
+	ns, ok := ctx.Args()["name"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:name"))
+	}
+	// get member
+	member, ok := ctx.Args()["member"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:member"))
+	}
+	m, err := ctx.GetObject([]byte(packNsMemberIndex(owner, string(ns), member)))
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "namespace member not found"))
+	}
+	return code.OK(m)
+}
+
+// ListNsMembers lists members of namespace
+func (x *Xdata) ListNsMembers(ctx code.Context) code.Response {
+	// get owner
+	owner, ok := ctx.Args()["owner"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:owner"))
+	}
+	// get ns
+	ns, ok := ctx.Args()["name"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:name"))
+	}
+
+	iter := ctx.NewIterator(code.PrefixRange([]byte(packNsMemberFilter(owner, string(ns)))))
+	defer iter.Close()
+
+	var ms []blockchain.NsMember
+	for iter.Next() {
+		var m blockchain.NsMember
+		if err := json.Unmarshal(iter.Value(), &m); err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal NsMember"))
+		}
+		ms = append(ms, m)
+	}
+	s, err := json.Marshal(ms)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal namespace members"))
+	}
+	return code.OK(s)
+}
+
+// getNsMemberOptions gets NsMemberOptions from args and verifies signature of namespace owner on operation op
+func (x *Xdata) getNsMemberOptions(ctx code.Context, op string) (opt blockchain.NsMemberOptions, err error) {
+	o, ok := ctx.Args()["opt"]
+	if !ok {
+		return opt, errorx.New(errorx.ErrCodeParam, "missing param:opt")
+	}
+	if err := json.Unmarshal(o, &opt); err != nil {
+		return opt, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal NsMemberOptions")
+	}
+	m := fmt.Sprintf("%s,%s,%x,%s,%d", op, opt.Namespace, opt.Member, opt.Role, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.Owner, []byte(m)); err != nil {
+		return opt, err
+	}
+	if err := checkAndSetNsMemberTime(ctx, opt); err != nil {
+		return opt, err
+	}
+	return opt, nil
+}
+
+// checkAndSetNsMemberTime rejects options not later than the last update of the member, so that
+//  an old signed options could not be replayed, e.g. to re-grant a role after the member was removed
+func checkAndSetNsMemberTime(ctx code.Context, opt blockchain.NsMemberOptions) error {
+	index := packNsMemberTimeIndex(opt.Owner, opt.Namespace, opt.Member)
+	if t, err := ctx.GetObject([]byte(index)); err == nil {
+		last, err := strconv.ParseInt(string(t), 10, 64)
+		if err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to parse last update time of member")
+		}
+		if opt.CurrentTime <= last {
+			return errorx.New(errorx.ErrCodeParam, "bad param:currentTime, not later than last update of member")
+		}
+	}
+	if err := ctx.PutObject([]byte(index), []byte(strconv.FormatInt(opt.CurrentTime, 10))); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set index-nsmember-time on chain")
+	}
+	return nil
+}
+
 // UpdateFilePublicSliceMeta is used to update file public slice metas
 func (x *Xdata) UpdateFilePublicSliceMeta(ctx code.Context) code.Response {
 	// get opt
@@ -271,6 +553,7 @@ func (x *Xdata) UpdateFilePublicSliceMeta(ctx code.Context) code.Response {
 		return code.Error(errorx.New(errorx.ErrCodeNotAuthorized, "bad param, file owner is wrong"))
 	}
 	// update slices
+	oldSlices := f.Slices
 	f.Slices = opt.Slices
 	nfs, err := json.Marshal(f)
 	if err != nil {
@@ -280,9 +563,151 @@ func (x *Xdata) UpdateFilePublicSliceMeta(ctx code.Context) code.Response {
 	if err := ctx.PutObject([]byte(f.ID), nfs); err != nil {
 		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set id-file on chain"))
 	}
+	if err := x.clearCorruptedSlices(ctx, f); err != nil {
+		return code.Error(err)
+	}
+
+	// set node-sliceID-expireTime on chain for nodes which slices migrated to
+	nodeSice := make(map[string][]string)
+	for _, slice := range f.Slices {
+		nodeSice[string(slice.NodeID)] = append(nodeSice[string(slice.NodeID)], slice.ID)
+	}
+	for nodeId, sliceL := range nodeSice {
+		prefixNodeFileSlice := packNodeSliceIndex(nodeId, f)
+		if err := ctx.PutObject([]byte(prefixNodeFileSlice), []byte(strings.Join(sliceL, ","))); err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set index-id on chain"))
+		}
+	}
+	// remove node-sliceID-expireTime of nodes which all slices migrated away from,
+	//  slices left on them are no longer referenced and collected by slice gc
+	removed := make(map[string]bool)
+	for _, slice := range oldSlices {
+		nodeId := string(slice.NodeID)
+		if _, ok := nodeSice[nodeId]; ok || removed[nodeId] {
+			continue
+		}
+		removed[nodeId] = true
+		if err := ctx.DeleteObject([]byte(packNodeSliceIndex(nodeId, f))); err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to delete index-id on chain"))
+		}
+	}
 	return code.OK([]byte("OK"))
 }
 
+// AppendFile appends slices of data to an unexpired file, file structure, length and merkle root are updated
+func (x *Xdata) AppendFile(ctx code.Context) code.Response {
+	// get opt
+	o, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	// unmarshal opt
+	var opt blockchain.AppendFileOptions
+	if err := json.Unmarshal(o, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal AppendFileOptions"))
+	}
+	if len(opt.Slices) == 0 || opt.Length == 0 {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "slices is empty when appending file"))
+	}
+	// verify sig
+	ss, err := json.Marshal(opt.Slices)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal slices"))
+	}
+	m := fmt.Sprintf("%s,%x,%x,%d,%x,%d", opt.FileID, hash.Hash(ss), hash.Hash(opt.Structure), opt.Length,
+		opt.MerkleRoot, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.Owner, []byte(m)); err != nil {
+		return code.Error(err)
+	}
+
+	// get file from id
+	f, err := x.getFileById(ctx, []byte(opt.FileID))
+	if err != nil {
+		return code.Error(err)
+	}
+	if string(f.Owner) != string(opt.Owner) {
+		return code.Error(errorx.New(errorx.ErrCodeNotAuthorized, "bad param, file owner is wrong"))
+	}
+	if f.ExpireTime <= opt.CurrentTime {
+		return code.Error(errorx.New(errorx.ErrCodeExpired, "file already expired"))
+	}
+	existing := make(map[string]bool, len(f.Slices))
+	for _, slice := range f.Slices {
+		existing[slice.ID] = true
+	}
+	for _, slice := range opt.Slices {
+		if existing[slice.ID] {
+			return code.Error(errorx.New(errorx.ErrCodeAlreadyExists, "duplicated slice %s", slice.ID))
+		}
+	}
+
+	// get file ns
+	fileNsIndex := packFileNsIndex(f.Owner, f.Namespace)
+	nsr, err := ctx.GetObject([]byte(fileNsIndex))
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "file namespace not found"))
+	}
+	var ns blockchain.Namespace
+	if err = json.Unmarshal(nsr, &ns); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal namespace"))
+	}
+	if reason := ns.AppendExceeded(f.Length, opt.Length); reason != "" {
+		return code.Error(errorx.New(errorx.ErrCodeQuotaExceeded, reason))
+	}
+
+	// update file
+	of, err := json.Marshal(f)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal File"))
+	}
+	f.Slices = append(f.Slices, opt.Slices...)
+	f.Structure = opt.Structure
+	f.Length += opt.Length
+	f.MerkleRoot = opt.MerkleRoot
+	nf, err := json.Marshal(f)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal File"))
+	}
+	if (ns.FilesStruSize + len(nf) - len(of)) >= blockchain.ContractMessageMaxSize {
+		return code.Error(errorx.New(errorx.ErrCodeParam,
+			"files struct size of ns larger than max, please upload file using new ns"))
+	}
+	if err := ctx.PutObject([]byte(f.ID), nf); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set id-file on chain"))
+	}
+
+	// update ns
+	ns.UpdateTime = opt.CurrentTime
+	ns.FilesStruSize += len(nf) - len(of)
+	ns.FilesTotalSize += opt.Length
+	nsf, err := json.Marshal(ns)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal File namespace"))
+	}
+	if err := ctx.PutObject([]byte(fileNsIndex), nsf); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to update index-ns on chain"))
+	}
+	nsListIndex := packFileNsListIndex(ns.Owner, ns.Name, ns.CreateTime)
+	if err := ctx.PutObject([]byte(nsListIndex), nsf); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to update listIndex-ns on chain"))
+	}
+
+	// set node-sliceID-expireTime on chain for nodes which appended slices are stored on
+	nodeSice := make(map[string][]string)
+	for _, slice := range f.Slices {
+		nodeSice[string(slice.NodeID)] = append(nodeSice[string(slice.NodeID)], slice.ID)
+	}
+	for nodeId, sliceL := range nodeSice {
+		prefixNodeFileSlice := packNodeSliceIndex(nodeId, f)
+		if err := ctx.PutObject([]byte(prefixNodeFileSlice), []byte(strings.Join(sliceL, ","))); err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set index-id on chain"))
+		}
+	}
+	return code.OK(nf)
+}
+
 // GetFileByName gets file by name from xchain
 func (x *Xdata) GetFileByName(ctx code.Context) code.Response {
 	// get owner
@@ -495,6 +920,92 @@ func (x *Xdata) SliceMigrateRecord(ctx code.Context) code.Response {
 	return code.OK([]byte("ok"))
 }
 
+// ReportCorruptedSlice is called by storage node to report a corrupted slice it holds,
+//  the record is removed once the slice is migrated away from the node
+func (x *Xdata) ReportCorruptedSlice(ctx code.Context) code.Response {
+	// get opt
+	o, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	// unmarshal opt
+	var opt blockchain.ReportCorruptedSliceOptions
+	if err := json.Unmarshal(o, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal ReportCorruptedSliceOptions"))
+	}
+	// check nonce
+	if err := checkAndSetNonce(ctx, opt.NodeID, opt.Nonce); err != nil {
+		return code.Error(err)
+	}
+	// verify sig
+	nodePK, err := hex.DecodeString(string(opt.NodeID))
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to decode nodeID"))
+	}
+	msg := fmt.Sprintf("%s,%s,%s,%d", string(opt.NodeID), opt.FileID, opt.SliceID, opt.Nonce)
+	if err := x.checkSign(opt.Signature, nodePK, []byte(msg)); err != nil {
+		return code.Error(err)
+	}
+
+	// check the slice is stored on the node
+	f, err := x.getFileById(ctx, []byte(opt.FileID))
+	if err != nil {
+		return code.Error(err)
+	}
+	found := false
+	for _, slice := range f.Slices {
+		if slice.ID == opt.SliceID && string(slice.NodeID) == string(opt.NodeID) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return code.Error(errorx.New(errorx.ErrCodeNotFound, "slice not found on node"))
+	}
+
+	cs := blockchain.CorruptedSlice{
+		FileID:     opt.FileID,
+		SliceID:    opt.SliceID,
+		NodeID:     opt.NodeID,
+		ReportTime: opt.Nonce,
+	}
+	b, err := json.Marshal(cs)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal CorruptedSlice"))
+	}
+	if err := ctx.PutObject([]byte(packCorruptedSliceIndex(f.Owner, cs)), b); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to put corrupted slice on xchain"))
+	}
+	return code.OK([]byte("OK"))
+}
+
+// ListCorruptedSlices lists corrupted slices of files owned by owner
+func (x *Xdata) ListCorruptedSlices(ctx code.Context) code.Response {
+	// get owner
+	owner, ok := ctx.Args()["owner"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:owner"))
+	}
+
+	iter := ctx.NewIterator(code.PrefixRange([]byte(packCorruptedSliceFilter(owner, ""))))
+	defer iter.Close()
+
+	var csl []blockchain.CorruptedSlice
+	for iter.Next() {
+		var cs blockchain.CorruptedSlice
+		if err := json.Unmarshal(iter.Value(), &cs); err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal CorruptedSlice"))
+		}
+		csl = append(csl, cs)
+	}
+	s, err := json.Marshal(csl)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal corrupted slices"))
+	}
+	return code.OK(s)
+}
+
 // ListFiles lists files from xchain
 func (x *Xdata) ListFiles(ctx code.Context) code.Response {
 	// get opt
@@ -511,16 +1022,23 @@ func (x *Xdata) ListFiles(ctx code.Context) code.Response {
 	// pack prefix
 	prefix := packFileNameFilter(opt.Owner, opt.Namespace)
 
-	// get iter by prefix
-	iter := ctx.NewIterator(code.PrefixRange([]byte(prefix)))
+	// get iter by prefix, continuing from cursor
+	iter, err := newPageIterator(ctx, prefix, opt.Cursor)
+	if err != nil {
+		return code.Error(err)
+	}
 	defer iter.Close()
 
 	// iterate iter
 	var fs []blockchain.File
+	var last []byte
+	var next string
 	for iter.Next() {
 		if opt.Limit > 0 && uint64(len(fs)) >= opt.Limit {
+			next = blockchain.EncodeCursor(last)
 			break
 		}
+		last = iter.Key()
 		f, err := x.getFileById(ctx, iter.Value())
 		if err != nil {
 			return code.Error(err)
@@ -531,7 +1049,65 @@ func (x *Xdata) ListFiles(ctx code.Context) code.Response {
 		fs = append(fs, f)
 	}
 
-	s, err := json.Marshal(fs)
+	s, err = json.Marshal(blockchain.FilePage{Files: fs, Next: next})
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal Files"))
+	}
+	return code.OK(s)
+}
+
+// QueryFiles queries files by tags, name prefix, size range and expire time range
+//  files are looked up by the tag index if any tags given, or by the file name index
+func (x *Xdata) QueryFiles(ctx code.Context) code.Response {
+	// get opt
+	s, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	// unmarshal opt
+	var opt blockchain.QueryFileOptions
+	if err := json.Unmarshal(s, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal QueryFileOptions"))
+	}
+	// pack prefix, file names are sorted by name instead of publish time when filtered by NamePrefix
+	prefix := packFileNameFilter(opt.Owner, opt.Namespace)
+	if key, value := opt.IndexTag(); len(key) > 0 {
+		prefix = packFileTagFilter(opt.Owner, key, value, opt.Namespace, opt.NamePrefix)
+	} else if opt.IndexNamePrefix() {
+		prefix = packFileNamePrefixFilter(opt.Owner, opt.Namespace, opt.NamePrefix)
+	}
+
+	// get iter by prefix, continuing from cursor
+	iter, err := newPageIterator(ctx, prefix, opt.Cursor)
+	if err != nil {
+		return code.Error(err)
+	}
+	defer iter.Close()
+
+	// iterate iter
+	var fs []blockchain.File
+	var last []byte
+	var next string
+	limit := opt.PageLimit()
+	for iter.Next() {
+		if uint64(len(fs)) >= limit {
+			next = blockchain.EncodeCursor(last)
+			break
+		}
+		last = iter.Key()
+		f, err := x.getFileById(ctx, iter.Value())
+		if err != nil {
+			return code.Error(err)
+		}
+		if !opt.Match(f) {
+			continue
+		}
+		fs = append(fs, f)
+	}
+
+	s, err = json.Marshal(blockchain.FilePage{Files: fs, Next: next})
 	if err != nil {
 		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to marshal Files"))
@@ -555,16 +1131,23 @@ func (x *Xdata) ListExpiredFiles(ctx code.Context) code.Response {
 	// pack prefix
 	prefix := packFileNameFilter(opt.Owner, opt.Namespace)
 
-	// get iter by prefix
-	iter := ctx.NewIterator(code.PrefixRange([]byte(prefix)))
+	// get iter by prefix, continuing from cursor
+	iter, err := newPageIterator(ctx, prefix, opt.Cursor)
+	if err != nil {
+		return code.Error(err)
+	}
 	defer iter.Close()
 
 	// iterate iter
 	var fs []blockchain.File
+	var last []byte
+	var next string
 	for iter.Next() {
 		if opt.Limit > 0 && uint64(len(fs)) >= opt.Limit {
+			next = blockchain.EncodeCursor(last)
 			break
 		}
+		last = iter.Key()
 		f, err := x.getFileById(ctx, iter.Value())
 		if err != nil {
 			return code.Error(err)
@@ -578,7 +1161,7 @@ func (x *Xdata) ListExpiredFiles(ctx code.Context) code.Response {
 		fs = append(fs, f)
 	}
 
-	s, err := json.Marshal(fs)
+	s, err = json.Marshal(blockchain.FilePage{Files: fs, Next: next})
 	if err != nil {
 		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to marshal Files"))
@@ -602,16 +1185,23 @@ func (x *Xdata) ListFileNs(ctx code.Context) code.Response {
 	// pack prefix
 	prefix := packFileNsListFilter(opt.Owner)
 
-	// get iter by prefix
-	iter := ctx.NewIterator(code.PrefixRange([]byte(prefix)))
+	// get iter by prefix, continuing from cursor
+	iter, err := newPageIterator(ctx, prefix, opt.Cursor)
+	if err != nil {
+		return code.Error(err)
+	}
 	defer iter.Close()
 
 	// iterate iter
 	var nss []blockchain.Namespace
+	var last []byte
+	var next string
 	for iter.Next() {
 		if opt.Limit > 0 && uint64(len(nss)) >= opt.Limit {
+			next = blockchain.EncodeCursor(last)
 			break
 		}
+		last = iter.Key()
 		var ns blockchain.Namespace
 		if err := json.Unmarshal(iter.Value(), &ns); err != nil {
 			return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
@@ -623,7 +1213,7 @@ func (x *Xdata) ListFileNs(ctx code.Context) code.Response {
 		nss = append(nss, ns)
 	}
 
-	s, err := json.Marshal(nss)
+	s, err = json.Marshal(blockchain.NamespacePage{Namespaces: nss, Next: next})
 	if err != nil {
 		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to marshal namespace list"))
@@ -677,6 +1267,50 @@ func (x *Xdata) getFileById(ctx code.Context, fileID []byte) (f blockchain.File,
 	return f, nil
 }
 
+// clearCorruptedSlices removes corrupted slice records of the file which are no longer stored on the reporting node
+func (x *Xdata) clearCorruptedSlices(ctx code.Context, f blockchain.File) error {
+	iter := ctx.NewIterator(code.PrefixRange([]byte(packCorruptedSliceFilter(f.Owner, f.ID))))
+	defer iter.Close()
+
+	var staleKeys [][]byte
+	for iter.Next() {
+		var cs blockchain.CorruptedSlice
+		if err := json.Unmarshal(iter.Value(), &cs); err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal CorruptedSlice")
+		}
+		stale := true
+		for _, slice := range f.Slices {
+			if slice.ID == cs.SliceID && string(slice.NodeID) == string(cs.NodeID) {
+				stale = false
+				break
+			}
+		}
+		if stale {
+			staleKeys = append(staleKeys, iter.Key())
+		}
+	}
+	for _, key := range staleKeys {
+		if err := ctx.DeleteObject(key); err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to delete corrupted slice on xchain")
+		}
+	}
+	return nil
+}
+
+// removeFileTagIndex removes tag index entries of the file, entries already removed are skipped
+func (x *Xdata) removeFileTagIndex(ctx code.Context, f blockchain.File) error {
+	for k, v := range f.Tags {
+		index := []byte(packFileTagIndex(f.Owner, k, v, f))
+		if _, err := ctx.GetObject(index); err != nil {
+			continue
+		}
+		if err := ctx.DeleteObject(index); err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to delete tagIndex-id on chain")
+		}
+	}
+	return nil
+}
+
 func (x *Xdata) checkSign(sign, owner, mes []byte) (err error) {
 	// verify sig
 	if len(sign) != ecdsa.SignatureLength {
@@ -699,6 +1333,38 @@ func (x *Xdata) getNsNewStructSize(ctx code.Context, nsr []byte, ctime int64) (s
 			"failed to unmarshal namespace")
 	}
 
+	nsFileStructSize, nsFilesTotalSize, nsFileActiveNum, retired, err := x.countNsUsage(ctx, bns, ctime)
+	if err != nil {
+		return nil, err
+	}
+	if bns.FilesStruSize == nsFileStructSize && bns.FilesTotalSize == nsFilesTotalSize &&
+		bns.FileActiveNum == nsFileActiveNum {
+		return nil, errorx.New(errorx.ErrCodeAlreadyUpdate,
+			"ns-struct-size is already updated, not need to modify again")
+	}
+	// files out of retain period could no longer be renewed, so they are dropped from tag index
+	for _, f := range retired {
+		if err := x.removeFileTagIndex(ctx, f); err != nil {
+			return nil, err
+		}
+	}
+	bns.FilesStruSize = nsFileStructSize
+	bns.FilesTotalSize = nsFilesTotalSize
+	bns.FileActiveNum = nsFileActiveNum
+	bns.UpdateTime = ctime
+
+	s, err = json.Marshal(bns)
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal namespaces")
+	}
+	return s, nil
+}
+
+// countNsUsage counts struct size, total size and number of files in namespace,
+//  files out of retain period are not counted, but returned as retired if they have tags
+func (x *Xdata) countNsUsage(ctx code.Context, bns blockchain.Namespace, ctime int64) (
+	structSize int, totalSize uint64, activeNum int64, retired []blockchain.File, err error) {
 	// pack prefix
 	prefix := packFileNameFilter(bns.Owner, bns.Name)
 
@@ -707,32 +1373,23 @@ func (x *Xdata) getNsNewStructSize(ctx code.Context, nsr []byte, ctime int64) (s
 	defer iter.Close()
 
 	// iterate iter
-	nsFileStructSize := 0
 	for iter.Next() {
 		f, err := x.getFileById(ctx, iter.Value())
 		if err != nil {
-			return nil, err
+			return 0, 0, 0, nil, err
 		}
 		if f.ExpireTime+blockchain.FileRetainPeriod.Nanoseconds() > ctime {
 			fs, err := json.Marshal(f)
 			if err != nil {
-				return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+				return 0, 0, 0, nil, errorx.NewCode(err, errorx.ErrCodeInternal,
 					"failed to marshal file of get ns-struct-size")
 			}
-			nsFileStructSize += len(fs)
+			structSize += len(fs)
+			totalSize += f.Length
+			activeNum++
+		} else if len(f.Tags) > 0 {
+			retired = append(retired, f)
 		}
 	}
-	if bns.FilesStruSize == nsFileStructSize {
-		return nil, errorx.New(errorx.ErrCodeAlreadyUpdate,
-			"ns-struct-size is already updated, not need to modify again")
-	}
-	bns.FilesStruSize = nsFileStructSize
-	bns.UpdateTime = ctime
-
-	s, err = json.Marshal(bns)
-	if err != nil {
-		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
-			"failed to marshal namespaces")
-	}
-	return s, nil
+	return structSize, totalSize, activeNum, retired, nil
 }
@@ -0,0 +1,93 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+
+	"github.com/xuperchain/xuperchain/core/contractsdk/go/code"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// UpdateHealthPolicy sets a new version of node health policy, signed by the network admin
+func (x *Xdata) UpdateHealthPolicy(ctx code.Context) code.Response {
+	s, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	var opt blockchain.UpdateHealthPolicyOptions
+	if err := json.Unmarshal(s, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal UpdateHealthPolicyOptions"))
+	}
+	admin, err := ctx.GetObject([]byte(keyNetworkAdmin))
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotAuthorized, "network admin not configured"))
+	}
+	adminPub, err := hex.DecodeString(string(admin))
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "bad network admin"))
+	}
+	// verify sig
+	p := opt.Policy
+	if err := x.checkSign(opt.Signature, adminPub, []byte(p.Message(opt.CurrentTime))); err != nil {
+		return code.Error(err)
+	}
+	if err := p.Valid(); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeParam, "bad param:policy"))
+	}
+	current := blockchain.DefaultHealthPolicy()
+	if cs, err := ctx.GetObject([]byte(keyHealthPolicy)); err == nil {
+		if err := json.Unmarshal(cs, &current); err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal HealthPolicy"))
+		}
+	}
+	if p.Version != current.Version+1 {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "bad param:version, should be %d", current.Version+1))
+	}
+
+	p.UpdateTime = opt.CurrentTime
+	ps, err := json.Marshal(p)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal HealthPolicy"))
+	}
+	if err := ctx.PutObject([]byte(keyHealthPolicy), ps); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set health policy on chain"))
+	}
+	if err := ctx.PutObject([]byte(packHealthPolicyIndex(p.Version)), ps); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set index-hpolicy on chain"))
+	}
+	return code.OK(ps)
+}
+
+// GetHealthPolicy gets node health policy of a version, or the current one if version is not given
+func (x *Xdata) GetHealthPolicy(ctx code.Context) code.Response {
+	key := keyHealthPolicy
+	if v, ok := ctx.Args()["version"]; ok {
+		version, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeParam, "bad param:version"))
+		}
+		key = packHealthPolicyIndex(version)
+	}
+	s, err := ctx.GetObject([]byte(key))
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "health policy not found"))
+	}
+	return code.OK(s)
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/xuperchain/xuperchain/core/contractsdk/go/code"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// CommitHeartbeats posts aggregated heartbeats of a day instead of each heartbeat, signed by the node,
+//  commits of a node should not overlap, and heartbeats are counted by GetHeartbeatNum as well
+func (x *Xdata) CommitHeartbeats(ctx code.Context) code.Response {
+	s, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	var opt blockchain.CommitHeartbeatsOptions
+	if err := json.Unmarshal(s, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal CommitHeartbeatsOptions"))
+	}
+	c := opt.Commit
+	if c.Count < 1 || c.Start > c.End || c.Count > c.MaxCount() || c.CommitTime < c.End {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "bad param:commit"))
+	}
+	if c.Start < opt.DayBegin || c.End >= opt.DayBegin+int64(24*time.Hour) {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "bad param:heartbeats should be in one day"))
+	}
+	// verify sig
+	nodePK, err := hex.DecodeString(string(c.NodeID))
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeParam, "failed to decode nodeID"))
+	}
+	if err := x.checkSign(opt.Signature, nodePK, []byte(c.Message())); err != nil {
+		return code.Error(err)
+	}
+
+	index := packNodeIndex(c.NodeID)
+	n, err := ctx.GetObject([]byte(index))
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "node not found"))
+	}
+	var node blockchain.Node
+	if err := json.Unmarshal(n, &node); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal node"))
+	}
+	if last, err := ctx.GetObject([]byte(packHeartbeatLastIndex(c.NodeID))); err == nil {
+		var lc blockchain.HeartbeatCommit
+		if err := json.Unmarshal(last, &lc); err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal HeartbeatCommit"))
+		}
+		if c.Start <= lc.End {
+			return code.Error(errorx.New(errorx.ErrCodeAlreadyExists, "heartbeats overlap the last commit"))
+		}
+	}
+
+	commits, err := x.getHeartbeatCommits(ctx, c.NodeID, opt.DayBegin)
+	if err != nil {
+		return code.Error(err)
+	}
+	commits = append(commits, c)
+	cs, err := json.Marshal(commits)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal HeartbeatCommits"))
+	}
+	if err := ctx.PutObject([]byte(packHeartbeatCommitIndex(c.NodeID, opt.DayBegin)), cs); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set index-hbcommit on chain"))
+	}
+	lc, err := json.Marshal(c)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal HeartbeatCommit"))
+	}
+	if err := ctx.PutObject([]byte(packHeartbeatLastIndex(c.NodeID)), lc); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set index-hblast on chain"))
+	}
+
+	// update node heartbeat time
+	if c.End > node.UpdateAt {
+		node.UpdateAt = c.End
+		newn, err := json.Marshal(node)
+		if err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal node"))
+		}
+		if err := ctx.PutObject([]byte(index), newn); err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to put index-Node on xchain"))
+		}
+		if err := ctx.PutObject([]byte(packNodeListIndex(node)), newn); err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain,
+				"failed to put listIndex-Node on xchain"))
+		}
+	}
+	return code.OK(nil)
+}
+
+// GetHeartbeatCommits gets heartbeat commits of a node in the day beginning at currentTime
+func (x *Xdata) GetHeartbeatCommits(ctx code.Context) code.Response {
+	nodeID, ok := ctx.Args()["id"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:id"))
+	}
+	c, ok := ctx.Args()["currentTime"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:currentTime"))
+	}
+	ctime, err := strconv.ParseInt(string(c), 10, 64)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeParam, "failed to parseInt currentTime"))
+	}
+	commits, err := x.getHeartbeatCommits(ctx, nodeID, ctime)
+	if err != nil {
+		return code.Error(err)
+	}
+	s, err := json.Marshal(commits)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal HeartbeatCommits"))
+	}
+	return code.OK(s)
+}
+
+// GetLastHeartbeatCommit gets the last heartbeat commit of a node
+func (x *Xdata) GetLastHeartbeatCommit(ctx code.Context) code.Response {
+	nodeID, ok := ctx.Args()["id"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:id"))
+	}
+	s, err := ctx.GetObject([]byte(packHeartbeatLastIndex(nodeID)))
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "heartbeat commit not found"))
+	}
+	return code.OK(s)
+}
+
+func (x *Xdata) getHeartbeatCommits(ctx code.Context, nodeID []byte, dayBegin int64) (
+	[]blockchain.HeartbeatCommit, error) {
+	var commits []blockchain.HeartbeatCommit
+	s, err := ctx.GetObject([]byte(packHeartbeatCommitIndex(nodeID, dayBegin)))
+	if err != nil {
+		return commits, nil
+	}
+	if err := json.Unmarshal(s, &commits); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal HeartbeatCommits")
+	}
+	return commits, nil
+}
@@ -28,5 +28,12 @@ func (x *Xdata) Initialize(ctx code.Context) code.Response {
 	if err := ctx.PutObject([]byte("creator"), creator); err != nil {
 		return code.Error(err)
 	}
+	// network admin is optional, it's a hex public key allowed to set health policy,
+	//  it is only set here when the contract is deployed, as Initialize is not run again on upgrade
+	if admin, ok := ctx.Args()["admin"]; ok {
+		if err := ctx.PutObject([]byte(keyNetworkAdmin), admin); err != nil {
+			return code.Error(err)
+		}
+	}
 	return code.OK(nil)
 }
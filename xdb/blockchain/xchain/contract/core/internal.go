@@ -19,7 +19,10 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/xuperchain/xuperchain/core/contractsdk/go/code"
+
 	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
 )
 
 const (
@@ -36,6 +39,18 @@ const (
 	prefixNodeSliceMigrateIndex = "index_slicemigrate"
 	prefixNodeFileSlice         = "index_fslice"
 	prefixNodeNonceIndex        = "index_ndnonce"
+	prefixCorruptedSliceIndex   = "index_corrupt"
+	prefixNsMemberIndex         = "index_nsmember"
+	prefixNsMemberTimeIndex     = "index_nsmtime"
+	prefixFileTagIndex          = "index_ftag"
+	prefixTransferIndex         = "index_transfer"
+	prefixTransferListIndex     = "index_transfer_list"
+	prefixHealthPolicyIndex     = "index_hpolicy"
+	prefixHeartbeatCommitIndex  = "index_hbcommit"
+	prefixHeartbeatLastIndex    = "index_hblast"
+
+	keyNetworkAdmin = "network_admin"
+	keyHealthPolicy = "health_policy"
 )
 
 func packNodeIndex(nodeID []byte) string {
@@ -50,6 +65,14 @@ func packNodeHeartBeatIndex(nodeID []byte, ctime int64) string {
 	return fmt.Sprintf("%s/%x/%d", prefixNodeHeartbeatIndex, nodeID, ctime)
 }
 
+func packHeartbeatCommitIndex(nodeID []byte, dayBegin int64) string {
+	return fmt.Sprintf("%s/%x/%d", prefixHeartbeatCommitIndex, nodeID, dayBegin)
+}
+
+func packHeartbeatLastIndex(nodeID []byte) string {
+	return fmt.Sprintf("%s/%x", prefixHeartbeatLastIndex, nodeID)
+}
+
 func packNonceIndex(node []byte, nonce int64) string {
 	return fmt.Sprintf("%s/%x/%d", prefixNodeNonceIndex, node, nonce)
 }
@@ -90,6 +113,18 @@ func getNodeSliceMigrateTime(key []byte) int64 {
 	return subByInt64Max(expireTime)
 }
 
+func packCorruptedSliceIndex(owner []byte, cs blockchain.CorruptedSlice) string {
+	return fmt.Sprintf("%s/%x/%s/%s/%s", prefixCorruptedSliceIndex, owner, cs.FileID, cs.SliceID, cs.NodeID)
+}
+
+func packCorruptedSliceFilter(owner []byte, fileID string) string {
+	filter := fmt.Sprintf("%s/%x/", prefixCorruptedSliceIndex, owner)
+	if len(fileID) > 0 {
+		filter += fmt.Sprintf("%s/", fileID)
+	}
+	return filter
+}
+
 func packFileNameIndex(owner []byte, ns, name string) string {
 	return fmt.Sprintf("%s/%x/%s/%s", prefixFilenameIndex, owner, ns, name)
 }
@@ -110,6 +145,52 @@ func packFileNsListFilter(owner []byte) string {
 	return prefixFileNsListIndex + "/" + fmt.Sprintf("%x/", owner)
 }
 
+func packNsMemberIndex(owner []byte, ns string, member []byte) string {
+	return fmt.Sprintf("%s/%x/%s/%x", prefixNsMemberIndex, owner, ns, member)
+}
+
+func packNsMemberTimeIndex(owner []byte, ns string, member []byte) string {
+	return fmt.Sprintf("%s/%x/%s/%x", prefixNsMemberTimeIndex, owner, ns, member)
+}
+
+func packNsMemberFilter(owner []byte, ns string) string {
+	return fmt.Sprintf("%s/%x/%s/", prefixNsMemberIndex, owner, ns)
+}
+
+func packFileTagIndex(owner []byte, key, value string, f blockchain.File) string {
+	return fmt.Sprintf("%s/%x/%s=%s/%s/%s/%s", prefixFileTagIndex, owner, key, value, f.Namespace, f.Name, f.ID)
+}
+
+// packFileTagFilter packs filter of tag index, namePrefix is used only if ns is given
+func packFileTagFilter(owner []byte, key, value, ns, namePrefix string) string {
+	filter := fmt.Sprintf("%s/%x/%s=%s/", prefixFileTagIndex, owner, key, value)
+	if len(ns) > 0 {
+		filter += fmt.Sprintf("%s/%s", ns, namePrefix)
+	}
+	return filter
+}
+
+func packTransferIndex(id string) string {
+	return fmt.Sprintf("%s/%s", prefixTransferIndex, id)
+}
+
+func packTransferListIndex(user []byte, t blockchain.Transfer) string {
+	return fmt.Sprintf("%s/%x/%d/%s", prefixTransferListIndex, user, subByInt64Max(t.ProposeTime), t.ID)
+}
+
+func packTransferListFilter(user []byte) string {
+	return fmt.Sprintf("%s/%x/", prefixTransferListIndex, user)
+}
+
+func packHealthPolicyIndex(version int64) string {
+	return fmt.Sprintf("%s/%d", prefixHealthPolicyIndex, version)
+}
+
+// packFileNamePrefixFilter packs filter of file name index, which matches latest files of names with namePrefix
+func packFileNamePrefixFilter(owner []byte, ns, namePrefix string) string {
+	return fmt.Sprintf("%s/%x/%s/%s", prefixFilenameIndex, owner, ns, namePrefix)
+}
+
 func packFileNameFilter(owner []byte, ns string) string {
 	filter := prefixFilenameListIndex + "/" + fmt.Sprintf("%x/", owner)
 	if len(ns) > 0 {
@@ -151,3 +232,16 @@ func packChallengeFilter(owner, target []byte) string {
 func subByInt64Max(n int64) int64 {
 	return math.MaxInt64 - n
 }
+
+// newPageIterator returns an iterator over keys under prefix, starting after the key cursor points to
+func newPageIterator(ctx code.Context, prefix, cursor string) (code.Iterator, error) {
+	start, limit := code.PrefixRange([]byte(prefix))
+	if len(cursor) > 0 {
+		key, err := blockchain.DecodeCursor(cursor, []byte(prefix))
+		if err != nil {
+			return nil, errorx.NewCode(err, errorx.ErrCodeParam, "bad param:cursor")
+		}
+		start = append(key, 0)
+	}
+	return ctx.NewIterator(start, limit), nil
+}
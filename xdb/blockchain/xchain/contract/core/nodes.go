@@ -129,8 +129,40 @@ func (x *Xdata) NodeOnline(ctx code.Context) code.Response {
 	return x.setNodeOnlineStatus(ctx, true)
 }
 
+// NodeDrain sets node draining, dataOwners will migrate slices away from a draining node
+//  and no new slices will be placed on it. Node is recorded drained once it holds no live slices,
+//  so calling it again on a draining node checks and records the progress
+func (x *Xdata) NodeDrain(ctx code.Context) code.Response {
+	return x.updateNodeStatus(ctx, func(node *blockchain.Node, opt blockchain.NodeOperateOptions) bool {
+		changed := false
+		if !node.Draining {
+			node.Draining = true
+			node.DrainTime = opt.Nonce
+			changed = true
+		}
+		if node.DrainedTime == 0 && x.countNodeSlices(ctx, opt.NodeID, opt.Nonce) == 0 {
+			node.DrainedTime = opt.Nonce
+			changed = true
+		}
+		return changed
+	})
+}
+
 // setNodeOnlineStatus sets node status
 func (x *Xdata) setNodeOnlineStatus(ctx code.Context, online bool) code.Response {
+	return x.updateNodeStatus(ctx, func(node *blockchain.Node, opt blockchain.NodeOperateOptions) bool {
+		if node.Online == online {
+			return false
+		}
+		node.Online = online
+		return true
+	})
+}
+
+// updateNodeStatus verifies NodeOperateOptions signed by node and updates node with the given function,
+//  node is saved only if the function returns true
+func (x *Xdata) updateNodeStatus(ctx code.Context,
+	update func(node *blockchain.Node, opt blockchain.NodeOperateOptions) bool) code.Response {
 	s, ok := ctx.Args()["opt"]
 	if !ok {
 		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
@@ -167,9 +199,7 @@ func (x *Xdata) setNodeOnlineStatus(ctx code.Context, online bool) code.Response
 			"failed to unmarshal node"))
 	}
 
-	if node.Online != online {
-		// change status
-		node.Online = online
+	if update(&node, opt) {
 		// marshal new node
 		newn, err := json.Marshal(node)
 		if err != nil {
@@ -304,17 +334,30 @@ func (x *Xdata) GetHeartbeatNum(ctx code.Context) code.Response {
 		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to parseInt currentTime"))
 	}
 
+	// heartbeats are sent one by one, or aggregated in commits
+	commits, err := x.getHeartbeatCommits(ctx, nodeID, ctime)
+	if err != nil {
+		return code.Error(err)
+	}
+	num := 0
+	for _, c := range commits {
+		num += c.Count
+	}
+
 	hindex := packNodeHeartBeatIndex(nodeID, ctime)
 	// get node by index
 	n, err := ctx.GetObject([]byte(hindex))
 	var hb []int64
 	if err != nil {
+		if len(commits) > 0 {
+			return code.OK([]byte(strconv.Itoa(num)))
+		}
 		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "heartbeat not found"))
 	}
 	if err := json.Unmarshal(n, &hb); err != nil {
 		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal node"))
 	}
-	return code.OK([]byte(strconv.Itoa(len(hb))))
+	return code.OK([]byte(strconv.Itoa(num + len(hb))))
 }
 
 // ListFiles lists expired files from xchain
@@ -366,6 +409,116 @@ func (x *Xdata) ListNodesExpireSlice(ctx code.Context) code.Response {
 	return code.OK(rs)
 }
 
+// GetNodeSliceNum counts slices of unexpired files on the node
+func (x *Xdata) GetNodeSliceNum(ctx code.Context) code.Response {
+	// get id
+	nodeID, ok := ctx.Args()["id"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:id"))
+	}
+	ctime, err := x.getCtxTime(ctx, "currentTime")
+	if err != nil {
+		return code.Error(err)
+	}
+	return code.OK([]byte(strconv.Itoa(x.countNodeSlices(ctx, nodeID, ctime))))
+}
+
+// countNodeSlices counts slices of files unexpired at ctime on the node
+func (x *Xdata) countNodeSlices(ctx code.Context, nodeID []byte, ctime int64) int {
+	// pack prefix
+	prefix := packNodeSliceFilter(string(nodeID))
+
+	// get iter by prefix
+	iter := ctx.NewIterator(code.PrefixRange([]byte(prefix)))
+	defer iter.Close()
+
+	// index may be stale after slices migrated or expire time updated, so check files
+	num := 0
+	checked := make(map[string]bool)
+	for iter.Next() {
+		fileID, _ := getNodeSliceFileId(iter.Key())
+		if len(fileID) == 0 || checked[fileID] {
+			continue
+		}
+		checked[fileID] = true
+		f, err := x.getFileById(ctx, []byte(fileID))
+		if err != nil || f.ExpireTime <= ctime {
+			continue
+		}
+		for _, slice := range f.Slices {
+			if string(slice.NodeID) == string(nodeID) {
+				num++
+			}
+		}
+	}
+	return num
+}
+
+// ListNodeSlices lists a page of slices stored on the node, which are referenced by files on chain,
+//  a page holds slices of at most opt.PageLimit() files, and slices of a file whose expire time
+//  was updated may be listed again in following pages
+func (x *Xdata) ListNodeSlices(ctx code.Context) code.Response {
+	// get opt
+	s, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	// unmarshal opt
+	var opt blockchain.ListNodeSliceOptions
+	if err := json.Unmarshal(s, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal ListNodeSliceOptions"))
+	}
+	nodeID := string(opt.Target)
+
+	// pack prefix
+	prefix := packNodeSliceFilter(nodeID)
+
+	// get iter by prefix, continuing from cursor
+	iter, err := newPageIterator(ctx, prefix, opt.Cursor)
+	if err != nil {
+		return code.Error(err)
+	}
+	defer iter.Close()
+
+	// index may be stale after slices migrated, so check files
+	var sl []blockchain.NodeSlice
+	var last []byte
+	var next string
+	var num uint64
+	limit := opt.PageLimit()
+	checked := make(map[string]bool)
+	for iter.Next() {
+		if num >= limit {
+			next = blockchain.EncodeCursor(last)
+			break
+		}
+		last = iter.Key()
+		num++
+		fileID, _ := getNodeSliceFileId(iter.Key())
+		if len(fileID) == 0 || checked[fileID] {
+			continue
+		}
+		checked[fileID] = true
+		f, err := x.getFileById(ctx, []byte(fileID))
+		if err != nil {
+			continue
+		}
+		for _, slice := range f.Slices {
+			if string(slice.NodeID) == nodeID {
+				sl = append(sl, blockchain.NodeSlice{FileID: f.ID, ExpireTime: f.ExpireTime,
+					PublicSliceMeta: slice})
+			}
+		}
+	}
+	rs, err := json.Marshal(blockchain.NodeSlicePage{Slices: sl, Next: next})
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal slices"))
+	}
+	return code.OK(rs)
+}
+
 // GetSliceMigrateRecords queries node slice migration records
 func (x *Xdata) GetSliceMigrateRecords(ctx code.Context) code.Response {
 	// get opt
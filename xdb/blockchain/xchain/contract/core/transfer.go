@@ -0,0 +1,330 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/xuperchain/xuperchain/core/contractsdk/go/code"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// ProposeTransfer proposes an ownership transfer of a file or a namespace, signed by the owner
+func (x *Xdata) ProposeTransfer(ctx code.Context) code.Response {
+	s, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	var opt blockchain.ProposeTransferOptions
+	if err := json.Unmarshal(s, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal ProposeTransferOptions"))
+	}
+	if len(opt.ID) == 0 || len(opt.NewNamespace) == 0 || len(opt.NewOwner) == 0 {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "bad param:id, new owner and new namespace are required"))
+	}
+	if bytes.Equal(opt.Owner, opt.NewOwner) {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "bad param:new owner should differ from owner"))
+	}
+	// verify sig
+	m := fmt.Sprintf("%s,%s,%s,%x,%s,%d", opt.ID, opt.Namespace, opt.FileID, opt.NewOwner, opt.NewNamespace, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.Owner, []byte(m)); err != nil {
+		return code.Error(err)
+	}
+	index := packTransferIndex(opt.ID)
+	if _, err := ctx.GetObject([]byte(index)); err == nil {
+		return code.Error(errorx.New(errorx.ErrCodeAlreadyExists, "duplicated transfer id"))
+	}
+	if _, err := ctx.GetObject([]byte(packFileNsIndex(opt.Owner, opt.Namespace))); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "file namespace not found"))
+	}
+	t := blockchain.Transfer{
+		ID:           opt.ID,
+		Owner:        opt.Owner,
+		Namespace:    opt.Namespace,
+		FileID:       opt.FileID,
+		NewOwner:     opt.NewOwner,
+		NewNamespace: opt.NewNamespace,
+		Status:       blockchain.TransferProposed,
+		ProposeTime:  opt.CurrentTime,
+		Files:        make(map[string]string),
+	}
+	if len(opt.FileID) > 0 {
+		f, err := x.getFileById(ctx, []byte(opt.FileID))
+		if err != nil {
+			return code.Error(err)
+		}
+		if !t.Covers(f) || f.ExpireTime <= opt.CurrentTime {
+			return code.Error(errorx.New(errorx.ErrCodeParam, "file not found in namespace or already expired"))
+		}
+	}
+	if err := x.putTransfer(ctx, t); err != nil {
+		return code.Error(err)
+	}
+	for _, user := range [][]byte{t.Owner, t.NewOwner} {
+		if err := ctx.PutObject([]byte(packTransferListIndex(user, t)), []byte(t.ID)); err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set index-transfer-list on chain"))
+		}
+	}
+	return code.OK([]byte("OK"))
+}
+
+// AcceptTransfer accepts a proposed transfer, signed by the new owner
+func (x *Xdata) AcceptTransfer(ctx code.Context) code.Response {
+	s, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	var opt blockchain.AcceptTransferOptions
+	if err := json.Unmarshal(s, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal AcceptTransferOptions"))
+	}
+	t, err := x.getTransfer(ctx, opt.ID)
+	if err != nil {
+		return code.Error(err)
+	}
+	// verify sig
+	m := fmt.Sprintf("%s,%d", opt.ID, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, t.NewOwner, []byte(m)); err != nil {
+		return code.Error(err)
+	}
+	if t.Status != blockchain.TransferProposed {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "transfer is %s, not proposed", t.Status))
+	}
+	if _, err := ctx.GetObject([]byte(packFileNsIndex(t.NewOwner, t.NewNamespace))); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "new namespace not found"))
+	}
+	t.Status = blockchain.TransferAccepted
+	t.AcceptTime = opt.CurrentTime
+	if err := x.putTransfer(ctx, t); err != nil {
+		return code.Error(err)
+	}
+	return code.OK([]byte("OK"))
+}
+
+// CancelTransfer cancels a transfer not completed yet, signed by the owner or the new owner
+func (x *Xdata) CancelTransfer(ctx code.Context) code.Response {
+	s, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	var opt blockchain.CancelTransferOptions
+	if err := json.Unmarshal(s, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal CancelTransferOptions"))
+	}
+	t, err := x.getTransfer(ctx, opt.ID)
+	if err != nil {
+		return code.Error(err)
+	}
+	if !bytes.Equal(opt.User, t.Owner) && !bytes.Equal(opt.User, t.NewOwner) {
+		return code.Error(errorx.New(errorx.ErrCodeNotAuthorized, "only the owner or the new owner could cancel transfer"))
+	}
+	// verify sig, the status is signed so that a signature of accepting could not cancel the transfer
+	m := fmt.Sprintf("%s,%s,%d", blockchain.TransferCanceled, opt.ID, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, opt.User, []byte(m)); err != nil {
+		return code.Error(err)
+	}
+	if t.Status != blockchain.TransferProposed && t.Status != blockchain.TransferAccepted {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "transfer is %s, could not be canceled", t.Status))
+	}
+	t.Status = blockchain.TransferCanceled
+	t.CancelTime = opt.CurrentTime
+	if err := x.putTransfer(ctx, t); err != nil {
+		return code.Error(err)
+	}
+	return code.OK([]byte("OK"))
+}
+
+// CompleteTransfer records that a file of the old owner has been replaced by a file of the new owner,
+//  and expires the old file. An empty file id finishes a namespace transfer
+func (x *Xdata) CompleteTransfer(ctx code.Context) code.Response {
+	s, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	var opt blockchain.CompleteTransferOptions
+	if err := json.Unmarshal(s, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal CompleteTransferOptions"))
+	}
+	t, err := x.getTransfer(ctx, opt.ID)
+	if err != nil {
+		return code.Error(err)
+	}
+	// verify sig
+	m := fmt.Sprintf("%s,%s,%s,%x,%d", opt.ID, opt.FileID, opt.NewFileID, opt.ContentHash, opt.CurrentTime)
+	if err := x.checkSign(opt.Signature, t.NewOwner, []byte(m)); err != nil {
+		return code.Error(err)
+	}
+	if t.Status != blockchain.TransferAccepted {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "transfer is %s, not accepted", t.Status))
+	}
+
+	if len(opt.FileID) == 0 {
+		if len(t.FileID) > 0 {
+			return code.Error(errorx.New(errorx.ErrCodeParam, "bad param:file id is required by file transfer"))
+		}
+		// a namespace transfer completes only after every live file of the namespace is replaced
+		n, err := x.countUntransferred(ctx, t, opt.CurrentTime)
+		if err != nil {
+			return code.Error(err)
+		}
+		if n > 0 {
+			return code.Error(errorx.New(errorx.ErrCodeParam, "%d files of namespace not transferred yet", n))
+		}
+	} else {
+		if _, ok := t.Files[opt.FileID]; ok {
+			return code.Error(errorx.New(errorx.ErrCodeAlreadyExists, "file already transferred"))
+		}
+		of, err := x.getFileById(ctx, []byte(opt.FileID))
+		if err != nil {
+			return code.Error(err)
+		}
+		nf, err := x.getFileById(ctx, []byte(opt.NewFileID))
+		if err != nil {
+			return code.Error(err)
+		}
+		if !t.Covers(of) || of.ExpireTime <= opt.CurrentTime {
+			return code.Error(errorx.New(errorx.ErrCodeParam, "file not included in transfer or already expired"))
+		}
+		if !bytes.Equal(nf.Owner, t.NewOwner) || nf.Namespace != t.NewNamespace ||
+			nf.Name != of.Name || nf.Length != of.Length || nf.ExpireTime <= opt.CurrentTime {
+			return code.Error(errorx.New(errorx.ErrCodeParam, "new file does not match the transferred file"))
+		}
+		// the old owner signs a receipt over hash and length of the plaintext it handed over, so only files
+		//  handed over by the old owner could be completed. Content of the new file is not bound to the hash
+		//  on chain, the new owner checks the plaintext against the hash before re-uploading it
+		rm := fmt.Sprintf("%s,%s,%x,%d", t.ID, of.ID, opt.ContentHash, of.Length)
+		if len(opt.ContentHash) == 0 || x.checkSign(opt.Receipt, t.Owner, []byte(rm)) != nil {
+			return code.Error(errorx.New(errorx.ErrCodeParam, "bad param:receipt of old owner"))
+		}
+		// expire the old file, slices of it are cleaned by storage nodes after retain period
+		of.ExpireTime = opt.CurrentTime
+		s, err := json.Marshal(of)
+		if err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal File"))
+		}
+		if err := ctx.PutObject([]byte(of.ID), s); err != nil {
+			return code.Error(errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set id-file on chain"))
+		}
+		if err := x.removeFileTagIndex(ctx, of); err != nil {
+			return code.Error(err)
+		}
+		if t.Files == nil {
+			t.Files = make(map[string]string)
+		}
+		t.Files[opt.FileID] = opt.NewFileID
+	}
+	if len(opt.FileID) == 0 || len(t.FileID) > 0 {
+		t.Status = blockchain.TransferCompleted
+		t.CompleteTime = opt.CurrentTime
+	}
+	if err := x.putTransfer(ctx, t); err != nil {
+		return code.Error(err)
+	}
+	return code.OK([]byte("OK"))
+}
+
+// GetTransferByID gets a transfer by id
+func (x *Xdata) GetTransferByID(ctx code.Context) code.Response {
+	id, ok := ctx.Args()["id"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:id"))
+	}
+	t, err := ctx.GetObject([]byte(packTransferIndex(string(id))))
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeNotFound, "transfer not found"))
+	}
+	return code.OK(t)
+}
+
+// ListTransfers lists transfers proposed by or to a user, latest first
+func (x *Xdata) ListTransfers(ctx code.Context) code.Response {
+	s, ok := ctx.Args()["opt"]
+	if !ok {
+		return code.Error(errorx.New(errorx.ErrCodeParam, "missing param:opt"))
+	}
+	var opt blockchain.ListTransferOptions
+	if err := json.Unmarshal(s, &opt); err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal ListTransferOptions"))
+	}
+
+	iter := ctx.NewIterator(code.PrefixRange([]byte(packTransferListFilter(opt.User))))
+	defer iter.Close()
+
+	var ts []blockchain.Transfer
+	for iter.Next() {
+		if opt.Limit > 0 && uint64(len(ts)) >= opt.Limit {
+			break
+		}
+		t, err := x.getTransfer(ctx, string(iter.Value()))
+		if err != nil {
+			return code.Error(err)
+		}
+		ts = append(ts, t)
+	}
+	s, err := json.Marshal(ts)
+	if err != nil {
+		return code.Error(errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal transfers"))
+	}
+	return code.OK(s)
+}
+
+// countUntransferred counts files of the old owner in namespace of transfer, not expired and not replaced yet
+func (x *Xdata) countUntransferred(ctx code.Context, t blockchain.Transfer, currentTime int64) (int, error) {
+	iter := ctx.NewIterator(code.PrefixRange([]byte(packFileNameFilter(t.Owner, t.Namespace))))
+	defer iter.Close()
+
+	n := 0
+	for iter.Next() {
+		f, err := x.getFileById(ctx, iter.Value())
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := t.Files[f.ID]; ok || f.ExpireTime <= currentTime {
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (x *Xdata) getTransfer(ctx code.Context, id string) (t blockchain.Transfer, err error) {
+	s, err := ctx.GetObject([]byte(packTransferIndex(id)))
+	if err != nil {
+		return t, errorx.NewCode(err, errorx.ErrCodeNotFound, "the transfer[%s] not found", id)
+	}
+	if err = json.Unmarshal(s, &t); err != nil {
+		return t, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal Transfer")
+	}
+	return t, nil
+}
+
+func (x *Xdata) putTransfer(ctx code.Context, t blockchain.Transfer) error {
+	s, err := json.Marshal(t)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal Transfer")
+	}
+	if err := ctx.PutObject([]byte(packTransferIndex(t.ID)), s); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeWriteBlockchain, "failed to set index-transfer on chain")
+	}
+	return nil
+}
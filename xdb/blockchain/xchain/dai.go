@@ -0,0 +1,48 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xchain
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// ListDaiTasks lists tasks of dai from the dai contract on xchain
+func (x *XChain) ListDaiTasks(ctx context.Context, opt *blockchain.ListDaiTaskOptions) ([]blockchain.DaiTask, error) {
+	if len(x.DaiContractName) == 0 || x.Contract != nil {
+		return nil, errorx.New(errorx.ErrCodeConfig, "dai contract not configured")
+	}
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal ListDaiTaskOptions")
+	}
+	args := map[string]string{
+		"opt": string(s),
+	}
+	mName := "ListTask"
+	r, err := x.queryContract(x.DaiContractName, args, mName)
+	if err != nil {
+		return nil, err
+	}
+	var tasks []blockchain.DaiTask
+	if err := json.Unmarshal(r, &tasks); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal dai tasks")
+	}
+	return tasks, nil
+}
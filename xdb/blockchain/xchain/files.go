@@ -161,6 +161,40 @@ func (x *XChain) UpdateNsReplica(ctx context.Context, opt *blockchain.UpdateNsRe
 	return nil
 }
 
+// UpdateNsQuota updates file namespace quota
+func (x *XChain) UpdateNsQuota(ctx context.Context, opt *blockchain.UpdateNsQuotaOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal UpdateNsQuotaOptions")
+	}
+	args := map[string]string{
+		"opt": string(s),
+	}
+	mName := "UpdateNsQuota"
+	if _, err := x.InvokeContract(args, mName); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateNsRenewal updates renewal policy of file namespace
+func (x *XChain) UpdateNsRenewal(ctx context.Context, opt *blockchain.UpdateNsRenewalOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal UpdateNsRenewalOptions")
+	}
+	args := map[string]string{
+		"opt": string(s),
+	}
+	mName := "UpdateNsRenewal"
+	if _, err := x.InvokeContract(args, mName); err != nil {
+		return err
+	}
+	return nil
+}
+
 // UpdateFilePublicSliceMeta is used to update file public slice metas
 func (x *XChain) UpdateFilePublicSliceMeta(ctx context.Context, opt *blockchain.UpdateFilePSMOptions) error {
 	s, err := json.Marshal(*opt)
@@ -178,6 +212,58 @@ func (x *XChain) UpdateFilePublicSliceMeta(ctx context.Context, opt *blockchain.
 	return nil
 }
 
+// AppendFile appends slices of data to a file
+func (x *XChain) AppendFile(ctx context.Context, opt *blockchain.AppendFileOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal AppendFileOptions")
+	}
+	args := map[string]string{
+		"opt": string(s),
+	}
+	mName := "AppendFile"
+	if _, err := x.InvokeContract(args, mName); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReportCorruptedSlice is used by storage node to report a corrupted slice it holds
+func (x *XChain) ReportCorruptedSlice(ctx context.Context, opt *blockchain.ReportCorruptedSliceOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal ReportCorruptedSliceOptions")
+	}
+	args := map[string]string{
+		"opt": string(s),
+	}
+	mName := "ReportCorruptedSlice"
+	if _, err := x.InvokeContract(args, mName); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListCorruptedSlices lists corrupted slices of files owned by owner
+func (x *XChain) ListCorruptedSlices(ctx context.Context, owner []byte) ([]blockchain.CorruptedSlice, error) {
+	var csl []blockchain.CorruptedSlice
+	args := map[string]string{
+		"owner": string(owner),
+	}
+	mName := "ListCorruptedSlices"
+	s, err := x.QueryContract(args, mName)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(s, &csl); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal corrupted slices")
+	}
+	return csl, nil
+}
+
 // SliceMigrateRecord is used by node to slice migration record
 func (x *XChain) SliceMigrateRecord(ctx context.Context, id, sig []byte, fid, sid string, ctime int64) error {
 	args := map[string]string{
@@ -195,11 +281,12 @@ func (x *XChain) SliceMigrateRecord(ctx context.Context, id, sig []byte, fid, si
 }
 
 // ListFileNs lists file namespaces by owner
-func (x *XChain) ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) ([]blockchain.Namespace, error) {
-	var ns []blockchain.Namespace
+func (x *XChain) ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) (
+	[]blockchain.Namespace, string, error) {
+	var page blockchain.NamespacePage
 	opts, err := json.Marshal(*opt)
 	if err != nil {
-		return ns, errorx.NewCode(err, errorx.ErrCodeInternal,
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to marshal ListNsOptions")
 	}
 	args := map[string]string{
@@ -208,13 +295,13 @@ func (x *XChain) ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions)
 	mName := "ListFileNs"
 	resp, err := x.QueryContract(args, mName)
 	if err != nil {
-		return ns, err
+		return nil, "", err
 	}
-	if err = json.Unmarshal([]byte(resp), &ns); err != nil {
-		return ns, errorx.NewCode(err, errorx.ErrCodeInternal,
-			"failed to unmarshal File")
+	if err = json.Unmarshal([]byte(resp), &page); err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal namespaces")
 	}
-	return ns, nil
+	return page.Namespaces, page.Next, nil
 }
 
 // GetNsByName gets namespace by nsName from xchain
@@ -237,14 +324,78 @@ func (x *XChain) GetNsByName(ctx context.Context, owner []byte, name string) (bl
 	return ns, nil
 }
 
+// AddNsMember adds a member into namespace, or updates role of the member
+func (x *XChain) AddNsMember(ctx context.Context, opt *blockchain.NsMemberOptions) error {
+	return x.invokeNsMember(opt, "AddNsMember")
+}
+
+// RemoveNsMember removes a member from namespace
+func (x *XChain) RemoveNsMember(ctx context.Context, opt *blockchain.NsMemberOptions) error {
+	return x.invokeNsMember(opt, "RemoveNsMember")
+}
+
+func (x *XChain) invokeNsMember(opt *blockchain.NsMemberOptions, mName string) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal NsMemberOptions")
+	}
+	args := map[string]string{
+		"opt": string(s),
+	}
+	if _, err := x.InvokeContract(args, mName); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetNsMember gets a member of namespace from xchain
+func (x *XChain) GetNsMember(ctx context.Context, owner []byte, ns string, member []byte) (blockchain.NsMember, error) {
+	var m blockchain.NsMember
+	args := map[string]string{
+		"owner":  string(owner),
+		"name":   ns,
+		"member": string(member),
+	}
+	mName := "GetNsMember"
+	s, err := x.QueryContract(args, mName)
+	if err != nil {
+		return m, err
+	}
+	if err = json.Unmarshal(s, &m); err != nil {
+		return m, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal NsMember")
+	}
+	return m, nil
+}
+
+// ListNsMembers lists members of namespace from xchain
+func (x *XChain) ListNsMembers(ctx context.Context, owner []byte, ns string) ([]blockchain.NsMember, error) {
+	var ms []blockchain.NsMember
+	args := map[string]string{
+		"owner": string(owner),
+		"name":  ns,
+	}
+	mName := "ListNsMembers"
+	s, err := x.QueryContract(args, mName)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(s, &ms); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal namespace members")
+	}
+	return ms, nil
+}
+
 // ListFiles lists files from xchain
 func (x *XChain) ListFiles(ctx context.Context, opt *blockchain.ListFileOptions) (
-	[]blockchain.File, error) {
-	var fs []blockchain.File
+	[]blockchain.File, string, error) {
+	var page blockchain.FilePage
 
 	opts, err := json.Marshal(*opt)
 	if err != nil {
-		return fs, errorx.NewCode(err, errorx.ErrCodeInternal,
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to marshal ListFileOptions")
 	}
 	args := map[string]string{
@@ -253,24 +404,50 @@ func (x *XChain) ListFiles(ctx context.Context, opt *blockchain.ListFileOptions)
 	mName := "ListFiles"
 	s, err := x.QueryContract(args, mName)
 	if err != nil {
-		return fs, err
+		return nil, "", err
+	}
+	if err = json.Unmarshal(s, &page); err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal Files")
+	}
+
+	return page.Files, page.Next, nil
+}
+
+// QueryFiles queries files by tags, name prefix, size range and expire time range
+func (x *XChain) QueryFiles(ctx context.Context, opt *blockchain.QueryFileOptions) (
+	[]blockchain.File, string, error) {
+	var page blockchain.FilePage
+
+	opts, err := json.Marshal(*opt)
+	if err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal QueryFileOptions")
+	}
+	args := map[string]string{
+		"opt": string(opts),
+	}
+	mName := "QueryFiles"
+	s, err := x.QueryContract(args, mName)
+	if err != nil {
+		return nil, "", err
 	}
-	if err = json.Unmarshal(s, &fs); err != nil {
-		return fs, errorx.NewCode(err, errorx.ErrCodeInternal,
+	if err = json.Unmarshal(s, &page); err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to unmarshal Files")
 	}
 
-	return fs, nil
+	return page.Files, page.Next, nil
 }
 
 // ListExpiredFiles lists expired but valid files
 func (x *XChain) ListExpiredFiles(ctx context.Context, opt *blockchain.ListFileOptions) (
-	[]blockchain.File, error) {
-	var fs []blockchain.File
+	[]blockchain.File, string, error) {
+	var page blockchain.FilePage
 
 	opts, err := json.Marshal(*opt)
 	if err != nil {
-		return fs, errorx.NewCode(err, errorx.ErrCodeInternal,
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to marshal ListFileOptions")
 	}
 	args := map[string]string{
@@ -279,12 +456,12 @@ func (x *XChain) ListExpiredFiles(ctx context.Context, opt *blockchain.ListFileO
 	mName := "ListExpiredFiles"
 	s, err := x.QueryContract(args, mName)
 	if err != nil {
-		return fs, err
+		return nil, "", err
 	}
-	if err = json.Unmarshal(s, &fs); err != nil {
-		return fs, errorx.NewCode(err, errorx.ErrCodeInternal,
+	if err = json.Unmarshal(s, &page); err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
 			"failed to unmarshal Files")
 	}
 
-	return fs, nil
+	return page.Files, page.Next, nil
 }
@@ -0,0 +1,76 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xchain
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/common"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// CommitHeartbeats posts aggregated heartbeats of a node on xchain
+func (x *XChain) CommitHeartbeats(ctx context.Context, opt *blockchain.CommitHeartbeatsOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal CommitHeartbeatsOptions")
+	}
+	args := map[string]string{
+		"opt": string(s),
+	}
+	if _, err := x.InvokeContract(args, "CommitHeartbeats"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetHeartbeatCommits gets heartbeat commits of a node in the day of timestamp from xchain
+func (x *XChain) GetHeartbeatCommits(ctx context.Context, id []byte, timestamp int64) (
+	[]blockchain.HeartbeatCommit, error) {
+	args := map[string]string{
+		"id":          string(id),
+		"currentTime": strconv.FormatInt(common.TodayBeginning(timestamp), 10),
+	}
+	s, err := x.QueryContract(args, "GetHeartbeatCommits")
+	if err != nil {
+		return nil, err
+	}
+	var commits []blockchain.HeartbeatCommit
+	if err = json.Unmarshal(s, &commits); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal HeartbeatCommits")
+	}
+	return commits, nil
+}
+
+// GetLastHeartbeatCommit gets the last heartbeat commit of a node from xchain
+func (x *XChain) GetLastHeartbeatCommit(ctx context.Context, id []byte) (blockchain.HeartbeatCommit, error) {
+	var c blockchain.HeartbeatCommit
+	args := map[string]string{
+		"id": string(id),
+	}
+	s, err := x.QueryContract(args, "GetLastHeartbeatCommit")
+	if err != nil {
+		return c, err
+	}
+	if err = json.Unmarshal(s, &c); err != nil {
+		return c, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal HeartbeatCommit")
+	}
+	return c, nil
+}
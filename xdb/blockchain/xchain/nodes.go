@@ -106,6 +106,66 @@ func (x *XChain) NodeOnline(ctx context.Context, opt *blockchain.NodeOperateOpti
 	return x.setNodeOnlineStatus(ctx, opt, true)
 }
 
+// NodeDrain set node status on chain to draining
+func (x *XChain) NodeDrain(ctx context.Context, opt *blockchain.NodeOperateOptions) error {
+	s, err := json.Marshal(*opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal NodeOperateOptions")
+	}
+	args := map[string]string{
+		"opt": string(s),
+	}
+	mName := "NodeDrain"
+	if _, err := x.InvokeContract(args, mName); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetNodeSliceNum gets number of slices of unexpired files on the node
+func (x *XChain) GetNodeSliceNum(ctx context.Context, id []byte, timestamp int64) (int, error) {
+	args := map[string]string{
+		"id":          string(id),
+		"currentTime": strconv.FormatInt(timestamp, 10),
+	}
+	mName := "GetNodeSliceNum"
+	number, err := x.QueryContract(args, mName)
+	if err != nil {
+		return 0, err
+	}
+	num, err := strconv.Atoi(string(number))
+	if err != nil {
+		return 0, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal slice number")
+	}
+	return num, nil
+}
+
+// ListNodeSlices lists a page of slices on the node which are referenced by files on chain,
+//  along with id and expire time of the file each slice belongs to, and the cursor of the next page
+func (x *XChain) ListNodeSlices(ctx context.Context, opt *blockchain.ListNodeSliceOptions) (
+	[]blockchain.NodeSlice, string, error) {
+	var page blockchain.NodeSlicePage
+
+	opts, err := json.Marshal(*opt)
+	if err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal ListNodeSliceOptions")
+	}
+	args := map[string]string{
+		"opt": string(opts),
+	}
+	mName := "ListNodeSlices"
+	s, err := x.QueryContract(args, mName)
+	if err != nil {
+		return nil, "", err
+	}
+	if err = json.Unmarshal(s, &page); err != nil {
+		return nil, "", errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal slices")
+	}
+	return page.Slices, page.Next, nil
+}
+
 // Heartbeat updates heartbeat of node
 func (x *XChain) Heartbeat(ctx context.Context, id, sig []byte, timestamp int64) error {
 	args := map[string]string{
@@ -183,53 +243,20 @@ func (x *XChain) ListNodesExpireSlice(ctx context.Context, opt *blockchain.ListN
 	return strings.Split(arrs, ","), nil
 }
 
-// GetNodeHealth gets node health status
+// GetNodeHealth gets node health status scored by the current health policy
 func (x *XChain) GetNodeHealth(ctx context.Context, id []byte) (string, error) {
 	now := time.Now().UnixNano()
-	node, err := x.GetNode(ctx, id)
+	policy, err := x.GetHealthPolicy(ctx, 0)
 	if err != nil {
 		return "", err
 	}
-
-	start, end := common.GetHeartBeatStats(now, node.RegTime)
-
-	// get proved challenges ratio
-	numOpt := blockchain.GetChallengeNumOptions{
-		TargetNode: id,
-		TimeStart:  start,
-		TimeEnd:    end,
+	node, err := x.GetNode(ctx, id)
+	if err != nil {
+		return "", err
 	}
-	all, err := x.GetChallengeNum(ctx, &numOpt)
+	stats, err := common.GetNodeHealthStats(ctx, x, node, policy, now)
 	if err != nil {
 		return "", err
 	}
-	provedRation := blockchain.DefaultChallProvedRate
-	if all != 0 {
-		numOpt.Status = blockchain.ChallengeProved
-		proved, err := x.GetChallengeNum(ctx, &numOpt)
-		if err != nil {
-			return "", err
-		}
-		provedRation = float64(proved) / float64(all)
-	}
-	// get hearbeat max number
-	heartBeaMax := common.GetHeartbeatMaxNum(start, end, node.RegTime)
-
-	heartBeatRate := blockchain.DefaultHearBeatRate
-	if heartBeaMax != 0 {
-		hearBeatTotal, err := common.GetHeartBeatTotalNumByTime(ctx, x, id, start, end)
-		if err != nil {
-			return "", err
-		}
-		heartBeatRate = float64(hearBeatTotal) / float64(heartBeaMax)
-	}
-	// calculate health index
-	health := blockchain.NodeHealthChallProp*provedRation + blockchain.NodeHealthHeartBeatProp*heartBeatRate
-	if health >= blockchain.NodeHealthBoundGood {
-		return blockchain.NodeHealthGood, nil
-	}
-	if health < blockchain.NodeHealthBoundMedium {
-		return blockchain.NodeHealthBad, nil
-	}
-	return blockchain.NodeHealthMedium, nil
+	return policy.Health(policy.Score(stats)), nil
 }
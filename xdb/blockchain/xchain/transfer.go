@@ -0,0 +1,96 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xchain
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// ProposeTransfer proposes an ownership transfer on xchain
+func (x *XChain) ProposeTransfer(ctx context.Context, opt *blockchain.ProposeTransferOptions) error {
+	return x.invokeTransfer(opt, "ProposeTransfer")
+}
+
+// AcceptTransfer accepts an ownership transfer on xchain
+func (x *XChain) AcceptTransfer(ctx context.Context, opt *blockchain.AcceptTransferOptions) error {
+	return x.invokeTransfer(opt, "AcceptTransfer")
+}
+
+// CancelTransfer cancels an ownership transfer on xchain
+func (x *XChain) CancelTransfer(ctx context.Context, opt *blockchain.CancelTransferOptions) error {
+	return x.invokeTransfer(opt, "CancelTransfer")
+}
+
+// CompleteTransfer records a transferred file, or finishes a namespace transfer on xchain
+func (x *XChain) CompleteTransfer(ctx context.Context, opt *blockchain.CompleteTransferOptions) error {
+	return x.invokeTransfer(opt, "CompleteTransfer")
+}
+
+// GetTransferByID gets an ownership transfer from xchain
+func (x *XChain) GetTransferByID(ctx context.Context, id string) (blockchain.Transfer, error) {
+	var t blockchain.Transfer
+	args := map[string]string{
+		"id": id,
+	}
+	s, err := x.QueryContract(args, "GetTransferByID")
+	if err != nil {
+		return t, err
+	}
+	if err = json.Unmarshal(s, &t); err != nil {
+		return t, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal Transfer")
+	}
+	return t, nil
+}
+
+// ListTransfers lists ownership transfers proposed by or to a user from xchain
+func (x *XChain) ListTransfers(ctx context.Context, opt *blockchain.ListTransferOptions) ([]blockchain.Transfer, error) {
+	var ts []blockchain.Transfer
+	opts, err := json.Marshal(*opt)
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal ListTransferOptions")
+	}
+	args := map[string]string{
+		"opt": string(opts),
+	}
+	s, err := x.QueryContract(args, "ListTransfers")
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(s, &ts); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to unmarshal transfers")
+	}
+	return ts, nil
+}
+
+func (x *XChain) invokeTransfer(opt interface{}, mName string) error {
+	s, err := json.Marshal(opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal,
+			"failed to marshal options of %s", mName)
+	}
+	args := map[string]string{
+		"opt": string(s),
+	}
+	if _, err := x.InvokeContract(args, mName); err != nil {
+		return err
+	}
+	return nil
+}
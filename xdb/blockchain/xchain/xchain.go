@@ -26,12 +26,20 @@ import (
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
 )
 
+// Contract runs methods of the contract and returns the response body
+type Contract interface {
+	Invoke(args map[string]string, mName string) ([]byte, error)
+	Query(args map[string]string, mName string) ([]byte, error)
+}
+
 type XChain struct {
 	ContractName    string              // ContractName is name of contract
+	DaiContractName string              // DaiContractName is name of dai contract on the same chain, optional
 	ContractAccount string              // ContractAccount is a contract account
 	ChainName       string              // ChainName is name of blockchain
 	Account         *account.Account    // Account is the local account, and is also the client account to request blockchain
 	XchainClient    *xchain.XuperClient // XchainClient is the util used to connect and request blockchain
+	Contract        Contract            // Contract runs the contract in process instead of requesting blockchain if set
 }
 
 // New creates a XChain client which is used for connecting and requesting blockchain
@@ -65,6 +73,7 @@ func New(conf *config.XchainConf) (*XChain, error) {
 	}
 	return &XChain{
 		ContractName:    conf.ContractName,
+		DaiContractName: conf.DaiContractName,
 		ContractAccount: conf.ContractAccount,
 		ChainName:       conf.ChainName,
 		Account:         acc,
@@ -74,6 +83,9 @@ func New(conf *config.XchainConf) (*XChain, error) {
 
 // InvokeContract invokes the contract
 func (x *XChain) InvokeContract(args map[string]string, mName string) ([]byte, error) {
+	if x.Contract != nil {
+		return x.Contract.Invoke(args, mName)
+	}
 	// initiate client for native contract
 	nativeContract := contract.InitNativeContractWithClient(
 		x.Account, x.ChainName, x.ContractName, x.ContractAccount, x.XchainClient)
@@ -105,9 +117,17 @@ func (x *XChain) InvokeContract(args map[string]string, mName string) ([]byte, e
 
 // QueryContract queries the contract
 func (x *XChain) QueryContract(args map[string]string, mName string) ([]byte, error) {
+	if x.Contract != nil {
+		return x.Contract.Query(args, mName)
+	}
+	return x.queryContract(x.ContractName, args, mName)
+}
+
+// queryContract queries the contract named cName on the chain
+func (x *XChain) queryContract(cName string, args map[string]string, mName string) ([]byte, error) {
 	// initiate client for native contract
 	nativeContract := contract.InitNativeContractWithClient(
-		x.Account, x.ChainName, x.ContractName, x.ContractAccount, x.XchainClient)
+		x.Account, x.ChainName, cName, x.ContractAccount, x.XchainClient)
 	// send request
 	resp, err := nativeContract.QueryNativeContract(mName, args)
 	if err != nil {
@@ -0,0 +1,999 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	grpcpkg "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/events"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+	pb "github.com/PaddlePaddle/PaddleDTX/xdb/protos/xuperdb"
+	servertypes "github.com/PaddlePaddle/PaddleDTX/xdb/server/types"
+)
+
+// options are the same as http client, so that callers can switch between transports easily
+type (
+	WriteOptions           = http.WriteOptions
+	AppendOptions          = http.AppendOptions
+	ReadOptions            = http.ReadOptions
+	AddNodeOptions         = http.AddNodeOptions
+	ListFileOptions        = http.ListFileOptions
+	ListNsOptions          = http.ListNsOptions
+	QueryFileOptions       = http.QueryFileOptions
+	RebalanceOptions       = http.RebalanceOptions
+	UpdateBandwidthOptions = http.UpdateBandwidthOptions
+	GetChallengesOptions   = http.GetChallengesOptions
+	WatchOptions           = http.WatchOptions
+)
+
+// Client grpc client of XuperDB, methods are the same as http client
+type Client struct {
+	conn   *grpcpkg.ClientConn
+	client pb.XuperDBClient
+}
+
+// New new a client by grpc server address like "127.0.0.1:8123"
+func New(addr string) (*Client, error) {
+	conn, err := grpcpkg.Dial(addr, grpcpkg.WithInsecure())
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeParam, "invalid addr")
+	}
+	c := &Client{
+		conn:   conn,
+		client: pb.NewXuperDBClient(conn),
+	}
+	return c, nil
+}
+
+// NewTLS new a client over TLS by grpc server address, conf could be created by http.TLSConfig
+func NewTLS(addr string, conf *tls.Config) (*Client, error) {
+	conn, err := grpcpkg.Dial(addr, grpcpkg.WithTransportCredentials(credentials.NewTLS(conf)))
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeParam, "invalid addr")
+	}
+	c := &Client{
+		conn:   conn,
+		client: pb.NewXuperDBClient(conn),
+	}
+	return c, nil
+}
+
+// Close closes the connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Write upload a file
+func (c *Client) Write(ctx context.Context, r io.Reader, opt WriteOptions) (
+	servertypes.WriteResponse, error) {
+
+	privkey, err := ecdsa.DecodePrivateKeyFromString(opt.PrivateKey)
+	if err != nil {
+		return servertypes.WriteResponse{}, err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+	owner := pubkey.String()
+
+	msg := fmt.Sprintf("%s:%s:%s", owner, opt.Namespace, opt.FileName)
+	sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(msg)))
+	if err != nil {
+		return servertypes.WriteResponse{}, errorx.Wrap(err, "failed to sign")
+	}
+
+	stream, err := c.client.Write(ctx)
+	if err != nil {
+		return servertypes.WriteResponse{}, parseError(err)
+	}
+	options := &pb.WriteOptions{
+		User:        owner,
+		Token:       sig.String(),
+		Namespace:   opt.Namespace,
+		FileName:    opt.FileName,
+		ExpireTime:  opt.ExpireTime,
+		Description: opt.Description,
+		Extra:       opt.Extra,
+		Tags:        opt.Tags,
+	}
+	if err := stream.Send(&pb.WriteRequest{Data: &pb.WriteRequest_Options{Options: options}}); err != nil {
+		return servertypes.WriteResponse{}, closeAndParseError(stream, err)
+	}
+	if err := pb.SendChunks(r, func(data []byte) error {
+		return stream.Send(&pb.WriteRequest{Data: &pb.WriteRequest_Chunk{Chunk: data}})
+	}); err != nil {
+		return servertypes.WriteResponse{}, closeAndParseError(stream, err)
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return servertypes.WriteResponse{}, parseError(err)
+	}
+	return servertypes.WriteResponse{FileID: resp.GetFileID()}, nil
+}
+
+// Append appends data to an existing file
+func (c *Client) Append(ctx context.Context, r io.Reader, opt AppendOptions) (
+	servertypes.AppendResponse, error) {
+
+	privkey, err := ecdsa.DecodePrivateKeyFromString(opt.PrivateKey)
+	if err != nil {
+		return servertypes.AppendResponse{}, err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+	owner := pubkey.String()
+	tm := time.Now().UnixNano()
+
+	msg := fmt.Sprintf("%s:%s:%d", owner, opt.FileID, tm)
+	sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(msg)))
+	if err != nil {
+		return servertypes.AppendResponse{}, errorx.Wrap(err, "failed to sign")
+	}
+
+	stream, err := c.client.Append(ctx)
+	if err != nil {
+		return servertypes.AppendResponse{}, parseError(err)
+	}
+	options := &pb.AppendOptions{
+		User:      owner,
+		Token:     sig.String(),
+		FileID:    opt.FileID,
+		Timestamp: tm,
+	}
+	if err := stream.Send(&pb.AppendRequest{Data: &pb.AppendRequest_Options{Options: options}}); err != nil {
+		return servertypes.AppendResponse{}, closeAndParseError(stream, err)
+	}
+	if err := pb.SendChunks(r, func(data []byte) error {
+		return stream.Send(&pb.AppendRequest{Data: &pb.AppendRequest_Chunk{Chunk: data}})
+	}); err != nil {
+		return servertypes.AppendResponse{}, closeAndParseError(stream, err)
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return servertypes.AppendResponse{}, parseError(err)
+	}
+	return servertypes.AppendResponse{FileID: resp.GetFileID(), Length: resp.GetLength()}, nil
+}
+
+// Read download a file
+func (c *Client) Read(ctx context.Context, opt ReadOptions) (io.ReadCloser, error) {
+	privkey, err := ecdsa.DecodePrivateKeyFromString(opt.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	tm := uint64(time.Now().UnixNano())
+	pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+	owner := pubkey.String()
+
+	var msg string
+	if len(opt.FileID) > 0 {
+		msg = fmt.Sprintf("%s:%d", opt.FileID, tm)
+	} else {
+		msg = fmt.Sprintf("%s:%s:%s:%d", owner, opt.Namespace, opt.FileName, tm)
+	}
+	sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(msg)))
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to sign")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.client.Read(ctx, &pb.ReadRequest{
+		User:      owner,
+		Token:     sig.String(),
+		Namespace: opt.Namespace,
+		FileName:  opt.FileName,
+		FileID:    opt.FileID,
+		Timestamp: tm,
+	})
+	if err != nil {
+		cancel()
+		return nil, parseError(err)
+	}
+	return newStreamReader(stream.Recv, cancel)
+}
+
+// AddNode add a storage node to system
+func (c *Client) AddNode(ctx context.Context, opt AddNodeOptions) error {
+	pubkey := ecdsa.PublicKeyFromPrivateKey(opt.PrivateKey)
+
+	msg := fmt.Sprintf("%s:%s", opt.Name, opt.Address)
+	sig, err := ecdsa.Sign(opt.PrivateKey, hash.Hash([]byte(msg)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign")
+	}
+	_, err = c.client.AddNode(ctx, &pb.AddNodeRequest{
+		NodeID:  pubkey.String(),
+		Name:    opt.Name,
+		Address: opt.Address,
+		Token:   sig.String(),
+	})
+	return parseError(err)
+}
+
+// ListNodes list all storage nodes in system
+func (c *Client) ListNodes(ctx context.Context) (blockchain.Nodes, error) {
+	nodes, err := c.client.ListNodes(ctx, &pb.Empty{})
+	if err != nil {
+		return nil, parseError(err)
+	}
+	return pb.ToNodes(nodes), nil
+}
+
+// GetNode get storage node by node id
+func (c *Client) GetNode(ctx context.Context, id string) (blockchain.Node, error) {
+	node, err := c.client.GetNode(ctx, &pb.GetNodeRequest{Id: id})
+	if err != nil {
+		return blockchain.Node{}, parseError(err)
+	}
+	return pb.ToNode(node), nil
+}
+
+// GetNodeHeartbeat get storage node heart beat number
+func (c *Client) GetNodeHeartbeat(ctx context.Context, id string, ctime int64) (map[string]int, error) {
+	num, err := c.client.GetHeartbeatNum(ctx, &pb.GetHeartbeatNumRequest{Id: id, CurrentTime: ctime})
+	if err != nil {
+		return nil, parseError(err)
+	}
+	res := map[string]int{
+		"heartBeatTotal": int(num.GetHeartBeatTotal()),
+		"heartBeatMax":   int(num.GetHeartBeatMax()),
+	}
+	return res, nil
+}
+
+// ListHeartbeats lists heartbeats kept by the storage node between start and end
+func (c *Client) ListHeartbeats(ctx context.Context, start, end int64) ([]blockchain.SignedHeartbeat, error) {
+	beats, err := c.client.ListHeartbeats(ctx, &pb.ListHeartbeatsRequest{Start: start, End: end})
+	if err != nil {
+		return nil, parseError(err)
+	}
+	return pb.ToSignedHeartbeats(beats), nil
+}
+
+// ListHeartbeatCommits lists heartbeat commits of a storage node between start and end
+func (c *Client) ListHeartbeatCommits(ctx context.Context, id string, start, end int64) (
+	[]blockchain.HeartbeatCommit, error) {
+	commits, err := c.client.ListHeartbeatCommits(ctx, &pb.ListHeartbeatsRequest{Id: id, Start: start, End: end})
+	if err != nil {
+		return nil, parseError(err)
+	}
+	return pb.ToHeartbeatCommits(commits), nil
+}
+
+// GetMigrateRecords get storage node migrate records
+func (c *Client) GetMigrateRecords(ctx context.Context, id string, start, end int64, limit uint64) ([]map[string]interface{}, error) {
+	records, err := c.client.GetSliceMigrateRecords(ctx, &pb.GetMigrateRecordsRequest{
+		Id:        id,
+		StartTime: start,
+		EndTime:   end,
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, parseError(err)
+	}
+	var ms []map[string]interface{}
+	if err := json.Unmarshal([]byte(records.GetRecords()), &ms); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal slice migrate records")
+	}
+	return ms, nil
+}
+
+// GetNodeHealth get storage node health status by node id
+func (c *Client) GetNodeHealth(ctx context.Context, id string) (string, error) {
+	health, err := c.client.GetNodeHealth(ctx, &pb.GetNodeRequest{Id: id})
+	if err != nil {
+		return "", parseError(err)
+	}
+	return health.GetStatus(), nil
+}
+
+// operateNode signs node id with nonce and calls the node api given by operate
+func (c *Client) operateNode(ctx context.Context, privateKey string,
+	operate func(context.Context, *pb.NodeOperateRequest, ...grpcpkg.CallOption) (*pb.Empty, error)) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(privateKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+	nodeID := pubkey.String()
+
+	nonce := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%d", nodeID, nonce)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign")
+	}
+	_, err = operate(ctx, &pb.NodeOperateRequest{
+		NodeID: nodeID,
+		Nonce:  nonce,
+		Token:  sig.String(),
+	})
+	return parseError(err)
+}
+
+// NodeOffline set storage node status offline
+func (c *Client) NodeOffline(ctx context.Context, privkey string) error {
+	return c.operateNode(ctx, privkey, c.client.NodeOffline)
+}
+
+// NodeOnline set storage node status online
+func (c *Client) NodeOnline(ctx context.Context, privkey string) error {
+	return c.operateNode(ctx, privkey, c.client.NodeOnline)
+}
+
+// NodeDrain set storage node status draining, slices on it will be migrated to other nodes
+func (c *Client) NodeDrain(ctx context.Context, privkey string) error {
+	return c.operateNode(ctx, privkey, c.client.NodeDrain)
+}
+
+// GetNodeDrainStatus get draining progress of storage node
+func (c *Client) GetNodeDrainStatus(ctx context.Context, id string) (blockchain.NodeDrainStatus, error) {
+	status, err := c.client.GetNodeDrainStatus(ctx, &pb.GetNodeRequest{Id: id})
+	if err != nil {
+		return blockchain.NodeDrainStatus{}, parseError(err)
+	}
+	return pb.ToNodeDrainStatus(status), nil
+}
+
+// GetHealthPolicy gets node health policy of a version, version 0 means the current one
+func (c *Client) GetHealthPolicy(ctx context.Context, version int64) (blockchain.HealthPolicy, error) {
+	policy, err := c.client.GetHealthPolicy(ctx, &pb.GetHealthPolicyRequest{Version: version})
+	if err != nil {
+		return blockchain.HealthPolicy{}, parseError(err)
+	}
+	return pb.ToHealthPolicy(policy), nil
+}
+
+// UpdateHealthPolicy sets a new version of node health policy, adminKey is private key of the network admin
+func (c *Client) UpdateHealthPolicy(ctx context.Context, adminKey string, policy blockchain.HealthPolicy) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(adminKey)
+	if err != nil {
+		return err
+	}
+	currentTime := time.Now().UnixNano()
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(policy.Message(currentTime))))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign health policy")
+	}
+	_, err = c.client.UpdateHealthPolicy(ctx, &pb.UpdateHealthPolicyRequest{
+		Policy:      pb.FromHealthPolicy(policy),
+		CurrentTime: currentTime,
+		Token:       sig.String(),
+	})
+	return parseError(err)
+}
+
+// SimulateNodeHealth scores existing storage nodes by the current health policy and by a proposed one
+func (c *Client) SimulateNodeHealth(ctx context.Context, policy blockchain.HealthPolicy) (
+	servertypes.HealthSimulation, error) {
+	sim, err := c.client.SimulateNodeHealth(ctx, pb.FromHealthPolicy(policy))
+	if err != nil {
+		return servertypes.HealthSimulation{}, parseError(err)
+	}
+	return pb.ToHealthSimulation(sim), nil
+}
+
+// ListFiles list unexpired files
+func (c *Client) ListFiles(ctx context.Context, opt ListFileOptions) ([]blockchain.File, string, error) {
+	files, err := c.client.ListFiles(ctx, &pb.ListFileRequest{
+		Owner:     opt.Owner,
+		Namespace: opt.Namespace,
+		TimeStart: opt.TimeStart,
+		TimeEnd:   opt.TimeEnd,
+		Limit:     opt.Limit,
+		Cursor:    opt.Next,
+	})
+	if err != nil {
+		return nil, "", parseError(err)
+	}
+	return pb.ToFiles(files), files.GetNext(), nil
+}
+
+// QueryFiles query unexpired files by tags, name prefix, size range and expire time range
+func (c *Client) QueryFiles(ctx context.Context, opt QueryFileOptions) ([]blockchain.File, string, error) {
+	files, err := c.client.QueryFiles(ctx, &pb.QueryFileRequest{
+		Owner:           opt.Owner,
+		Namespace:       opt.Namespace,
+		Tags:            opt.Tags,
+		NamePrefix:      opt.NamePrefix,
+		MinSize:         opt.MinSize,
+		MaxSize:         opt.MaxSize,
+		ExpireTimeStart: opt.ExpireTimeStart,
+		ExpireTimeEnd:   opt.ExpireTimeEnd,
+		Limit:           opt.Limit,
+		Cursor:          opt.Next,
+	})
+	if err != nil {
+		return nil, "", parseError(err)
+	}
+	return pb.ToFiles(files), files.GetNext(), nil
+}
+
+// ListExpiredFiles list expired but valid files
+func (c *Client) ListExpiredFiles(ctx context.Context, opt ListFileOptions) ([]blockchain.File, string, error) {
+	files, err := c.client.ListExpiredFiles(ctx, &pb.ListFileRequest{
+		Owner:     opt.Owner,
+		Namespace: opt.Namespace,
+		TimeStart: opt.TimeStart,
+		TimeEnd:   opt.TimeEnd,
+		Limit:     opt.Limit,
+		Cursor:    opt.Next,
+	})
+	if err != nil {
+		return nil, "", parseError(err)
+	}
+	return pb.ToFiles(files), files.GetNext(), nil
+}
+
+// GetFileByID get file info by file id
+func (c *Client) GetFileByID(ctx context.Context, id string) (blockchain.FileH, error) {
+	file, err := c.client.GetFileByID(ctx, &pb.GetFileRequest{Id: id})
+	if err != nil {
+		return blockchain.FileH{}, parseError(err)
+	}
+	return pb.ToFileH(file), nil
+}
+
+// GetFileByName get file info by file name, owner and namespace
+func (c *Client) GetFileByName(ctx context.Context, owner, ns, name string) (blockchain.FileH, error) {
+	file, err := c.client.GetFileByName(ctx, &pb.GetFileRequest{
+		Owner:     owner,
+		Namespace: ns,
+		Name:      name,
+	})
+	if err != nil {
+		return blockchain.FileH{}, parseError(err)
+	}
+	return pb.ToFileH(file), nil
+}
+
+// UpdateExpTimeByID update file expire time by file id
+func (c *Client) UpdateExpTimeByID(ctx context.Context, id, privateKey string, expireTime int64) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(privateKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%d,%d", id, expireTime, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign file expire time")
+	}
+	_, err = c.client.UpdateFileExpireTime(ctx, &pb.UpdateFileEtimeRequest{
+		Owner:       pubkey.String(),
+		FileID:      id,
+		ExpireTime:  expireTime,
+		CurrentTime: currentTime,
+		Token:       sig.String(),
+	})
+	return parseError(err)
+}
+
+// AddFileNs add a file namespace
+func (c *Client) AddFileNs(ctx context.Context, priKey, ns, des string, replica int) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	ctime := time.Now().UnixNano()
+	namespace := blockchain.Namespace{
+		Owner:        pubkey[:],
+		Name:         ns,
+		Description:  des,
+		CreateTime:   ctime,
+		UpdateTime:   ctime,
+		Replica:      replica,
+		FileTotalNum: 0,
+	}
+	s, err := json.Marshal(namespace)
+	if err != nil {
+		return errorx.Wrap(err, "failed to marshal namespace")
+	}
+	// sign ns info
+	sig, err := ecdsa.Sign(private, hash.Hash(s))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign namespace")
+	}
+	_, err = c.client.AddFileNs(ctx, &pb.AddNsRequest{
+		Owner:       pubkey.String(),
+		Namespace:   ns,
+		Description: des,
+		Replica:     int64(replica),
+		CreateTime:  ctime,
+		Token:       sig.String(),
+	})
+	return parseError(err)
+}
+
+// UpdateFileNsReplica update namespace replica
+func (c *Client) UpdateFileNsReplica(ctx context.Context, priKey, ns string, replica int) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%d,%d", ns, replica, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign update ns replica param")
+	}
+	_, err = c.client.UpdateNsReplica(ctx, &pb.UpdateNsReplicaRequest{
+		Owner:       pubkey.String(),
+		Namespace:   ns,
+		Replica:     int64(replica),
+		CurrentTime: currentTime,
+		Token:       sig.String(),
+	})
+	return parseError(err)
+}
+
+// UpdateFileNsQuota updates quota of file namespace, zero means unlimited
+func (c *Client) UpdateFileNsQuota(ctx context.Context, priKey, ns string, quota blockchain.NsQuota) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%d,%d,%d,%d", ns, quota.MaxBytes, quota.MaxFiles, quota.MaxFileSize, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign update ns quota param")
+	}
+	_, err = c.client.UpdateNsQuota(ctx, &pb.UpdateNsQuotaRequest{
+		Owner:       pubkey.String(),
+		Namespace:   ns,
+		Quota:       pb.FromNsQuota(quota),
+		CurrentTime: currentTime,
+		Token:       sig.String(),
+	})
+	return parseError(err)
+}
+
+// UpdateFileNsRenewal updates renewal policy of file namespace, files are not renewed if policy is empty
+func (c *Client) UpdateFileNsRenewal(ctx context.Context, priKey, ns string, renewal blockchain.NsRenewal) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%d,%d,%d", ns, renewal.Policy, renewal.Days, renewal.Until, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign update ns renewal param")
+	}
+	_, err = c.client.UpdateNsRenewal(ctx, &pb.UpdateNsRenewalRequest{
+		Owner:       pubkey.String(),
+		Namespace:   ns,
+		Renewal:     pb.FromNsRenewal(renewal),
+		CurrentTime: currentTime,
+		Token:       sig.String(),
+	})
+	return parseError(err)
+}
+
+// AddNsMember adds a member into namespace or updates role of the member, signed by namespace owner or admin
+func (c *Client) AddNsMember(ctx context.Context, priKey, ns, member, role string) error {
+	return c.updateNsMember(ctx, c.client.AddNsMember, priKey, ns, member, role)
+}
+
+// RemoveNsMember removes a member from namespace, signed by namespace owner or admin
+func (c *Client) RemoveNsMember(ctx context.Context, priKey, ns, member string) error {
+	return c.updateNsMember(ctx, c.client.RemoveNsMember, priKey, ns, member, "")
+}
+
+func (c *Client) updateNsMember(ctx context.Context,
+	update func(context.Context, *pb.NsMemberRequest, ...grpcpkg.CallOption) (*pb.Empty, error),
+	priKey, ns, member, role string) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%s,%d", ns, member, role, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign namespace member param")
+	}
+	_, err = update(ctx, &pb.NsMemberRequest{
+		User:        pubkey.String(),
+		Namespace:   ns,
+		Member:      member,
+		Role:        role,
+		CurrentTime: currentTime,
+		Token:       sig.String(),
+	})
+	return parseError(err)
+}
+
+// ListNsMembers lists members of namespace
+func (c *Client) ListNsMembers(ctx context.Context, ns string) ([]blockchain.NsMember, error) {
+	members, err := c.client.ListNsMembers(ctx, &pb.ListNsMembersRequest{Namespace: ns})
+	if err != nil {
+		return nil, parseError(err)
+	}
+	return pb.ToNsMembers(members), nil
+}
+
+// ProposeTransfer proposes to transfer a file or a whole namespace if fileID is empty to newOwner,
+//  signed by the owner. Returns id of the transfer
+func (c *Client) ProposeTransfer(ctx context.Context, priKey, ns, fileID, newOwner, newNs string) (string, error) {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return "", err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%s,%s,%d", ns, fileID, newOwner, newNs, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return "", errorx.Wrap(err, "failed to sign propose transfer param")
+	}
+	resp, err := c.client.ProposeTransfer(ctx, &pb.ProposeTransferRequest{
+		User:         pubkey.String(),
+		Namespace:    ns,
+		FileID:       fileID,
+		NewOwner:     newOwner,
+		NewNamespace: newNs,
+		CurrentTime:  currentTime,
+		Token:        sig.String(),
+	})
+	if err != nil {
+		return "", parseError(err)
+	}
+	return resp.GetId(), nil
+}
+
+// AcceptTransfer accepts a transfer, signed by the new owner
+func (c *Client) AcceptTransfer(ctx context.Context, priKey, id string) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%d", id, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign accept transfer param")
+	}
+	_, err = c.client.AcceptTransfer(ctx, &pb.AcceptTransferRequest{
+		User:        pubkey.String(),
+		Id:          id,
+		CurrentTime: currentTime,
+		Token:       sig.String(),
+	})
+	return parseError(err)
+}
+
+// CancelTransfer cancels a transfer not completed yet, signed by the owner or the new owner
+func (c *Client) CancelTransfer(ctx context.Context, priKey, id string) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%d", blockchain.TransferCanceled, id, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign cancel transfer param")
+	}
+	_, err = c.client.CancelTransfer(ctx, &pb.CancelTransferRequest{
+		User:        pubkey.String(),
+		Id:          id,
+		CurrentTime: currentTime,
+		Token:       sig.String(),
+	})
+	return parseError(err)
+}
+
+// Handover fetches a file of an accepted transfer from the dataOwner node of the old owner, signed by the new owner,
+//  the file is encrypted with public key of the new owner, see ecies.Decrypt
+func (c *Client) Handover(ctx context.Context, priKey, id, fileID string) (servertypes.HandoverResponse, error) {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return servertypes.HandoverResponse{}, err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%d", id, fileID, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return servertypes.HandoverResponse{}, errorx.Wrap(err, "failed to sign handover param")
+	}
+	resp, err := c.client.Handover(ctx, &pb.HandoverRequest{
+		User:        pubkey.String(),
+		Id:          id,
+		FileID:      fileID,
+		CurrentTime: currentTime,
+		Token:       sig.String(),
+	})
+	if err != nil {
+		return servertypes.HandoverResponse{}, parseError(err)
+	}
+	return servertypes.HandoverResponse{
+		TransferID:  resp.GetTransferID(),
+		FileID:      resp.GetFileID(),
+		Length:      resp.GetLength(),
+		ContentHash: resp.GetContentHash(),
+		Receipt:     resp.GetReceipt(),
+		Ciphertext:  resp.GetCiphertext(),
+	}, nil
+}
+
+// CompleteTransfer records that fileID has been re-uploaded as newFileID, signed by the new owner,
+//  contentHash and receipt are taken from the handover of fileID.
+//  Empty fileID and newFileID finish a namespace transfer
+func (c *Client) CompleteTransfer(ctx context.Context, priKey, id, fileID, newFileID, contentHash, receipt string) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%s,%s,%d", id, fileID, newFileID, contentHash, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign complete transfer param")
+	}
+	_, err = c.client.CompleteTransfer(ctx, &pb.CompleteTransferRequest{
+		User:        pubkey.String(),
+		Id:          id,
+		FileID:      fileID,
+		NewFileID:   newFileID,
+		ContentHash: contentHash,
+		Receipt:     receipt,
+		CurrentTime: currentTime,
+		Token:       sig.String(),
+	})
+	return parseError(err)
+}
+
+// GetTransfer gets a transfer by id
+func (c *Client) GetTransfer(ctx context.Context, id string) (blockchain.Transfer, error) {
+	t, err := c.client.GetTransfer(ctx, &pb.GetTransferRequest{Id: id})
+	if err != nil {
+		return blockchain.Transfer{}, parseError(err)
+	}
+	return pb.ToTransfer(t), nil
+}
+
+// ListTransfers lists transfers proposed by or to user, the dataOwner if user is empty
+func (c *Client) ListTransfers(ctx context.Context, user string, limit uint64) ([]blockchain.Transfer, error) {
+	ts, err := c.client.ListTransfers(ctx, &pb.ListTransfersRequest{User: user, Limit: limit})
+	if err != nil {
+		return nil, parseError(err)
+	}
+	return pb.ToTransfers(ts), nil
+}
+
+// Rebalance plans slice moves across storage nodes, and runs them on dataOwner node unless it's a dry run
+func (c *Client) Rebalance(ctx context.Context, opt RebalanceOptions) (servertypes.RebalancePlan, error) {
+	private, err := ecdsa.DecodePrivateKeyFromString(opt.PrivateKey)
+	if err != nil {
+		return servertypes.RebalancePlan{}, err
+	}
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%d,%d,%d", opt.Namespace, opt.MaxMoves, opt.MoveInterval, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return servertypes.RebalancePlan{}, errorx.Wrap(err, "failed to sign rebalance param")
+	}
+	plan, err := c.client.Rebalance(ctx, &pb.RebalanceRequest{
+		Namespace:    opt.Namespace,
+		MaxMoves:     int64(opt.MaxMoves),
+		MoveInterval: int64(opt.MoveInterval),
+		DryRun:       opt.DryRun,
+		CurrentTime:  currentTime,
+		Token:        sig.String(),
+	})
+	if err != nil {
+		return servertypes.RebalancePlan{}, parseError(err)
+	}
+	return pb.ToRebalancePlan(plan), nil
+}
+
+// GetBandwidth gets bandwidth limits in effect on dataOwner node
+func (c *Client) GetBandwidth(ctx context.Context) (servertypes.Bandwidth, error) {
+	b, err := c.client.GetBandwidth(ctx, &pb.Empty{})
+	if err != nil {
+		return servertypes.Bandwidth{}, parseError(err)
+	}
+	return pb.ToBandwidth(b), nil
+}
+
+// GetMaterialStats gets statistics of challenge materials kept by dataOwner node for each namespace
+func (c *Client) GetMaterialStats(ctx context.Context) (servertypes.MaterialReport, error) {
+	r, err := c.client.GetMaterialStats(ctx, &pb.Empty{})
+	if err != nil {
+		return servertypes.MaterialReport{}, parseError(err)
+	}
+	return pb.ToMaterialReport(r), nil
+}
+
+// UpdateBandwidth changes bandwidth limits of dataOwner node at runtime
+func (c *Client) UpdateBandwidth(ctx context.Context, opt UpdateBandwidthOptions) (servertypes.Bandwidth, error) {
+	private, err := ecdsa.DecodePrivateKeyFromString(opt.PrivateKey)
+	if err != nil {
+		return servertypes.Bandwidth{}, err
+	}
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%d,%d,%d", opt.Limit, opt.NodeLimit, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return servertypes.Bandwidth{}, errorx.Wrap(err, "failed to sign bandwidth param")
+	}
+	b, err := c.client.UpdateBandwidth(ctx, &pb.UpdateBandwidthRequest{
+		Limit:       opt.Limit,
+		NodeLimit:   opt.NodeLimit,
+		CurrentTime: currentTime,
+		Token:       sig.String(),
+	})
+	if err != nil {
+		return servertypes.Bandwidth{}, parseError(err)
+	}
+	return pb.ToBandwidth(b), nil
+}
+
+// ListFileNs list file namespaces
+func (c *Client) ListFileNs(ctx context.Context, opt ListNsOptions) ([]blockchain.Namespace, string, error) {
+	nss, err := c.client.ListFileNs(ctx, &pb.ListNsRequest{
+		Owner:     opt.Owner,
+		TimeStart: opt.TimeStart,
+		TimeEnd:   opt.TimeEnd,
+		Limit:     opt.Limit,
+		Cursor:    opt.Next,
+	})
+	if err != nil {
+		return nil, "", parseError(err)
+	}
+	return pb.ToNamespaces(nss), nss.GetNext(), nil
+}
+
+// GetNsByName get namespace by name and owner
+func (c *Client) GetNsByName(ctx context.Context, owner, ns string) (blockchain.NamespaceH, error) {
+	nsh, err := c.client.GetNsByName(ctx, &pb.GetNsRequest{Owner: owner, Name: ns})
+	if err != nil {
+		return blockchain.NamespaceH{}, parseError(err)
+	}
+	return pb.ToNamespaceH(nsh), nil
+}
+
+// GetFileSysHealth get system health status of an owner
+func (c *Client) GetFileSysHealth(ctx context.Context, owner string) (blockchain.FileSysHealth, error) {
+	health, err := c.client.GetFileSysHealth(ctx, &pb.GetFileSysHealthRequest{Owner: owner})
+	if err != nil {
+		return blockchain.FileSysHealth{}, parseError(err)
+	}
+	return pb.ToFileSysHealth(health), nil
+}
+
+// GetChallengeByID get challenge info by challenge id
+func (c *Client) GetChallengeByID(ctx context.Context, id string) (blockchain.Challenge, error) {
+	challenge, err := c.client.GetChallengeByID(ctx, &pb.GetChallengeRequest{Id: id})
+	if err != nil {
+		return blockchain.Challenge{}, parseError(err)
+	}
+	return pb.ToChallenge(challenge), nil
+}
+
+// GetToProveChallenges get challenges with status "ToProve"
+func (c *Client) GetToProveChallenges(ctx context.Context, opt GetChallengesOptions) ([]blockchain.Challenge, string, error) {
+	return c.listChallenges(ctx, opt, blockchain.ChallengeToProve)
+}
+
+// GetProvedChallenges get challenges with status "Proved"
+func (c *Client) GetProvedChallenges(ctx context.Context, opt GetChallengesOptions) ([]blockchain.Challenge, string, error) {
+	return c.listChallenges(ctx, opt, blockchain.ChallengeProved)
+}
+
+// GetFailedChallenges get challenges with status "Failed"
+func (c *Client) GetFailedChallenges(ctx context.Context, opt GetChallengesOptions) ([]blockchain.Challenge, string, error) {
+	return c.listChallenges(ctx, opt, blockchain.ChallengeFailed)
+}
+
+func (c *Client) listChallenges(ctx context.Context, opt GetChallengesOptions, status string) ([]blockchain.Challenge, string, error) {
+	challenges, err := c.client.ListChallenges(ctx, &pb.ListChallengeRequest{
+		Owner:      opt.Owner,
+		TargetNode: opt.TargetNode,
+		FileID:     opt.FileID,
+		Status:     status,
+		TimeStart:  opt.TimeStart,
+		TimeEnd:    opt.TimeEnd,
+		Limit:      opt.Limit,
+		Cursor:     opt.Next,
+	})
+	if err != nil {
+		return nil, "", parseError(err)
+	}
+	return pb.ToChallenges(challenges), challenges.GetNext(), nil
+}
+
+// WatchEvents receives events and calls onEvent for each one,
+//  blocks until ctx is done or connection breaks
+func (c *Client) WatchEvents(ctx context.Context, opt WatchOptions, onEvent func(events.Event)) error {
+	stream, err := c.client.WatchEvents(ctx, &pb.WatchRequest{
+		LastID: opt.LastID,
+		Types:  opt.Types,
+	})
+	if err != nil {
+		return parseError(err)
+	}
+	for {
+		e, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err == io.EOF {
+				return errorx.New(errorx.ErrCodeInternal, "events stream closed by server")
+			}
+			return parseError(err)
+		}
+		onEvent(pb.ToEvent(e))
+	}
+}
+
+// parseError converts grpc status into errorx.Error,
+//  status message is an errorx.Error encoded in json if it's returned by xdb server
+func parseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to do request")
+	}
+	if code, message, success := errorx.TryParseFromString(s.Message()); success {
+		return errorx.New(code, message)
+	}
+	return errorx.New(errorx.ErrCodeInternal, "grpc status: %s, message: %s", s.Code(), s.Message())
+}
+
+// closeAndParseError gets the real error from server if sending on a client stream fails
+func closeAndParseError(stream grpcpkg.ClientStream, err error) error {
+	if err == io.EOF {
+		err = stream.RecvMsg(nil)
+	}
+	return parseError(err)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"io"
+
+	pb "github.com/PaddlePaddle/PaddleDTX/xdb/protos/xuperdb"
+)
+
+// streamReader reads content from a server stream of chunks, and cancels the stream when closed
+type streamReader struct {
+	io.Reader
+	cancel context.CancelFunc
+}
+
+// newStreamReader receives the first chunk in case of error, like http client checks status code
+func newStreamReader(recv func() (*pb.Chunk, error), cancel context.CancelFunc) (io.ReadCloser, error) {
+	first, err := recv()
+	if err != nil && err != io.EOF {
+		cancel()
+		return nil, parseError(err)
+	}
+	received := err == io.EOF
+	r := pb.NewChunkReader(func() ([]byte, error) {
+		if !received {
+			received = true
+			return first.GetData(), nil
+		}
+		m, err := recv()
+		if err != nil && err != io.EOF {
+			return nil, parseError(err)
+		}
+		return m.GetData(), err
+	})
+	return &streamReader{Reader: r, cancel: cancel}, nil
+}
+
+func (s *streamReader) Close() error {
+	s.cancel()
+	return nil
+}
@@ -14,17 +14,21 @@
 package http
 
 import (
+	"bufio"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/url"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/events"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
 	httpkg "github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/http"
@@ -52,6 +56,37 @@ func New(addr string) (Client, error) {
 	return c, nil
 }
 
+// TLSConfig creates TLS config of clients, certificates of servers are verified by CAs in caFile,
+//  and the certificate in certFile bound to privateKey is presented to servers requiring it if certFile is not empty
+func TLSConfig(caFile, certFile, privateKey string) (*tls.Config, error) {
+	cas, err := httpkg.LoadCertPool(caFile)
+	if err != nil {
+		return nil, err
+	}
+	if certFile == "" {
+		return httpkg.NewClientTLSConfig(nil, cas), nil
+	}
+	private, err := ecdsa.DecodePrivateKeyFromString(privateKey)
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeParam, "failed to decode private key of certificate")
+	}
+	cert, err := httpkg.LoadCertificate(certFile, private)
+	if err != nil {
+		return nil, err
+	}
+	return httpkg.NewClientTLSConfig(&cert, cas), nil
+}
+
+// UseTLS makes all clients go over TLS, see TLSConfig, addresses of servers should be "https://..."
+func UseTLS(caFile, certFile, privateKey string) error {
+	conf, err := TLSConfig(caFile, certFile, privateKey)
+	if err != nil {
+		return err
+	}
+	httpkg.SetTLSConfig(conf)
+	return nil
+}
+
 type WriteOptions struct {
 	PrivateKey string
 
@@ -60,6 +95,7 @@ type WriteOptions struct {
 	ExpireTime  int64
 	Description string
 	Extra       string
+	Tags        map[string]string
 }
 
 // Write upload a file
@@ -92,6 +128,9 @@ func (c *Client) Write(ctx context.Context, r io.Reader, opt WriteOptions) (
 	q.Add("desc", opt.Description)
 	q.Add("ext", opt.Extra)
 	q.Add("expireTime", strconv.FormatInt(opt.ExpireTime, 10))
+	for k, v := range opt.Tags {
+		q.Add("tag", k+"="+v)
+	}
 	url.RawQuery = q.Encode()
 
 	var resp servertypes.WriteResponse
@@ -102,6 +141,48 @@ func (c *Client) Write(ctx context.Context, r io.Reader, opt WriteOptions) (
 	return resp, nil
 }
 
+type AppendOptions struct {
+	PrivateKey string
+
+	FileID string
+}
+
+// Append appends data to an existing file
+func (c *Client) Append(ctx context.Context, r io.Reader, opt AppendOptions) (
+	servertypes.AppendResponse, error) {
+
+	privkey, err := ecdsa.DecodePrivateKeyFromString(opt.PrivateKey)
+	if err != nil {
+		return servertypes.AppendResponse{}, err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+	owner := pubkey.String()
+	tm := time.Now().UnixNano()
+
+	msg := fmt.Sprintf("%s:%s:%d", owner, opt.FileID, tm)
+	sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(msg)))
+	if err != nil {
+		return servertypes.AppendResponse{}, errorx.Wrap(err, "failed to sign")
+	}
+
+	url := c.baseAddr
+	joinPath(&url, "file", "append")
+
+	q := url.Query()
+	q.Add("user", owner)
+	q.Add("token", sig.String())
+	q.Add("file_id", opt.FileID)
+	q.Add("timestamp", strconv.FormatInt(tm, 10))
+	url.RawQuery = q.Encode()
+
+	var resp servertypes.AppendResponse
+	if err := httpkg.PostResponse(ctx, url.String(), r, &resp); err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
 // ReadOptions use FileID or Namespace+FileName
 type ReadOptions struct {
 	PrivateKey string
@@ -231,6 +312,38 @@ func (c *Client) GetNodeHeartbeat(ctx context.Context, id string, ctime int64) (
 	return res, nil
 }
 
+// ListHeartbeats lists heartbeats kept by the storage node between start and end
+func (c *Client) ListHeartbeats(ctx context.Context, start, end int64) ([]blockchain.SignedHeartbeat, error) {
+	url := c.baseAddr
+	joinPath(&url, "node", "heartbeats")
+	q := url.Query()
+	q.Add("start", strconv.FormatInt(start, 10))
+	q.Add("end", strconv.FormatInt(end, 10))
+	url.RawQuery = q.Encode()
+	var beats []blockchain.SignedHeartbeat
+	if err := httpkg.GetResponse(ctx, url.String(), &beats); err != nil {
+		return nil, err
+	}
+	return beats, nil
+}
+
+// ListHeartbeatCommits lists heartbeat commits of a storage node between start and end
+func (c *Client) ListHeartbeatCommits(ctx context.Context, id string, start, end int64) (
+	[]blockchain.HeartbeatCommit, error) {
+	url := c.baseAddr
+	joinPath(&url, "node", "hbcommits")
+	q := url.Query()
+	q.Add("id", id)
+	q.Add("start", strconv.FormatInt(start, 10))
+	q.Add("end", strconv.FormatInt(end, 10))
+	url.RawQuery = q.Encode()
+	var commits []blockchain.HeartbeatCommit
+	if err := httpkg.GetResponse(ctx, url.String(), &commits); err != nil {
+		return nil, err
+	}
+	return commits, nil
+}
+
 // GetMigrateRecords get storage node migrate records
 func (c *Client) GetMigrateRecords(ctx context.Context, id string, start, end int64, limit uint64) ([]map[string]interface{}, error) {
 	url := c.baseAddr
@@ -264,6 +377,14 @@ func (c *Client) GetNodeHealth(ctx context.Context, id string) (string, error) {
 
 // setNodeOnlineStatus set storage node status online/offline
 func (c *Client) setNodeOnlineStatus(ctx context.Context, privateKey string, online bool) error {
+	if online {
+		return c.operateNode(ctx, privateKey, "online")
+	}
+	return c.operateNode(ctx, privateKey, "offline")
+}
+
+// operateNode signs node id with nonce and calls the node api given by action
+func (c *Client) operateNode(ctx context.Context, privateKey, action string) error {
 	private, err := ecdsa.DecodePrivateKeyFromString(privateKey)
 	if err != nil {
 		return err
@@ -279,11 +400,7 @@ func (c *Client) setNodeOnlineStatus(ctx context.Context, privateKey string, onl
 	}
 
 	url := c.baseAddr
-	if online {
-		joinPath(&url, "node", "online")
-	} else {
-		joinPath(&url, "node", "offline")
-	}
+	joinPath(&url, "node", action)
 	q := url.Query()
 	q.Add("node", nodeID)
 	q.Add("nonce", strconv.FormatInt(nonce, 10))
@@ -305,6 +422,87 @@ func (c *Client) NodeOnline(ctx context.Context, privkey string) error {
 	return c.setNodeOnlineStatus(ctx, privkey, true)
 }
 
+// NodeDrain set storage node status draining, slices on it will be migrated to other nodes
+func (c *Client) NodeDrain(ctx context.Context, privkey string) error {
+	return c.operateNode(ctx, privkey, "drain")
+}
+
+// GetNodeDrainStatus get draining progress of storage node
+func (c *Client) GetNodeDrainStatus(ctx context.Context, id string) (blockchain.NodeDrainStatus, error) {
+	url := c.baseAddr
+	joinPath(&url, "node", "drainstatus")
+	q := url.Query()
+	q.Add("id", id)
+	url.RawQuery = q.Encode()
+	var status blockchain.NodeDrainStatus
+	if err := httpkg.GetResponse(ctx, url.String(), &status); err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// GetHealthPolicy gets node health policy of a version, version 0 means the current one
+func (c *Client) GetHealthPolicy(ctx context.Context, version int64) (blockchain.HealthPolicy, error) {
+	url := c.baseAddr
+	joinPath(&url, "node", "healthpolicy")
+	q := url.Query()
+	q.Add("version", strconv.FormatInt(version, 10))
+	url.RawQuery = q.Encode()
+	var policy blockchain.HealthPolicy
+	if err := httpkg.GetResponse(ctx, url.String(), &policy); err != nil {
+		return policy, err
+	}
+	return policy, nil
+}
+
+// UpdateHealthPolicy sets a new version of node health policy, adminKey is private key of the network admin
+func (c *Client) UpdateHealthPolicy(ctx context.Context, adminKey string, policy blockchain.HealthPolicy) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(adminKey)
+	if err != nil {
+		return err
+	}
+	currentTime := time.Now().UnixNano()
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(policy.Message(currentTime))))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign health policy")
+	}
+	p, err := json.Marshal(policy)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal health policy")
+	}
+
+	url := c.baseAddr
+	joinPath(&url, "node", "uhealthpolicy")
+	q := url.Query()
+	q.Add("policy", string(p))
+	q.Add("ctime", strconv.FormatInt(currentTime, 10))
+	q.Add("token", sig.String())
+	url.RawQuery = q.Encode()
+	if _, err := httpkg.Post(ctx, url.String(), nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SimulateNodeHealth scores existing storage nodes by the current health policy and by a proposed one
+func (c *Client) SimulateNodeHealth(ctx context.Context, policy blockchain.HealthPolicy) (
+	servertypes.HealthSimulation, error) {
+	var sim servertypes.HealthSimulation
+	p, err := json.Marshal(policy)
+	if err != nil {
+		return sim, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal health policy")
+	}
+	url := c.baseAddr
+	joinPath(&url, "node", "simhealth")
+	q := url.Query()
+	q.Add("policy", string(p))
+	url.RawQuery = q.Encode()
+	if err := httpkg.GetResponse(ctx, url.String(), &sim); err != nil {
+		return sim, err
+	}
+	return sim, nil
+}
+
 type ListFileOptions struct {
 	Owner     string
 	Namespace string
@@ -312,6 +510,7 @@ type ListFileOptions struct {
 	TimeStart int64
 	TimeEnd   int64
 	Limit     uint64
+	Next      string // cursor returned by the previous page, empty for the first page
 }
 
 type ListNsOptions struct {
@@ -320,10 +519,11 @@ type ListNsOptions struct {
 	TimeStart int64
 	TimeEnd   int64
 	Limit     uint64
+	Next      string
 }
 
 // ListFiles list unexpired files
-func (c *Client) ListFiles(ctx context.Context, opt ListFileOptions) ([]blockchain.File, error) {
+func (c *Client) ListFiles(ctx context.Context, opt ListFileOptions) ([]blockchain.File, string, error) {
 	url := c.baseAddr
 	joinPath(&url, "file", "list")
 	q := url.Query()
@@ -332,16 +532,60 @@ func (c *Client) ListFiles(ctx context.Context, opt ListFileOptions) ([]blockcha
 	q.Add("start", strconv.FormatInt(opt.TimeStart, 10))
 	q.Add("end", strconv.FormatInt(opt.TimeEnd, 10))
 	q.Add("limit", strconv.FormatUint(opt.Limit, 10))
+	q.Add("next", opt.Next)
 	url.RawQuery = q.Encode()
 	var files []blockchain.File
-	if err := httpkg.GetResponse(ctx, url.String(), &files); err != nil {
-		return nil, err
+	next, err := httpkg.GetPageResponse(ctx, url.String(), &files)
+	if err != nil {
+		return nil, "", err
 	}
-	return files, nil
+	return files, next, nil
+}
+
+// QueryFileOptions query files by metadata, zero values are not used as filters
+type QueryFileOptions struct {
+	Owner      string
+	Namespace  string
+	Tags       map[string]string
+	NamePrefix string
+
+	MinSize uint64
+	MaxSize uint64
+
+	ExpireTimeStart int64
+	ExpireTimeEnd   int64
+	Limit           uint64
+	Next            string
+}
+
+// QueryFiles query unexpired files by tags, name prefix, size range and expire time range
+func (c *Client) QueryFiles(ctx context.Context, opt QueryFileOptions) ([]blockchain.File, string, error) {
+	url := c.baseAddr
+	joinPath(&url, "file", "query")
+	q := url.Query()
+	q.Add("owner", opt.Owner)
+	q.Add("ns", opt.Namespace)
+	for k, v := range opt.Tags {
+		q.Add("tag", k+"="+v)
+	}
+	q.Add("prefix", opt.NamePrefix)
+	q.Add("minsize", strconv.FormatUint(opt.MinSize, 10))
+	q.Add("maxsize", strconv.FormatUint(opt.MaxSize, 10))
+	q.Add("expstart", strconv.FormatInt(opt.ExpireTimeStart, 10))
+	q.Add("expend", strconv.FormatInt(opt.ExpireTimeEnd, 10))
+	q.Add("limit", strconv.FormatUint(opt.Limit, 10))
+	q.Add("next", opt.Next)
+	url.RawQuery = q.Encode()
+	var files []blockchain.File
+	next, err := httpkg.GetPageResponse(ctx, url.String(), &files)
+	if err != nil {
+		return nil, "", err
+	}
+	return files, next, nil
 }
 
 // ListExpiredFiles list expired but valid files
-func (c *Client) ListExpiredFiles(ctx context.Context, opt ListFileOptions) ([]blockchain.File, error) {
+func (c *Client) ListExpiredFiles(ctx context.Context, opt ListFileOptions) ([]blockchain.File, string, error) {
 	url := c.baseAddr
 	joinPath(&url, "file", "listexp")
 	q := url.Query()
@@ -350,12 +594,14 @@ func (c *Client) ListExpiredFiles(ctx context.Context, opt ListFileOptions) ([]b
 	q.Add("start", strconv.FormatInt(opt.TimeStart, 10))
 	q.Add("end", strconv.FormatInt(opt.TimeEnd, 10))
 	q.Add("limit", strconv.FormatUint(opt.Limit, 10))
+	q.Add("next", opt.Next)
 	url.RawQuery = q.Encode()
 	var files []blockchain.File
-	if err := httpkg.GetResponse(ctx, url.String(), &files); err != nil {
-		return nil, err
+	next, err := httpkg.GetPageResponse(ctx, url.String(), &files)
+	if err != nil {
+		return nil, "", err
 	}
-	return files, nil
+	return files, next, nil
 }
 
 // GetFileByID get file info by file id
@@ -492,8 +738,412 @@ func (c *Client) UpdateFileNsReplica(ctx context.Context, priKey, ns string, rep
 	return nil
 }
 
+// UpdateFileNsQuota updates quota of file namespace, zero means unlimited
+func (c *Client) UpdateFileNsQuota(ctx context.Context, priKey, ns string, quota blockchain.NsQuota) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%d,%d,%d,%d", ns, quota.MaxBytes, quota.MaxFiles, quota.MaxFileSize, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign update ns quota param")
+	}
+
+	url := c.baseAddr
+	joinPath(&url, "file", "uquota")
+	q := url.Query()
+	q.Add("owner", pubkey.String())
+	q.Add("ns", ns)
+	q.Add("maxbytes", strconv.FormatUint(quota.MaxBytes, 10))
+	q.Add("maxfiles", strconv.FormatInt(quota.MaxFiles, 10))
+	q.Add("maxfilesize", strconv.FormatUint(quota.MaxFileSize, 10))
+	q.Add("ctime", strconv.FormatInt(currentTime, 10))
+	q.Add("token", sig.String())
+	url.RawQuery = q.Encode()
+	if _, err := httpkg.Post(ctx, url.String(), nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateFileNsRenewal updates renewal policy of file namespace, files are not renewed if policy is empty
+func (c *Client) UpdateFileNsRenewal(ctx context.Context, priKey, ns string, renewal blockchain.NsRenewal) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%d,%d,%d", ns, renewal.Policy, renewal.Days, renewal.Until, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign update ns renewal param")
+	}
+
+	url := c.baseAddr
+	joinPath(&url, "file", "urenewal")
+	q := url.Query()
+	q.Add("owner", pubkey.String())
+	q.Add("ns", ns)
+	q.Add("policy", renewal.Policy)
+	q.Add("days", strconv.Itoa(renewal.Days))
+	q.Add("until", strconv.FormatInt(renewal.Until, 10))
+	q.Add("ctime", strconv.FormatInt(currentTime, 10))
+	q.Add("token", sig.String())
+	url.RawQuery = q.Encode()
+	if _, err := httpkg.Post(ctx, url.String(), nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddNsMember adds a member into namespace or updates role of the member, signed by namespace owner or admin
+func (c *Client) AddNsMember(ctx context.Context, priKey, ns, member, role string) error {
+	return c.updateNsMember(ctx, "addnsmember", priKey, ns, member, role)
+}
+
+// RemoveNsMember removes a member from namespace, signed by namespace owner or admin
+func (c *Client) RemoveNsMember(ctx context.Context, priKey, ns, member string) error {
+	return c.updateNsMember(ctx, "removensmember", priKey, ns, member, "")
+}
+
+func (c *Client) updateNsMember(ctx context.Context, action, priKey, ns, member, role string) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%s,%d", ns, member, role, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign namespace member param")
+	}
+
+	url := c.baseAddr
+	joinPath(&url, "file", action)
+	q := url.Query()
+	q.Add("user", pubkey.String())
+	q.Add("ns", ns)
+	q.Add("member", member)
+	q.Add("role", role)
+	q.Add("ctime", strconv.FormatInt(currentTime, 10))
+	q.Add("token", sig.String())
+	url.RawQuery = q.Encode()
+	if _, err := httpkg.Post(ctx, url.String(), nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListNsMembers lists members of namespace
+func (c *Client) ListNsMembers(ctx context.Context, ns string) ([]blockchain.NsMember, error) {
+	url := c.baseAddr
+	joinPath(&url, "file", "listnsmembers")
+	q := url.Query()
+	q.Add("ns", ns)
+	url.RawQuery = q.Encode()
+	var members []blockchain.NsMember
+	if err := httpkg.GetResponse(ctx, url.String(), &members); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// ProposeTransfer proposes to transfer a file or a whole namespace if fileID is empty to newOwner,
+//  signed by the owner. Returns id of the transfer
+func (c *Client) ProposeTransfer(ctx context.Context, priKey, ns, fileID, newOwner, newNs string) (string, error) {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return "", err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%s,%s,%d", ns, fileID, newOwner, newNs, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return "", errorx.Wrap(err, "failed to sign propose transfer param")
+	}
+
+	url := c.baseAddr
+	joinPath(&url, "file", "proposetransfer")
+	q := url.Query()
+	q.Add("user", pubkey.String())
+	q.Add("ns", ns)
+	q.Add("file_id", fileID)
+	q.Add("new_owner", newOwner)
+	q.Add("new_ns", newNs)
+	q.Add("ctime", strconv.FormatInt(currentTime, 10))
+	q.Add("token", sig.String())
+	url.RawQuery = q.Encode()
+	var id string
+	if err := httpkg.PostResponse(ctx, url.String(), nil, &id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// AcceptTransfer accepts a transfer, signed by the new owner
+func (c *Client) AcceptTransfer(ctx context.Context, priKey, id string) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%d", id, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign accept transfer param")
+	}
+
+	url := c.baseAddr
+	joinPath(&url, "file", "accepttransfer")
+	q := url.Query()
+	q.Add("user", pubkey.String())
+	q.Add("id", id)
+	q.Add("ctime", strconv.FormatInt(currentTime, 10))
+	q.Add("token", sig.String())
+	url.RawQuery = q.Encode()
+	if _, err := httpkg.Post(ctx, url.String(), nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CancelTransfer cancels a transfer not completed yet, signed by the owner or the new owner
+func (c *Client) CancelTransfer(ctx context.Context, priKey, id string) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%d", blockchain.TransferCanceled, id, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign cancel transfer param")
+	}
+
+	url := c.baseAddr
+	joinPath(&url, "file", "canceltransfer")
+	q := url.Query()
+	q.Add("user", pubkey.String())
+	q.Add("id", id)
+	q.Add("ctime", strconv.FormatInt(currentTime, 10))
+	q.Add("token", sig.String())
+	url.RawQuery = q.Encode()
+	if _, err := httpkg.Post(ctx, url.String(), nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Handover fetches a file of an accepted transfer from the dataOwner node of the old owner, signed by the new owner,
+//  the file is encrypted with public key of the new owner, see ecies.Decrypt
+func (c *Client) Handover(ctx context.Context, priKey, id, fileID string) (servertypes.HandoverResponse, error) {
+	var resp servertypes.HandoverResponse
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return resp, err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%d", id, fileID, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to sign handover param")
+	}
+
+	url := c.baseAddr
+	joinPath(&url, "file", "handover")
+	q := url.Query()
+	q.Add("user", pubkey.String())
+	q.Add("id", id)
+	q.Add("file_id", fileID)
+	q.Add("ctime", strconv.FormatInt(currentTime, 10))
+	q.Add("token", sig.String())
+	url.RawQuery = q.Encode()
+	if err := httpkg.PostResponse(ctx, url.String(), nil, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// CompleteTransfer records that fileID has been re-uploaded as newFileID, signed by the new owner,
+//  contentHash and receipt are taken from the handover of fileID.
+//  Empty fileID and newFileID finish a namespace transfer
+func (c *Client) CompleteTransfer(ctx context.Context, priKey, id, fileID, newFileID, contentHash, receipt string) error {
+	private, err := ecdsa.DecodePrivateKeyFromString(priKey)
+	if err != nil {
+		return err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(private)
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%s,%s,%d", id, fileID, newFileID, contentHash, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign complete transfer param")
+	}
+
+	url := c.baseAddr
+	joinPath(&url, "file", "completetransfer")
+	q := url.Query()
+	q.Add("user", pubkey.String())
+	q.Add("id", id)
+	q.Add("file_id", fileID)
+	q.Add("new_file_id", newFileID)
+	q.Add("content_hash", contentHash)
+	q.Add("receipt", receipt)
+	q.Add("ctime", strconv.FormatInt(currentTime, 10))
+	q.Add("token", sig.String())
+	url.RawQuery = q.Encode()
+	if _, err := httpkg.Post(ctx, url.String(), nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTransfer gets a transfer by id
+func (c *Client) GetTransfer(ctx context.Context, id string) (blockchain.Transfer, error) {
+	url := c.baseAddr
+	joinPath(&url, "file", "gettransfer")
+	q := url.Query()
+	q.Add("id", id)
+	url.RawQuery = q.Encode()
+	var t blockchain.Transfer
+	if err := httpkg.GetResponse(ctx, url.String(), &t); err != nil {
+		return t, err
+	}
+	return t, nil
+}
+
+// ListTransfers lists transfers proposed by or to user, the dataOwner if user is empty
+func (c *Client) ListTransfers(ctx context.Context, user string, limit uint64) ([]blockchain.Transfer, error) {
+	url := c.baseAddr
+	joinPath(&url, "file", "listtransfers")
+	q := url.Query()
+	q.Add("user", user)
+	q.Add("limit", strconv.FormatUint(limit, 10))
+	url.RawQuery = q.Encode()
+	var ts []blockchain.Transfer
+	if err := httpkg.GetResponse(ctx, url.String(), &ts); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+type RebalanceOptions struct {
+	PrivateKey string
+
+	Namespace    string
+	MaxMoves     int
+	MoveInterval time.Duration
+	DryRun       bool
+}
+
+// Rebalance plans slice moves across storage nodes, and runs them on dataOwner node unless it's a dry run
+func (c *Client) Rebalance(ctx context.Context, opt RebalanceOptions) (servertypes.RebalancePlan, error) {
+	var plan servertypes.RebalancePlan
+	private, err := ecdsa.DecodePrivateKeyFromString(opt.PrivateKey)
+	if err != nil {
+		return plan, err
+	}
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%d,%d,%d", opt.Namespace, opt.MaxMoves, opt.MoveInterval, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return plan, errorx.Wrap(err, "failed to sign rebalance param")
+	}
+
+	url := c.baseAddr
+	joinPath(&url, "file", "rebalance")
+	q := url.Query()
+	q.Add("ns", opt.Namespace)
+	q.Add("limit", strconv.Itoa(opt.MaxMoves))
+	q.Add("interval", strconv.FormatInt(int64(opt.MoveInterval), 10))
+	q.Add("dryrun", strconv.FormatBool(opt.DryRun))
+	q.Add("ctime", strconv.FormatInt(currentTime, 10))
+	q.Add("token", sig.String())
+	url.RawQuery = q.Encode()
+	if err := httpkg.PostResponse(ctx, url.String(), nil, &plan); err != nil {
+		return plan, err
+	}
+	return plan, nil
+}
+
+// GetBandwidth gets bandwidth limits in effect on dataOwner node
+func (c *Client) GetBandwidth(ctx context.Context) (servertypes.Bandwidth, error) {
+	url := c.baseAddr
+	joinPath(&url, "file", "getbandwidth")
+	var b servertypes.Bandwidth
+	if err := httpkg.GetResponse(ctx, url.String(), &b); err != nil {
+		return b, err
+	}
+	return b, nil
+}
+
+// GetMaterialStats gets statistics of challenge materials kept by dataOwner node for each namespace
+func (c *Client) GetMaterialStats(ctx context.Context) (servertypes.MaterialReport, error) {
+	url := c.baseAddr
+	joinPath(&url, "file", "materials")
+	var r servertypes.MaterialReport
+	if err := httpkg.GetResponse(ctx, url.String(), &r); err != nil {
+		return r, err
+	}
+	return r, nil
+}
+
+type UpdateBandwidthOptions struct {
+	PrivateKey string
+
+	Limit     int64 // unit: byte per second, no limit if 0
+	NodeLimit int64 // unit: byte per second, no limit if 0
+}
+
+// UpdateBandwidth changes bandwidth limits of dataOwner node at runtime
+func (c *Client) UpdateBandwidth(ctx context.Context, opt UpdateBandwidthOptions) (servertypes.Bandwidth, error) {
+	var b servertypes.Bandwidth
+	private, err := ecdsa.DecodePrivateKeyFromString(opt.PrivateKey)
+	if err != nil {
+		return b, err
+	}
+
+	currentTime := time.Now().UnixNano()
+	m := fmt.Sprintf("%d,%d,%d", opt.Limit, opt.NodeLimit, currentTime)
+	sig, err := ecdsa.Sign(private, hash.Hash([]byte(m)))
+	if err != nil {
+		return b, errorx.Wrap(err, "failed to sign bandwidth param")
+	}
+
+	url := c.baseAddr
+	joinPath(&url, "file", "ubandwidth")
+	q := url.Query()
+	q.Add("limit", strconv.FormatInt(opt.Limit, 10))
+	q.Add("nodelimit", strconv.FormatInt(opt.NodeLimit, 10))
+	q.Add("ctime", strconv.FormatInt(currentTime, 10))
+	q.Add("token", sig.String())
+	url.RawQuery = q.Encode()
+	if err := httpkg.PostResponse(ctx, url.String(), nil, &b); err != nil {
+		return b, err
+	}
+	return b, nil
+}
+
 // ListFileNs list file namespaces
-func (c *Client) ListFileNs(ctx context.Context, opt ListNsOptions) ([]blockchain.Namespace, error) {
+func (c *Client) ListFileNs(ctx context.Context, opt ListNsOptions) ([]blockchain.Namespace, string, error) {
 	url := c.baseAddr
 	joinPath(&url, "file", "listns")
 	q := url.Query()
@@ -501,12 +1151,14 @@ func (c *Client) ListFileNs(ctx context.Context, opt ListNsOptions) ([]blockchai
 	q.Add("start", strconv.FormatInt(opt.TimeStart, 10))
 	q.Add("end", strconv.FormatInt(opt.TimeEnd, 10))
 	q.Add("limit", strconv.FormatUint(opt.Limit, 10))
+	q.Add("next", opt.Next)
 	url.RawQuery = q.Encode()
 	var nss []blockchain.Namespace
-	if err := httpkg.GetResponse(ctx, url.String(), &nss); err != nil {
-		return nil, err
+	next, err := httpkg.GetPageResponse(ctx, url.String(), &nss)
+	if err != nil {
+		return nil, "", err
 	}
-	return nss, nil
+	return nss, next, nil
 }
 
 // GetNsByName get namespace by name and owner
@@ -560,10 +1212,11 @@ type GetChallengesOptions struct {
 	TimeStart int64
 	TimeEnd   int64
 	Limit     uint64
+	Next      string
 }
 
 // GetToProveChallenges get challenges with status "ToProve"
-func (c *Client) GetToProveChallenges(ctx context.Context, opt GetChallengesOptions) ([]blockchain.Challenge, error) {
+func (c *Client) GetToProveChallenges(ctx context.Context, opt GetChallengesOptions) ([]blockchain.Challenge, string, error) {
 	url := c.baseAddr
 	joinPath(&url, "challenge", "toprove")
 	q := url.Query()
@@ -573,16 +1226,18 @@ func (c *Client) GetToProveChallenges(ctx context.Context, opt GetChallengesOpti
 	q.Add("start", strconv.FormatInt(opt.TimeStart, 10))
 	q.Add("end", strconv.FormatInt(opt.TimeEnd, 10))
 	q.Add("limit", strconv.FormatInt(int64(opt.Limit), 10))
+	q.Add("next", opt.Next)
 	url.RawQuery = q.Encode()
 	var challenges []blockchain.Challenge
-	if err := httpkg.GetResponse(ctx, url.String(), &challenges); err != nil {
-		return challenges, err
+	next, err := httpkg.GetPageResponse(ctx, url.String(), &challenges)
+	if err != nil {
+		return nil, "", err
 	}
-	return challenges, nil
+	return challenges, next, nil
 }
 
 // GetProvedChallenges get challenges with status "Proved"
-func (c *Client) GetProvedChallenges(ctx context.Context, opt GetChallengesOptions) ([]blockchain.Challenge, error) {
+func (c *Client) GetProvedChallenges(ctx context.Context, opt GetChallengesOptions) ([]blockchain.Challenge, string, error) {
 	url := c.baseAddr
 	joinPath(&url, "challenge", "proved")
 	q := url.Query()
@@ -592,16 +1247,18 @@ func (c *Client) GetProvedChallenges(ctx context.Context, opt GetChallengesOptio
 	q.Add("start", strconv.FormatInt(opt.TimeStart, 10))
 	q.Add("end", strconv.FormatInt(opt.TimeEnd, 10))
 	q.Add("limit", strconv.FormatInt(int64(opt.Limit), 10))
+	q.Add("next", opt.Next)
 	url.RawQuery = q.Encode()
 	var challenges []blockchain.Challenge
-	if err := httpkg.GetResponse(ctx, url.String(), &challenges); err != nil {
-		return challenges, err
+	next, err := httpkg.GetPageResponse(ctx, url.String(), &challenges)
+	if err != nil {
+		return nil, "", err
 	}
-	return challenges, nil
+	return challenges, next, nil
 }
 
 // GetFailedChallenges get challenges with status "Failed"
-func (c *Client) GetFailedChallenges(ctx context.Context, opt GetChallengesOptions) ([]blockchain.Challenge, error) {
+func (c *Client) GetFailedChallenges(ctx context.Context, opt GetChallengesOptions) ([]blockchain.Challenge, string, error) {
 	url := c.baseAddr
 	joinPath(&url, "challenge", "failed")
 	q := url.Query()
@@ -611,10 +1268,55 @@ func (c *Client) GetFailedChallenges(ctx context.Context, opt GetChallengesOptio
 	q.Add("start", strconv.FormatInt(opt.TimeStart, 10))
 	q.Add("end", strconv.FormatInt(opt.TimeEnd, 10))
 	q.Add("limit", strconv.FormatInt(int64(opt.Limit), 10))
+	q.Add("next", opt.Next)
 	url.RawQuery = q.Encode()
 	var challenges []blockchain.Challenge
-	if err := httpkg.GetResponse(ctx, url.String(), &challenges); err != nil {
-		return challenges, err
+	next, err := httpkg.GetPageResponse(ctx, url.String(), &challenges)
+	if err != nil {
+		return nil, "", err
+	}
+	return challenges, next, nil
+}
+
+// WatchOptions options for watching events
+type WatchOptions struct {
+	LastID uint64   // events after LastID are replayed if still kept by server
+	Types  []string // event types or prefixes like "file.", all types if empty
+}
+
+// WatchEvents receives server-sent events and calls onEvent for each one,
+//  blocks until ctx is done or connection breaks
+func (c *Client) WatchEvents(ctx context.Context, opt WatchOptions, onEvent func(events.Event)) error {
+	url := c.baseAddr
+	joinPath(&url, "events")
+	q := url.Query()
+	q.Add("last_id", strconv.FormatUint(opt.LastID, 10))
+	q.Add("types", strings.Join(opt.Types, ","))
+	url.RawQuery = q.Encode()
+
+	body, err := httpkg.Get(ctx, url.String())
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		var e events.Event
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &e); err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to decode event")
+		}
+		onEvent(e)
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err := scanner.Err(); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to read events")
 	}
-	return challenges, nil
+	return errorx.New(errorx.ErrCodeInternal, "events stream closed by server")
 }
@@ -57,8 +57,9 @@ var provedCmd = &cobra.Command{
 			TimeStart:  startTime,
 			TimeEnd:    endTime.UnixNano(),
 			Limit:      limit,
+			Next:       cursor,
 		}
-		challenges, err := client.GetProvedChallenges(context.Background(), opt)
+		challenges, next, err := client.GetProvedChallenges(context.Background(), opt)
 		if err != nil {
 			fmt.Printf("err：%v\n", err)
 			return
@@ -74,6 +75,9 @@ var provedCmd = &cobra.Command{
 			}
 		}
 		fmt.Printf("Proved challenges from %s to %s\nNum: %d\n\n", start, end, len(challenges))
+		if len(next) > 0 {
+			fmt.Printf("more challenges, continue with --next %s\n\n", next)
+		}
 	},
 }
 
@@ -86,6 +90,7 @@ func init() {
 	provedCmd.Flags().StringVarP(&start, "start", "s", "", "challenge before startTime, example '2021-06-10 12:00:00'")
 	provedCmd.Flags().StringVarP(&end, "end", "e", time.Unix(0, time.Now().UnixNano()).Format(timeTemplate), "challenge after endTime, example '2021-06-10 12:00:00'")
 	provedCmd.Flags().Uint64VarP(&limit, "limit", "l", 0, "limit")
+	provedCmd.Flags().StringVar(&cursor, "next", "", "cursor printed by the previous page to continue listing")
 	provedCmd.Flags().Int8VarP(&list, "list", "", 1, "show challenges list or not, 0 not to show")
 
 	provedCmd.MarkFlagRequired("node")
@@ -28,6 +28,7 @@ var (
 	start       string
 	end         string
 	limit       uint64
+	cursor      string // cursor of the page to list, printed by the previous page
 	list        int8
 )
 
@@ -57,8 +57,9 @@ var toProveCmd = &cobra.Command{
 			TimeStart:  startTime,
 			TimeEnd:    endTime.UnixNano(),
 			Limit:      limit,
+			Next:       cursor,
 		}
-		challenges, err := client.GetToProveChallenges(context.Background(), opt)
+		challenges, next, err := client.GetToProveChallenges(context.Background(), opt)
 		if err != nil {
 			fmt.Printf("err：%v\n", err)
 			return
@@ -73,6 +74,9 @@ var toProveCmd = &cobra.Command{
 			}
 		}
 		fmt.Printf("ToProve challenges from %s to %s\nNum: %d\n\n", start, end, len(challenges))
+		if len(next) > 0 {
+			fmt.Printf("more challenges, continue with --next %s\n\n", next)
+		}
 	},
 }
 
@@ -85,6 +89,7 @@ func init() {
 	toProveCmd.Flags().StringVarP(&start, "start", "s", "", "challenge before startTime, example '2021-06-10 12:00:00'")
 	toProveCmd.Flags().StringVarP(&end, "end", "e", time.Unix(0, time.Now().UnixNano()).Format(timeTemplate), "challenge after endTime, example '2021-06-10 12:00:00'")
 	toProveCmd.Flags().Uint64VarP(&limit, "limit", "l", 0, "limit")
+	toProveCmd.Flags().StringVar(&cursor, "next", "", "cursor printed by the previous page to continue listing")
 	toProveCmd.Flags().Int8VarP(&list, "list", "", 1, "show challenges list or not, 0 not to show")
 
 	toProveCmd.MarkFlagRequired("node")
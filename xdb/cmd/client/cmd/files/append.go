@@ -0,0 +1,71 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package files
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+)
+
+// appendCmd represents the command to append data to a file in xuper db
+var appendCmd = &cobra.Command{
+	Use:   "append",
+	Short: "append data to a file in xuper db without re-uploading it",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
+		f, err := os.OpenFile(input, os.O_RDONLY, 0600)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		defer f.Close()
+
+		opt := httpclient.AppendOptions{
+			PrivateKey: privateKey,
+			FileID:     fileID,
+		}
+
+		resp, err := client.Append(context.Background(), f, opt)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
+		fmt.Println("FileID:", resp.FileID)
+		fmt.Println("Length:", resp.Length)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(appendCmd)
+
+	appendCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key")
+	appendCmd.Flags().StringVarP(&input, "input", "i", "", "input file path of data to append")
+	appendCmd.Flags().StringVarP(&fileID, "fileid", "f", "", "file id")
+
+	appendCmd.MarkFlagRequired("privkey")
+	appendCmd.MarkFlagRequired("input")
+	appendCmd.MarkFlagRequired("fileid")
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package files
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/archive"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+)
+
+// exportListLimit number of files listed per page when exporting
+const exportListLimit = 100
+
+var signer string
+
+// exportCmd represents the command to export files of a namespace into a signed archive
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "export plain text and metadata of all unexpired files in a namespace into a signed tar archive",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		privkey, err := ecdsa.DecodePrivateKeyFromString(privateKey)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+
+		f, err := os.OpenFile(output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		n, err := exportNs(context.Background(), client, privkey, pubkey.String(), f)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			os.Remove(output)
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		fmt.Printf("exported %d files of namespace %s into %s\n", n, namespace, output)
+	},
+}
+
+// exportNs writes all unexpired files of namespace into w, and returns number of files exported
+func exportNs(ctx context.Context, client httpclient.Client, privkey ecdsa.PrivateKey, owner string, w io.Writer) (int, error) {
+	aw := archive.NewWriter(w, privkey, namespace)
+	opt := httpclient.ListFileOptions{
+		Owner:     owner,
+		Namespace: namespace,
+		TimeEnd:   time.Now().UnixNano(),
+		Limit:     exportListLimit,
+	}
+	n := 0
+	for {
+		files, next, err := client.ListFiles(ctx, opt)
+		if err != nil {
+			return n, err
+		}
+		for _, file := range files {
+			r, err := client.Read(ctx, httpclient.ReadOptions{
+				PrivateKey: privateKey,
+				FileID:     file.ID,
+			})
+			if err != nil {
+				return n, errorx.Wrap(err, "failed to read file %s", file.Name)
+			}
+			meta := archive.FileMeta{
+				Name:        file.Name,
+				Description: file.Description,
+				Ext:         string(file.Ext),
+				Tags:        file.Tags,
+				ExpireTime:  file.ExpireTime,
+				Length:      file.Length,
+			}
+			err = aw.Add(meta, r)
+			r.Close()
+			if err != nil {
+				return n, err
+			}
+			n++
+			fmt.Printf("exported %s\n", file.Name)
+		}
+		if next == "" {
+			break
+		}
+		opt.Next = next
+	}
+	return n, aw.Close()
+}
+
+// importCmd represents the command to import files from an archive into a namespace
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "import files from an archive made by export into a namespace, files already existing are skipped",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		privkey, err := ecdsa.DecodePrivateKeyFromString(privateKey)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+
+		f, err := os.Open(input)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		defer f.Close()
+
+		// verify the whole archive before uploading any file
+		m, err := archive.Verify(f)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		trusted := signer
+		if trusted == "" {
+			trusted = pubkey.String()
+		}
+		if m.Owner != trusted {
+			fmt.Printf("err：archive is signed by %s, specify --signer if it is trusted\n", m.Owner)
+			return
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
+		imported, skipped := 0, 0
+		ctx := context.Background()
+		err = archive.Walk(f, m, func(meta archive.FileMeta, r io.Reader) error {
+			now := time.Now().UnixNano()
+			if meta.ExpireTime <= now {
+				fmt.Printf("skipped %s, expired at %s\n", meta.Name, time.Unix(0, meta.ExpireTime).Format(timeTemplate))
+				skipped++
+				return nil
+			}
+			fh, err := client.GetFileByName(ctx, pubkey.String(), namespace, meta.Name)
+			if err == nil && fh.File.ExpireTime > now {
+				fmt.Printf("skipped %s, already exists as %s\n", meta.Name, fh.File.ID)
+				skipped++
+				return nil
+			}
+			if err != nil && !errorx.Is(err, errorx.ErrCodeNotFound) {
+				return errorx.Wrap(err, "failed to get file %s", meta.Name)
+			}
+
+			resp, err := client.Write(ctx, r, httpclient.WriteOptions{
+				PrivateKey:  privateKey,
+				Namespace:   namespace,
+				FileName:    meta.Name,
+				ExpireTime:  meta.ExpireTime,
+				Description: meta.Description,
+				Extra:       meta.Ext,
+				Tags:        meta.Tags,
+			})
+			if err != nil {
+				return errorx.Wrap(err, "failed to write file %s", meta.Name)
+			}
+			fmt.Printf("imported %s as %s\n", meta.Name, resp.FileID)
+			imported++
+			return nil
+		})
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+		}
+		fmt.Printf("imported %d files and skipped %d files of %d into namespace %s\n", imported, skipped, len(m.Files), namespace)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(importCmd)
+
+	exportCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key of namespace owner, which also signs the archive")
+	exportCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace to export")
+	exportCmd.Flags().StringVarP(&output, "output", "o", "", "archive file path, should not exist")
+
+	exportCmd.MarkFlagRequired("privkey")
+	exportCmd.MarkFlagRequired("namespace")
+	exportCmd.MarkFlagRequired("output")
+
+	importCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key of target namespace owner")
+	importCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "target namespace, it should exist")
+	importCmd.Flags().StringVarP(&input, "input", "i", "", "archive file path")
+	importCmd.Flags().StringVarP(&signer, "signer", "s", "", "public key of the exporter trusted, the owner of privkey by default")
+
+	importCmd.MarkFlagRequired("privkey")
+	importCmd.MarkFlagRequired("namespace")
+	importCmd.MarkFlagRequired("input")
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package files
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+)
+
+var (
+	bandwidthLimit     int64
+	bandwidthNodeLimit int64
+)
+
+// getBandwidthCmd represents the command to get bandwidth limits of dataOwner node
+var getBandwidthCmd = &cobra.Command{
+	Use:   "getbandwidth",
+	Short: "get bandwidth limits of slices transferred between dataOwner node and storage nodes",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		b, err := client.GetBandwidth(context.Background())
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		fmt.Printf("Limit: %d\nNodeLimit: %d\n", b.Limit, b.NodeLimit)
+	},
+}
+
+// updateBandwidthCmd represents the command to change bandwidth limits of dataOwner node at runtime
+var updateBandwidthCmd = &cobra.Command{
+	Use:   "ubandwidth",
+	Short: "update bandwidth limits of slices transferred between dataOwner node and storage nodes",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		if bandwidthLimit < 0 || bandwidthNodeLimit < 0 {
+			fmt.Printf("err: bad param, limit and nodelimit must not be less than 0\n")
+			return
+		}
+
+		opt := httpclient.UpdateBandwidthOptions{
+			PrivateKey: privateKey,
+			Limit:      bandwidthLimit,
+			NodeLimit:  bandwidthNodeLimit,
+		}
+		b, err := client.UpdateBandwidth(context.Background(), opt)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		fmt.Printf("Limit: %d\nNodeLimit: %d\n", b.Limit, b.NodeLimit)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(getBandwidthCmd)
+	rootCmd.AddCommand(updateBandwidthCmd)
+
+	updateBandwidthCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key of dataOwner node")
+	updateBandwidthCmd.Flags().Int64VarP(&bandwidthLimit, "limit", "l", 0, "total bandwidth of dataOwner node, unit: byte per second, no limit if 0")
+	updateBandwidthCmd.Flags().Int64VarP(&bandwidthNodeLimit, "nodelimit", "p", 0, "bandwidth to each storage node, unit: byte per second, no limit if 0")
+
+	updateBandwidthCmd.MarkFlagRequired("privkey")
+}
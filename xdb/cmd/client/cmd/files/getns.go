@@ -20,6 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
 	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
 )
 
@@ -45,10 +46,37 @@ var getNsCmd = &cobra.Command{
 
 		fmt.Printf("Name: %s\nFileTotalNum: %d\nFileNormalNum: %d\nFileExpiredNum: %d\nReplica: %d\nGreenFileNum: %d\nYellowFileNum: %d\nRedFileNum: %d\n",
 			ns.Name, ns.FileTotalNum, nsh.FileNormalNum, nsh.FileExpiredNum, ns.Replica, nsh.GreenFileNum, nsh.YellowFileNum, nsh.RedFileNum)
+		maxFileSize := "unlimited"
+		if ns.Quota.MaxFileSize > 0 {
+			maxFileSize = fmt.Sprintf("%d", ns.Quota.MaxFileSize)
+		}
+		fmt.Printf("UsedBytes: %s\nUsedFiles: %s\nMaxFileSize: %s\n",
+			quotaUsage(ns.FilesTotalSize, ns.Quota.MaxBytes),
+			quotaUsage(uint64(ns.FileActiveNum), uint64(ns.Quota.MaxFiles)), maxFileSize)
+		fmt.Printf("Renewal: %s\n", renewalPolicy(ns.Renewal))
 		fmt.Printf("Description: %s\nUpdateTime: %s\nCreateTime: %s\n\n", ns.Description, utime, ctime)
 	},
 }
 
+// quotaUsage formats usage of a quota with percentage, limit 0 means unlimited
+func quotaUsage(used, limit uint64) string {
+	if limit == 0 {
+		return fmt.Sprintf("%d / unlimited", used)
+	}
+	return fmt.Sprintf("%d / %d (%.2f%%)", used, limit, float64(used)*100/float64(limit))
+}
+
+// renewalPolicy formats renewal policy of namespace
+func renewalPolicy(r blockchain.NsRenewal) string {
+	switch r.Policy {
+	case blockchain.NsRenewalUntil:
+		return fmt.Sprintf("renew by %d days until %s", r.Days, time.Unix(0, r.Until).Format(timeTemplate))
+	case blockchain.NsRenewalTask:
+		return fmt.Sprintf("renew by %d days while used by active dai tasks", r.Days)
+	}
+	return "none"
+}
+
 func init() {
 	rootCmd.AddCommand(getNsCmd)
 
@@ -55,8 +55,9 @@ var listFilesCmd = &cobra.Command{
 			TimeStart: startTime,
 			TimeEnd:   endTime.UnixNano(),
 			Limit:     limit,
+			Next:      cursor,
 		}
-		resp, err := client.ListFiles(context.Background(), opt)
+		resp, next, err := client.ListFiles(context.Background(), opt)
 		if err != nil {
 			fmt.Printf("err：%v\n", err)
 			return
@@ -72,6 +73,9 @@ var listFilesCmd = &cobra.Command{
 		} else {
 			fmt.Printf("\nfiles num from %s to %s: %d\n\n", start, end, len(resp))
 		}
+		if len(next) > 0 {
+			fmt.Printf("more files, continue with --next %s\n\n", next)
+		}
 	},
 }
 
@@ -107,8 +111,9 @@ var listExpFilesCmd = &cobra.Command{
 			TimeStart: startTime,
 			TimeEnd:   endTime.UnixNano(),
 			Limit:     limit,
+			Next:      cursor,
 		}
-		resp, err := client.ListExpiredFiles(context.Background(), opt)
+		resp, next, err := client.ListExpiredFiles(context.Background(), opt)
 		if err != nil {
 			fmt.Printf("err：%v\n", err)
 			return
@@ -124,6 +129,9 @@ var listExpFilesCmd = &cobra.Command{
 		} else {
 			fmt.Printf("\nfiles num from %s to %s: %d\n\n", start, end, len(resp))
 		}
+		if len(next) > 0 {
+			fmt.Printf("more files, continue with --next %s\n\n", next)
+		}
 	},
 }
 
@@ -136,6 +144,7 @@ func init() {
 	listFilesCmd.Flags().StringVarP(&start, "start", "s", "", "file publish after startTime, example '2021-06-10 12:00:00'")
 	listFilesCmd.Flags().StringVarP(&end, "end", "e", time.Unix(0, time.Now().UnixNano()).Format(timeTemplate), "file publish before endTime, example '2021-06-10 12:00:00'")
 	listFilesCmd.Flags().Uint64VarP(&limit, "limit", "l", 0, "limit for list file, 0 for unlimited")
+	listFilesCmd.Flags().StringVar(&cursor, "next", "", "cursor printed by the previous page to continue listing")
 
 	listFilesCmd.MarkFlagRequired("owner")
 	listFilesCmd.MarkFlagRequired("namespace")
@@ -145,6 +154,7 @@ func init() {
 	listExpFilesCmd.Flags().StringVarP(&start, "start", "s", "", "file publish after startTime, example '2021-06-10 12:00:00'")
 	listExpFilesCmd.Flags().StringVarP(&end, "end", "e", time.Unix(0, time.Now().UnixNano()).Format(timeTemplate), "file publish before endTime, example '2021-06-10 12:00:00'")
 	listExpFilesCmd.Flags().Uint64VarP(&limit, "limit", "l", 0, "limit for list file, 0 for unlimited")
+	listExpFilesCmd.Flags().StringVar(&cursor, "next", "", "cursor printed by the previous page to continue listing")
 
 	listExpFilesCmd.MarkFlagRequired("owner")
 	listExpFilesCmd.MarkFlagRequired("namespace")
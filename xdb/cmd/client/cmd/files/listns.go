@@ -52,9 +52,10 @@ var nsListCmd = &cobra.Command{
 			TimeStart: startTime,
 			TimeEnd:   endTime.UnixNano(),
 			Limit:     limit,
+			Next:      cursor,
 		}
 
-		response, err := client.ListFileNs(context.Background(), opt)
+		response, next, err := client.ListFileNs(context.Background(), opt)
 		if err != nil {
 			fmt.Printf("err：%v\n", err)
 			return
@@ -69,6 +70,9 @@ var nsListCmd = &cobra.Command{
 		if len(response) == 0 {
 			fmt.Printf("\nno ns, please add first\n\n")
 		}
+		if len(next) > 0 {
+			fmt.Printf("more namespaces, continue with --next %s\n\n", next)
+		}
 	},
 }
 
@@ -79,6 +83,7 @@ func init() {
 	nsListCmd.Flags().StringVarP(&start, "start", "s", "", "ns create after startTime, example '2021-06-10 12:00:00'")
 	nsListCmd.Flags().StringVarP(&end, "end", "e", time.Unix(0, time.Now().UnixNano()).Format(timeTemplate), "ns create before endTime, example '2021-06-10 12:00:00'")
 	nsListCmd.Flags().Uint64VarP(&limit, "limit", "l", 0, "limit for list ns")
+	nsListCmd.Flags().StringVar(&cursor, "next", "", "cursor printed by the previous page to continue listing")
 
 	nsListCmd.MarkFlagRequired("owner")
 }
@@ -0,0 +1,55 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package files
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+)
+
+// materialsCmd represents the command to report merkle challenge materials kept by dataOwner node
+var materialsCmd = &cobra.Command{
+	Use:   "materials",
+	Short: "report counts and disk usage of merkle challenge materials of each namespace",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		r, err := client.GetMaterialStats(context.Background())
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		fmt.Printf("%-20s %-8s %-10s %-10s %-10s %-12s\n", "Namespace", "Files", "Records", "Ranges", "Used", "Size")
+		for _, s := range r.Namespaces {
+			ns := s.Namespace
+			// files deleted from blockchain, materials are dropped in next compaction
+			if ns == "" {
+				ns = "-"
+			}
+			fmt.Printf("%-20s %-8d %-10d %-10d %-10d %-12d\n", ns, s.Files, s.Records, s.Ranges, s.Used, s.Size)
+		}
+		fmt.Printf("\nDiskUsage: %d\n", r.DiskUsage)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(materialsCmd)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package files
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+)
+
+var (
+	member string
+	role   string
+)
+
+// nsMemberCmd represents the command to manage namespace members
+var nsMemberCmd = &cobra.Command{
+	Use:   "ns-member",
+	Short: "manage members of file namespace, namespace owner or admins are allowed",
+}
+
+// nsMemberAddCmd represents the command to add a namespace member
+var nsMemberAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "add a member into namespace, or update role of the member",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		if !blockchain.NsRoleAllows(role, blockchain.NsRoleReader) {
+			fmt.Printf("err: bad param, role should be one of admin, writer and reader\n")
+			return
+		}
+
+		if err := client.AddNsMember(context.Background(), privateKey, namespace, member, role); err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		fmt.Println("OK")
+	},
+}
+
+// nsMemberRemoveCmd represents the command to remove a namespace member
+var nsMemberRemoveCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "remove a member from namespace",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
+		if err := client.RemoveNsMember(context.Background(), privateKey, namespace, member); err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		fmt.Println("OK")
+	},
+}
+
+// nsMemberListCmd represents the command to list namespace members
+var nsMemberListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list members of namespace",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
+		members, err := client.ListNsMembers(context.Background(), namespace)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		for _, m := range members {
+			atime := time.Unix(0, m.AddTime).Format(timeTemplate)
+			fmt.Printf("Member: %s\nRole: %s\nAddTime: %s\n\n", hex.EncodeToString(m.Member), m.Role, atime)
+		}
+		if len(members) == 0 {
+			fmt.Printf("\nno member in ns\n\n")
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(nsMemberCmd)
+	nsMemberCmd.AddCommand(nsMemberAddCmd, nsMemberRemoveCmd, nsMemberListCmd)
+
+	nsMemberAddCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key of namespace owner or admin")
+	nsMemberAddCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "file namespace")
+	nsMemberAddCmd.Flags().StringVarP(&member, "member", "m", "", "public key of member")
+	nsMemberAddCmd.Flags().StringVarP(&role, "role", "r", blockchain.NsRoleReader, "role of member, admin, writer or reader")
+	nsMemberAddCmd.MarkFlagRequired("privkey")
+	nsMemberAddCmd.MarkFlagRequired("namespace")
+	nsMemberAddCmd.MarkFlagRequired("member")
+
+	nsMemberRemoveCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key of namespace owner or admin")
+	nsMemberRemoveCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "file namespace")
+	nsMemberRemoveCmd.Flags().StringVarP(&member, "member", "m", "", "public key of member")
+	nsMemberRemoveCmd.MarkFlagRequired("privkey")
+	nsMemberRemoveCmd.MarkFlagRequired("namespace")
+	nsMemberRemoveCmd.MarkFlagRequired("member")
+
+	nsMemberListCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "file namespace")
+	nsMemberListCmd.MarkFlagRequired("namespace")
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package files
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+)
+
+var (
+	namePrefix string
+	minSize    uint64
+	maxSize    uint64
+	expStart   string
+	expEnd     string
+)
+
+// queryFilesCmd represents the command to query files by tags and metadata
+var queryFilesCmd = &cobra.Command{
+	Use:   "query",
+	Short: "query files of xuper db by tags, name prefix, size and expire time",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
+		fileTags, err := parseTags(tags)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		if len(namespace) == 0 && len(fileTags) == 0 {
+			fmt.Printf("err: bad param, namespace or tags must be given\n")
+			return
+		}
+
+		var expStartTime, expEndTime int64
+		if expStart != "" {
+			s, err := time.ParseInLocation(timeTemplate, expStart, time.Local)
+			if err != nil {
+				fmt.Printf("err：%v\n", err)
+				return
+			}
+			expStartTime = s.UnixNano()
+		}
+		if expEnd != "" {
+			e, err := time.ParseInLocation(timeTemplate, expEnd, time.Local)
+			if err != nil {
+				fmt.Printf("err：%v\n", err)
+				return
+			}
+			expEndTime = e.UnixNano()
+		}
+
+		opt := httpclient.QueryFileOptions{
+			Owner:           owner,
+			Namespace:       namespace,
+			Tags:            fileTags,
+			NamePrefix:      namePrefix,
+			MinSize:         minSize,
+			MaxSize:         maxSize,
+			ExpireTimeStart: expStartTime,
+			ExpireTimeEnd:   expEndTime,
+			Limit:           limit,
+			Next:            cursor,
+		}
+		resp, next, err := client.QueryFiles(context.Background(), opt)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		for _, f := range resp {
+			ptime := time.Unix(0, f.PublishTime).Format(timeTemplate)
+			etime := time.Unix(0, f.ExpireTime).Format(timeTemplate)
+			fmt.Printf("FileID: %s\nFileName: %s\nFileDescription: %s\nNamespace: %s\nFileLength: %v\nTags: %v\nPublishTimes: %s\nExpireTime: %s\n\n",
+				f.ID, f.Name, f.Description, f.Namespace, f.Length, f.Tags, ptime, etime)
+		}
+		if len(resp) == 0 {
+			fmt.Printf("\nno files\n\n")
+		} else {
+			fmt.Printf("\nfiles num: %d\n\n", len(resp))
+		}
+		if len(next) > 0 {
+			fmt.Printf("more files, continue with --next %s\n\n", next)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(queryFilesCmd)
+
+	queryFilesCmd.Flags().StringVarP(&owner, "owner", "o", "", "owner for file")
+	queryFilesCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace for file, all namespaces if empty")
+	queryFilesCmd.Flags().StringArrayVar(&tags, "tag", nil, "file tag to match, example 'feature_set=credit', could be used multiple times")
+	queryFilesCmd.Flags().StringVarP(&namePrefix, "prefix", "p", "", "prefix of file name")
+	queryFilesCmd.Flags().Uint64Var(&minSize, "minsize", 0, "min plain text length of file")
+	queryFilesCmd.Flags().Uint64Var(&maxSize, "maxsize", 0, "max plain text length of file, 0 for unlimited")
+	queryFilesCmd.Flags().StringVar(&expStart, "expstart", "", "file expires after the time, example '2021-06-10 12:00:00'")
+	queryFilesCmd.Flags().StringVar(&expEnd, "expend", "", "file expires before the time, example '2021-06-10 12:00:00'")
+	queryFilesCmd.Flags().Uint64VarP(&limit, "limit", "l", 0, "limit for query file, 0 or more than 100 for 100")
+	queryFilesCmd.Flags().StringVar(&cursor, "next", "", "cursor printed by the previous page to continue listing")
+
+	queryFilesCmd.MarkFlagRequired("owner")
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package files
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+)
+
+var (
+	maxMoves     int
+	moveInterval int
+	dryRun       bool
+)
+
+// rebalanceCmd represents the command to rebalance file slices across storage nodes
+var rebalanceCmd = &cobra.Command{
+	Use:   "rebalance",
+	Short: "move file slices to even out usage of storage nodes, e.g. after new nodes join",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		if maxMoves < 0 || moveInterval < 0 {
+			fmt.Printf("err: bad param, limit and interval must not be less than 0\n")
+			return
+		}
+
+		opt := httpclient.RebalanceOptions{
+			PrivateKey:   privateKey,
+			Namespace:    namespace,
+			MaxMoves:     maxMoves,
+			MoveInterval: time.Duration(moveInterval) * time.Second,
+			DryRun:       dryRun,
+		}
+		plan, err := client.Rebalance(context.Background(), opt)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
+		fmt.Printf("Nodes:\n")
+		for _, n := range plan.Nodes {
+			fmt.Printf("NodeID: %s\nName: %s\nUsageBefore: %d\nUsageAfter: %d\n\n", n.NodeID, n.Name, n.Before, n.After)
+		}
+		fmt.Printf("Moves:\n")
+		for _, m := range plan.Moves {
+			fmt.Printf("FileID: %s\nNamespace: %s\nSliceID: %s\nLength: %d\nFrom: %s\nTo: %s\n\n",
+				m.FileID, m.Namespace, m.SliceID, m.Length, m.From, m.To)
+		}
+		if len(plan.Moves) == 0 {
+			fmt.Printf("nodes are balanced, no slice needs to move\n")
+			return
+		}
+		if plan.DryRun {
+			fmt.Printf("dry run, %d slice moves planned\n", len(plan.Moves))
+		} else {
+			fmt.Printf("rebalance started, %d slice moves planned\n", len(plan.Moves))
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(rebalanceCmd)
+
+	rebalanceCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key of dataOwner node")
+	rebalanceCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "only rebalance files in the namespace, all namespaces if empty")
+	rebalanceCmd.Flags().IntVarP(&maxMoves, "limit", "l", 0, "maximum number of slices to move, no limit if 0")
+	rebalanceCmd.Flags().IntVarP(&moveInterval, "interval", "i", 5, "seconds to wait between two slice moves")
+	rebalanceCmd.Flags().BoolVar(&dryRun, "dry-run", false, "only print the plan without moving slices")
+
+	rebalanceCmd.MarkFlagRequired("privkey")
+}
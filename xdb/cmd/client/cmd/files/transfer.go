@@ -0,0 +1,348 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package files
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecies"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+	servertypes "github.com/PaddlePaddle/PaddleDTX/xdb/server/types"
+)
+
+var (
+	newOwner     string
+	newNamespace string
+	fromHost     string
+	user         string
+)
+
+// transferCmd represents the command to transfer ownership of files between dataOwners
+var transferCmd = &cobra.Command{
+	Use:   "transfer",
+	Short: "transfer ownership of a file or a namespace to another dataOwner",
+}
+
+// transferProposeCmd represents the command to propose a transfer
+var transferProposeCmd = &cobra.Command{
+	Use:   "propose",
+	Short: "propose to transfer a file, or the whole namespace if file id is not given, signed by the owner",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		tid, err := client.ProposeTransfer(context.Background(), privateKey, namespace, id, newOwner, newNamespace)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		fmt.Printf("TransferID: %s\n", tid)
+	},
+}
+
+// transferAcceptCmd represents the command to accept a transfer
+var transferAcceptCmd = &cobra.Command{
+	Use:   "accept",
+	Short: "accept a transfer on dataOwner of the new owner, the new namespace should exist",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		if err := client.AcceptTransfer(context.Background(), privateKey, id); err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		fmt.Println("OK")
+	},
+}
+
+// transferCancelCmd represents the command to cancel a transfer
+var transferCancelCmd = &cobra.Command{
+	Use:   "cancel",
+	Short: "cancel a transfer not completed yet, by the owner or the new owner",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		if err := client.CancelTransfer(context.Background(), privateKey, id); err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		fmt.Println("OK")
+	},
+}
+
+// transferFetchCmd represents the command to move files of an accepted transfer to the new owner
+var transferFetchCmd = &cobra.Command{
+	Use:   "fetch",
+	Short: "get files of an accepted transfer handed over by dataOwner of the old owner, and re-upload them to the new owner",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		from, err := httpclient.New(fromHost)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		privkey, err := ecdsa.DecodePrivateKeyFromString(privateKey)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+
+		ctx := context.Background()
+		t, err := client.GetTransfer(ctx, id)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		if t.Status != blockchain.TransferAccepted {
+			fmt.Printf("err：transfer is %s, only accepted transfers could be fetched\n", t.Status)
+			return
+		}
+		if !bytes.Equal(t.NewOwner, pubkey[:]) {
+			fmt.Printf("err：privkey is not of the new owner %x\n", t.NewOwner)
+			return
+		}
+
+		n, err := fetchTransfer(ctx, client, from, pubkey.String(), t)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			fmt.Printf("%d files transferred, run fetch again to continue\n", n)
+			return
+		}
+		// a namespace transfer finishes only after all files of the namespace are replaced,
+		//  including files added to the namespace while fetching
+		if len(t.FileID) == 0 {
+			if err := client.CompleteTransfer(ctx, privateKey, t.ID, "", "", "", ""); err != nil {
+				fmt.Printf("err：%v\n", err)
+				fmt.Printf("%d files transferred, run fetch again to continue\n", n)
+				return
+			}
+		}
+		fmt.Printf("%d files transferred into namespace %s, transfer completed\n", n, t.NewNamespace)
+	},
+}
+
+// fetchTransfer re-uploads files of transfer not transferred yet, and returns number of files transferred
+func fetchTransfer(ctx context.Context, client, from httpclient.Client, pubkey string, t blockchain.Transfer) (int, error) {
+	var files []blockchain.File
+	if len(t.FileID) > 0 {
+		fh, err := client.GetFileByID(ctx, t.FileID)
+		if err != nil {
+			return 0, err
+		}
+		files = append(files, fh.File)
+	} else {
+		opt := httpclient.ListFileOptions{
+			Owner:     hex.EncodeToString(t.Owner),
+			Namespace: t.Namespace,
+			TimeEnd:   time.Now().UnixNano(),
+			Limit:     exportListLimit,
+		}
+		for {
+			fs, next, err := client.ListFiles(ctx, opt)
+			if err != nil {
+				return 0, err
+			}
+			files = append(files, fs...)
+			if next == "" {
+				break
+			}
+			opt.Next = next
+		}
+	}
+
+	n := 0
+	for _, file := range files {
+		if _, ok := t.Files[file.ID]; ok {
+			continue
+		}
+		newID, h, err := reuploadFile(ctx, client, from, pubkey, t, file)
+		if err != nil {
+			return n, err
+		}
+		if err := client.CompleteTransfer(ctx, privateKey, t.ID, file.ID, newID, h.ContentHash, h.Receipt); err != nil {
+			return n, errorx.Wrap(err, "failed to complete transfer of file %s", file.Name)
+		}
+		n++
+		fmt.Printf("transferred %s as %s\n", file.Name, newID)
+	}
+	return n, nil
+}
+
+// reuploadFile gets file handed over by dataOwner of the old owner and writes it into the new namespace,
+//  a file already re-uploaded by an interrupted fetch is reused. The handover carries receipt of the old owner
+//  required to complete the transfer of the file
+func reuploadFile(ctx context.Context, client, from httpclient.Client, pubkey string, t blockchain.Transfer,
+	file blockchain.File) (string, servertypes.HandoverResponse, error) {
+	h, err := from.Handover(ctx, privateKey, t.ID, file.ID)
+	if err != nil {
+		return "", h, errorx.Wrap(err, "failed to get handover of file %s", file.Name)
+	}
+	privkey, err := ecdsa.DecodePrivateKeyFromString(privateKey)
+	if err != nil {
+		return "", h, err
+	}
+	plain, err := ecies.Decrypt(privkey, h.Ciphertext)
+	if err != nil {
+		return "", h, errorx.Wrap(err, "failed to decrypt file %s", file.Name)
+	}
+	if hex.EncodeToString(hash.Hash(plain)) != h.ContentHash || uint64(len(plain)) != file.Length {
+		return "", h, errorx.New(errorx.ErrCodeInternal, "file %s handed over does not match its hash", file.Name)
+	}
+
+	fh, err := client.GetFileByName(ctx, pubkey, t.NewNamespace, file.Name)
+	if err == nil && fh.File.Length == file.Length && fh.File.ExpireTime > time.Now().UnixNano() {
+		return fh.File.ID, h, nil
+	}
+	if err != nil && !errorx.Is(err, errorx.ErrCodeNotFound) {
+		return "", h, errorx.Wrap(err, "failed to get file %s", file.Name)
+	}
+
+	resp, err := client.Write(ctx, bytes.NewReader(plain), httpclient.WriteOptions{
+		PrivateKey:  privateKey,
+		Namespace:   t.NewNamespace,
+		FileName:    file.Name,
+		ExpireTime:  file.ExpireTime,
+		Description: file.Description,
+		Extra:       string(file.Ext),
+		Tags:        file.Tags,
+	})
+	if err != nil {
+		return "", h, errorx.Wrap(err, "failed to write file %s", file.Name)
+	}
+	return resp.FileID, h, nil
+}
+
+// transferGetCmd represents the command to get a transfer
+var transferGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "get a transfer by id",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		t, err := client.GetTransfer(context.Background(), id)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		printTransfer(t)
+		for old, nid := range t.Files {
+			fmt.Printf("  %s => %s\n", old, nid)
+		}
+	},
+}
+
+// transferListCmd represents the command to list transfers
+var transferListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list transfers proposed by or to a dataOwner",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		ts, err := client.ListTransfers(context.Background(), user, limit)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		for _, t := range ts {
+			printTransfer(t)
+		}
+		if len(ts) == 0 {
+			fmt.Printf("\nno transfer found\n\n")
+		}
+	},
+}
+
+func printTransfer(t blockchain.Transfer) {
+	ptime := time.Unix(0, t.ProposeTime).Format(timeTemplate)
+	fmt.Printf("TransferID: %s\nStatus: %s\nOwner: %x\nNamespace: %s\nFileID: %s\nNewOwner: %x\nNewNamespace: %s\nProposeTime: %s\n",
+		t.ID, t.Status, t.Owner, t.Namespace, t.FileID, t.NewOwner, t.NewNamespace, ptime)
+	if t.AcceptTime > 0 {
+		fmt.Printf("AcceptTime: %s\n", time.Unix(0, t.AcceptTime).Format(timeTemplate))
+	}
+	if t.CompleteTime > 0 {
+		fmt.Printf("CompleteTime: %s\n", time.Unix(0, t.CompleteTime).Format(timeTemplate))
+	}
+	if t.CancelTime > 0 {
+		fmt.Printf("CancelTime: %s\n", time.Unix(0, t.CancelTime).Format(timeTemplate))
+	}
+	fmt.Printf("TransferredFiles: %d\n\n", len(t.Files))
+}
+
+func init() {
+	rootCmd.AddCommand(transferCmd)
+	transferCmd.AddCommand(transferProposeCmd, transferAcceptCmd, transferCancelCmd, transferFetchCmd, transferGetCmd,
+		transferListCmd)
+
+	transferProposeCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key of the owner")
+	transferProposeCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace to transfer, or namespace of the file")
+	transferProposeCmd.Flags().StringVarP(&id, "id", "i", "", "id of file to transfer, the whole namespace if empty")
+	transferProposeCmd.Flags().StringVarP(&newOwner, "to", "t", "", "public key of the new owner")
+	transferProposeCmd.Flags().StringVarP(&newNamespace, "newns", "N", "", "namespace of the new owner to put files into")
+	transferProposeCmd.MarkFlagRequired("privkey")
+	transferProposeCmd.MarkFlagRequired("namespace")
+	transferProposeCmd.MarkFlagRequired("to")
+	transferProposeCmd.MarkFlagRequired("newns")
+
+	transferAcceptCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key of the new owner")
+	transferAcceptCmd.Flags().StringVarP(&id, "id", "i", "", "transfer id")
+	transferAcceptCmd.MarkFlagRequired("privkey")
+	transferAcceptCmd.MarkFlagRequired("id")
+
+	transferCancelCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key of the owner or the new owner")
+	transferCancelCmd.Flags().StringVarP(&id, "id", "i", "", "transfer id")
+	transferCancelCmd.MarkFlagRequired("privkey")
+	transferCancelCmd.MarkFlagRequired("id")
+
+	transferFetchCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key of the new owner")
+	transferFetchCmd.Flags().StringVarP(&id, "id", "i", "", "transfer id")
+	transferFetchCmd.Flags().StringVarP(&fromHost, "from", "f", "", "server address of dataOwner of the old owner")
+	transferFetchCmd.MarkFlagRequired("privkey")
+	transferFetchCmd.MarkFlagRequired("id")
+	transferFetchCmd.MarkFlagRequired("from")
+
+	transferGetCmd.Flags().StringVarP(&id, "id", "i", "", "transfer id")
+	transferGetCmd.MarkFlagRequired("id")
+
+	transferListCmd.Flags().StringVarP(&user, "user", "u", "", "public key of dataOwner, the one of host by default")
+	transferListCmd.Flags().Uint64VarP(&limit, "limit", "l", 0, "number of transfers to list, no limit if 0")
+}
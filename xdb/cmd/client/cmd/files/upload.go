@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -29,6 +30,7 @@ var (
 	description string
 	extra       string
 	expireTime  string
+	tags        []string
 )
 
 // uploadDataCmd represents the command to upload file into xuper db
@@ -55,6 +57,12 @@ var uploadCmd = &cobra.Command{
 			return
 		}
 
+		fileTags, err := parseTags(tags)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
 		opt := httpclient.WriteOptions{
 			PrivateKey: privateKey,
 
@@ -63,6 +71,7 @@ var uploadCmd = &cobra.Command{
 			ExpireTime:  stamp.UnixNano(),
 			Description: description,
 			Extra:       extra,
+			Tags:        fileTags,
 		}
 
 		resp, err := client.Write(context.Background(), f, opt)
@@ -85,6 +94,7 @@ func init() {
 	uploadCmd.Flags().StringVarP(&description, "description", "d", "", "file description")
 	uploadCmd.Flags().StringVarP(&expireTime, "expireTime", "e", "", "expire time, example '2021-06-10 12:00:00'")
 	uploadCmd.Flags().StringVar(&extra, "ext", "", "file extra info")
+	uploadCmd.Flags().StringArrayVar(&tags, "tag", nil, "searchable file tag, example 'feature_set=credit', could be used multiple times")
 
 	uploadCmd.MarkFlagRequired("privkey")
 	uploadCmd.MarkFlagRequired("input")
@@ -92,3 +102,19 @@ func init() {
 	uploadCmd.MarkFlagRequired("filename")
 	uploadCmd.MarkFlagRequired("expireTime")
 }
+
+// parseTags parses tags like "key=value"
+func parseTags(tags []string) (map[string]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		kv := strings.SplitN(t, "=", 2)
+		if len(kv) != 2 || len(kv[0]) == 0 {
+			return nil, fmt.Errorf("bad tag %s, should be key=value", t)
+		}
+		m[kv[0]] = kv[1]
+	}
+	return m, nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package files
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+)
+
+var (
+	maxBytes    uint64
+	maxFiles    int64
+	maxFileSize uint64
+)
+
+// updateNsQuotaCmd represents the command to update namespace quota
+var updateNsQuotaCmd = &cobra.Command{
+	Use:   "uquota",
+	Short: "update file namespace quota of xuper db, 0 means unlimited",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		if maxFiles < 0 || len(namespace) == 0 {
+			fmt.Printf("err: bad param, maxfiles must not be negative and ns length must greater than 0")
+			return
+		}
+
+		quota := blockchain.NsQuota{
+			MaxBytes:    maxBytes,
+			MaxFiles:    maxFiles,
+			MaxFileSize: maxFileSize,
+		}
+		err = client.UpdateFileNsQuota(context.Background(), privateKey, namespace, quota)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
+		fmt.Println("OK")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(updateNsQuotaCmd)
+
+	updateNsQuotaCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key")
+	updateNsQuotaCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace for file")
+	updateNsQuotaCmd.Flags().Uint64VarP(&maxBytes, "maxbytes", "b", 0, "max total plain text bytes of files, 0 for unlimited")
+	updateNsQuotaCmd.Flags().Int64VarP(&maxFiles, "maxfiles", "f", 0, "max number of files, 0 for unlimited")
+	updateNsQuotaCmd.Flags().Uint64VarP(&maxFileSize, "maxfilesize", "s", 0, "max plain text bytes of a file, 0 for unlimited")
+
+	updateNsQuotaCmd.MarkFlagRequired("privkey")
+	updateNsQuotaCmd.MarkFlagRequired("namespace")
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package files
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+)
+
+var (
+	renewPolicy string
+	renewDays   int
+	renewUntil  string
+)
+
+// updateNsRenewalCmd represents the command to update namespace renewal policy
+var updateNsRenewalCmd = &cobra.Command{
+	Use:   "urenewal",
+	Short: "update file namespace renewal policy of xuper db, files are renewed by dataOwner node before they expire",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
+		renewal := blockchain.NsRenewal{
+			Policy: renewPolicy,
+			Days:   renewDays,
+		}
+		if renewPolicy == blockchain.NsRenewalUntil {
+			stamp, err := time.ParseInLocation(timeTemplate, renewUntil, time.Local)
+			if err != nil {
+				fmt.Printf("err：bad param until, %v\n", err)
+				return
+			}
+			renewal.Until = stamp.UnixNano()
+		}
+		if renewPolicy == blockchain.NsRenewalNone {
+			renewal.Days = 0
+		}
+		if !blockchain.ValidNsRenewal(renewal) {
+			fmt.Printf("err: bad param, policy must be 'until', 'task' or empty, days must be greater than 0 if policy is not empty\n")
+			return
+		}
+
+		err = client.UpdateFileNsRenewal(context.Background(), privateKey, namespace, renewal)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
+		fmt.Println("OK")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(updateNsRenewalCmd)
+
+	updateNsRenewalCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key")
+	updateNsRenewalCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace for file")
+	updateNsRenewalCmd.Flags().StringVarP(&renewPolicy, "policy", "p", "",
+		"renewal policy, 'until' renews files until the time given, 'task' renews files used by active dai tasks, empty for no renewal")
+	updateNsRenewalCmd.Flags().IntVarP(&renewDays, "days", "d", 0, "days to extend expire time of files by each time")
+	updateNsRenewalCmd.Flags().StringVarP(&renewUntil, "until", "u", "", "files are not renewed beyond the time if policy is 'until', example '2022-06-10 12:00:00'")
+
+	updateNsRenewalCmd.MarkFlagRequired("privkey")
+	updateNsRenewalCmd.MarkFlagRequired("namespace")
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+)
+
+// getNodeDrainStatusCmd represents the command to get node draining progress
+var getNodeDrainStatusCmd = &cobra.Command{
+	Use:   "drain-status",
+	Short: "get node draining progress by id",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
+		status, err := client.GetNodeDrainStatus(context.Background(), id)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		if !status.Draining {
+			fmt.Printf("NodeID: %s\nDraining: false\nLiveSlices: %d\n", status.NodeID, status.LiveSlices)
+			return
+		}
+		dtime := time.Unix(0, status.DrainTime).Format(timeTemplate)
+		fmt.Printf("NodeID: %s\nDraining: true\nDrainTime: %s\nLiveSlices: %d\nMigratedSlices: %d\nRemovable: %v\n",
+			status.NodeID, dtime, status.LiveSlices, status.MigratedSlices, status.Removable)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(getNodeDrainStatusCmd)
+
+	getNodeDrainStatusCmd.Flags().StringVarP(&id, "id", "i", "", "id")
+
+	getNodeDrainStatusCmd.MarkFlagRequired("host")
+	getNodeDrainStatusCmd.MarkFlagRequired("id")
+}
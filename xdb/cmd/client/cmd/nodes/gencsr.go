@@ -0,0 +1,72 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodes
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+)
+
+var csrOutput string
+
+// genCSRCmd generates a certificate signing request of node key, certificates issued by CA for it
+//  are bound to the node id, and could be used to enable TLS, see tls section of the configuration
+var genCSRCmd = &cobra.Command{
+	Use:   "gencsr",
+	Short: "generate a certificate signing request of node key for TLS",
+	Run: func(cmd *cobra.Command, args []string) {
+		privkey, err := ecdsa.DecodePrivateKeyFromString(privateKey)
+		if err != nil {
+			fmt.Printf("failed to decode private key, err: %v\n", err)
+			return
+		}
+		key := ecdsa.ParsePrivateKey(privkey)
+		template := &x509.CertificateRequest{
+			Subject: pkix.Name{CommonName: name},
+		}
+		der, err := x509.CreateCertificateRequest(rand.Reader, template, &key)
+		if err != nil {
+			fmt.Printf("failed to create certificate request, err: %v\n", err)
+			return
+		}
+		csr := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+		if csrOutput == "" {
+			fmt.Print(string(csr))
+			return
+		}
+		if err := ioutil.WriteFile(csrOutput, csr, 0644); err != nil {
+			fmt.Printf("failed to write certificate request, err: %v\n", err)
+			return
+		}
+		fmt.Println("certificate request is written into", csrOutput)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(genCSRCmd)
+
+	genCSRCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key of the node")
+	genCSRCmd.Flags().StringVarP(&name, "name", "n", "", "common name of the certificate, e.g. the node name")
+	genCSRCmd.Flags().StringVarP(&csrOutput, "output", "o", "", "output file path, printed if empty")
+
+	genCSRCmd.MarkFlagRequired("privkey")
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodes
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+)
+
+// verifyHeartbeatsCmd represents the command to verify heartbeats aggregated by a storage node
+var verifyHeartbeatsCmd = &cobra.Command{
+	Use:   "hbverify",
+	Short: "verify heartbeats kept by a storage node against heartbeat commits on blockchain",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err: %v\n", err)
+			return
+		}
+		endTime := time.Now().UnixNano()
+		if end != "" {
+			tamp, err := time.ParseInLocation(timeTemplate, end, time.Local)
+			if err != nil {
+				fmt.Printf("err: %v\n", err)
+				return
+			}
+			endTime = tamp.UnixNano()
+		}
+		startTime := endTime - int64(24*time.Hour)
+		if start != "" {
+			tamp, err := time.ParseInLocation(timeTemplate, start, time.Local)
+			if err != nil {
+				fmt.Printf("err: %v\n", err)
+				return
+			}
+			startTime = tamp.UnixNano()
+		}
+
+		ctx := context.Background()
+		commits, err := client.ListHeartbeatCommits(ctx, id, startTime, endTime)
+		if err != nil {
+			fmt.Printf("err: %v\n", err)
+			return
+		}
+		if len(commits) == 0 {
+			fmt.Println("no heartbeat commits found")
+			return
+		}
+		// heartbeats of all commits are fetched at a time
+		beats, err := client.ListHeartbeats(ctx, commits[0].Start, commits[len(commits)-1].End)
+		if err != nil {
+			fmt.Printf("err: %v\n", err)
+			return
+		}
+
+		failed := 0
+		fmt.Printf("%-20s %-20s %-8s %-16s %s\n", "Start", "End", "Count", "Root", "Result")
+		for _, c := range commits {
+			var cb []blockchain.SignedHeartbeat
+			for _, b := range beats {
+				if b.Timestamp >= c.Start && b.Timestamp <= c.End {
+					cb = append(cb, b)
+				}
+			}
+			result := "ok"
+			if err := c.Verify(cb); err != nil {
+				result = err.Error()
+				failed++
+			}
+			fmt.Printf("%-20s %-20s %-8d %-16s %s\n", time.Unix(0, c.Start).Format(timeTemplate),
+				time.Unix(0, c.End).Format(timeTemplate), c.Count, hex.EncodeToString(c.Root)[:16], result)
+		}
+		fmt.Printf("\n%d commits verified, %d failed\n", len(commits), failed)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(verifyHeartbeatsCmd)
+
+	verifyHeartbeatsCmd.Flags().StringVarP(&id, "id", "i", "", "id of the storage node")
+	verifyHeartbeatsCmd.Flags().StringVarP(&start, "start", "s", "", "start of time period, 24 hours before end by default, example '2021-07-10 12:00:00'")
+	verifyHeartbeatsCmd.Flags().StringVarP(&end, "end", "e", "", "end of time period, now by default, example '2021-07-11 12:00:00'")
+
+	verifyHeartbeatsCmd.MarkFlagRequired("host")
+	verifyHeartbeatsCmd.MarkFlagRequired("id")
+}
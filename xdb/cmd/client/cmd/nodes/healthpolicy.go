@@ -0,0 +1,176 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+)
+
+var (
+	policyVersion int64
+	policy        blockchain.HealthPolicy
+	maxLatency    time.Duration
+)
+
+// healthPolicyCmd represents the command to manage the node health policy
+var healthPolicyCmd = &cobra.Command{
+	Use:   "healthpolicy",
+	Short: "get, update or simulate the policy scoring health of storage nodes",
+}
+
+// getHealthPolicyCmd represents the command to get node health policy
+var getHealthPolicyCmd = &cobra.Command{
+	Use:   "get",
+	Short: "get node health policy of a version, the current one if version is not given",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		p, err := client.GetHealthPolicy(context.Background(), policyVersion)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		printHealthPolicy(p)
+	},
+}
+
+// updateHealthPolicyCmd represents the command to set a new version of node health policy
+var updateHealthPolicyCmd = &cobra.Command{
+	Use:   "update",
+	Short: "set a new version of node health policy signed by the network admin, flags not given keep current values",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		ctx := context.Background()
+		p, err := proposedHealthPolicy(ctx, client, cmd.Flags())
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		if err := client.UpdateHealthPolicy(ctx, privateKey, p); err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		fmt.Printf("health policy updated to version %d\n", p.Version)
+	},
+}
+
+// simulateHealthPolicyCmd represents the command to score existing nodes by a proposed policy
+var simulateHealthPolicyCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "show how existing nodes would score under a proposed policy, flags not given keep current values",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(host)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		ctx := context.Background()
+		p, err := proposedHealthPolicy(ctx, client, cmd.Flags())
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		sim, err := client.SimulateNodeHealth(ctx, p)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+		fmt.Printf("%-20s %-10s %-10s %-10s %-10s\n", "Name", "Current", "Health", "Proposed", "Health")
+		for _, n := range sim.Nodes {
+			fmt.Printf("%-20s %-10.4f %-10s %-10.4f %-10s\n", n.Name, n.CurrentScore, n.CurrentHealth,
+				n.ProposedScore, n.ProposedHealth)
+		}
+		fmt.Printf("\n%d nodes scored, current policy version %d\n", len(sim.Nodes), sim.CurrentVersion)
+	},
+}
+
+// proposedHealthPolicy overrides the current policy with flags given, version is increased by one
+func proposedHealthPolicy(ctx context.Context, client httpclient.Client, flags *pflag.FlagSet) (
+	blockchain.HealthPolicy, error) {
+	p, err := client.GetHealthPolicy(ctx, 0)
+	if err != nil {
+		return p, err
+	}
+	flags.Visit(func(f *pflag.Flag) {
+		switch f.Name {
+		case "window":
+			p.Window = policy.Window
+		case "challenge":
+			p.ChallengeWeight = policy.ChallengeWeight
+		case "heartbeat":
+			p.HeartbeatWeight = policy.HeartbeatWeight
+		case "latency":
+			p.LatencyWeight = policy.LatencyWeight
+		case "good":
+			p.GoodBound = policy.GoodBound
+		case "medium":
+			p.MediumBound = policy.MediumBound
+		case "maxlatency":
+			p.MaxLatency = maxLatency.Nanoseconds()
+		}
+	})
+	p.Version++
+	return p, p.Valid()
+}
+
+func printHealthPolicy(p blockchain.HealthPolicy) {
+	utime := "-"
+	if p.UpdateTime > 0 {
+		utime = time.Unix(0, p.UpdateTime).Format(timeTemplate)
+	}
+	fmt.Printf("Version: %d\nWindow: %d days\nChallengeWeight: %g\nHeartbeatWeight: %g\nLatencyWeight: %g\n"+
+		"GoodBound: %g\nMediumBound: %g\nDefaultChallengeRate: %g\nDefaultHeartbeatRate: %g\nMaxLatency: %v\nUpdateTime: %s\n",
+		p.Version, p.Window, p.ChallengeWeight, p.HeartbeatWeight, p.LatencyWeight, p.GoodBound, p.MediumBound,
+		p.DefaultChallengeRate, p.DefaultHeartbeatRate, time.Duration(p.MaxLatency), utime)
+}
+
+func init() {
+	rootCmd.AddCommand(healthPolicyCmd)
+	healthPolicyCmd.AddCommand(getHealthPolicyCmd)
+	healthPolicyCmd.AddCommand(updateHealthPolicyCmd)
+	healthPolicyCmd.AddCommand(simulateHealthPolicyCmd)
+
+	getHealthPolicyCmd.Flags().Int64VarP(&policyVersion, "version", "v", 0, "policy version, 0 for the current one")
+
+	for _, c := range []*cobra.Command{updateHealthPolicyCmd, simulateHealthPolicyCmd} {
+		c.Flags().IntVarP(&policy.Window, "window", "w", 0, "days of statistics, in [1, 90]")
+		c.Flags().Float64Var(&policy.ChallengeWeight, "challenge", 0, "weight of proved challenges rate")
+		c.Flags().Float64Var(&policy.HeartbeatWeight, "heartbeat", 0, "weight of heartbeat rate")
+		c.Flags().Float64Var(&policy.LatencyWeight, "latency", 0, "weight of challenge answer latency, 0 to disable")
+		c.Flags().Float64Var(&policy.GoodBound, "good", 0, "nodes scoring no less than it are green")
+		c.Flags().Float64Var(&policy.MediumBound, "medium", 0, "nodes scoring less than it are red")
+		c.Flags().DurationVar(&maxLatency, "maxlatency", 0, "average answer latency scoring 0, such as 30m")
+	}
+	updateHealthPolicyCmd.Flags().StringVarP(&privateKey, "privkey", "k", "", "private key of the network admin")
+
+	getHealthPolicyCmd.MarkFlagRequired("host")
+	updateHealthPolicyCmd.MarkFlagRequired("host")
+	updateHealthPolicyCmd.MarkFlagRequired("privkey")
+	simulateHealthPolicyCmd.MarkFlagRequired("host")
+}
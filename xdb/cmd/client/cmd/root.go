@@ -18,15 +18,29 @@ import (
 
 	"github.com/spf13/cobra"
 
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/cmd/client/cmd/challenge"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/cmd/client/cmd/files"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/cmd/client/cmd/nodes"
 )
 
+var (
+	caFile   string
+	certFile string
+	certKey  string
+)
+
 // rootCmd represents the base command that is called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "xdata",
 	Short: "for file and node operation",
+	// requests go over TLS if CA certificates are given, and hosts should be "https://..."
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if caFile == "" {
+			return nil
+		}
+		return httpclient.UseTLS(caFile, certFile, certKey)
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -37,6 +51,10 @@ func Execute() {
 	}
 }
 func init() {
+	rootCmd.PersistentFlags().StringVar(&caFile, "cacert", "", "PEM certificates of CAs verifying the server, enable TLS if set")
+	rootCmd.PersistentFlags().StringVar(&certFile, "cert", "", "PEM certificate presented to the server requiring mutual TLS")
+	rootCmd.PersistentFlags().StringVar(&certKey, "certkey", "", "private key of the certificate, in hex like node keys")
+
 	rootCmd.AddCommand(files.RootCmd())
 	rootCmd.AddCommand(nodes.RootCmd())
 	rootCmd.AddCommand(challenge.RootCmd())
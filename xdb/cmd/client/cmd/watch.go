@@ -0,0 +1,99 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	httpclient "github.com/PaddlePaddle/PaddleDTX/xdb/client/http"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/events"
+)
+
+const (
+	timeTemplate         = "2006-01-02 15:04:05"
+	watchReconnectPeriod = 3 * time.Second
+)
+
+var (
+	watchHost   string
+	watchTypes  string
+	watchLastID uint64
+)
+
+// watchCmd represents the command to watch lifecycle events of a node
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "watch file, node and challenge events of xuper db node, reconnect automatically until interrupted",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := httpclient.New(watchHost)
+		if err != nil {
+			fmt.Printf("err：%v\n", err)
+			return
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-quit
+			cancel()
+		}()
+
+		opt := httpclient.WatchOptions{
+			LastID: watchLastID,
+		}
+		if watchTypes != "" {
+			opt.Types = strings.Split(watchTypes, ",")
+		}
+		for {
+			err := client.WatchEvents(ctx, opt, func(e events.Event) {
+				opt.LastID = e.ID
+				etime := time.Unix(0, e.Time).Format(timeTemplate)
+				fmt.Printf("ID: %d\nType: %s\nTime: %s\nNode: %s\n", e.ID, e.Type, etime, e.Node)
+				for k, v := range e.Fields {
+					fmt.Printf("  %s: %s\n", k, v)
+				}
+				fmt.Println()
+			})
+			if ctx.Err() != nil {
+				return
+			}
+			fmt.Printf("err：%v, reconnect in %s\n", err, watchReconnectPeriod)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(watchReconnectPeriod):
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+
+	watchCmd.Flags().StringVar(&watchHost, "host", "", "server address of xuper db")
+	watchCmd.Flags().StringVarP(&watchTypes, "types", "t", "", "event types separated by comma, prefix like 'file.' matches all file events, all types if empty")
+	watchCmd.Flags().Uint64Var(&watchLastID, "last-id", 0, "replay events after the id if still kept by server")
+
+	watchCmd.MarkFlagRequired("host")
+}
@@ -34,9 +34,10 @@ var (
 )
 
 type BlockchainConf struct {
-	Type   string
-	Xchain *XchainConf
-	Fabric *FabricConf
+	Type     string
+	Xchain   *XchainConf
+	Fabric   *FabricConf
+	Embedded *EmbeddedConf
 }
 
 type XchainConf struct {
@@ -45,6 +46,7 @@ type XchainConf struct {
 	ContractAccount string
 	ChainAddress    string
 	ChainName       string
+	DaiContractName string // contract of dai on the same chain, used by renewal policy "task"
 }
 
 type FabricConf struct {
@@ -53,6 +55,14 @@ type FabricConf struct {
 	Chaincode  string
 	UserName   string
 	OrgName    string
+	// chaincode of dai on the same channel, used by renewal policy "task"
+	DaiChaincode string
+}
+
+// EmbeddedConf is the configuration of the in-process blockchain used for offline development
+type EmbeddedConf struct {
+	DBPath string // BoltDB file to persist the ledger, the ledger is kept in memory if empty
+	Admin  string // hex public key of the network admin who sets node health policy, optional
 }
 
 type MonitorConf struct {
@@ -61,13 +71,65 @@ type MonitorConf struct {
 	FileclearInterval    int
 	FilemaintainerSwitch string
 	FilemigrateInterval  int
+	SlicegcSwitch        string
+	SlicegcInterval      int
+	SlicegcGracePeriod   int
+	SlicegcMode          string
+	ScrubSwitch          string
+	ScrubInterval        int
+	ScrubRate            int
+	HeartbeatMode        string // "chain" sends each heartbeat onto blockchain, "aggregate" commits them regularly
+	HbcommitInterval     int    // unit: minute, how often aggregated heartbeats are committed
+	HeartbeatLogPath     string // directory keeping signed heartbeats in "aggregate" mode
+	RenewalSwitch        string
+	RenewalInterval      int      // unit: hour
+	RenewalAhead         int      // files expiring within RenewalAhead hours are renewed
+	RenewalTaskOwners    []string // public keys of dai requesters or executors whose tasks are checked by policy "task"
+	MaterialgcInterval   int      // unit: hour, how often challenge materials of expired or deleted files are dropped
+	MaterialgcGrace      int      // unit: hour, materials saved within the grace period are kept
 }
 
-type ServerConf struct {
-	Name          string
+type MetricsConf struct {
 	ListenAddress string
-	PrivateKey    string
-	PublicAddress string
+}
+
+type TracingConf struct {
+	Exporter    string
+	Endpoint    string
+	Insecure    bool
+	FilePath    string
+	SampleRatio float64
+}
+
+type EventsConf struct {
+	WatchInterval  int // unit: second, how often blockchain is watched for events
+	ExpiringWindow int // unit: hour, files expiring within the window are reported
+	Webhook        *WebhookConf
+}
+
+type WebhookConf struct {
+	URL        string
+	Types      []string // event types posted, all types if empty
+	MaxRetries int
+	Timeout    int // unit: second
+}
+
+type ServerConf struct {
+	Name              string
+	ListenAddress     string
+	GRPCListenAddress string
+	PrivateKey        string
+	PublicAddress     string
+	TLS               *TLSConf // servers and requests to other nodes go without TLS if nil
+}
+
+// TLSConf configures TLS of http and grpc servers, and of slices pushed to and pulled from storage nodes.
+//  The private key of the certificate is the node private key, so the certificate is bound to node id,
+//  and nodes reject peers presenting certificates not bound to their ids registered on blockchain.
+type TLSConf struct {
+	CertFile   string // PEM certificate of the node, its public key must be the node public key
+	CAFile     string // PEM certificates of CAs verifying certificates of peers
+	ClientAuth bool   // require certificates of clients, that is mutual TLS
 }
 
 type Log struct {
@@ -125,16 +187,53 @@ func GetLogConf() *Log {
 func GetServerConf() *ServerConf {
 	if serverType == NodeTypeDataOwner {
 		return &ServerConf{
-			Name:          dataOwnerConf.Name,
-			ListenAddress: dataOwnerConf.ListenAddress,
-			PrivateKey:    dataOwnerConf.PrivateKey,
-			PublicAddress: dataOwnerConf.PublicAddress}
+			Name:              dataOwnerConf.Name,
+			ListenAddress:     dataOwnerConf.ListenAddress,
+			GRPCListenAddress: dataOwnerConf.GRPCListenAddress,
+			PrivateKey:        dataOwnerConf.PrivateKey,
+			PublicAddress:     dataOwnerConf.PublicAddress,
+			TLS:               dataOwnerConf.TLS}
 	} else if serverType == NodeTypeStorage {
 		return &ServerConf{
-			Name:          storageConf.Name,
-			ListenAddress: storageConf.ListenAddress,
-			PrivateKey:    storageConf.PrivateKey,
-			PublicAddress: storageConf.PublicAddress}
+			Name:              storageConf.Name,
+			ListenAddress:     storageConf.ListenAddress,
+			GRPCListenAddress: storageConf.GRPCListenAddress,
+			PrivateKey:        storageConf.PrivateKey,
+			PublicAddress:     storageConf.PublicAddress,
+			TLS:               storageConf.TLS}
+	} else {
+		return nil
+	}
+}
+
+// GetMetricsConf returns nil if metrics is not configured
+func GetMetricsConf() *MetricsConf {
+	if serverType == NodeTypeDataOwner {
+		return dataOwnerConf.Metrics
+	} else if serverType == NodeTypeStorage {
+		return storageConf.Metrics
+	} else {
+		return nil
+	}
+}
+
+// GetTracingConf returns nil if tracing is not configured
+func GetTracingConf() *TracingConf {
+	if serverType == NodeTypeDataOwner {
+		return dataOwnerConf.Tracing
+	} else if serverType == NodeTypeStorage {
+		return storageConf.Tracing
+	} else {
+		return nil
+	}
+}
+
+// GetEventsConf returns nil if events watching is not configured
+func GetEventsConf() *EventsConf {
+	if serverType == NodeTypeDataOwner {
+		return dataOwnerConf.Events
+	} else if serverType == NodeTypeStorage {
+		return storageConf.Events
 	} else {
 		return nil
 	}
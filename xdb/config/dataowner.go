@@ -14,10 +14,12 @@
 package config
 
 type DataOwnerConf struct {
-	Name          string
-	ListenAddress string
-	PrivateKey    string
-	PublicAddress string
+	Name              string
+	ListenAddress     string
+	GRPCListenAddress string // grpc server is disabled if empty
+	PrivateKey        string
+	PublicAddress     string
+	TLS               *TLSConf
 
 	Slicer     *DataOwnerSlicerConf
 	Encryptor  *DataOwnerEncryptorConf
@@ -25,6 +27,11 @@ type DataOwnerConf struct {
 	Copier     *DataOwnerCopierConf
 	Monitor    *MonitorConf
 	Challenger *DataOwnerChallenger
+	Metrics    *MetricsConf
+	Tracing    *TracingConf
+	Events     *EventsConf
+	Cache      *DataOwnerCacheConf
+	Bandwidth  *DataOwnerBandwidthConf
 }
 
 type DataOwnerSlicerConf struct {
@@ -69,3 +76,18 @@ type ChallengerMerkleConf struct {
 type DataOwnerCopierConf struct {
 	Type string
 }
+
+// DataOwnerCacheConf configures the cache of verified slices on the read path
+type DataOwnerCacheConf struct {
+	MemorySize int64  // unit: byte, max size of slices kept in memory, memory tier is disabled if 0
+	DiskPath   string // directory of slices kept on disk, disk tier is disabled if empty
+	DiskSize   int64  // unit: byte, max size of slices kept on disk
+	TTL        int    // unit: hour, slices are also invalidated when their files expire
+}
+
+// DataOwnerBandwidthConf configures bandwidth limits of slices transferred to and from Storage Nodes,
+//  they can be changed at runtime by the admin API
+type DataOwnerBandwidthConf struct {
+	Limit     int64 // unit: byte per second, total bandwidth of the node, no limit if 0
+	NodeLimit int64 // unit: byte per second, bandwidth to each Storage Node, no limit if 0
+}
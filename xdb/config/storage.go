@@ -14,14 +14,21 @@
 package config
 
 type StorageConf struct {
-	Name          string
-	ListenAddress string
-	PrivateKey    string
-	PublicAddress string
+	Name              string
+	ListenAddress     string
+	GRPCListenAddress string // grpc server is disabled if empty
+	PrivateKey        string
+	PublicAddress     string
+	TLS               *TLSConf
+	AllowedOwners     []string // hex public keys of dataOwner nodes allowed to push slices, any if empty
+	MaxSliceSize      int64    // maximum size of a pushed slice, unit: byte
 
 	Blockchain *BlockchainConf
 	Monitor    *MonitorConf
 	Mode       *StorageModeConf
+	Metrics    *MetricsConf
+	Tracing    *TracingConf
+	Events     *EventsConf
 }
 
 type StorageModeConf struct {
@@ -0,0 +1,359 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache keeps verified slices pulled from storage nodes on the read path of dataOwner nodes,
+//  slices are kept after decrypted with the node-specific key, they are still encrypted with the file key
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/config"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/metrics"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+var logger = logrus.WithField("module", "slice-cache")
+
+const (
+	tierMemory = "memory"
+	tierDisk   = "disk"
+
+	sliceSuffix = ".slice"
+	metaSuffix  = ".meta"
+
+	defaultTTL    = 24 * time.Hour
+	purgeInterval = 10 * time.Minute
+)
+
+// SliceCache keeps verified slices in memory and on disk, keyed by slice id and cipher hash,
+//  a slice is invalid after TTL or its file expires, and evicted in LRU order when size exceeds limit
+type SliceCache struct {
+	lock sync.Mutex
+
+	ttl      time.Duration
+	diskPath string
+	memory   *tier
+	disk     *tier // disk is nil if disk tier is disabled
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New creates SliceCache, slices kept on disk before are loaded
+func New(conf *config.DataOwnerCacheConf) (*SliceCache, error) {
+	if conf.MemorySize < 0 || conf.DiskSize < 0 || conf.TTL < 0 {
+		return nil, errorx.New(errorx.ErrCodeConfig, "invalid cache size or ttl")
+	}
+	if conf.MemorySize == 0 && len(conf.DiskPath) == 0 {
+		return nil, errorx.New(errorx.ErrCodeConfig, "either memorySize or diskPath of cache is required")
+	}
+
+	c := &SliceCache{
+		ttl:      defaultTTL,
+		diskPath: conf.DiskPath,
+		memory:   newTier(tierMemory, conf.MemorySize),
+		done:     make(chan struct{}),
+	}
+	if conf.TTL > 0 {
+		c.ttl = time.Duration(conf.TTL) * time.Hour
+	}
+	if len(conf.DiskPath) > 0 {
+		if conf.DiskSize == 0 {
+			return nil, errorx.New(errorx.ErrCodeConfig, "missing diskSize of cache")
+		}
+		if err := os.MkdirAll(conf.DiskPath, 0700); err != nil {
+			return nil, errorx.NewCode(err, errorx.ErrCodeConfig, "failed to create cache directory")
+		}
+		c.disk = newTier(tierDisk, conf.DiskSize)
+		if err := c.loadDisk(); err != nil {
+			return nil, err
+		}
+	}
+
+	var ctx context.Context
+	ctx, c.cancel = context.WithCancel(context.Background())
+	go c.purgeLoop(ctx)
+
+	logger.WithFields(logrus.Fields{
+		"memory_size": conf.MemorySize,
+		"disk_path":   conf.DiskPath,
+		"disk_size":   conf.DiskSize,
+		"ttl":         c.ttl,
+	}).Info("slice cache initialization")
+	return c, nil
+}
+
+// Get returns the slice if cached and valid
+func (c *SliceCache) Get(sliceID string, cipherHash []byte) ([]byte, bool) {
+	key := packKey(sliceID, cipherHash)
+	now := time.Now().UnixNano()
+	l := logger.WithFields(logrus.Fields{"slice_id": sliceID, "key": key})
+
+	c.lock.Lock()
+	if e := c.memory.get(key); e != nil {
+		if e.Deadline > now {
+			c.lock.Unlock()
+			metrics.SliceCacheRequests.WithLabelValues(tierMemory, metrics.CacheHit).Inc()
+			l.Debug("slice cache hit in memory")
+			return e.data, true
+		}
+		c.memory.remove(key)
+		c.updateSize()
+	}
+	metrics.SliceCacheRequests.WithLabelValues(tierMemory, metrics.CacheMiss).Inc()
+
+	var e *entry
+	if c.disk != nil {
+		if e = c.disk.get(key); e != nil && e.Deadline <= now {
+			c.removeDisk(c.disk.remove(key))
+			e = nil
+		}
+	}
+	c.lock.Unlock()
+	if c.disk == nil {
+		l.Debug("slice cache missed")
+		return nil, false
+	}
+	if e == nil {
+		metrics.SliceCacheRequests.WithLabelValues(tierDisk, metrics.CacheMiss).Inc()
+		l.Debug("slice cache missed")
+		return nil, false
+	}
+
+	// load and check slice from disk
+	data, err := ioutil.ReadFile(c.slicePath(key))
+	if err == nil && !bytes.Equal(hash.Hash(data), e.Digest) {
+		err = errorx.New(errorx.ErrCodeInternal, "bad digest")
+	}
+	if err != nil {
+		l.WithError(err).Warn("failed to load slice from disk cache")
+		c.lock.Lock()
+		if de := c.disk.get(key); de == e {
+			c.removeDisk(c.disk.remove(key))
+		}
+		c.lock.Unlock()
+		metrics.SliceCacheRequests.WithLabelValues(tierDisk, metrics.CacheMiss).Inc()
+		return nil, false
+	}
+	metrics.SliceCacheRequests.WithLabelValues(tierDisk, metrics.CacheHit).Inc()
+	l.Debug("slice cache hit on disk")
+
+	// promote into memory
+	me := *e
+	me.data = data
+	c.lock.Lock()
+	c.memory.add(&me)
+	c.updateSize()
+	c.lock.Unlock()
+	return data, true
+}
+
+// Put caches the verified slice of the file, expireTime is the file expiration time in nanosecond
+func (c *SliceCache) Put(fileID, sliceID string, cipherHash, data []byte, expireTime int64) {
+	deadline := time.Now().Add(c.ttl).UnixNano()
+	if expireTime > 0 && expireTime < deadline {
+		deadline = expireTime
+	}
+	if deadline <= time.Now().UnixNano() {
+		return
+	}
+	e := &entry{
+		Key:        packKey(sliceID, cipherHash),
+		FileID:     fileID,
+		SliceID:    sliceID,
+		CipherHash: cipherHash,
+		Digest:     hash.Hash(data),
+		Size:       int64(len(data)),
+		Deadline:   deadline,
+	}
+	me := *e
+	me.data = data
+
+	c.lock.Lock()
+	c.memory.add(&me)
+	c.updateSize()
+	c.lock.Unlock()
+
+	if c.disk == nil || e.Size > c.disk.maxSize {
+		return
+	}
+	if err := c.saveDisk(e, data); err != nil {
+		logger.WithError(err).WithField("slice_id", sliceID).Warn("failed to save slice into disk cache")
+		return
+	}
+	c.lock.Lock()
+	for _, evicted := range c.disk.add(e) {
+		c.removeDisk(evicted)
+	}
+	c.updateSize()
+	c.lock.Unlock()
+}
+
+// Invalidate removes all slices of the file, called when the file expires or is deleted
+func (c *SliceCache) Invalidate(fileID string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.removeIf(func(e *entry) bool {
+		return e.FileID == fileID
+	})
+	logger.WithField("file_id", fileID).Debug("slice cache invalidated")
+}
+
+// Close stops purging expired slices, slices on disk are kept for next start
+func (c *SliceCache) Close() {
+	c.cancel()
+	<-c.done
+}
+
+func (c *SliceCache) purgeLoop(ctx context.Context) {
+	defer close(c.done)
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := time.Now().UnixNano()
+			c.lock.Lock()
+			c.removeIf(func(e *entry) bool {
+				return e.Deadline <= now
+			})
+			c.lock.Unlock()
+		}
+	}
+}
+
+// removeIf removes matching slices in both tiers, must be called with lock held
+func (c *SliceCache) removeIf(f func(e *entry) bool) {
+	for _, e := range c.memory.filter(f) {
+		c.memory.remove(e.Key)
+	}
+	if c.disk != nil {
+		for _, e := range c.disk.filter(f) {
+			c.removeDisk(c.disk.remove(e.Key))
+		}
+	}
+	c.updateSize()
+}
+
+func (c *SliceCache) updateSize() {
+	metrics.SliceCacheBytes.WithLabelValues(tierMemory).Set(float64(c.memory.size))
+	if c.disk != nil {
+		metrics.SliceCacheBytes.WithLabelValues(tierDisk).Set(float64(c.disk.size))
+	}
+}
+
+// saveDisk writes the slice before its meta, so that a slice is loaded only if completely written
+func (c *SliceCache) saveDisk(e *entry, data []byte) error {
+	meta, err := json.Marshal(e)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal slice meta")
+	}
+	if err := writeFile(c.slicePath(e.Key), data); err != nil {
+		return err
+	}
+	return writeFile(c.metaPath(e.Key), meta)
+}
+
+// removeDisk removes files of the slice evicted from disk tier
+func (c *SliceCache) removeDisk(e *entry) {
+	if e == nil {
+		return
+	}
+	for _, p := range []string{c.metaPath(e.Key), c.slicePath(e.Key)} {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			logger.WithError(err).WithField("path", p).Warn("failed to remove cached slice")
+		}
+	}
+}
+
+// loadDisk loads valid slices kept on disk, least recently written ones are evicted first
+func (c *SliceCache) loadDisk() error {
+	metas, err := filepath.Glob(filepath.Join(c.diskPath, "*"+metaSuffix))
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to list cache directory")
+	}
+	type loaded struct {
+		e     *entry
+		mtime time.Time
+	}
+	var ls []loaded
+	now := time.Now().UnixNano()
+	for _, m := range metas {
+		key := strings.TrimSuffix(filepath.Base(m), metaSuffix)
+		var e entry
+		s, err := ioutil.ReadFile(m)
+		if err == nil {
+			err = json.Unmarshal(s, &e)
+		}
+		info, serr := os.Stat(c.slicePath(key))
+		if err != nil || serr != nil || e.Key != key || e.Deadline <= now || info.Size() != e.Size {
+			c.removeDisk(&entry{Key: key})
+			continue
+		}
+		ls = append(ls, loaded{e: &e, mtime: info.ModTime()})
+	}
+	sort.Slice(ls, func(i, j int) bool {
+		return ls[i].mtime.Before(ls[j].mtime)
+	})
+	for _, l := range ls {
+		for _, evicted := range c.disk.add(l.e) {
+			c.removeDisk(evicted)
+		}
+	}
+	c.updateSize()
+	logger.WithField("slices", c.disk.lru.Len()).Info("load slices from disk cache")
+	return nil
+}
+
+func (c *SliceCache) slicePath(key string) string {
+	return filepath.Join(c.diskPath, key+sliceSuffix)
+}
+
+func (c *SliceCache) metaPath(key string) string {
+	return filepath.Join(c.diskPath, key+metaSuffix)
+}
+
+// packKey packs cache key with slice id and cipher hash,
+//  cipher hash differs among replicas and changes after migration
+func packKey(sliceID string, cipherHash []byte) string {
+	return hex.EncodeToString(hash.Hash([]byte(sliceID + ":" + hex.EncodeToString(cipherHash))))
+}
+
+// writeFile writes file through a temporary file, so that it is never partially written
+func writeFile(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to write file")
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to rename file")
+	}
+	return nil
+}
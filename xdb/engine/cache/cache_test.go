@@ -0,0 +1,106 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/config"
+)
+
+func TestMemory(t *testing.T) {
+	c, err := New(&config.DataOwnerCacheConf{MemorySize: 8})
+	require.NoError(t, err)
+	defer c.Close()
+
+	c.Put("f1", "s1", []byte("h1"), []byte("1234"), 0)
+	c.Put("f1", "s2", []byte("h2"), []byte("5678"), 0)
+	data, ok := c.Get("s1", []byte("h1"))
+	require.True(t, ok)
+	require.Equal(t, []byte("1234"), data)
+
+	// another replica of s1 is not cached
+	_, ok = c.Get("s1", []byte("h1'"))
+	require.False(t, ok)
+
+	// s2 is least recently used and evicted
+	c.Put("f2", "s3", []byte("h3"), []byte("abcd"), 0)
+	_, ok = c.Get("s2", []byte("h2"))
+	require.False(t, ok)
+	_, ok = c.Get("s3", []byte("h3"))
+	require.True(t, ok)
+
+	// larger than limit
+	c.Put("f2", "s4", []byte("h4"), []byte("123456789"), 0)
+	_, ok = c.Get("s4", []byte("h4"))
+	require.False(t, ok)
+
+	// invalidated when file expires
+	c.Put("f3", "s5", []byte("h5"), []byte("x"), time.Now().Add(-time.Second).UnixNano())
+	_, ok = c.Get("s5", []byte("h5"))
+	require.False(t, ok)
+	c.Put("f3", "s5", []byte("h5"), []byte("x"), time.Now().Add(50*time.Millisecond).UnixNano())
+	_, ok = c.Get("s5", []byte("h5"))
+	require.True(t, ok)
+	time.Sleep(60 * time.Millisecond)
+	_, ok = c.Get("s5", []byte("h5"))
+	require.False(t, ok)
+
+	c.Invalidate("f1")
+	_, ok = c.Get("s1", []byte("h1"))
+	require.False(t, ok)
+	_, ok = c.Get("s3", []byte("h3"))
+	require.True(t, ok)
+}
+
+func TestDisk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slice-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	conf := &config.DataOwnerCacheConf{DiskPath: dir, DiskSize: 8}
+	c, err := New(conf)
+	require.NoError(t, err)
+	c.Put("f1", "s1", []byte("h1"), []byte("1234"), 0)
+	c.Put("f2", "s2", []byte("h2"), []byte("5678"), 0)
+	data, ok := c.Get("s1", []byte("h1"))
+	require.True(t, ok)
+	require.Equal(t, []byte("1234"), data)
+	c.Close()
+
+	// loaded after restart
+	c, err = New(conf)
+	require.NoError(t, err)
+	defer c.Close()
+	data, ok = c.Get("s2", []byte("h2"))
+	require.True(t, ok)
+	require.Equal(t, []byte("5678"), data)
+
+	// corrupted slice is dropped
+	require.NoError(t, ioutil.WriteFile(c.slicePath(packKey("s1", []byte("h1"))), []byte("4321"), 0600))
+	_, ok = c.Get("s1", []byte("h1"))
+	require.False(t, ok)
+
+	c.Invalidate("f2")
+	_, ok = c.Get("s2", []byte("h2"))
+	require.False(t, ok)
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
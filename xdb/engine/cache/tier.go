@@ -0,0 +1,97 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"container/list"
+)
+
+// entry describes a slice kept in cache
+type entry struct {
+	Key        string
+	FileID     string
+	SliceID    string
+	CipherHash []byte
+	Digest     []byte // Digest is hash of the slice, checked when loaded from disk
+	Size       int64
+	Deadline   int64 // unit: nanosecond, the slice is invalid after the deadline
+
+	data []byte // data is set only in memory tier
+}
+
+// tier is a size bounded LRU index of cached slices
+type tier struct {
+	name    string
+	maxSize int64
+	size    int64
+	lru     *list.List
+	index   map[string]*list.Element
+}
+
+func newTier(name string, maxSize int64) *tier {
+	return &tier{
+		name:    name,
+		maxSize: maxSize,
+		lru:     list.New(),
+		index:   make(map[string]*list.Element),
+	}
+}
+
+// get returns the entry and marks it as recently used
+func (t *tier) get(key string) *entry {
+	elem, ok := t.index[key]
+	if !ok {
+		return nil
+	}
+	t.lru.MoveToFront(elem)
+	return elem.Value.(*entry)
+}
+
+// add adds the entry and returns entries evicted to keep size under limit,
+//  the entry is not added if it is larger than the limit, and replaces the one with the same key
+func (t *tier) add(e *entry) (evicted []*entry) {
+	if e.Size > t.maxSize {
+		return nil
+	}
+	t.remove(e.Key)
+	for t.size+e.Size > t.maxSize {
+		back := t.lru.Back()
+		evicted = append(evicted, t.remove(back.Value.(*entry).Key))
+	}
+	t.index[e.Key] = t.lru.PushFront(e)
+	t.size += e.Size
+	return evicted
+}
+
+// remove removes the entry, returns nil if not exist
+func (t *tier) remove(key string) *entry {
+	elem, ok := t.index[key]
+	if !ok {
+		return nil
+	}
+	e := t.lru.Remove(elem).(*entry)
+	delete(t.index, key)
+	t.size -= e.Size
+	return e
+}
+
+// filter returns entries matching f
+func (t *tier) filter(f func(e *entry) bool) (es []*entry) {
+	for elem := t.lru.Front(); elem != nil; elem = elem.Next() {
+		if e := elem.Value.(*entry); f(e) {
+			es = append(es, e)
+		}
+	}
+	return es
+}
@@ -15,9 +15,14 @@ package ldbstorage
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/syndtr/goleveldb/leveldb"
@@ -34,6 +39,7 @@ const (
 type LevelDBStorage struct {
 	root string
 	db   *leveldb.DB
+	lock sync.Mutex // serializes rewriting records by Update, Prune and Compact
 }
 
 // New creat a levelDB to save challenge material
@@ -73,6 +79,9 @@ func (s *LevelDBStorage) Save(ctx context.Context, cms []types.Material) error {
 
 // Update update challenge material by key
 func (s *LevelDBStorage) Update(ctx context.Context, m types.Material, key []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
 	batch := leveldb.Batch{}
 
 	value, err := json.Marshal(m.Ranges)
@@ -116,6 +125,146 @@ func (s *LevelDBStorage) Load(ctx context.Context, key []byte) (
 	return m, nil
 }
 
+// Stats counts challenge materials and their size of each file
+func (s *LevelDBStorage) Stats(ctx context.Context) ([]types.MaterialStats, error) {
+	stats := make(map[string]*types.MaterialStats)
+	var fileIDs []string
+	iter := s.db.NewIterator(nil, nil)
+	defer iter.Release()
+	for iter.Next() {
+		fileID, _, _, _, ok := parseMaterialKey(iter.Key())
+		if !ok {
+			continue
+		}
+		st, exist := stats[fileID]
+		if !exist {
+			st = &types.MaterialStats{FileID: fileID}
+			stats[fileID] = st
+			fileIDs = append(fileIDs, fileID)
+		}
+		st.Records++
+		st.Size += int64(len(iter.Key()) + len(iter.Value()))
+		var ranges []types.RangeHash
+		if err := json.Unmarshal(iter.Value(), &ranges); err != nil {
+			continue
+		}
+		for _, r := range ranges {
+			if r.Used {
+				st.Used++
+			} else {
+				st.Ranges++
+			}
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to iterate materials")
+	}
+
+	res := make([]types.MaterialStats, 0, len(fileIDs))
+	for _, fileID := range fileIDs {
+		res = append(res, *stats[fileID])
+	}
+	return res, nil
+}
+
+// Prune deletes challenge materials of a file saved before given time except those of slices kept by keep,
+//  all materials of the file saved before are deleted if keep is nil, returns the number of records deleted
+func (s *LevelDBStorage) Prune(ctx context.Context, fileID string, before int64,
+	keep func(sliceID string, nodeID []byte) bool) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	batch := leveldb.Batch{}
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(fileID+":")), nil)
+	for iter.Next() {
+		_, sliceID, nodeID, ctime, ok := parseMaterialKey(iter.Key())
+		if ok && (ctime >= before || (keep != nil && keep(sliceID, nodeID))) {
+			continue
+		}
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return 0, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to iterate materials")
+	}
+	if batch.Len() == 0 {
+		return 0, nil
+	}
+	if err := s.db.Write(&batch, nil); err != nil {
+		return 0, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to write batch")
+	}
+	return batch.Len(), nil
+}
+
+// Compact drops ranges already used by challenges and records with no ranges left,
+//  then compacts the underlying levelDB to release disk space, returns the number of ranges dropped
+func (s *LevelDBStorage) Compact(ctx context.Context) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	batch := leveldb.Batch{}
+	dropped := 0
+	iter := s.db.NewIterator(nil, nil)
+	for iter.Next() {
+		var ranges []types.RangeHash
+		if err := json.Unmarshal(iter.Value(), &ranges); err != nil {
+			continue
+		}
+		left := make([]types.RangeHash, 0, len(ranges))
+		for _, r := range ranges {
+			if !r.Used {
+				left = append(left, r)
+			}
+		}
+		if len(left) == len(ranges) {
+			continue
+		}
+		dropped += len(ranges) - len(left)
+		key := append([]byte{}, iter.Key()...)
+		if len(left) == 0 {
+			batch.Delete(key)
+			continue
+		}
+		value, err := json.Marshal(left)
+		if err != nil {
+			iter.Release()
+			return 0, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal ranges")
+		}
+		batch.Put(key, value)
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return 0, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to iterate materials")
+	}
+	if batch.Len() > 0 {
+		if err := s.db.Write(&batch, nil); err != nil {
+			return 0, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to write batch")
+		}
+	}
+	if err := s.db.CompactRange(util.Range{}); err != nil {
+		return dropped, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to compact leveldb")
+	}
+	return dropped, nil
+}
+
+// DiskUsage returns the size of levelDB files on disk
+func (s *LevelDBStorage) DiskUsage() (int64, error) {
+	var size int64
+	err := filepath.Walk(filepath.Join(s.root, dbName), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to stat leveldb")
+	}
+	return size, nil
+}
+
 func (s *LevelDBStorage) Close() {
 	s.db.Close()
 }
@@ -123,3 +272,20 @@ func (s *LevelDBStorage) Close() {
 func makeMaterialKey(fileID, sliceID string, nodeID []byte, ctime int64) []byte {
 	return []byte(fmt.Sprintf("%s:%s:%x:%d", fileID, sliceID, nodeID, ctime))
 }
+
+// parseMaterialKey parses fileID, sliceID, nodeID and saving time from key made by makeMaterialKey
+func parseMaterialKey(key []byte) (fileID, sliceID string, nodeID []byte, ctime int64, ok bool) {
+	parts := strings.Split(string(key), ":")
+	if len(parts) != 4 {
+		return "", "", nil, 0, false
+	}
+	nodeID, err := hex.DecodeString(parts[2])
+	if err != nil {
+		return "", "", nil, 0, false
+	}
+	ctime, err = strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return "", "", nil, 0, false
+	}
+	return parts[0], parts[1], nodeID, ctime, true
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldbstorage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/challenger/merkle/types"
+)
+
+func TestRetention(t *testing.T) {
+	s, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer s.Close()
+
+	ctx := context.Background()
+	ranges := []types.RangeHash{{Hash: []byte("h1"), Used: true}, {Hash: []byte("h2")}}
+	cms := []types.Material{
+		{FileID: "f1", SliceID: "s1", NodeID: []byte("n1"), Ranges: ranges},
+		{FileID: "f1", SliceID: "s2", NodeID: []byte("n2"), Ranges: ranges},
+		{FileID: "f2", SliceID: "s1", NodeID: []byte("n1"), Ranges: ranges[:1]},
+	}
+	saved := time.Now().UnixNano()
+	require.NoError(t, s.Save(ctx, cms))
+
+	stats, err := s.Stats(ctx)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, "f1", stats[0].FileID)
+	require.Equal(t, 2, stats[0].Records)
+	require.Equal(t, 2, stats[0].Ranges)
+	require.Equal(t, 2, stats[0].Used)
+
+	// materials saved within the grace period are kept
+	n, err := s.Prune(ctx, "f1", saved, nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	// slices migrated to other nodes are dropped
+	before := time.Now().UnixNano()
+	n, err = s.Prune(ctx, "f1", before, func(sliceID string, nodeID []byte) bool {
+		return sliceID == "s1" && string(nodeID) == "n1"
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	// used ranges are dropped, so are records without ranges left
+	n, err = s.Compact(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	stats, err = s.Stats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.MaterialStats{{FileID: "f1", Records: 1, Ranges: 1, Size: stats[0].Size}}, stats)
+
+	n, err = s.Prune(ctx, "f1", before, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	stats, err = s.Stats(ctx)
+	require.NoError(t, err)
+	require.Empty(t, stats)
+
+	usage, err := s.DiskUsage()
+	require.NoError(t, err)
+	require.True(t, usage > 0)
+}
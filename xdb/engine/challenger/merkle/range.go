@@ -46,6 +46,7 @@ var (
 // RandChallenger is the Merkle-Tree based Challenger
 type RandChallenger struct {
 	closeOnce sync.Once
+	lock      sync.Mutex // serializes taking and compacting materials
 
 	shrinkSize  uint64 // maximum of segment (end_idx - start_idx)
 	segmentSize uint64 // number of content segments to make merkle tree
@@ -250,6 +251,8 @@ func (m *RandChallenger) Save(ctx context.Context, cms []ctype.Material) error {
 // Take take a challenge material for publishing a challenge
 func (m *RandChallenger) Take(ctx context.Context, fileID string, sliceID string, nodeID []byte) (
 	ctype.RangeHash, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
 
 	keyList, err := m.storage.NewIterator(ctx, []byte(fmt.Sprintf("%s:%s:%x", fileID, sliceID, nodeID)))
 	if err != nil {
@@ -283,6 +286,45 @@ func (m *RandChallenger) Take(ctx context.Context, fileID string, sliceID string
 	return ctype.RangeHash{}, errorx.Wrap(errorx.ErrNotFound, "no available challenger materials")
 }
 
+// MaterialStats counts challenge materials of each file, and returns disk usage of the storage
+func (m *RandChallenger) MaterialStats(ctx context.Context) ([]ctype.MaterialStats, int64, error) {
+	stats, err := m.storage.Stats(ctx)
+	if err != nil {
+		return nil, 0, errorx.Wrap(err, "failed to count challenge materials")
+	}
+	usage, err := m.storage.DiskUsage()
+	if err != nil {
+		return nil, 0, errorx.Wrap(err, "failed to get disk usage of challenge materials")
+	}
+	return stats, usage, nil
+}
+
+// PruneMaterials deletes challenge materials of a file saved before given time except those of slices kept by keep,
+//  all materials of the file saved before are deleted if keep is nil
+func (m *RandChallenger) PruneMaterials(ctx context.Context, fileID string, before int64,
+	keep func(sliceID string, nodeID []byte) bool) (int, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	n, err := m.storage.Prune(ctx, fileID, before, keep)
+	if err != nil {
+		return 0, errorx.Wrap(err, "failed to prune challenge materials")
+	}
+	return n, nil
+}
+
+// CompactMaterials drops used ranges and releases disk space of the storage
+func (m *RandChallenger) CompactMaterials(ctx context.Context) (int, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	n, err := m.storage.Compact(ctx)
+	if err != nil {
+		return 0, errorx.Wrap(err, "failed to compact challenge materials")
+	}
+	return n, nil
+}
+
 func (m *RandChallenger) Close() {
 	m.closeOnce.Do(m.storage.Close)
 }
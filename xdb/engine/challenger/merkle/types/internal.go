@@ -33,6 +33,15 @@ type Material struct {
 	Ranges  []RangeHash
 }
 
+// MaterialStats statistics of challenge materials kept for a file
+type MaterialStats struct {
+	FileID  string
+	Records int   // number of records saved
+	Ranges  int   // number of ranges not used yet
+	Used    int   // number of ranges used but not compacted
+	Size    int64 // unit: byte, size of keys and values
+}
+
 type CalculateOptions struct {
 	RangeHash []byte
 	Timestamp int64
@@ -48,6 +57,10 @@ type MaterialStorage interface {
 	Load(ctx context.Context, key []byte) (Material, error)
 	NewIterator(ctx context.Context, prefix []byte) ([][]byte, error)
 	Update(ctx context.Context, cms Material, key []byte) error
+	Stats(ctx context.Context) ([]MaterialStats, error)
+	Prune(ctx context.Context, fileID string, before int64, keep func(sliceID string, nodeID []byte) bool) (int, error)
+	Compact(ctx context.Context) (int, error)
+	DiskUsage() (int64, error)
 
 	Close()
 }
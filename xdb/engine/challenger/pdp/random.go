@@ -173,3 +173,19 @@ func (m *RandChallenger) Take(ctx context.Context, fileID string, sliceID string
 func (m *RandChallenger) NewSetup(sliceData []byte, rangeAmount int, merkleMaterialQueue chan<- ctype.Material, cm ctype.Material) error {
 	return errorx.New(errorx.ErrCodeInternal, "pdp not implemented method NewSetup")
 }
+
+// MaterialStats not implemented for random challenge
+func (m *RandChallenger) MaterialStats(ctx context.Context) (s []ctype.MaterialStats, u int64, err error) {
+	return s, u, errorx.New(errorx.ErrCodeInternal, "pdp not implemented method MaterialStats")
+}
+
+// PruneMaterials not implemented for random challenge
+func (m *RandChallenger) PruneMaterials(ctx context.Context, fileID string, before int64,
+	keep func(sliceID string, nodeID []byte) bool) (int, error) {
+	return 0, errorx.New(errorx.ErrCodeInternal, "pdp not implemented method PruneMaterials")
+}
+
+// CompactMaterials not implemented for random challenge
+func (m *RandChallenger) CompactMaterials(ctx context.Context) (int, error) {
+	return 0, errorx.New(errorx.ErrCodeInternal, "pdp not implemented method CompactMaterials")
+}
@@ -31,8 +31,8 @@ type HealthChain interface {
 	GetNodeHealth(ctx context.Context, id []byte) (string, error)
 	GetHeartbeatNum(ctx context.Context, id []byte, timestamp int64) (int, error)
 
-	ListFiles(ctx context.Context, opt *blockchain.ListFileOptions) ([]blockchain.File, error)
-	ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) ([]blockchain.Namespace, error)
+	ListFiles(ctx context.Context, opt *blockchain.ListFileOptions) ([]blockchain.File, string, error)
+	ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) ([]blockchain.Namespace, string, error)
 }
 
 // GetHealthNodes gets online healthy(green, yellow) nodes
@@ -72,7 +72,7 @@ func FindNewNodes(healthNodes blockchain.NodeHs, selected []string) (blockchain.
 	var greenNodeList blockchain.Nodes
 	var yellowNodeList blockchain.Nodes
 	for _, n := range healthNodes {
-		if strInSet(selected, string(n.Node.ID)) {
+		if n.Node.Draining || strInSet(selected, string(n.Node.ID)) {
 			continue
 		}
 		if n.Health == blockchain.NodeHealthGood {
@@ -104,7 +104,7 @@ func GetNsFilesHealth(ctx context.Context, ns blockchain.Namespace, chain Health
 		TimeEnd:     time.Now().UnixNano(),
 		CurrentTime: time.Now().UnixNano(),
 	}
-	files, err := chain.ListFiles(ctx, &listOpt)
+	files, _, err := chain.ListFiles(ctx, &listOpt)
 	if err != nil {
 		return nsh, errorx.Wrap(err, "failed to list file on blockchain")
 	}
@@ -41,7 +41,7 @@ type MigrateChain interface {
 
 // MigrateCopier defines slice copier when migrating a file
 type MigrateCopier interface {
-	Push(ctx context.Context, id, sourceId string, r io.Reader, node *blockchain.Node) error
+	Push(ctx context.Context, id, sourceId string, cipherHash []byte, r io.Reader, node *blockchain.Node) error
 	Pull(ctx context.Context, id, fileId string, node *blockchain.Node) (io.ReadCloser, error)
 	ReplicaExpansion(ctx context.Context, opt *copier.ReplicaExpOptions, enc MigrateEncryptor, ca, sourceId, fileId string) (
 		[]blockchain.PublicSliceMeta, []encryptor.EncryptedSlice, error)
@@ -101,7 +101,7 @@ func EncAndPush(ctx context.Context, copier MigrateCopier, encrypt MigrateEncryp
 	if err != nil {
 		return es, err
 	}
-	return es, copier.Push(ctx, es.SliceID, sourceId, bytes.NewReader(es.CipherText), node)
+	return es, copier.Push(ctx, es.SliceID, sourceId, es.CipherHash, bytes.NewReader(es.CipherText), node)
 }
 
 // GetSigmaISliceIdx get random challenge material for a slice
@@ -135,7 +135,7 @@ func ExpandFileSlices(ctx context.Context, privkey ecdsa.PrivateKey, cp MigrateC
 			SliceId:       sid,
 			SelectedNodes: snode,
 			NewReplica:    replica,
-			NodesList:     healthNodes,
+			NodesList:     ExcludeDrainingNodes(healthNodes),
 			PrivateKey:    privkey[:],
 			SliceMetas:    slices,
 		}
@@ -46,8 +46,19 @@ func ToNodeHsMap(nodes blockchain.NodeHs) map[string]blockchain.Node {
 	return nodesMap
 }
 
+// ExcludeDrainingNodes removes draining nodes, which take no new slices
+func ExcludeDrainingNodes(nodes blockchain.NodeHs) blockchain.NodeHs {
+	var ret blockchain.NodeHs
+	for _, n := range nodes {
+		if !n.Node.Draining {
+			ret = append(ret, n)
+		}
+	}
+	return ret
+}
+
 // GetHeartbeatNum get heart beat number of a storage node from blockchain
-func GetHeartbeatNum(ctx context.Context, chain HealthChain, id []byte, ts []int64) (int, error) {
+func GetHeartbeatNum(ctx context.Context, chain HeartbeatChain, id []byte, ts []int64) (int, error) {
 	hearBeatTotal := 0
 	wg := sync.WaitGroup{}
 	wg.Add(len(ts))
@@ -84,12 +95,12 @@ func TodayBeginning(timestamp int64) int64 {
 	return dayTime.UnixNano()
 }
 
-// GetHeartBeatStats get heart beat statistics during start and end time
-func GetHeartBeatStats(now, regTime int64) (int64, int64) {
+// GetHeartBeatStats get heart beat statistics during start and end time, window is in days
+func GetHeartBeatStats(now, regTime int64, window int) (int64, int64) {
 	// yesterday
 	end := TodayBeginning(now) - int64(24*time.Hour)
-	// seven days ago
-	start := end - int64((blockchain.NodeHealthTimeDur-1)*24*time.Hour)
+	// window days ago
+	start := end - int64(time.Duration(window-1)*24*time.Hour)
 	if start < regTime {
 		start = regTime
 		end = now
@@ -98,7 +109,7 @@ func GetHeartBeatStats(now, regTime int64) (int64, int64) {
 }
 
 // GetHeartBeatTotalNumByTime get total heart beat number of a storage node during given time period
-func GetHeartBeatTotalNumByTime(ctx context.Context, chain HealthChain, id []byte, start, end int64) (int, error) {
+func GetHeartBeatTotalNumByTime(ctx context.Context, chain HeartbeatChain, id []byte, start, end int64) (int, error) {
 	t := start
 
 	var ts []int64
@@ -114,13 +125,119 @@ func GetHeartBeatTotalNumByTime(ctx context.Context, chain HealthChain, id []byt
 	return heartBeatTotal, nil
 }
 
-// GetHeartbeatMaxNum calculate the max possible heart beat number given a time period
-func GetHeartbeatMaxNum(start, end, regTime int64) int {
+// HeartbeatCommitChain defines contract/chaincode methods needed to list heartbeat commits of nodes
+type HeartbeatCommitChain interface {
+	GetHeartbeatCommits(ctx context.Context, id []byte, timestamp int64) ([]blockchain.HeartbeatCommit, error)
+}
+
+// ListHeartbeatCommits lists heartbeat commits of a storage node overlapping the given time period
+func ListHeartbeatCommits(ctx context.Context, chain HeartbeatCommitChain, id []byte, start, end int64) (
+	[]blockchain.HeartbeatCommit, error) {
+	var commits []blockchain.HeartbeatCommit
+	for t := TodayBeginning(start); t <= end; t = TodayBeginning(t + int64(24*time.Hour)) {
+		cs, err := chain.GetHeartbeatCommits(ctx, id, t)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range cs {
+			if c.End >= start && c.Start <= end {
+				commits = append(commits, c)
+			}
+		}
+	}
+	return commits, nil
+}
+
+// GetHeartbeatMaxNum calculate the max possible heart beat number given a time period, window is in days
+func GetHeartbeatMaxNum(start, end, regTime int64, window int) int {
 	// get heartbeat max
-	heartBeatMax := blockchain.NodeHealthTimeDur * blockchain.HeartBeatPerDay
-	// if register time is not enough 7 days, max heart beat num is from reg to now
+	heartBeatMax := window * blockchain.HeartBeatPerDay
+	// if register time is not enough window days, max heart beat num is from reg to now
 	if start == regTime {
 		heartBeatMax = int((end - start) / int64(blockchain.HeartBeatFreq))
 	}
 	return heartBeatMax
 }
+
+// HeartbeatChain defines contract/chaincode methods needed to count heartbeats of nodes
+type HeartbeatChain interface {
+	GetHeartbeatNum(ctx context.Context, id []byte, timestamp int64) (int, error)
+}
+
+// NodeStatsChain defines contract/chaincode methods needed to collect statistics of node health
+type NodeStatsChain interface {
+	HeartbeatChain
+	GetChallengeNum(ctx context.Context, opt *blockchain.GetChallengeNumOptions) (uint64, error)
+	ListChallengeRequests(ctx context.Context, opt *blockchain.ListChallengeOptions) ([]blockchain.Challenge, string, error)
+}
+
+// GetNodeHealthStats collects statistics of a storage node in the window of health policy,
+//  answer latency of proved challenges is only collected if the policy scores it
+func GetNodeHealthStats(ctx context.Context, chain NodeStatsChain, node blockchain.Node,
+	policy blockchain.HealthPolicy, now int64) (stats blockchain.NodeHealthStats, err error) {
+	start, end := GetHeartBeatStats(now, node.RegTime, policy.Window)
+
+	// get proved challenges ratio
+	numOpt := blockchain.GetChallengeNumOptions{
+		TargetNode: node.ID,
+		TimeStart:  start,
+		TimeEnd:    end,
+	}
+	if stats.ChallengeNum, err = chain.GetChallengeNum(ctx, &numOpt); err != nil {
+		return stats, err
+	}
+	if stats.ChallengeNum > 0 {
+		numOpt.Status = blockchain.ChallengeProved
+		if stats.ProvedNum, err = chain.GetChallengeNum(ctx, &numOpt); err != nil {
+			return stats, err
+		}
+	}
+
+	// get heartbeat ratio
+	stats.HeartbeatMax = GetHeartbeatMaxNum(start, end, node.RegTime, policy.Window)
+	if stats.HeartbeatMax > 0 {
+		if stats.HeartbeatNum, err = GetHeartBeatTotalNumByTime(ctx, chain, node.ID, start, end); err != nil {
+			return stats, err
+		}
+	}
+
+	if policy.LatencyWeight > 0 && stats.ProvedNum > 0 {
+		if err := getAnswerLatency(ctx, chain, node.ID, start, end, &stats); err != nil {
+			return stats, err
+		}
+	}
+	return stats, nil
+}
+
+// getAnswerLatency averages answer latency of challenges proved by node between start and end
+func getAnswerLatency(ctx context.Context, chain NodeStatsChain, id []byte, start, end int64,
+	stats *blockchain.NodeHealthStats) error {
+	opt := blockchain.ListChallengeOptions{
+		TargetNode: id,
+		Status:     blockchain.ChallengeProved,
+		TimeStart:  start,
+		TimeEnd:    end,
+		Limit:      1000,
+	}
+	var total int64
+	for {
+		cs, next, err := chain.ListChallengeRequests(ctx, &opt)
+		if err != nil {
+			return err
+		}
+		for _, c := range cs {
+			if c.AnswerTime > c.ChallengeTime {
+				total += c.AnswerTime - c.ChallengeTime
+				stats.LatencySamples++
+			}
+		}
+		if next == "" {
+			break
+		}
+		opt.Cursor = next
+	}
+	if stats.LatencySamples > 0 {
+		stats.AvgLatency = total / int64(stats.LatencySamples)
+	}
+	return nil
+}
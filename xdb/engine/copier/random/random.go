@@ -15,12 +15,14 @@ package random
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"math/rand"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/common"
@@ -30,9 +32,12 @@ import (
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
 	etype "github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/metrics"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/bandwidth"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/http"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/tracing"
 )
 
 var (
@@ -44,13 +49,16 @@ var (
 //  If you want more Storage Nodes, you can call ReplicaExpansion(),
 //  and it pulls slices from original nodes and decrypts and re-encrypts those slices,
 //  then push them onto new Storage Nodes.
+//  Slices pushed and pulled are limited by bandwidth limiter if not nil.
 type RandomCopier struct {
 	privateKey ecdsa.PrivateKey
+	limiter    *bandwidth.Limiter
 }
 
-func New(privkey ecdsa.PrivateKey) *RandomCopier {
+func New(privkey ecdsa.PrivateKey, limiter *bandwidth.Limiter) *RandomCopier {
 	c := &RandomCopier{
 		privateKey: privkey,
+		limiter:    limiter,
 	}
 	logger.Info("copier initialization")
 	return c
@@ -111,19 +119,43 @@ func (m *RandomCopier) Select(slice slicer.Slice, nodes blockchain.NodeHs, opt *
 	return ls, nil
 }
 
-// Push pushes slices onto Storage Node
-func (m *RandomCopier) Push(ctx context.Context, id, sourceId string, r io.Reader, node *blockchain.Node) error {
-	url := fmt.Sprintf("http://%s/v1/slice/push?slice_id=%s&source_id=%s", node.Address, id, sourceId)
+// Push pushes slices onto Storage Node, signed over slice id, target node id, cipher hash and timestamp
+func (m *RandomCopier) Push(ctx context.Context, id, sourceId string, cipherHash []byte, r io.Reader,
+	node *blockchain.Node) (err error) {
+	ctx, span := tracing.Start(ctx, "RandomCopier.Push", attribute.String("slice_id", id),
+		attribute.String("target_node", node.Name), attribute.String("address", node.Address))
+	defer func() { tracing.End(span, err) }()
+
+	timestamp := time.Now().UnixNano()
+	msg := fmt.Sprintf("%s,%s,%s,%d", id, node.ID, hex.EncodeToString(cipherHash), timestamp)
+	sig, err := ecdsa.Sign(m.privateKey, hash.Hash([]byte(msg)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign slice push")
+	}
+	url := fmt.Sprintf("%s://%s/v1/slice/push?slice_id=%s&source_id=%s&cipher_hash=%s&timestamp=%d&signature=%s",
+		http.Scheme(), node.Address, id, sourceId, hex.EncodeToString(cipherHash), timestamp, sig.String())
+	// certificate of the storage node must be bound to its id on blockchain over TLS
+	ctx = http.WithPeer(ctx, node.ID)
+
+	if m.limiter != nil {
+		r = m.limiter.Reader(ctx, string(node.ID), r)
+	}
 
 	var resp etype.PushResponse
 	if err := http.PostResponse(ctx, url, r, &resp); err != nil {
+		metrics.SlicesPushed.WithLabelValues(node.Name, metrics.StatusFailed).Inc()
 		return errorx.Wrap(err, "failed to do post")
 	}
+	metrics.SlicesPushed.WithLabelValues(node.Name, metrics.StatusSuccess).Inc()
 
 	return nil
 }
 
-func (m *RandomCopier) Pull(ctx context.Context, id, fileId string, node *blockchain.Node) (io.ReadCloser, error) {
+func (m *RandomCopier) Pull(ctx context.Context, id, fileId string, node *blockchain.Node) (rc io.ReadCloser, err error) {
+	ctx, span := tracing.Start(ctx, "RandomCopier.Pull", attribute.String("slice_id", id),
+		attribute.String("target_node", node.Name), attribute.String("address", node.Address))
+	defer func() { tracing.End(span, err) }()
+
 	// 新增签名
 	timestamp := time.Now().UnixNano()
 	msg := fmt.Sprintf("%s,%s,%d", id, fileId, timestamp)
@@ -131,14 +163,19 @@ func (m *RandomCopier) Pull(ctx context.Context, id, fileId string, node *blockc
 	if err != nil {
 		return nil, errorx.Wrap(err, "failed to sign sile pull ")
 	}
-	url := fmt.Sprintf("http://%s/v1/slice/pull?slice_id=%s&file_id=%s&timestamp=%d&signature=%s",
-		node.Address, id, fileId, timestamp, sig.String())
+	url := fmt.Sprintf("%s://%s/v1/slice/pull?slice_id=%s&file_id=%s&timestamp=%d&signature=%s",
+		http.Scheme(), node.Address, id, fileId, timestamp, sig.String())
 
-	r, err := http.Get(ctx, url)
+	r, err := http.Get(http.WithPeer(ctx, node.ID), url)
 	if err != nil {
+		metrics.SlicesPulled.WithLabelValues(node.Name, metrics.StatusFailed).Inc()
 		return nil, errorx.Wrap(err, "failed to do get")
 	}
+	metrics.SlicesPulled.WithLabelValues(node.Name, metrics.StatusSuccess).Inc()
 
+	if m.limiter != nil {
+		return m.limiter.ReadCloser(ctx, string(node.ID), r), nil
+	}
 	return r, nil
 }
 
@@ -16,6 +16,8 @@ package engine
 import (
 	"context"
 	"io"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -29,6 +31,7 @@ import (
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/peer"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/bandwidth"
 	fl_crypto "github.com/PaddlePaddle/PaddleDTX/crypto/client/service/xchain"
 )
 
@@ -65,6 +68,9 @@ type Challenger interface {
 	NewSetup(sliceData []byte, rangeAmount int, merkleMaterialQueue chan<- ctype.Material, cm ctype.Material) error
 	Save(ctx context.Context, cms []ctype.Material) error
 	Take(ctx context.Context, fileID string, sliceID string, nodeID []byte) (ctype.RangeHash, error)
+	MaterialStats(ctx context.Context) ([]ctype.MaterialStats, int64, error)
+	PruneMaterials(ctx context.Context, fileID string, before int64, keep func(sliceID string, nodeID []byte) bool) (int, error)
+	CompactMaterials(ctx context.Context) (int, error)
 
 	GetChallengeConf() (string, types.PDP)
 	Close()
@@ -77,7 +83,7 @@ type Challenger interface {
 //  then push them onto new Storage Nodes.
 type Copier interface {
 	Select(slice slicer.Slice, nodes blockchain.NodeHs, opt *copier.SelectOptions) (copier.LocatedSlice, error)
-	Push(ctx context.Context, id, sourceId string, r io.Reader, node *blockchain.Node) error
+	Push(ctx context.Context, id, sourceId string, cipherHash []byte, r io.Reader, node *blockchain.Node) error
 	Pull(ctx context.Context, id, fileId string, node *blockchain.Node) (io.ReadCloser, error)
 	ReplicaExpansion(ctx context.Context, opt *copier.ReplicaExpOptions, enc common.MigrateEncryptor,
 		challengAlgorithm, sourceId, fileId string) ([]blockchain.PublicSliceMeta, []encryptor.EncryptedSlice, error)
@@ -92,31 +98,76 @@ type Blockchain interface {
 	GetNode(ctx context.Context, id []byte) (blockchain.Node, error)
 	NodeOffline(ctx context.Context, opt *blockchain.NodeOperateOptions) error
 	NodeOnline(ctx context.Context, opt *blockchain.NodeOperateOptions) error
+	NodeDrain(ctx context.Context, opt *blockchain.NodeOperateOptions) error
+	GetNodeSliceNum(ctx context.Context, id []byte, timestamp int64) (int, error)
 	Heartbeat(ctx context.Context, id, sig []byte, timestamp int64) error
 	GetHeartbeatNum(ctx context.Context, id []byte, timestamp int64) (int, error)
+	CommitHeartbeats(ctx context.Context, opt *blockchain.CommitHeartbeatsOptions) error
+	GetHeartbeatCommits(ctx context.Context, id []byte, timestamp int64) ([]blockchain.HeartbeatCommit, error)
+	GetLastHeartbeatCommit(ctx context.Context, id []byte) (blockchain.HeartbeatCommit, error)
 	GetNodeHealth(ctx context.Context, id []byte) (string, error)
+	GetChallengeNum(ctx context.Context, opt *blockchain.GetChallengeNumOptions) (uint64, error)
+	GetHealthPolicy(ctx context.Context, version int64) (blockchain.HealthPolicy, error)
+	UpdateHealthPolicy(ctx context.Context, opt *blockchain.UpdateHealthPolicyOptions) error
 	ListNodesExpireSlice(ctx context.Context, opt *blockchain.ListNodeSliceOptions) ([]string, error)
+	ListNodeSlices(ctx context.Context, opt *blockchain.ListNodeSliceOptions) ([]blockchain.NodeSlice, string, error)
 	GetSliceMigrateRecords(ctx context.Context, opt *blockchain.NodeSliceMigrateOptions) (string, error)
 
 	PublishFile(ctx context.Context, file *blockchain.PublishFileOptions) error
+	AppendFile(ctx context.Context, opt *blockchain.AppendFileOptions) error
 	GetFileByName(ctx context.Context, owner []byte, ns, name string) (blockchain.File, error)
 	GetFileByID(ctx context.Context, id string) (blockchain.File, error)
 	UpdateFileExpireTime(ctx context.Context, opt *blockchain.UpdatExptimeOptions) (blockchain.File, error)
 	AddFileNs(ctx context.Context, opt *blockchain.AddNsOptions) error
 	UpdateNsFilesCap(ctx context.Context, opt *blockchain.UpdateNsFilesCapOptions) (blockchain.Namespace, error)
 	UpdateNsReplica(ctx context.Context, opt *blockchain.UpdateNsReplicaOptions) error
+	UpdateNsQuota(ctx context.Context, opt *blockchain.UpdateNsQuotaOptions) error
+	UpdateNsRenewal(ctx context.Context, opt *blockchain.UpdateNsRenewalOptions) error
 	UpdateFilePublicSliceMeta(ctx context.Context, opt *blockchain.UpdateFilePSMOptions) error
 	SliceMigrateRecord(ctx context.Context, id, sig []byte, fid, sid string, ctime int64) error
+	ReportCorruptedSlice(ctx context.Context, opt *blockchain.ReportCorruptedSliceOptions) error
+	ListCorruptedSlices(ctx context.Context, owner []byte) ([]blockchain.CorruptedSlice, error)
 	GetNsByName(ctx context.Context, owner []byte, name string) (blockchain.Namespace, error)
-	ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) ([]blockchain.Namespace, error)
-	ListFiles(ctx context.Context, opt *blockchain.ListFileOptions) ([]blockchain.File, error)
-	ListExpiredFiles(ctx context.Context, opt *blockchain.ListFileOptions) ([]blockchain.File, error)
+	AddNsMember(ctx context.Context, opt *blockchain.NsMemberOptions) error
+	RemoveNsMember(ctx context.Context, opt *blockchain.NsMemberOptions) error
+	GetNsMember(ctx context.Context, owner []byte, ns string, member []byte) (blockchain.NsMember, error)
+	ListNsMembers(ctx context.Context, owner []byte, ns string) ([]blockchain.NsMember, error)
+	ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) ([]blockchain.Namespace, string, error)
+	ListFiles(ctx context.Context, opt *blockchain.ListFileOptions) ([]blockchain.File, string, error)
+	QueryFiles(ctx context.Context, opt *blockchain.QueryFileOptions) ([]blockchain.File, string, error)
+	ListExpiredFiles(ctx context.Context, opt *blockchain.ListFileOptions) ([]blockchain.File, string, error)
 
 	ListChallengeRequests(ctx context.Context, opt *blockchain.ListChallengeOptions) (
-		[]blockchain.Challenge, error)
+		[]blockchain.Challenge, string, error)
 	ChallengeRequest(ctx context.Context, opt *blockchain.ChallengeRequestOptions) error
 	ChallengeAnswer(ctx context.Context, opt *blockchain.ChallengeAnswerOptions) ([]byte, error)
 	GetChallengeById(ctx context.Context, id string) (blockchain.Challenge, error)
+
+	ListDaiTasks(ctx context.Context, opt *blockchain.ListDaiTaskOptions) ([]blockchain.DaiTask, error)
+
+	ProposeTransfer(ctx context.Context, opt *blockchain.ProposeTransferOptions) error
+	AcceptTransfer(ctx context.Context, opt *blockchain.AcceptTransferOptions) error
+	CompleteTransfer(ctx context.Context, opt *blockchain.CompleteTransferOptions) error
+	CancelTransfer(ctx context.Context, opt *blockchain.CancelTransferOptions) error
+	GetTransferByID(ctx context.Context, id string) (blockchain.Transfer, error)
+	ListTransfers(ctx context.Context, opt *blockchain.ListTransferOptions) ([]blockchain.Transfer, error)
+}
+
+// SliceCache keeps verified slices pulled from Storage Nodes, so that reading the same file
+//  again needs no pulling and decrypting of its slices
+//  see more from engine.cache
+type SliceCache interface {
+	Get(sliceID string, cipherHash []byte) ([]byte, bool)
+	Put(fileID, sliceID string, cipherHash, data []byte, expireTime int64)
+	Invalidate(fileID string)
+	Close()
+}
+
+// Bandwidth limits bandwidth of slices transferred to and from Storage Nodes
+//  see more from pkgs.bandwidth
+type Bandwidth interface {
+	Limits() bandwidth.Limits
+	SetLimits(limits bandwidth.Limits)
 }
 
 // Storage stores files locally
@@ -127,6 +178,7 @@ type Storage interface {
 	Exist(key string) (bool, error)
 	LoadStr(ctx context.Context, key string) (string, error)
 	SaveAndUpdate(ctx context.Context, key, value string) error
+	List(ctx context.Context) (map[string]time.Time, error)
 }
 
 type Engine struct {
@@ -136,6 +188,10 @@ type Engine struct {
 	chain      Blockchain
 	copier     Copier
 	storage    Storage
+	sliceCache SliceCache // sliceCache is nil if slices are not cached
+	bandwidth  Bandwidth
+	pushGuard  *pushGuard
+	appending  sync.Map // ids of files being appended
 
 	monitor *Monitor
 }
@@ -150,6 +206,11 @@ type NewEngineOption struct {
 	Chain      Blockchain
 	Copier     Copier
 	Storage    Storage
+	SliceCache SliceCache
+	Bandwidth  Bandwidth
+
+	AllowedOwners [][]byte // dataOwner nodes allowed to push slices, any having a namespace if empty
+	MaxSliceSize  int64    // maximum size of a pushed slice, defaultMaxSliceSize if 0
 }
 
 // NewEngine initiates Engine
@@ -165,6 +226,9 @@ func NewEngine(conf *config.MonitorConf, opt *NewEngineOption) (*Engine, error)
 		chain:      opt.Chain,
 		copier:     opt.Copier,
 		storage:    opt.Storage,
+		sliceCache: opt.SliceCache,
+		bandwidth:  opt.Bandwidth,
+		pushGuard:  newPushGuard(opt.LocalNode.ID, opt.AllowedOwners, opt.MaxSliceSize),
 		monitor:    monitor,
 	}
 	return e, nil
@@ -182,4 +246,7 @@ func (e *Engine) Close() {
 	if e.monitor != nil {
 		e.monitor.Close()
 	}
+	if e.sliceCache != nil {
+		e.sliceCache.Close()
+	}
 }
@@ -14,6 +14,7 @@
 package engine
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
@@ -21,16 +22,42 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/tracing"
 )
 
 // Push receive slices from others
-// rewriting a slice is not allowed
+// rewriting a slice is not allowed, and pushes must be signed by registered dataOwner nodes
 func (e *Engine) Push(ctx context.Context, opt types.PushOptions, r io.Reader) (
-	types.PushResponse, error) {
+	resp types.PushResponse, err error) {
+	ctx, span := tracing.Start(ctx, "Engine.Push", attribute.String("slice_id", opt.SliceID))
+	defer func() { tracing.End(span, err) }()
+
+	// certificate of the client must be bound to the dataOwner node pushing slices if presented
+	if len(opt.PeerID) > 0 && hex.EncodeToString(opt.PeerID) != opt.SourceId {
+		return types.PushResponse{}, errorx.New(errorx.ErrCodeNotAuthorized,
+			"certificate of client is not bound to source node %s", opt.SourceId)
+	}
+
+	// verify token, which must be signed for the local node
+	msgDigest := e.pushGuard.pushDigest(opt)
+	if err := verifyUserToken(opt.SourceId, opt.Signature, msgDigest); err != nil {
+		return types.PushResponse{}, errorx.Wrap(err, "failed to verify slice push token")
+	}
+	if err := e.pushGuard.checkReplay(msgDigest, opt.Timestamp); err != nil {
+		return types.PushResponse{}, err
+	}
+	source, err := hex.DecodeString(opt.SourceId)
+	if err != nil {
+		return types.PushResponse{}, errorx.NewCode(err, errorx.ErrCodeParam, "bad source id")
+	}
+	if err := e.pushGuard.checkOwner(ctx, e.chain, source); err != nil {
+		return types.PushResponse{}, err
+	}
 
 	exist, err := e.storage.Exist(opt.SliceID)
 	if err != nil {
@@ -41,7 +68,16 @@ func (e *Engine) Push(ctx context.Context, opt types.PushOptions, r io.Reader) (
 		return types.PushResponse{}, nil
 	}
 
-	if err := e.storage.Save(ctx, opt.SliceID, r); err != nil {
+	// slices not matching the announced hash are not saved
+	data, err := e.pushGuard.readSlice(r)
+	if err != nil {
+		return types.PushResponse{}, err
+	}
+	if !bytes.Equal(hash.Hash(data), opt.CipherHash) {
+		return types.PushResponse{}, errorx.New(errorx.ErrCodeParam, "slice does not match cipher hash")
+	}
+
+	if err := e.storage.Save(ctx, opt.SliceID, bytes.NewReader(data)); err != nil {
 		logger.WithError(err).Errorf("push %s", opt.SliceID)
 		return types.PushResponse{}, errorx.Wrap(err, "failed to save slice")
 	}
@@ -55,7 +91,11 @@ func (e *Engine) Push(ctx context.Context, opt types.PushOptions, r io.Reader) (
 }
 
 // Pull serve local slices
-func (e *Engine) Pull(ctx context.Context, opt types.PullOptions) (io.ReadCloser, error) {
+func (e *Engine) Pull(ctx context.Context, opt types.PullOptions) (rc io.ReadCloser, err error) {
+	ctx, span := tracing.Start(ctx, "Engine.Pull", attribute.String("slice_id", opt.SliceID),
+		attribute.String("file_id", opt.FileID))
+	defer func() { tracing.End(span, err) }()
+
 	//check timestamp
 	var requestExpiredTime time.Duration = 5 * time.Minute
 	if int64(opt.Timestamp) < (time.Now().UnixNano() - requestExpiredTime.Nanoseconds()) {
@@ -75,6 +115,10 @@ func (e *Engine) Pull(ctx context.Context, opt types.PullOptions) (io.ReadCloser
 	if err := verifyUserToken(hex.EncodeToString(file.Owner), opt.Signature, msgDigest); err != nil {
 		return nil, errorx.Wrap(err, "failed to verify slice pull  token")
 	}
+	// certificate of the client must be bound to the file owner if presented
+	if len(opt.PeerID) > 0 && !bytes.Equal(opt.PeerID, file.Owner) {
+		return nil, errorx.New(errorx.ErrCodeNotAuthorized, "certificate of client is not bound to file owner")
+	}
 
 	exist, err := e.storage.Exist(opt.SliceID)
 	if err != nil {
@@ -84,7 +128,7 @@ func (e *Engine) Pull(ctx context.Context, opt types.PullOptions) (io.ReadCloser
 		return nil, errorx.New(errorx.ErrCodeNotFound, "slice not found")
 	}
 
-	rc, err := e.storage.Load(ctx, opt.SliceID)
+	rc, err = e.storage.Load(ctx, opt.SliceID)
 	if err != nil {
 		return nil, errorx.Wrap(err, "failed to load slice")
 	}
@@ -0,0 +1,212 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/common"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/encryptor"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/events"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/metrics"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/tracing"
+)
+
+// Append appends data to an existing file without re-uploading it. Data appended is encrypted as a new segment
+//  of the file, then sliced and pushed to storage nodes the same as writing, and new slices take pdp indices
+//  after the last ones on each node, so that tags of slices already stored are still valid.
+//  Modifying data of a file is not supported, since slices are never rewritten once pushed to storage nodes
+func (e *Engine) Append(ctx context.Context, opt types.AppendOptions, r io.Reader) (
+	resp types.AppendResponse, err error) {
+	ctx, span := tracing.Start(ctx, "Engine.Append", attribute.String("file_id", opt.FileID))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	// verify token
+	if opt.Timestamp < time.Now().UnixNano()-(5*time.Minute).Nanoseconds() {
+		return resp, errorx.New(errorx.ErrCodeParam, "request has expired")
+	}
+	msg := fmt.Sprintf("%s:%s:%d", opt.User, opt.FileID, opt.Timestamp)
+	if err := verifyUserToken(opt.User, opt.Token, hash.Hash([]byte(msg))); err != nil {
+		return resp, errorx.Wrap(err, "failed to verify token")
+	}
+
+	// appending to a file one by one, or data appended at the same time would overwrite each other's structure
+	if _, loaded := e.appending.LoadOrStore(opt.FileID, struct{}{}); loaded {
+		return resp, errorx.New(errorx.ErrCodeAlreadyUpdate, "file is being appended")
+	}
+	defer e.appending.Delete(opt.FileID)
+
+	pubkey := ecdsa.PublicKeyFromPrivateKey(e.monitor.challengingMonitor.PrivateKey)
+	owner := pubkey.String()
+	f, err := e.chain.GetFileByID(ctx, opt.FileID)
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to get file from blockchain")
+	}
+	if hex.EncodeToString(f.Owner) != owner {
+		return resp, errorx.New(errorx.ErrCodeNotAuthorized, "not authorized")
+	}
+	if f.ExpireTime <= time.Now().UnixNano() {
+		return resp, errorx.New(errorx.ErrCodeExpired, "file already expired")
+	}
+	// check user role, files are owned by local node
+	if err := e.verifyNsRole(ctx, opt.User, f.Namespace, blockchain.NsRoleWriter); err != nil {
+		return resp, err
+	}
+	// sigma_i of new slices could only be calculated by the same pdp key
+	ca, pdp := e.challenger.GetChallengeConf()
+	if (ca == types.PDPChallengAlgorithm) != (len(f.PdpPubkey) > 0) ||
+		(ca == types.PDPChallengAlgorithm && !bytes.Equal(f.PdpPubkey, pdp.PdpPubkey)) {
+		return resp, errorx.New(errorx.ErrCodeParam, "file was not challenged the same way as local node")
+	}
+	ns, err := e.chain.GetNsByName(ctx, pubkey[:], f.Namespace)
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to get ns from blockchain")
+	}
+	fs, err := e.recoverChainFileStructure(f.Structure)
+	if err != nil {
+		return resp, err
+	}
+	segment := fs.Segments()
+
+	// encrypt data appended as a new segment
+	_, encSpan := tracing.Start(ctx, "encryptFile")
+	cipher, err := e.encryptor.Encrypt(context.TODO(), r,
+		&encryptor.EncryptOptions{SliceID: segmentKeyID(f.ID, segment)})
+	tracing.End(encSpan, err)
+	if err != nil {
+		logger.WithError(err).Error("file encryption failed")
+		return resp, errorx.NewCode(err, errorx.ErrCodeCrypto, "file encryption failed")
+	}
+	appendLen := len(cipher.CipherText) - 16
+	if appendLen <= 0 {
+		return resp, errorx.New(errorx.ErrCodeParam, "empty data to append")
+	}
+
+	slicesNum := math.Ceil(float64(len(cipher.CipherText)) / float64(e.slicer.GetBlockSize()))
+	if (ns.FilesStruSize + calculateFileMaxStructSize(int(slicesNum), ns.Replica)) >= blockchain.ContractMessageMaxSize {
+		return resp, errorx.New(errorx.ErrCodeParam, "files total struct size of ns more than maximum")
+	}
+	if reason := ns.AppendExceeded(f.Length, uint64(appendLen)); reason != "" {
+		logger.WithFields(logrus.Fields{
+			"file_id":   f.ID,
+			"namespace": f.Namespace,
+		}).Warnf("namespace quota exceeded: %s", reason)
+		return resp, errorx.New(errorx.ErrCodeQuotaExceeded, reason)
+	}
+	nodes, err := common.GetHealthNodes(ctx, e.chain)
+	if err != nil {
+		return resp, err
+	}
+	// draining nodes take no new slices
+	nodes = common.ExcludeDrainingNodes(nodes)
+	if len(nodes) < ns.Replica {
+		return resp, errorx.Internal(err, "available healthy nodes smaller than replica")
+	}
+
+	logger.WithFields(logrus.Fields{
+		"file_id":   f.ID,
+		"namespace": f.Namespace,
+		"segment":   segment,
+		"length":    appendLen,
+	}).Info("append file")
+
+	sliceMetas, finishedEncSlices, err := e.pushCipher(ctx, cipher.CipherText, ns.Replica, nodes, owner)
+	if err != nil {
+		return resp, err
+	}
+	if ca == types.MerkleChallengAlgorithm {
+		if err := e.generateAndSaveMerkle(ctx, finishedEncSlices, f.ID, f.ExpireTime); err != nil {
+			return resp, err
+		}
+	}
+
+	// pack slices appended, file structure and merkle root of the whole file
+	pdp.RandU, pdp.RandV = f.RandU, f.RandV
+	chainSlices, err := packChainSlices(ca, finishedEncSlices, lastSliceIdxMap(f.Slices), pdp)
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to pack chain slices")
+	}
+	for _, s := range sliceMetas {
+		fs = append(fs, blockchain.PrivateSliceMeta{
+			SliceID:   s.ID,
+			PlainHash: s.Hash,
+			Segment:   segment,
+		})
+	}
+	structure, err := e.encryptChainFileStructure(fs)
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to pack chain file structure")
+	}
+	appendOpt := blockchain.AppendFileOptions{
+		FileID:      f.ID,
+		Owner:       f.Owner,
+		Slices:      chainSlices,
+		Structure:   structure,
+		Length:      uint64(appendLen),
+		MerkleRoot:  calculateStructureMerkleRoot(fs),
+		CurrentTime: time.Now().UnixNano(),
+	}
+
+	// sign and append onto blockchain
+	ss, err := json.Marshal(appendOpt.Slices)
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to marshal slices")
+	}
+	m := fmt.Sprintf("%s,%x,%x,%d,%x,%d", appendOpt.FileID, hash.Hash(ss), hash.Hash(appendOpt.Structure),
+		appendOpt.Length, appendOpt.MerkleRoot, appendOpt.CurrentTime)
+	sig, err := ecdsa.Sign(e.monitor.challengingMonitor.PrivateKey, hash.Hash([]byte(m)))
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to sign")
+	}
+	appendOpt.Signature = sig[:]
+
+	appCtx, appSpan := tracing.Start(ctx, "appendFile")
+	err = e.chain.AppendFile(appCtx, &appendOpt)
+	tracing.End(appSpan, err)
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to append file on blockchain")
+	}
+
+	length := f.Length + uint64(appendLen)
+	logger.WithField("file_id", f.ID).Debug("file appended")
+	metrics.WriteBytes.Add(float64(appendLen))
+	events.Publish(events.TypeFileAppended, map[string]string{
+		"file_id":   f.ID,
+		"file_name": f.Name,
+		"namespace": f.Namespace,
+		"segment":   strconv.Itoa(segment),
+		"length":    strconv.FormatUint(length, 10),
+	})
+	resp.FileID = f.ID
+	resp.Length = length
+	return resp, nil
+}
@@ -16,6 +16,7 @@ package engine
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -25,19 +26,20 @@ import (
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/common"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/bandwidth"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
 )
 
 // ListFiles lists files from blockchain
 func (e *Engine) ListFiles(ctx context.Context, opt types.ListFileOptions) (
-	[]blockchain.File, error) {
+	[]blockchain.File, string, error) {
 	if len(opt.Namespace) != 0 {
 		if _, err := e.chain.GetNsByName(ctx, opt.Owner, opt.Namespace); err != nil {
 			if errorx.Is(err, errorx.ErrCodeNotFound) {
-				return nil, errorx.New(errorx.ErrCodeNotFound, "ns not found")
+				return nil, "", errorx.New(errorx.ErrCodeNotFound, "ns not found")
 			} else {
-				return nil, errorx.Wrap(err, "failed to get ns from blockchain")
+				return nil, "", errorx.Wrap(err, "failed to get ns from blockchain")
 			}
 		}
 	}
@@ -47,25 +49,64 @@ func (e *Engine) ListFiles(ctx context.Context, opt types.ListFileOptions) (
 		TimeStart:   opt.TimeStart,
 		TimeEnd:     opt.TimeEnd,
 		Limit:       opt.Limit,
+		Cursor:      opt.Cursor,
 		CurrentTime: opt.CurrentTime,
 	}
-	files, err := e.chain.ListFiles(ctx, &bcopt)
+	files, next, err := e.chain.ListFiles(ctx, &bcopt)
 	if err != nil {
-		return nil, errorx.Wrap(err, "failed to read blockchain")
+		return nil, "", errorx.Wrap(err, "failed to read blockchain")
 	}
-	return files, nil
+	return files, next, nil
+}
+
+// QueryFiles query files by tags, name prefix, size range and expire time range
+func (e *Engine) QueryFiles(ctx context.Context, opt types.QueryFileOptions) (
+	[]blockchain.File, string, error) {
+	if len(opt.Namespace) != 0 {
+		if _, err := e.chain.GetNsByName(ctx, opt.Owner, opt.Namespace); err != nil {
+			if errorx.Is(err, errorx.ErrCodeNotFound) {
+				return nil, "", errorx.New(errorx.ErrCodeNotFound, "ns not found")
+			} else {
+				return nil, "", errorx.Wrap(err, "failed to get ns from blockchain")
+			}
+		}
+	}
+	if opt.MaxSize > 0 && opt.MinSize > opt.MaxSize {
+		return nil, "", errorx.New(errorx.ErrCodeParam, "bad param: size range")
+	}
+	if opt.ExpireTimeEnd > 0 && opt.ExpireTimeStart > opt.ExpireTimeEnd {
+		return nil, "", errorx.New(errorx.ErrCodeParam, "bad param: expire time range")
+	}
+	bcopt := blockchain.QueryFileOptions{
+		Owner:           opt.Owner,
+		Namespace:       opt.Namespace,
+		Tags:            opt.Tags,
+		NamePrefix:      opt.NamePrefix,
+		MinSize:         opt.MinSize,
+		MaxSize:         opt.MaxSize,
+		ExpireTimeStart: opt.ExpireTimeStart,
+		ExpireTimeEnd:   opt.ExpireTimeEnd,
+		CurrentTime:     opt.CurrentTime,
+		Limit:           opt.Limit,
+		Cursor:          opt.Cursor,
+	}
+	files, next, err := e.chain.QueryFiles(ctx, &bcopt)
+	if err != nil {
+		return nil, "", errorx.Wrap(err, "failed to read blockchain")
+	}
+	return files, next, nil
 }
 
 // ListExpiredFiles list expired but still valid files
 func (e *Engine) ListExpiredFiles(ctx context.Context, opt types.ListFileOptions) (
-	[]blockchain.File, error) {
+	[]blockchain.File, string, error) {
 	if len(opt.Namespace) != 0 {
 		_, err := e.chain.GetNsByName(ctx, opt.Owner, opt.Namespace)
 		if err != nil {
 			if errorx.Is(err, errorx.ErrCodeNotFound) {
-				return nil, errorx.New(errorx.ErrCodeNotFound, "ns not found")
+				return nil, "", errorx.New(errorx.ErrCodeNotFound, "ns not found")
 			} else {
-				return nil, errorx.Wrap(err, "failed to get ns from blockchain")
+				return nil, "", errorx.Wrap(err, "failed to get ns from blockchain")
 			}
 		}
 	}
@@ -75,13 +116,14 @@ func (e *Engine) ListExpiredFiles(ctx context.Context, opt types.ListFileOptions
 		TimeStart:   opt.TimeStart,
 		TimeEnd:     opt.TimeEnd,
 		Limit:       opt.Limit,
+		Cursor:      opt.Cursor,
 		CurrentTime: opt.CurrentTime,
 	}
-	files, err := e.chain.ListExpiredFiles(ctx, &bcopt)
+	files, next, err := e.chain.ListExpiredFiles(ctx, &bcopt)
 	if err != nil {
-		return nil, errorx.Wrap(err, "failed to read blockchain")
+		return nil, "", errorx.Wrap(err, "failed to read blockchain")
 	}
-	return files, nil
+	return files, next, nil
 }
 
 // GetFileByID gets file by id from blockchain
@@ -204,6 +246,8 @@ func (e *Engine) UpdateFileExpireTime(ctx context.Context, opt types.UpdateFileE
 }
 
 // AddFileNs adds file namespace
+//  namespaces are owned by local node, only the node owner could add namespaces,
+//  members are granted roles per namespace after it's added
 func (e *Engine) AddFileNs(ctx context.Context, opt types.AddNsOptions) (err error) {
 	if err := e.verifyUserID(opt.Owner); err != nil {
 		return err
@@ -238,17 +282,23 @@ func (e *Engine) AddFileNs(ctx context.Context, opt types.AddNsOptions) (err err
 	return nil
 }
 
-// UpdateNsReplica updates file namespace replica
+// UpdateNsReplica updates file namespace replica, only namespace owner or admins are allowed
 func (e *Engine) UpdateNsReplica(ctx context.Context, opt types.UpdateNsOptions) error {
 	localPrv := e.monitor.challengingMonitor.PrivateKey
 	localPub := ecdsa.PublicKeyFromPrivateKey(localPrv)
+	if len(opt.Owner) == 0 {
+		opt.Owner = localPub.String()
+	}
 	m := fmt.Sprintf("%s,%d,%d", opt.Namespace, opt.Replica, opt.CurrentTime)
-	if err := verifyUserToken(localPub.String(), opt.Token, hash.Hash([]byte(m))); err != nil {
+	if err := verifyUserToken(opt.Owner, opt.Token, hash.Hash([]byte(m))); err != nil {
 		return err
 	}
-	sig, err := ecdsa.DecodeSignatureFromString(opt.Token)
+	if err := e.verifyNsRole(ctx, opt.Owner, opt.Namespace, blockchain.NsRoleAdmin); err != nil {
+		return err
+	}
+	sig, err := ecdsa.Sign(localPrv, hash.Hash([]byte(m)))
 	if err != nil {
-		return errorx.Wrap(err, "failed to decode signature")
+		return errorx.Wrap(err, "failed to sign update ns replica param")
 	}
 
 	// get replica from chain
@@ -264,8 +314,8 @@ func (e *Engine) UpdateNsReplica(ctx context.Context, opt types.UpdateNsOptions)
 	if err != nil {
 		return errorx.Wrap(err, "failed to get nodes from blockchain")
 	}
-	// new replica must less than nodes
-	if opt.Replica > len(healthNodes) {
+	// new replica must less than nodes, except draining nodes
+	if opt.Replica > len(common.ExcludeDrainingNodes(healthNodes)) {
 		return errorx.New(errorx.ErrCodeInternal, "no optional healthy node to expand replica")
 	}
 
@@ -277,7 +327,7 @@ func (e *Engine) UpdateNsReplica(ctx context.Context, opt types.UpdateNsOptions)
 		TimeEnd:     time.Now().UnixNano(),
 		CurrentTime: time.Now().UnixNano(),
 	}
-	files, err := e.chain.ListFiles(ctx, &bcopt)
+	files, _, err := e.chain.ListFiles(ctx, &bcopt)
 	if err != nil {
 		return errorx.Wrap(err, "failed to get file list on  blockchain")
 	}
@@ -307,20 +357,274 @@ func (e *Engine) UpdateNsReplica(ctx context.Context, opt types.UpdateNsOptions)
 	return nil
 }
 
+// UpdateNsQuota updates file namespace quota, only namespace owner or admins are allowed
+func (e *Engine) UpdateNsQuota(ctx context.Context, opt types.UpdateNsQuotaOptions) error {
+	if opt.MaxFiles < 0 {
+		return errorx.New(errorx.ErrCodeParam, "bad param: max files")
+	}
+	localPrv := e.monitor.challengingMonitor.PrivateKey
+	localPub := ecdsa.PublicKeyFromPrivateKey(localPrv)
+	if len(opt.Owner) == 0 {
+		opt.Owner = localPub.String()
+	}
+	m := fmt.Sprintf("%s,%d,%d,%d,%d", opt.Namespace, opt.MaxBytes, opt.MaxFiles, opt.MaxFileSize, opt.CurrentTime)
+	if err := verifyUserToken(opt.Owner, opt.Token, hash.Hash([]byte(m))); err != nil {
+		return err
+	}
+	if err := e.verifyNsRole(ctx, opt.Owner, opt.Namespace, blockchain.NsRoleAdmin); err != nil {
+		return err
+	}
+	sig, err := ecdsa.Sign(localPrv, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign update ns quota param")
+	}
+
+	sopt := &blockchain.UpdateNsQuotaOptions{
+		Owner: localPub[:],
+		Name:  opt.Namespace,
+		Quota: blockchain.NsQuota{
+			MaxBytes:    opt.MaxBytes,
+			MaxFiles:    opt.MaxFiles,
+			MaxFileSize: opt.MaxFileSize,
+		},
+		CurrentTime: opt.CurrentTime,
+		Signature:   sig[:],
+	}
+	if err := e.chain.UpdateNsQuota(ctx, sopt); err != nil {
+		return errorx.Wrap(err, "failed to update file ns quota on blockchain")
+	}
+	return nil
+}
+
+// UpdateNsRenewal updates renewal policy of file namespace, only namespace owner or admins are allowed,
+//  files of the namespace are renewed by FileMaintainer under the policy
+func (e *Engine) UpdateNsRenewal(ctx context.Context, opt types.UpdateNsRenewalOptions) error {
+	renewal := blockchain.NsRenewal{
+		Policy: opt.Policy,
+		Days:   opt.Days,
+		Until:  opt.Until,
+	}
+	if !blockchain.ValidNsRenewal(renewal) {
+		return errorx.New(errorx.ErrCodeParam, "bad param: renewal policy")
+	}
+	localPrv := e.monitor.challengingMonitor.PrivateKey
+	localPub := ecdsa.PublicKeyFromPrivateKey(localPrv)
+	if len(opt.Owner) == 0 {
+		opt.Owner = localPub.String()
+	}
+	m := fmt.Sprintf("%s,%s,%d,%d,%d", opt.Namespace, opt.Policy, opt.Days, opt.Until, opt.CurrentTime)
+	if err := verifyUserToken(opt.Owner, opt.Token, hash.Hash([]byte(m))); err != nil {
+		return err
+	}
+	if err := e.verifyNsRole(ctx, opt.Owner, opt.Namespace, blockchain.NsRoleAdmin); err != nil {
+		return err
+	}
+	sig, err := ecdsa.Sign(localPrv, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign update ns renewal param")
+	}
+
+	sopt := &blockchain.UpdateNsRenewalOptions{
+		Owner:       localPub[:],
+		Name:        opt.Namespace,
+		Renewal:     renewal,
+		CurrentTime: opt.CurrentTime,
+		Signature:   sig[:],
+	}
+	if err := e.chain.UpdateNsRenewal(ctx, sopt); err != nil {
+		return errorx.Wrap(err, "failed to update file ns renewal on blockchain")
+	}
+	return nil
+}
+
+// AddNsMember adds a member into namespace or updates role of the member,
+//  only namespace owner or admins are allowed
+func (e *Engine) AddNsMember(ctx context.Context, opt types.NsMemberOptions) error {
+	if !blockchain.NsRoleAllows(opt.Role, blockchain.NsRoleReader) {
+		return errorx.New(errorx.ErrCodeParam, "bad param: role")
+	}
+	return e.updateNsMember(ctx, opt, blockchain.NsMemberAdd, e.chain.AddNsMember)
+}
+
+// RemoveNsMember removes a member from namespace, only namespace owner or admins are allowed
+func (e *Engine) RemoveNsMember(ctx context.Context, opt types.NsMemberOptions) error {
+	opt.Role = ""
+	return e.updateNsMember(ctx, opt, blockchain.NsMemberRemove, e.chain.RemoveNsMember)
+}
+
+// ListNsMembers lists members of namespace owned by local node
+func (e *Engine) ListNsMembers(ctx context.Context, ns string) ([]blockchain.NsMember, error) {
+	localPub := ecdsa.PublicKeyFromPrivateKey(e.monitor.challengingMonitor.PrivateKey)
+	members, err := e.chain.ListNsMembers(ctx, localPub[:], ns)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list namespace members from blockchain")
+	}
+	return members, nil
+}
+
+func (e *Engine) updateNsMember(ctx context.Context, opt types.NsMemberOptions, op string,
+	update func(context.Context, *blockchain.NsMemberOptions) error) error {
+	if opt.CurrentTime+5*time.Second.Nanoseconds() < time.Now().UnixNano() {
+		return errorx.New(errorx.ErrCodeExpired, "request expired")
+	}
+	m := fmt.Sprintf("%s,%s,%s,%d", opt.Namespace, opt.Member, opt.Role, opt.CurrentTime)
+	if err := verifyUserToken(opt.User, opt.Token, hash.Hash([]byte(m))); err != nil {
+		return err
+	}
+	if err := e.verifyNsRole(ctx, opt.User, opt.Namespace, blockchain.NsRoleAdmin); err != nil {
+		return err
+	}
+	member, err := ecdsa.DecodePublicKeyFromString(opt.Member)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeParam, "bad param: member")
+	}
+
+	mopt := &blockchain.NsMemberOptions{
+		Namespace:   opt.Namespace,
+		Member:      member[:],
+		Role:        opt.Role,
+		CurrentTime: opt.CurrentTime,
+	}
+	if err := e.signNsMemberOptions(mopt, op); err != nil {
+		return err
+	}
+	if err := update(ctx, mopt); err != nil {
+		return errorx.Wrap(err, "failed to update namespace member on blockchain")
+	}
+	return nil
+}
+
+// signNsMemberOptions signs NsMemberOptions on operation op by local node which owns the namespace
+func (e *Engine) signNsMemberOptions(opt *blockchain.NsMemberOptions, op string) error {
+	localPrv := e.monitor.challengingMonitor.PrivateKey
+	localPub := ecdsa.PublicKeyFromPrivateKey(localPrv)
+	m := fmt.Sprintf("%s,%s,%x,%s,%d", op, opt.Namespace, opt.Member, opt.Role, opt.CurrentTime)
+	sig, err := ecdsa.Sign(localPrv, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign namespace member")
+	}
+	opt.Owner = localPub[:]
+	opt.Signature = sig[:]
+	return nil
+}
+
+// Rebalance plans slice moves to even out usage of healthy storage nodes, and runs them unless it's a dry run
+func (e *Engine) Rebalance(ctx context.Context, opt types.RebalanceOptions) (types.RebalancePlan, error) {
+	if e.monitor.fileMaintainer == nil {
+		return types.RebalancePlan{}, errorx.New(errorx.ErrCodeConfig, "filemaintainer is off, rebalance is not supported")
+	}
+	if opt.CurrentTime+5*time.Second.Nanoseconds() < time.Now().UnixNano() {
+		return types.RebalancePlan{}, errorx.New(errorx.ErrCodeExpired, "request expired")
+	}
+	localPub := ecdsa.PublicKeyFromPrivateKey(e.monitor.challengingMonitor.PrivateKey)
+	m := fmt.Sprintf("%s,%d,%d,%d", opt.Namespace, opt.MaxMoves, opt.MoveInterval, opt.CurrentTime)
+	if err := verifyUserToken(localPub.String(), opt.Token, hash.Hash([]byte(m))); err != nil {
+		return types.RebalancePlan{}, err
+	}
+	if len(opt.Namespace) > 0 {
+		if _, err := e.chain.GetNsByName(ctx, localPub[:], opt.Namespace); err != nil {
+			return types.RebalancePlan{}, errorx.Wrap(err, "failed to get ns from blockchain")
+		}
+	}
+
+	plan, err := e.monitor.fileMaintainer.Rebalance(ctx, &opt)
+	if err != nil {
+		return plan, errorx.Wrap(err, "failed to rebalance")
+	}
+	return plan, nil
+}
+
+// GetBandwidth returns bandwidth limits in effect
+func (e *Engine) GetBandwidth(ctx context.Context) (types.Bandwidth, error) {
+	if e.bandwidth == nil {
+		return types.Bandwidth{}, errorx.New(errorx.ErrCodeConfig, "bandwidth limits are only supported by dataOwner node")
+	}
+	limits := e.bandwidth.Limits()
+	return types.Bandwidth{Limit: limits.Limit, NodeLimit: limits.NodeLimit}, nil
+}
+
+// GetMaterialStats reports merkle challenge materials kept by the dataOwner node for each namespace
+func (e *Engine) GetMaterialStats(ctx context.Context) (types.MaterialReport, error) {
+	var report types.MaterialReport
+	if e.challenger == nil {
+		return report, errorx.New(errorx.ErrCodeConfig, "challenge materials are only kept by dataOwner node")
+	}
+	if algorithm, _ := e.challenger.GetChallengeConf(); algorithm != types.MerkleChallengAlgorithm {
+		return report, errorx.New(errorx.ErrCodeConfig, "challenge materials are only kept for merkle challenges")
+	}
+	stats, usage, err := e.challenger.MaterialStats(ctx)
+	if err != nil {
+		return report, err
+	}
+	report.DiskUsage = usage
+
+	nsStats := make(map[string]*types.NsMaterialStats)
+	for _, st := range stats {
+		var ns string
+		file, err := e.chain.GetFileByID(ctx, st.FileID)
+		if err != nil && !errorx.Is(err, errorx.ErrCodeNotFound) {
+			return report, errorx.Wrap(err, "failed to get file from blockchain")
+		}
+		if err == nil {
+			ns = file.Namespace
+		}
+		s, ok := nsStats[ns]
+		if !ok {
+			s = &types.NsMaterialStats{Namespace: ns}
+			nsStats[ns] = s
+		}
+		s.Files++
+		s.Records += st.Records
+		s.Ranges += st.Ranges
+		s.Used += st.Used
+		s.Size += st.Size
+	}
+	for _, s := range nsStats {
+		report.Namespaces = append(report.Namespaces, *s)
+	}
+	sort.Slice(report.Namespaces, func(i, j int) bool {
+		return report.Namespaces[i].Namespace < report.Namespaces[j].Namespace
+	})
+	return report, nil
+}
+
+// UpdateBandwidth changes bandwidth limits at runtime, slices being transferred are affected immediately,
+//  only the dataOwner node itself is allowed to do it
+func (e *Engine) UpdateBandwidth(ctx context.Context, opt types.UpdateBandwidthOptions) (types.Bandwidth, error) {
+	if e.bandwidth == nil {
+		return types.Bandwidth{}, errorx.New(errorx.ErrCodeConfig, "bandwidth limits are only supported by dataOwner node")
+	}
+	localPub := ecdsa.PublicKeyFromPrivateKey(e.monitor.challengingMonitor.PrivateKey)
+	m := fmt.Sprintf("%d,%d,%d", opt.Limit, opt.NodeLimit, opt.CurrentTime)
+	if err := verifyUserToken(localPub.String(), opt.Token, hash.Hash([]byte(m))); err != nil {
+		return types.Bandwidth{}, err
+	}
+
+	e.bandwidth.SetLimits(bandwidth.Limits{Limit: opt.Limit, NodeLimit: opt.NodeLimit})
+	logger.WithFields(logrus.Fields{
+		"limit":      opt.Limit,
+		"node_limit": opt.NodeLimit,
+	}).Info("bandwidth limits updated")
+
+	return e.GetBandwidth(ctx)
+}
+
 // ListFileNs lists file namespaces by owner
-func (e *Engine) ListFileNs(ctx context.Context, opt types.ListNsOptions) (nss []blockchain.Namespace, err error) {
+func (e *Engine) ListFileNs(ctx context.Context, opt types.ListNsOptions) (
+	nss []blockchain.Namespace, next string, err error) {
 	nsopt := blockchain.ListNsOptions{
 		Owner:       opt.Owner,
 		TimeStart:   opt.TimeStart,
 		TimeEnd:     opt.TimeEnd,
 		Limit:       opt.Limit,
+		Cursor:      opt.Cursor,
 		CurrentTime: time.Now().UnixNano(),
 	}
-	nss, err = e.chain.ListFileNs(ctx, &nsopt)
+	nss, next, err = e.chain.ListFileNs(ctx, &nsopt)
 	if err != nil {
-		return nil, errorx.Wrap(err, "failed to read blockchain")
+		return nil, "", errorx.Wrap(err, "failed to read blockchain")
 	}
-	return nss, nil
+	return nss, next, nil
 }
 
 // GetNsByName gets namespace by name from blockchain
@@ -345,7 +649,7 @@ func (e *Engine) GetFileSysHealth(ctx context.Context, owner []byte) (fh blockch
 		TimeStart: 0,
 		TimeEnd:   time.Now().UnixNano(),
 	}
-	nss, err := e.chain.ListFileNs(ctx, &nsopt)
+	nss, _, err := e.chain.ListFileNs(ctx, &nsopt)
 	if err != nil {
 		return fh, errorx.Wrap(err, "failed to read blockchain")
 	}
@@ -375,28 +679,31 @@ func (e *Engine) GetChallengeById(ctx context.Context, id string) (challenge blo
 }
 
 //GetChallenges lists all challenges with given status from blockchain
-func (e *Engine) GetChallenges(ctx context.Context, opt blockchain.ListChallengeOptions) (challenges []blockchain.Challenge, err error) {
+func (e *Engine) GetChallenges(ctx context.Context, opt blockchain.ListChallengeOptions) (
+	challenges []blockchain.Challenge, next string, err error) {
 	_, err = e.chain.GetNode(ctx, opt.TargetNode)
 	if err != nil {
 		if errorx.Is(err, errorx.ErrCodeNotFound) {
-			return challenges, errorx.New(errorx.ErrCodeNotFound, "node not found")
+			return challenges, "", errorx.New(errorx.ErrCodeNotFound, "node not found")
 		} else {
-			return challenges, errorx.Wrap(err, "failed to read blockchain")
+			return challenges, "", errorx.Wrap(err, "failed to read blockchain")
 		}
 	}
-	challenges, err = e.chain.ListChallengeRequests(ctx, &opt)
+	challenges, next, err = e.chain.ListChallengeRequests(ctx, &opt)
 	if err != nil {
 		if errorx.Is(err, errorx.ErrCodeNotFound) {
-			return challenges, errorx.New(errorx.ErrCodeNotFound, "challenge not found")
+			return challenges, "", errorx.New(errorx.ErrCodeNotFound, "challenge not found")
 		} else {
-			return challenges, errorx.Wrap(err, "failed to read blockchain")
+			return challenges, "", errorx.Wrap(err, "failed to read blockchain")
 		}
 	}
-	return challenges, nil
+	return challenges, next, nil
 }
 
 func (e *Engine) nsReplicaExpansion(ctx context.Context, files []blockchain.File, healthNodes blockchain.NodeHs,
 	replica int, pri ecdsa.PrivateKey) error {
+	// replica expansion gives way to user reads and file writing
+	ctx = bandwidth.WithPriority(ctx, bandwidth.PriorityBackground)
 
 	nodesMap := common.ToNodeHsMap(healthNodes)
 	interval := e.monitor.challengingMonitor.RequestInterval.Nanoseconds()
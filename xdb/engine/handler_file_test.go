@@ -0,0 +1,154 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain/embedded"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/monitor/challenging"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/monitor/filemaintainer"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+// newTestEngine creates an engine of dataOwner-node backed by an in-memory embedded chain
+func newTestEngine(t *testing.T) (*Engine, ecdsa.PrivateKey) {
+	chain, err := embedded.New(nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { chain.Contract.(*embedded.Contract).Close() })
+
+	privkey, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	e := &Engine{
+		chain: chain,
+		monitor: &Monitor{
+			challengingMonitor: &challenging.ChallengingMonitor{PrivateKey: privkey},
+		},
+	}
+	return e, privkey
+}
+
+func addNsOptions(t *testing.T, privkey ecdsa.PrivateKey, owner ecdsa.PublicKey, name string) types.AddNsOptions {
+	ctime := time.Now().UnixNano()
+	s, err := json.Marshal(blockchain.Namespace{
+		Owner:      owner[:],
+		Name:       name,
+		CreateTime: ctime,
+		UpdateTime: ctime,
+		Replica:    1,
+	})
+	require.NoError(t, err)
+	sig, err := ecdsa.Sign(privkey, hash.Hash(s))
+	require.NoError(t, err)
+	return types.AddNsOptions{
+		Owner:      ecdsa.PublicKeyFromPrivateKey(privkey).String(),
+		Namespace:  name,
+		Replica:    1,
+		CreateTime: ctime,
+		Token:      sig.String(),
+	}
+}
+
+func nsMemberOptions(t *testing.T, privkey ecdsa.PrivateKey, ns, member, role string) types.NsMemberOptions {
+	ctime := time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%s,%d", ns, member, role, ctime)
+	sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	return types.NsMemberOptions{
+		User:        ecdsa.PublicKeyFromPrivateKey(privkey).String(),
+		Namespace:   ns,
+		Member:      member,
+		Role:        role,
+		CurrentTime: ctime,
+		Token:       sig.String(),
+	}
+}
+
+func TestNsRoles(t *testing.T) {
+	e, ownerPrv := newTestEngine(t)
+	ctx := context.Background()
+	ownerPub := ecdsa.PublicKeyFromPrivateKey(ownerPrv)
+	require.NoError(t, e.AddFileNs(ctx, addNsOptions(t, ownerPrv, ownerPub, "ns1")))
+
+	adminPrv, adminPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	writerPrv, writerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	_, readerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+
+	// owner grants admin, the admin manages other members
+	require.NoError(t, e.AddNsMember(ctx, nsMemberOptions(t, ownerPrv, "ns1", adminPub.String(), blockchain.NsRoleAdmin)))
+	require.NoError(t, e.AddNsMember(ctx, nsMemberOptions(t, adminPrv, "ns1", writerPub.String(), blockchain.NsRoleWriter)))
+	require.NoError(t, e.verifyNsRole(ctx, writerPub.String(), "ns1", blockchain.NsRoleReader))
+	err = e.verifyNsRole(ctx, writerPub.String(), "ns1", blockchain.NsRoleAdmin)
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotAuthorized), err)
+	err = e.verifyNsRole(ctx, readerPub.String(), "ns1", blockchain.NsRoleReader)
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotAuthorized), err)
+
+	// writers could not manage members
+	err = e.AddNsMember(ctx, nsMemberOptions(t, writerPrv, "ns1", readerPub.String(), blockchain.NsRoleReader))
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotAuthorized), err)
+
+	// only the node owner adds namespaces, even admins of other namespaces could not
+	err = e.AddFileNs(ctx, addNsOptions(t, adminPrv, ownerPub, "ns2"))
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotAuthorized), err)
+	err = e.AddFileNs(ctx, addNsOptions(t, adminPrv, adminPub, "ns2"))
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotAuthorized), err)
+
+	// removed admins lose their role
+	require.NoError(t, e.RemoveNsMember(ctx, nsMemberOptions(t, ownerPrv, "ns1", adminPub.String(), "")))
+	err = e.AddNsMember(ctx, nsMemberOptions(t, adminPrv, "ns1", readerPub.String(), blockchain.NsRoleReader))
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotAuthorized), err)
+}
+
+func rebalanceOptions(t *testing.T, privkey ecdsa.PrivateKey, ns string, ctime int64) types.RebalanceOptions {
+	opt := types.RebalanceOptions{Namespace: ns, MaxMoves: 1, CurrentTime: ctime}
+	m := fmt.Sprintf("%s,%d,%d,%d", opt.Namespace, opt.MaxMoves, opt.MoveInterval, opt.CurrentTime)
+	sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	opt.Token = sig.String()
+	return opt
+}
+
+func TestRebalance(t *testing.T) {
+	ctx := context.Background()
+	e, localPrv := newTestEngine(t)
+	e.monitor.fileMaintainer = &filemaintainer.FileMaintainer{}
+
+	// requests signed by local owner are rejected once stale
+	stale := time.Now().Add(-10 * time.Second).UnixNano()
+	_, err := e.Rebalance(ctx, rebalanceOptions(t, localPrv, "ns1", stale))
+	require.True(t, errorx.Is(err, errorx.ErrCodeExpired), err)
+
+	// fresh requests of others are rejected
+	otherPrv, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	_, err = e.Rebalance(ctx, rebalanceOptions(t, otherPrv, "ns1", time.Now().UnixNano()))
+	require.True(t, errorx.Is(err, errorx.ErrCodeBadSignature), err)
+
+	// fresh requests of local owner pass verification
+	_, err = e.Rebalance(ctx, rebalanceOptions(t, localPrv, "ns1", time.Now().UnixNano()))
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotFound), err)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/common"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+)
+
+// GetHealthPolicy gets node health policy of a version, version 0 means the current one
+func (e *Engine) GetHealthPolicy(ctx context.Context, version int64) (blockchain.HealthPolicy, error) {
+	policy, err := e.chain.GetHealthPolicy(ctx, version)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrCodeNotFound) {
+			return policy, errorx.New(errorx.ErrCodeNotFound, "health policy not found")
+		}
+		return policy, errorx.Wrap(err, "failed to get health policy")
+	}
+	return policy, nil
+}
+
+// UpdateHealthPolicy relays a new version of node health policy signed by the network admin to blockchain,
+//  the signature is verified by contract against the admin configured at deployment
+func (e *Engine) UpdateHealthPolicy(ctx context.Context, opt types.UpdateHealthPolicyOptions) error {
+	if opt.CurrentTime+5*time.Second.Nanoseconds() < time.Now().UnixNano() {
+		return errorx.New(errorx.ErrCodeExpired, "request expired")
+	}
+	if err := opt.Policy.Valid(); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeParam, "bad param: policy")
+	}
+	sig, err := ecdsa.DecodeSignatureFromString(opt.Token)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeParam, "bad param: token")
+	}
+	uopt := &blockchain.UpdateHealthPolicyOptions{
+		Policy:      opt.Policy,
+		CurrentTime: opt.CurrentTime,
+		Signature:   sig[:],
+	}
+	if err := e.chain.UpdateHealthPolicy(ctx, uopt); err != nil {
+		return errorx.Wrap(err, "failed to update health policy on blockchain")
+	}
+	logger.WithFields(logrus.Fields{
+		"version": opt.Policy.Version,
+	}).Info("node health policy updated")
+	return nil
+}
+
+// SimulateNodeHealth scores existing storage nodes by the current health policy and by a proposed one,
+//  so that the network admin sees how nodes are affected before updating the policy
+func (e *Engine) SimulateNodeHealth(ctx context.Context, proposed blockchain.HealthPolicy) (
+	sim types.HealthSimulation, err error) {
+	if err := proposed.Valid(); err != nil {
+		return sim, errorx.NewCode(err, errorx.ErrCodeParam, "bad param: policy")
+	}
+	current, err := e.chain.GetHealthPolicy(ctx, 0)
+	if err != nil {
+		return sim, errorx.Wrap(err, "failed to get health policy")
+	}
+	nodes, err := e.chain.ListNodes(ctx)
+	if err != nil {
+		return sim, errorx.Wrap(err, "failed to list nodes")
+	}
+	sim.CurrentVersion = current.Version
+	// statistics are collected again only if the proposed policy looks at a different window or latency
+	recollect := proposed.Window != current.Window || (proposed.LatencyWeight > 0 && current.LatencyWeight == 0)
+
+	now := time.Now().UnixNano()
+	for _, n := range nodes {
+		cs, err := common.GetNodeHealthStats(ctx, e.chain, n, current, now)
+		if err != nil {
+			return sim, errorx.Wrap(err, "failed to collect statistics of node %s", n.ID)
+		}
+		ps := cs
+		if recollect {
+			if ps, err = common.GetNodeHealthStats(ctx, e.chain, n, proposed, now); err != nil {
+				return sim, errorx.Wrap(err, "failed to collect statistics of node %s", n.ID)
+			}
+		}
+		score := types.NodeHealthScore{
+			NodeID:        string(n.ID),
+			Name:          n.Name,
+			CurrentScore:  current.Score(cs),
+			ProposedScore: proposed.Score(ps),
+		}
+		score.CurrentHealth = current.Health(score.CurrentScore)
+		score.ProposedHealth = proposed.Health(score.ProposedScore)
+		sim.Nodes = append(sim.Nodes, score)
+	}
+	return sim, nil
+}
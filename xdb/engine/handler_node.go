@@ -142,6 +142,74 @@ func (e *Engine) NodeOnline(ctx context.Context, opt types.NodeOnlineOptions) er
 	return nil
 }
 
+// NodeDrain set storage node status to draining, slices on it will be migrated by dataOwners
+func (e *Engine) NodeDrain(ctx context.Context, opt types.NodeDrainOptions) error {
+	if err := e.verifyUserID(opt.NodeID); err != nil {
+		return err
+	}
+	sig, err := ecdsa.DecodeSignatureFromString(opt.Token)
+	if err != nil {
+		return errorx.Wrap(err, "failed to decode signature")
+	}
+
+	nodeOpts := &blockchain.NodeOperateOptions{
+		NodeID: []byte(opt.NodeID),
+		Nonce:  opt.Nonce,
+		Sig:    sig[:],
+	}
+	if err := e.chain.NodeDrain(ctx, nodeOpts); err != nil {
+		if errorx.Is(err, errorx.ErrCodeNotFound) {
+			return errorx.New(errorx.ErrCodeNotFound, "node not found")
+		} else {
+			return errorx.Wrap(err, "failed to read blockchain")
+		}
+	}
+	return nil
+}
+
+// GetNodeDrainStatus gets draining progress of storage node by node id
+func (e *Engine) GetNodeDrainStatus(ctx context.Context, id []byte) (status blockchain.NodeDrainStatus, err error) {
+	node, err := e.chain.GetNode(ctx, id)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrCodeNotFound) {
+			return status, errorx.New(errorx.ErrCodeNotFound, "node not found")
+		} else {
+			return status, errorx.Wrap(err, "failed to read blockchain")
+		}
+	}
+	status.NodeID = string(node.ID)
+	status.Draining = node.Draining
+	status.DrainTime = node.DrainTime
+
+	now := time.Now().UnixNano()
+	status.LiveSlices, err = e.chain.GetNodeSliceNum(ctx, id, now)
+	if err != nil {
+		return status, errorx.Wrap(err, "failed to get node slice number")
+	}
+	if !node.Draining {
+		return status, nil
+	}
+
+	// count slices migrated away since draining started
+	mopt := &blockchain.NodeSliceMigrateOptions{
+		Target:    id,
+		StartTime: node.DrainTime,
+		EndTime:   now,
+	}
+	records, err := e.chain.GetSliceMigrateRecords(ctx, mopt)
+	if err != nil {
+		return status, errorx.Wrap(err, "failed to get node migrate records")
+	}
+	var mrs []map[string]interface{}
+	if err := json.Unmarshal([]byte(records), &mrs); err != nil {
+		return status, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to unmarshal migrate records")
+	}
+	status.MigratedSlices = len(mrs)
+	// removable only if recorded on chain, LiveSlices may be zero while slices migrations are pending
+	status.Removable = node.DrainedTime > 0
+	return status, nil
+}
+
 // GetNodeHealth gets storage node health status by node id
 func (e *Engine) GetNodeHealth(ctx context.Context, id []byte) (string, error) {
 	status, err := e.chain.GetNodeHealth(ctx, id)
@@ -180,9 +248,13 @@ func (e *Engine) GetHeartbeatNum(ctx context.Context, id []byte, ctime int64) (i
 	if ctime != 0 && ctime < node.RegTime {
 		return 0, 0, errorx.New(errorx.ErrCodeNotFound, "invalid time, must greater than node register time")
 	}
+	policy, err := e.chain.GetHealthPolicy(ctx, 0)
+	if err != nil {
+		return 0, 0, errorx.Wrap(err, "failed to get health policy")
+	}
 	now := time.Now().UnixNano()
-	start, end := common.GetHeartBeatStats(now, node.RegTime)
-	heartBeatMax := common.GetHeartbeatMaxNum(start, end, node.RegTime)
+	start, end := common.GetHeartBeatStats(now, node.RegTime, policy.Window)
+	heartBeatMax := common.GetHeartbeatMaxNum(start, end, node.RegTime, policy.Window)
 
 	// get heartbeat num of ctime
 	if ctime != 0 && ctime >= node.RegTime {
@@ -213,3 +285,32 @@ func (e *Engine) GetHeartbeatNum(ctx context.Context, id []byte, ctime int64) (i
 	}
 	return heartBeatTotal, heartBeatMax, nil
 }
+
+// ListHeartbeats lists heartbeats kept by local storage node between start and end,
+//  which are aggregated and committed on blockchain in aggregate mode
+func (e *Engine) ListHeartbeats(ctx context.Context, start, end int64) ([]blockchain.SignedHeartbeat, error) {
+	if e.monitor.nodeMaintainer == nil {
+		return nil, errorx.New(errorx.ErrCodeConfig, "nodemaintainer is off, heartbeats are not kept")
+	}
+	beats, err := e.monitor.nodeMaintainer.ListHeartbeats(start, end)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list heartbeats")
+	}
+	return beats, nil
+}
+
+// ListHeartbeatCommits lists heartbeat commits of a storage node overlapping the given time period from blockchain
+func (e *Engine) ListHeartbeatCommits(ctx context.Context, id []byte, start, end int64) (
+	[]blockchain.HeartbeatCommit, error) {
+	if _, err := e.chain.GetNode(ctx, id); err != nil {
+		if errorx.Is(err, errorx.ErrCodeNotFound) {
+			return nil, errorx.New(errorx.ErrCodeNotFound, "node not found")
+		}
+		return nil, errorx.Wrap(err, "failed to read blockchain")
+	}
+	commits, err := common.ListHeartbeatCommits(ctx, e.chain, id, start, end)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list heartbeat commits")
+	}
+	return commits, nil
+}
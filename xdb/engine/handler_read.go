@@ -24,13 +24,18 @@ import (
 
 	"github.com/cjqpker/slidewindow"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/common"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/encryptor"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/metrics"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/bandwidth"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/tracing"
 )
 
 var defaultConcurrency uint64 = 10
@@ -57,26 +62,71 @@ func verifyReadToken(ctx context.Context, opt types.ReadOptions) error {
 	return nil
 }
 
-// Read download file by pulling slices from storage nodes
+// Read download file by pulling slices from storage nodes, the plaintext is streamed
+//  to the returned reader segment by segment as slices are pulled
 func (e *Engine) Read(ctx context.Context, opt types.ReadOptions) (io.ReadCloser, error) {
-	ctx, cancel := context.WithCancel(ctx)
+	start := time.Now()
+	ctx, span := tracing.Start(ctx, "Engine.Read", attribute.String("file_id", opt.FileID),
+		attribute.String("namespace", opt.Namespace), attribute.String("file_name", opt.FileName))
+	finish := func(err error) {
+		metrics.ReadDuration.WithLabelValues(metrics.Status(err)).Observe(metrics.Since(start))
+		tracing.End(span, err)
+	}
 
-	// check key match
-	if err := e.verifyUserID(opt.User); err != nil {
-		cancel()
+	f, err := e.getFile4Read(ctx, opt)
+	if err != nil {
+		finish(err)
 		return nil, err
 	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		err := e.readFile(ctx, f, writer)
+		finish(err)
+		writer.CloseWithError(err)
+	}()
+	return reader, nil
+}
+
+// getFile4Read verifies read options and finds the file to read from blockchain
+func (e *Engine) getFile4Read(ctx context.Context, opt types.ReadOptions) (blockchain.File, error) {
 	// verify token
 	if err := verifyReadToken(ctx, opt); err != nil {
-		cancel()
-		return nil, err
+		return blockchain.File{}, err
+	}
+
+	// find file from blockchain
+	localPub := ecdsa.PublicKeyFromPrivateKey(e.monitor.challengingMonitor.PrivateKey)
+	f, err := getBlockchainFile4Read(ctx, e.chain, localPub[:], &opt)
+	if err != nil {
+		if e.sliceCache != nil && len(opt.FileID) > 0 &&
+			(errorx.Is(err, errorx.ErrCodeExpired) || errorx.Is(err, errorx.ErrCodeNotFound)) {
+			e.sliceCache.Invalidate(opt.FileID)
+		}
+		return f, err
+	}
+	if localPub.String() != hex.EncodeToString(f.Owner) {
+		return f, errorx.New(errorx.ErrCodeNotAuthorized, "not authorized")
+	}
+	// check user role
+	if err := e.verifyNsRole(ctx, opt.User, f.Namespace, blockchain.NsRoleReader); err != nil {
+		return f, err
 	}
+	return f, nil
+}
+
+// readFile pulls slices of a file of local node from storage nodes and writes the plaintext to w.
+//  Segments are encrypted separately, so each segment is decrypted and written as soon as
+//  its last slice is pulled, only the cipher text of one segment is held in memory
+func (e *Engine) readFile(ctx context.Context, f blockchain.File, w io.Writer) error {
+	// user reads take priority over other traffic to Storage Nodes
+	ctx, cancel := context.WithCancel(bandwidth.WithPriority(ctx, bandwidth.PriorityRead))
 
 	// prepare
 	allNodes, err := e.chain.ListNodes(ctx)
 	if err != nil {
 		cancel()
-		return nil, errorx.Wrap(err, "failed to get nodes from blockchain")
+		return errorx.Wrap(err, "failed to get nodes from blockchain")
 	}
 	// get online nodes
 	var nodes blockchain.Nodes
@@ -87,26 +137,15 @@ func (e *Engine) Read(ctx context.Context, opt types.ReadOptions) (io.ReadCloser
 	}
 	if len(nodes) == 0 {
 		cancel()
-		return nil, errorx.New(errorx.ErrCodeInternal, "empty online nodes")
+		return errorx.New(errorx.ErrCodeInternal, "empty online nodes")
 	}
 	nodesMap := common.ToNodesMap(nodes)
 
-	// find file from blockchain
-	f, err := getBlockchainFile4Read(ctx, e.chain, &opt)
-	if err != nil {
-		cancel()
-		return nil, err
-	}
-	if opt.User != hex.EncodeToString(f.Owner) {
-		cancel()
-		return nil, errorx.New(errorx.ErrCodeNotAuthorized, "not authorized")
-	}
-
 	// recover structure
 	fs, err := e.recoverChainFileStructure(f.Structure)
 	if err != nil {
 		cancel()
-		return nil, err
+		return err
 	}
 
 	// use sliding window
@@ -127,6 +166,13 @@ func (e *Engine) Read(ctx context.Context, opt types.ReadOptions) (io.ReadCloser
 		if !ok {
 			return errorx.Internal(nil, "bad file structure")
 		}
+		// 0 at the end of each segment is trimmed
+		segmentEnd := fs.SegmentEnd(int(s.Index()))
+		// any replica cached is ok
+		if plainText, ok := e.getCachedSlice(targetPool); ok {
+			setSliceData(s, segmentEnd, plainText)
+			return nil
+		}
 		for _, target := range targetPool {
 			select {
 			case <-ctx.Done():
@@ -145,7 +191,7 @@ func (e *Engine) Read(ctx context.Context, opt types.ReadOptions) (io.ReadCloser
 			if err != nil {
 				logger.WithFields(logrus.Fields{
 					"slice_id":    target.ID,
-					"file_id":     opt.FileID,
+					"file_id":     f.ID,
 					"target_node": string(node.ID),
 				}).WithError(err).Warn("failed to pull slice")
 				continue
@@ -181,12 +227,10 @@ func (e *Engine) Read(ctx context.Context, opt types.ReadOptions) (io.ReadCloser
 				continue
 			}
 
-			// trim 0 at the end of file
-			if s.Index() != sw.Total-1 {
-				s.Set("data", plainText)
-			} else {
-				s.Set("data", bytes.TrimRight(plainText, string([]byte{0})))
+			if e.sliceCache != nil {
+				e.sliceCache.Put(f.ID, target.ID, target.CipherHash, plainText, f.ExpireTime)
 			}
+			setSliceData(s, segmentEnd, plainText)
 
 			break
 		}
@@ -198,49 +242,80 @@ func (e *Engine) Read(ctx context.Context, opt types.ReadOptions) (io.ReadCloser
 		return nil
 	}
 
-	reader, writer := io.Pipe()
+	// cipher text of the segment being pulled
+	var segment bytes.Buffer
+	var length int
 	sw.Done = func(ctx context.Context, s *slidewindow.Session) error {
 		data, exist := s.Get("data")
 		if !exist {
 			return errorx.New(errorx.ErrCodeNotFound, "failed to find data")
 		}
 
-		if _, err := writer.Write(data.([]byte)); err != nil {
-			return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to write")
+		segment.Write(data.([]byte))
+		if !fs.SegmentEnd(int(s.Index())) {
+			return nil
 		}
 
-		// exit on success
-		if s.Index() == uint64(len(fs)-1) {
-			writer.Close()
+		// decrypt the segment once all of its slices are pulled
+		index := fs[int(s.Index())].Segment
+		_, decSpan := tracing.Start(ctx, "recoverSegment", attribute.Int("segment", index))
+		eOpt := encryptor.RecoverOptions{
+			SliceID: segmentKeyID(f.ID, index),
 		}
+		plain, err := e.encryptor.Recover(ctx, &segment, &eOpt)
+		tracing.End(decSpan, err)
+		if err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeCrypto, "file decryption failed")
+		}
+		segment.Reset()
 
+		n, err := w.Write(plain)
+		length += n
+		if err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to write")
+		}
 		return nil
 	}
 
-	go func() {
-		defer cancel()
-		if err := sw.Start(ctx); err != nil {
-			writer.CloseWithError(err)
+	err = sw.Start(ctx)
+	cancel()
+	metrics.ReadBytes.Add(float64(length))
+	if err != nil {
+		return errorx.Wrap(err, "failed to pull slices")
+	}
+	return nil
+}
+
+// getCachedSlice returns the slice if any replica of it is cached
+func (e *Engine) getCachedSlice(targetPool []blockchain.PublicSliceMeta) ([]byte, bool) {
+	if e.sliceCache == nil {
+		return nil, false
+	}
+	for _, target := range targetPool {
+		if plainText, ok := e.sliceCache.Get(target.ID, target.CipherHash); ok {
+			return plainText, true
 		}
-	}()
+	}
+	return nil, false
+}
 
-	// decrypt recovered file
-	plain, err := e.encryptor.Recover(context.TODO(), reader, &encryptor.RecoverOptions{})
-	if err != nil {
-		return nil, errorx.NewCode(err, errorx.ErrCodeCrypto, "file decryption failed")
+// setSliceData sets decrypted slice into session, 0 at the end of the slice is trimmed if trim is true
+func setSliceData(s *slidewindow.Session, trim bool, plainText []byte) {
+	if !trim {
+		s.Set("data", plainText)
+	} else {
+		s.Set("data", bytes.TrimRight(plainText, string([]byte{0})))
 	}
-	return ioutil.NopCloser(bytes.NewReader(plain)), nil
 }
 
-func getBlockchainFile4Read(ctx context.Context, chain Blockchain, opt *types.ReadOptions) (
+func getBlockchainFile4Read(ctx context.Context, chain Blockchain, owner []byte, opt *types.ReadOptions) (
 	blockchain.File, error) {
 	var err error
 	var f blockchain.File
 	if len(opt.FileID) > 0 {
 		f, err = chain.GetFileByID(ctx, opt.FileID)
 	} else {
-		pubkey, _ := hex.DecodeString(opt.User)
-		f, err = chain.GetFileByName(ctx, pubkey, opt.Namespace, opt.FileName)
+		f, err = chain.GetFileByName(ctx, owner, opt.Namespace, opt.FileName)
 	}
 	if err != nil {
 		return f, errorx.Wrap(err, "failed to read file from blockchain")
@@ -0,0 +1,327 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/config"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/encryptor"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/monitor/challenging"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/metrics"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/server"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/tracing"
+)
+
+// plainEncryptor leaves data as it is
+type plainEncryptor struct {
+	Encryptor
+}
+
+func (plainEncryptor) Recover(ctx context.Context, r io.Reader, opt *encryptor.RecoverOptions) ([]byte, error) {
+	return ioutil.ReadAll(r)
+}
+
+// readChain lists the storage nodes and files to read from
+type readChain struct {
+	Blockchain
+	nodes blockchain.Nodes
+	files map[string]blockchain.File
+}
+
+func (c readChain) ListNodes(ctx context.Context) (blockchain.Nodes, error) {
+	return c.nodes, nil
+}
+
+func (c readChain) GetFileByID(ctx context.Context, id string) (blockchain.File, error) {
+	f, ok := c.files[id]
+	if !ok {
+		return f, errorx.New(errorx.ErrCodeNotFound, "file not found")
+	}
+	return f, nil
+}
+
+// waitCopier serves slices, and slices of segments after the first one are served
+//  only after the first segment is written
+type waitCopier struct {
+	Copier
+	slices  map[string][]byte
+	wait    map[string]bool
+	written chan struct{}
+}
+
+func (c *waitCopier) Pull(ctx context.Context, id, fileId string, node *blockchain.Node) (io.ReadCloser, error) {
+	if c.wait[id] {
+		select {
+		case <-c.written:
+		case <-time.After(3 * time.Second):
+			return nil, errorx.New(errorx.ErrCodeInternal, "first segment not written")
+		}
+	}
+	return ioutil.NopCloser(bytes.NewReader(c.slices[id])), nil
+}
+
+// notifyWriter notifies once the first write is done
+type notifyWriter struct {
+	bytes.Buffer
+	writes  int
+	written chan struct{}
+}
+
+func (w *notifyWriter) Write(p []byte) (int, error) {
+	if w.writes == 0 {
+		close(w.written)
+	}
+	w.writes++
+	return w.Buffer.Write(p)
+}
+
+func TestReadFileStream(t *testing.T) {
+	node := blockchain.Node{ID: []byte("node1"), Online: true}
+	structure := blockchain.FileStructure{
+		{SliceID: "s1", Segment: 0},
+		{SliceID: "s2", Segment: 0},
+		{SliceID: "s3", Segment: 1},
+		{SliceID: "s4", Segment: 1},
+	}
+	bs, err := structure.Marshal()
+	require.NoError(t, err)
+
+	slices := map[string][]byte{"s1": []byte("hello "), "s2": []byte("world"), "s3": []byte(", appended"), "s4": []byte(" data")}
+	f := blockchain.File{ID: "file1", Structure: bs}
+	for _, s := range structure {
+		f.Slices = append(f.Slices, blockchain.PublicSliceMeta{ID: s.SliceID, NodeID: node.ID,
+			CipherHash: hash.Hash(slices[s.SliceID]), Length: uint64(len(slices[s.SliceID]))})
+	}
+
+	written := make(chan struct{})
+	e := &Engine{
+		chain:     readChain{nodes: blockchain.Nodes{node}},
+		encryptor: plainEncryptor{},
+		copier:    &waitCopier{slices: slices, wait: map[string]bool{"s3": true, "s4": true}, written: written},
+	}
+
+	// the first segment is written before slices of the second one are pulled
+	w := &notifyWriter{written: written}
+	require.NoError(t, e.readFile(context.Background(), f, w))
+	require.Equal(t, "hello world, appended data", w.String())
+	require.Equal(t, 2, w.writes)
+}
+
+// newReadTestEngine creates an engine of dataOwner-node with a file of two segments to read
+func newReadTestEngine(t *testing.T) (*Engine, ecdsa.PrivateKey, blockchain.File) {
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+
+	node := blockchain.Node{ID: []byte("node1"), Online: true}
+	structure := blockchain.FileStructure{{SliceID: "s1", Segment: 0}, {SliceID: "s2", Segment: 1}}
+	bs, err := structure.Marshal()
+	require.NoError(t, err)
+	slices := map[string][]byte{"s1": []byte("hello "), "s2": []byte("world")}
+	f := blockchain.File{ID: "file1", Name: "file1", Namespace: "ns", Owner: pubkey[:], Length: 11, Structure: bs}
+	for _, s := range structure {
+		f.Slices = append(f.Slices, blockchain.PublicSliceMeta{ID: s.SliceID, NodeID: node.ID,
+			CipherHash: hash.Hash(slices[s.SliceID]), Length: uint64(len(slices[s.SliceID]))})
+	}
+
+	e := &Engine{
+		chain:     readChain{nodes: blockchain.Nodes{node}, files: map[string]blockchain.File{f.ID: f}},
+		encryptor: plainEncryptor{},
+		copier:    &waitCopier{slices: slices},
+		monitor: &Monitor{
+			challengingMonitor: &challenging.ChallengingMonitor{PrivateKey: privkey},
+		},
+	}
+	return e, privkey, f
+}
+
+// serveTestEngine serves e as dataOwner-node over http, and returns address of the server
+func serveTestEngine(t *testing.T, e *Engine) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	srv, err := server.New(addr, e, nil)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), "server-type", config.NodeTypeDataOwner))
+	done := make(chan struct{})
+	go func() {
+		srv.Serve(ctx)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+	return "http://" + addr
+}
+
+// newReadRequest creates a request reading file of the engine owner
+func newReadRequest(t *testing.T, addr string, privkey ecdsa.PrivateKey, fileID string) *http.Request {
+	ts := time.Now().UnixNano()
+	sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(fmt.Sprintf("%s:%d", fileID, ts))))
+	require.NoError(t, err)
+	pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+
+	params := url.Values{}
+	params.Set("user", pubkey.String())
+	params.Set("token", sig.String())
+	params.Set("file_id", fileID)
+	params.Set("timestamp", strconv.FormatInt(ts, 10))
+	req, err := http.NewRequest(http.MethodGet, addr+"/v1/file/read?"+params.Encode(), nil)
+	require.NoError(t, err)
+	return req
+}
+
+// readSampleCount returns the number of observations of file reading with status
+func readSampleCount(t *testing.T, status string) uint64 {
+	var m dto.Metric
+	require.NoError(t, metrics.ReadDuration.WithLabelValues(status).(prometheus.Metric).Write(&m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestReadMetrics(t *testing.T) {
+	e, privkey, f := newReadTestEngine(t)
+	addr := serveTestEngine(t, e)
+
+	successes := readSampleCount(t, metrics.StatusSuccess)
+	failures := readSampleCount(t, metrics.StatusFailed)
+	readBytes := testutil.ToFloat64(metrics.ReadBytes)
+
+	resp, err := http.DefaultClient.Do(newReadRequest(t, addr, privkey, f.ID))
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "hello world", string(body))
+
+	require.Equal(t, successes+1, readSampleCount(t, metrics.StatusSuccess))
+	require.Equal(t, failures, readSampleCount(t, metrics.StatusFailed))
+	require.Equal(t, readBytes+float64(len(body)), testutil.ToFloat64(metrics.ReadBytes))
+
+	// failed reading is observed too
+	resp, err = http.DefaultClient.Do(newReadRequest(t, addr, privkey, "file2"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.NotEqual(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, successes+1, readSampleCount(t, metrics.StatusSuccess))
+	require.Equal(t, failures+1, readSampleCount(t, metrics.StatusFailed))
+
+	// collectors are exposed on the metrics endpoint
+	rec := httptest.NewRecorder()
+	promhttp.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Contains(t, rec.Body.String(), fmt.Sprintf(`xdb_file_read_duration_seconds_count{status="success"} %d`, successes+1))
+	require.Contains(t, rec.Body.String(), "xdb_file_read_bytes_total")
+}
+
+// recordSpans sets a global TracerProvider recording spans, the previous one is restored on cleanup
+func recordSpans(t *testing.T) (*sdktrace.TracerProvider, *tracetest.SpanRecorder) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	prevTP, prevProp := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevProp)
+	})
+	return tp, sr
+}
+
+func TestReadTracing(t *testing.T) {
+	tp, sr := recordSpans(t)
+	e, privkey, f := newReadTestEngine(t)
+	addr := serveTestEngine(t, e)
+
+	// span context of client is propagated to server by http header
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "client")
+	req := newReadRequest(t, addr, privkey, f.ID)
+	tracing.Inject(ctx, req.Header)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	parent.End()
+
+	spans := make(map[string][]sdktrace.ReadOnlySpan)
+	for _, s := range sr.Ended() {
+		spans[s.Name()] = append(spans[s.Name()], s)
+	}
+	require.Len(t, spans["Engine.Read"], 1)
+	read := spans["Engine.Read"][0]
+	require.Equal(t, parent.SpanContext().TraceID(), read.SpanContext().TraceID())
+	require.Equal(t, parent.SpanContext().SpanID(), read.Parent().SpanID())
+	require.True(t, read.Parent().IsRemote())
+	require.Equal(t, codes.Unset, read.Status().Code)
+
+	// each segment is recovered in a child span of Engine.Read
+	require.Len(t, spans["recoverSegment"], 2)
+	for _, s := range spans["recoverSegment"] {
+		require.Equal(t, read.SpanContext().TraceID(), s.SpanContext().TraceID())
+		require.Equal(t, read.SpanContext().SpanID(), s.Parent().SpanID())
+	}
+
+	// errors are recorded on span
+	resp, err = http.DefaultClient.Do(newReadRequest(t, addr, privkey, "file2"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	var failed sdktrace.ReadOnlySpan
+	for _, s := range sr.Ended() {
+		if s.Name() == "Engine.Read" && s.SpanContext().SpanID() != read.SpanContext().SpanID() {
+			failed = s
+		}
+	}
+	require.NotNil(t, failed)
+	require.Equal(t, codes.Error, failed.Status().Code)
+	require.False(t, failed.Parent().IsValid())
+}
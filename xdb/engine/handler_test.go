@@ -0,0 +1,115 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/config"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+	localstorage "github.com/PaddlePaddle/PaddleDTX/xdb/storage/local"
+)
+
+// testNodeID is id of the storage node in push tests
+var testNodeID = []byte("storage-node")
+
+func pushOptions(t *testing.T, privkey ecdsa.PrivateKey, nodeID []byte, sliceID string, data []byte,
+	ts int64) types.PushOptions {
+	cipherHash := hash.Hash(data)
+	m := fmt.Sprintf("%s,%s,%s,%d", sliceID, nodeID, hex.EncodeToString(cipherHash), ts)
+	sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	return types.PushOptions{
+		SliceID:    sliceID,
+		SourceId:   ecdsa.PublicKeyFromPrivateKey(privkey).String(),
+		CipherHash: cipherHash,
+		Timestamp:  ts,
+		Signature:  sig.String(),
+	}
+}
+
+func TestPush(t *testing.T) {
+	e, _ := newTestEngine(t)
+	storage, err := localstorage.New(&config.LocalConf{RootPath: t.TempDir()})
+	require.NoError(t, err)
+	e.storage = storage
+
+	ctx := context.Background()
+	ownerPriv, ownerPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	// namespaces are added on dataOwner nodes of their owners
+	e.monitor.challengingMonitor.PrivateKey = ownerPriv
+	require.NoError(t, e.AddFileNs(ctx, addNsOptions(t, ownerPriv, ownerPub, "ns")))
+	data := []byte("cipher text of slice")
+	e.pushGuard = newPushGuard(testNodeID, nil, int64(len(data)))
+
+	// signed pushes of dataOwners with namespaces are accepted once
+	opt := pushOptions(t, ownerPriv, testNodeID, uuid.NewString(), data, time.Now().UnixNano())
+	_, err = e.Push(ctx, opt, bytes.NewReader(data))
+	require.NoError(t, err)
+	exist, err := storage.Exist(opt.SliceID)
+	require.NoError(t, err)
+	require.True(t, exist)
+	_, err = e.Push(ctx, opt, bytes.NewReader(data))
+	require.True(t, errorx.Is(err, errorx.ErrCodeAlreadyExists), err)
+
+	// pushes signed for another storage node are rejected
+	other := pushOptions(t, ownerPriv, []byte("another-node"), uuid.NewString(), data, time.Now().UnixNano())
+	_, err = e.Push(ctx, other, bytes.NewReader(data))
+	require.True(t, errorx.Is(err, errorx.ErrCodeBadSignature), err)
+	exist, err = storage.Exist(other.SliceID)
+	require.NoError(t, err)
+	require.False(t, exist)
+
+	// pushes out of replay window are rejected
+	stale := pushOptions(t, ownerPriv, testNodeID, uuid.NewString(), data, time.Now().Add(-2*pushReplayWindow).UnixNano())
+	_, err = e.Push(ctx, stale, bytes.NewReader(data))
+	require.True(t, errorx.Is(err, errorx.ErrCodeExpired), err)
+
+	// slices not matching the signed hash, or exceeding the maximum size, are not saved
+	opt = pushOptions(t, ownerPriv, testNodeID, uuid.NewString(), data, time.Now().UnixNano())
+	_, err = e.Push(ctx, opt, bytes.NewReader([]byte("something else")))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	large := append(data, '!')
+	opt = pushOptions(t, ownerPriv, testNodeID, uuid.NewString(), large, time.Now().UnixNano())
+	_, err = e.Push(ctx, opt, bytes.NewReader(large))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+	exist, err = storage.Exist(opt.SliceID)
+	require.NoError(t, err)
+	require.False(t, exist)
+
+	// a new key could add a namespace, but is not allowed unless listed by the storage node
+	otherPriv, otherPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	e.monitor.challengingMonitor.PrivateKey = otherPriv
+	require.NoError(t, e.AddFileNs(ctx, addNsOptions(t, otherPriv, otherPub, "ns")))
+	e.pushGuard = newPushGuard(testNodeID, [][]byte{ownerPub[:]}, 0)
+	opt = pushOptions(t, otherPriv, testNodeID, uuid.NewString(), data, time.Now().UnixNano())
+	_, err = e.Push(ctx, opt, bytes.NewReader(data))
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotAuthorized), err)
+	opt = pushOptions(t, ownerPriv, testNodeID, uuid.NewString(), data, time.Now().UnixNano())
+	_, err = e.Push(ctx, opt, bytes.NewReader(data))
+	require.NoError(t, err)
+}
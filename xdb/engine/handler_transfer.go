@@ -0,0 +1,279 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecies"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+// ProposeTransfer proposes to transfer a file or a whole namespace of local node to another dataOwner,
+//  returns id of the transfer. Once accepted, local node hands over the files encrypted for the new owner,
+//  who re-uploads them to its own namespace, see Handover and CompleteTransfer
+func (e *Engine) ProposeTransfer(ctx context.Context, opt types.ProposeTransferOptions) (string, error) {
+	if err := e.verifyTransferRequest(opt.User, opt.CurrentTime); err != nil {
+		return "", err
+	}
+	m := fmt.Sprintf("%s,%s,%s,%s,%d", opt.Namespace, opt.FileID, opt.NewOwner, opt.NewNamespace, opt.CurrentTime)
+	if err := verifyUserToken(opt.User, opt.Token, hash.Hash([]byte(m))); err != nil {
+		return "", err
+	}
+	newOwner, err := ecdsa.DecodePublicKeyFromString(opt.NewOwner)
+	if err != nil {
+		return "", errorx.NewCode(err, errorx.ErrCodeParam, "bad param: new owner")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", errorx.Internal(err, "failed to get uuid")
+	}
+
+	localPrv := e.monitor.challengingMonitor.PrivateKey
+	localPub := ecdsa.PublicKeyFromPrivateKey(localPrv)
+	cm := fmt.Sprintf("%s,%s,%s,%x,%s,%d", id.String(), opt.Namespace, opt.FileID, newOwner[:], opt.NewNamespace,
+		opt.CurrentTime)
+	sig, err := ecdsa.Sign(localPrv, hash.Hash([]byte(cm)))
+	if err != nil {
+		return "", errorx.Wrap(err, "failed to sign propose transfer param")
+	}
+	popt := &blockchain.ProposeTransferOptions{
+		ID:           id.String(),
+		Owner:        localPub[:],
+		Namespace:    opt.Namespace,
+		FileID:       opt.FileID,
+		NewOwner:     newOwner[:],
+		NewNamespace: opt.NewNamespace,
+		CurrentTime:  opt.CurrentTime,
+		Signature:    sig[:],
+	}
+	if err := e.chain.ProposeTransfer(ctx, popt); err != nil {
+		return "", errorx.Wrap(err, "failed to propose transfer on blockchain")
+	}
+	logger.WithFields(logrus.Fields{
+		"transfer":  popt.ID,
+		"namespace": opt.Namespace,
+		"file_id":   opt.FileID,
+		"new_owner": opt.NewOwner,
+	}).Info("ownership transfer proposed")
+	return popt.ID, nil
+}
+
+// AcceptTransfer accepts a transfer proposed to the owner of local node
+func (e *Engine) AcceptTransfer(ctx context.Context, opt types.AcceptTransferOptions) error {
+	if err := e.verifyTransferRequest(opt.User, opt.CurrentTime); err != nil {
+		return err
+	}
+	m := fmt.Sprintf("%s,%d", opt.ID, opt.CurrentTime)
+	if err := verifyUserToken(opt.User, opt.Token, hash.Hash([]byte(m))); err != nil {
+		return err
+	}
+	sig, err := ecdsa.Sign(e.monitor.challengingMonitor.PrivateKey, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign accept transfer param")
+	}
+	aopt := &blockchain.AcceptTransferOptions{
+		ID:          opt.ID,
+		CurrentTime: opt.CurrentTime,
+		Signature:   sig[:],
+	}
+	if err := e.chain.AcceptTransfer(ctx, aopt); err != nil {
+		return errorx.Wrap(err, "failed to accept transfer on blockchain")
+	}
+	return nil
+}
+
+// CancelTransfer cancels a transfer proposed by or to the owner of local node, files already
+//  replaced stay with the new owner
+func (e *Engine) CancelTransfer(ctx context.Context, opt types.CancelTransferOptions) error {
+	if err := e.verifyTransferRequest(opt.User, opt.CurrentTime); err != nil {
+		return err
+	}
+	m := fmt.Sprintf("%s,%s,%d", blockchain.TransferCanceled, opt.ID, opt.CurrentTime)
+	if err := verifyUserToken(opt.User, opt.Token, hash.Hash([]byte(m))); err != nil {
+		return err
+	}
+	localPrv := e.monitor.challengingMonitor.PrivateKey
+	localPub := ecdsa.PublicKeyFromPrivateKey(localPrv)
+	sig, err := ecdsa.Sign(localPrv, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign cancel transfer param")
+	}
+	copt := &blockchain.CancelTransferOptions{
+		ID:          opt.ID,
+		User:        localPub[:],
+		CurrentTime: opt.CurrentTime,
+		Signature:   sig[:],
+	}
+	if err := e.chain.CancelTransfer(ctx, copt); err != nil {
+		return errorx.Wrap(err, "failed to cancel transfer on blockchain")
+	}
+	logger.WithField("transfer", opt.ID).Info("ownership transfer canceled")
+	return nil
+}
+
+// Handover hands over a file of an accepted transfer to the new owner, the plaintext is encrypted
+//  with public key of the new owner and never leaves local node in clear. Local node signs a receipt over
+//  hash of the plaintext, which the new owner has to submit to complete the transfer of the file
+func (e *Engine) Handover(ctx context.Context, opt types.HandoverOptions) (types.HandoverResponse, error) {
+	var resp types.HandoverResponse
+	if opt.CurrentTime+5*time.Second.Nanoseconds() < time.Now().UnixNano() {
+		return resp, errorx.New(errorx.ErrCodeExpired, "request expired")
+	}
+	m := fmt.Sprintf("%s,%s,%d", opt.ID, opt.FileID, opt.CurrentTime)
+	if err := verifyUserToken(opt.User, opt.Token, hash.Hash([]byte(m))); err != nil {
+		return resp, err
+	}
+	newOwner, err := ecdsa.DecodePublicKeyFromString(opt.User)
+	if err != nil {
+		return resp, errorx.NewCode(err, errorx.ErrCodeParam, "bad user id")
+	}
+
+	t, err := e.GetTransfer(ctx, opt.ID)
+	if err != nil {
+		return resp, err
+	}
+	if t.Status != blockchain.TransferAccepted {
+		return resp, errorx.New(errorx.ErrCodeParam, "transfer is %s, not accepted", t.Status)
+	}
+	if !bytes.Equal(t.NewOwner, newOwner[:]) {
+		return resp, errorx.New(errorx.ErrCodeNotAuthorized, "user is not the new owner of transfer")
+	}
+	f, err := e.chain.GetFileByID(ctx, opt.FileID)
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to read file from blockchain")
+	}
+	localPrv := e.monitor.challengingMonitor.PrivateKey
+	localPub := ecdsa.PublicKeyFromPrivateKey(localPrv)
+	if !bytes.Equal(f.Owner, localPub[:]) || !t.Covers(f) {
+		return resp, errorx.New(errorx.ErrCodeNotAuthorized, "file not included in transfer")
+	}
+	if _, ok := t.Files[f.ID]; ok {
+		return resp, errorx.New(errorx.ErrCodeAlreadyExists, "file already transferred")
+	}
+
+	var buf bytes.Buffer
+	if err := e.readFile(ctx, f, &buf); err != nil {
+		return resp, err
+	}
+	plain := buf.Bytes()
+	contentHash := hash.Hash(plain)
+	rm := fmt.Sprintf("%s,%s,%x,%d", t.ID, f.ID, contentHash, f.Length)
+	receipt, err := ecdsa.Sign(localPrv, hash.Hash([]byte(rm)))
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to sign transfer receipt")
+	}
+	cipherText, err := ecies.Encrypt(newOwner, plain)
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to encrypt file for new owner")
+	}
+	logger.WithFields(logrus.Fields{
+		"transfer": t.ID,
+		"file_id":  f.ID,
+	}).Info("file handed over to new owner")
+
+	resp = types.HandoverResponse{
+		TransferID:  t.ID,
+		FileID:      f.ID,
+		Length:      f.Length,
+		ContentHash: hex.EncodeToString(contentHash),
+		Receipt:     receipt.String(),
+		Ciphertext:  cipherText,
+	}
+	return resp, nil
+}
+
+// CompleteTransfer records that a file of the old owner has been re-uploaded by local node,
+//  the old file expires then. An empty FileID finishes a namespace transfer after all files re-uploaded
+func (e *Engine) CompleteTransfer(ctx context.Context, opt types.CompleteTransferOptions) error {
+	if err := e.verifyTransferRequest(opt.User, opt.CurrentTime); err != nil {
+		return err
+	}
+	contentHash, err := hex.DecodeString(opt.ContentHash)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeParam, "bad param: content hash")
+	}
+	receipt, err := hex.DecodeString(opt.Receipt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeParam, "bad param: receipt")
+	}
+	m := fmt.Sprintf("%s,%s,%s,%x,%d", opt.ID, opt.FileID, opt.NewFileID, contentHash, opt.CurrentTime)
+	if err := verifyUserToken(opt.User, opt.Token, hash.Hash([]byte(m))); err != nil {
+		return err
+	}
+	sig, err := ecdsa.Sign(e.monitor.challengingMonitor.PrivateKey, hash.Hash([]byte(m)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign complete transfer param")
+	}
+	copt := &blockchain.CompleteTransferOptions{
+		ID:          opt.ID,
+		FileID:      opt.FileID,
+		NewFileID:   opt.NewFileID,
+		ContentHash: contentHash,
+		Receipt:     receipt,
+		CurrentTime: opt.CurrentTime,
+		Signature:   sig[:],
+	}
+	if err := e.chain.CompleteTransfer(ctx, copt); err != nil {
+		return errorx.Wrap(err, "failed to complete transfer on blockchain")
+	}
+	return nil
+}
+
+// GetTransfer gets a transfer from blockchain
+func (e *Engine) GetTransfer(ctx context.Context, id string) (blockchain.Transfer, error) {
+	t, err := e.chain.GetTransferByID(ctx, id)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrCodeNotFound) {
+			return t, err
+		}
+		return t, errorx.Wrap(err, "failed to get transfer from blockchain")
+	}
+	return t, nil
+}
+
+// ListTransfers lists transfers proposed by or to a user from blockchain
+func (e *Engine) ListTransfers(ctx context.Context, opt types.ListTransferOptions) ([]blockchain.Transfer, error) {
+	user := ecdsa.PublicKeyFromPrivateKey(e.monitor.challengingMonitor.PrivateKey)
+	if len(opt.User) > 0 {
+		var err error
+		if user, err = ecdsa.DecodePublicKeyFromString(opt.User); err != nil {
+			return nil, errorx.NewCode(err, errorx.ErrCodeParam, "bad param: user")
+		}
+	}
+	ts, err := e.chain.ListTransfers(ctx, &blockchain.ListTransferOptions{User: user[:], Limit: opt.Limit})
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list transfers from blockchain")
+	}
+	return ts, nil
+}
+
+// verifyTransferRequest checks that a transfer request is issued by the owner of local node and not stale
+func (e *Engine) verifyTransferRequest(userID string, ctime int64) error {
+	if ctime+5*time.Second.Nanoseconds() < time.Now().UnixNano() {
+		return errorx.New(errorx.ErrCodeExpired, "request expired")
+	}
+	return e.verifyUserID(userID)
+}
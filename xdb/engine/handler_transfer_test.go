@@ -0,0 +1,117 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+// publishTestFile publishes a file into namespace of local node
+func publishTestFile(t *testing.T, e *Engine, privkey ecdsa.PrivateKey, ns, id string) {
+	pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+	ptime := time.Now().UnixNano()
+	file := blockchain.File{
+		ID:          id,
+		Name:        id,
+		Namespace:   ns,
+		Owner:       pubkey[:],
+		Length:      100,
+		Slices:      []blockchain.PublicSliceMeta{{ID: id + "-s1", NodeID: []byte("node1"), SliceIdx: 1}},
+		PublishTime: ptime,
+		ExpireTime:  ptime + time.Hour.Nanoseconds(),
+	}
+	s, err := json.Marshal(file)
+	require.NoError(t, err)
+	sig, err := ecdsa.Sign(privkey, hash.Hash(s))
+	require.NoError(t, err)
+	require.NoError(t, e.chain.PublishFile(context.Background(),
+		&blockchain.PublishFileOptions{File: file, Signature: sig[:]}))
+}
+
+func handoverOptions(t *testing.T, privkey ecdsa.PrivateKey, id, fileID string, ctime int64) types.HandoverOptions {
+	m := fmt.Sprintf("%s,%s,%d", id, fileID, ctime)
+	sig, err := ecdsa.Sign(privkey, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	return types.HandoverOptions{
+		User:        ecdsa.PublicKeyFromPrivateKey(privkey).String(),
+		ID:          id,
+		FileID:      fileID,
+		CurrentTime: ctime,
+		Token:       sig.String(),
+	}
+}
+
+func TestHandover(t *testing.T) {
+	e, privkey := newTestEngine(t)
+	ctx := context.Background()
+	pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+	require.NoError(t, e.AddFileNs(ctx, addNsOptions(t, privkey, pubkey, "ns")))
+	publishTestFile(t, e, privkey, "ns", "file1")
+	publishTestFile(t, e, privkey, "ns", "file2")
+
+	newPriv, newPub, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	// the new namespace is added on dataOwner-node of the new owner
+	ctime := time.Now().UnixNano()
+	bns := blockchain.Namespace{Owner: newPub[:], Name: "newns", CreateTime: ctime, UpdateTime: ctime, Replica: 1}
+	s, err := json.Marshal(bns)
+	require.NoError(t, err)
+	sig, err := ecdsa.Sign(newPriv, hash.Hash(s))
+	require.NoError(t, err)
+	require.NoError(t, e.chain.AddFileNs(ctx, &blockchain.AddNsOptions{Namespace: bns, Signature: sig[:]}))
+
+	ctime = time.Now().UnixNano()
+	m := fmt.Sprintf("%s,%s,%s,%s,%d", "ns", "file1", newPub.String(), "newns", ctime)
+	sig, err = ecdsa.Sign(privkey, hash.Hash([]byte(m)))
+	require.NoError(t, err)
+	id, err := e.ProposeTransfer(ctx, types.ProposeTransferOptions{User: pubkey.String(), Namespace: "ns",
+		FileID: "file1", NewOwner: newPub.String(), NewNamespace: "newns", CurrentTime: ctime, Token: sig.String()})
+	require.NoError(t, err)
+
+	// files are handed over only after the transfer is accepted
+	_, err = e.Handover(ctx, handoverOptions(t, newPriv, id, "file1", time.Now().UnixNano()))
+	require.True(t, errorx.Is(err, errorx.ErrCodeParam), err)
+
+	ctime = time.Now().UnixNano()
+	sig, err = ecdsa.Sign(newPriv, hash.Hash([]byte(fmt.Sprintf("%s,%d", id, ctime))))
+	require.NoError(t, err)
+	require.NoError(t, e.chain.AcceptTransfer(ctx, &blockchain.AcceptTransferOptions{ID: id, CurrentTime: ctime,
+		Signature: sig[:]}))
+
+	// only to the new owner, with a fresh request, and only files covered by the transfer
+	otherPriv, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	_, err = e.Handover(ctx, handoverOptions(t, otherPriv, id, "file1", time.Now().UnixNano()))
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotAuthorized), err)
+	_, err = e.Handover(ctx, handoverOptions(t, newPriv, id, "file1", time.Now().Add(-time.Minute).UnixNano()))
+	require.True(t, errorx.Is(err, errorx.ErrCodeExpired), err)
+	_, err = e.Handover(ctx, handoverOptions(t, newPriv, id, "file2", time.Now().UnixNano()))
+	require.True(t, errorx.Is(err, errorx.ErrCodeNotAuthorized), err)
+	opt := handoverOptions(t, newPriv, id, "file1", time.Now().UnixNano())
+	opt.Token = handoverOptions(t, newPriv, id, "file2", opt.CurrentTime).Token
+	_, err = e.Handover(ctx, opt)
+	require.True(t, errorx.Is(err, errorx.ErrCodeBadSignature), err)
+}
@@ -16,16 +16,17 @@ package engine
 import (
 	"bytes"
 	"context"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"math"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
 	ctype "github.com/PaddlePaddle/PaddleDTX/xdb/engine/challenger/merkle/types"
@@ -35,8 +36,12 @@ import (
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/slicer"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/events"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/metrics"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/bandwidth"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/tracing"
 )
 
 const (
@@ -57,26 +62,33 @@ func (e *Engine) Write(ctx context.Context, opt types.WriteOptions,
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	var errOccurred error
+	start := time.Now()
+	ctx, span := tracing.Start(ctx, "Engine.Write", attribute.String("namespace", opt.Namespace),
+		attribute.String("file_name", opt.FileName))
+	defer func() {
+		metrics.WriteDuration.WithLabelValues(metrics.Status(err)).Observe(metrics.Since(start))
+		tracing.End(span, err)
+	}()
 
-	// check key match
-	if err := e.verifyUserID(opt.User); err != nil {
-		return resp, err
-	}
 	// verify token
 	msg := fmt.Sprintf("%s:%s:%s", opt.User, opt.Namespace, opt.FileName)
 	if err := verifyUserToken(opt.User, opt.Token, hash.Hash([]byte(msg))); err != nil {
 		return resp, errorx.Wrap(err, "failed to verify token")
 	}
+	// check user role, files are owned by local node
+	if err := e.verifyNsRole(ctx, opt.User, opt.Namespace, blockchain.NsRoleWriter); err != nil {
+		return resp, err
+	}
+	pubkey := ecdsa.PublicKeyFromPrivateKey(e.monitor.challengingMonitor.PrivateKey)
+	owner := pubkey.String()
 
 	// duplicate check
-	pubkey, _ := hex.DecodeString(opt.User)
-	if _, err := e.chain.GetFileByName(ctx, pubkey, opt.Namespace, opt.FileName); err == nil {
+	if _, err := e.chain.GetFileByName(ctx, pubkey[:], opt.Namespace, opt.FileName); err == nil {
 		return resp, errorx.New(errorx.ErrCodeAlreadyExists, "duplicated name")
 	} else if !errorx.Is(err, errorx.ErrCodeNotFound) {
 		return resp, errorx.Wrap(err, "failed to read blockchain")
 	}
-	ns, err := e.chain.GetNsByName(ctx, pubkey, opt.Namespace)
+	ns, err := e.chain.GetNsByName(ctx, pubkey[:], opt.Namespace)
 	if err != nil {
 		return resp, errorx.Wrap(err, "failed to get ns from blockchain")
 	}
@@ -88,10 +100,11 @@ func (e *Engine) Write(ctx context.Context, opt types.WriteOptions,
 	if err != nil {
 		return resp, err
 	}
+	// draining nodes take no new slices
+	nodes = common.ExcludeDrainingNodes(nodes)
 	if len(nodes) < ns.Replica {
 		return resp, errorx.Internal(err, "available healthy nodes smaller than replica")
 	}
-	nodesMap := common.ToNodeHsMap(nodes)
 
 	logger.WithFields(logrus.Fields{
 		"file_id":       fileID.String(),
@@ -102,12 +115,13 @@ func (e *Engine) Write(ctx context.Context, opt types.WriteOptions,
 	}).Info("write file")
 
 	// encrypt file first
+	_, encSpan := tracing.Start(ctx, "encryptFile")
 	cipher, err := e.encryptor.Encrypt(context.TODO(), r, &encryptor.EncryptOptions{})
+	tracing.End(encSpan, err)
 	if err != nil {
 		logger.WithError(err).Error("file encryption failed")
 		return resp, errorx.NewCode(err, errorx.ErrCodeCrypto, "file encryption failed")
 	}
-	r = bytes.NewReader(cipher.CipherText)
 	originalLen := len(cipher.CipherText) - 16
 
 	slicesNum := math.Ceil(float64(len(cipher.CipherText)) / float64(e.slicer.GetBlockSize()))
@@ -120,9 +134,80 @@ func (e *Engine) Write(ctx context.Context, opt types.WriteOptions,
 		}).Warnf("files total struct size of ns more than maximum")
 		return resp, errorx.New(errorx.ErrCodeParam, "files total struct size of ns more than maximum")
 	}
+	if reason := ns.QuotaExceeded(uint64(originalLen)); reason != "" {
+		logger.WithFields(logrus.Fields{
+			"file_id":   fileID.String(),
+			"namespace": opt.Namespace,
+		}).Warnf("namespace quota exceeded: %s", reason)
+		return resp, errorx.New(errorx.ErrCodeQuotaExceeded, reason)
+	}
+
+	// get chanller
+	ca, pdp := e.challenger.GetChallengeConf()
+
+	sliceMetas, finishedEncSlices, err := e.pushCipher(ctx, cipher.CipherText, ns.Replica, nodes, owner)
+	if err != nil {
+		return resp, err
+	}
+	if ca == types.MerkleChallengAlgorithm {
+		if err := e.generateAndSaveMerkle(ctx, finishedEncSlices, fileID.String(), opt.ExpireTime); err != nil {
+			return resp, err
+		}
+	}
+
+	// Write meta info to blockchain
+	chainFile, err := e.packChainFile(fileID.String(), ca, opt, sliceMetas, originalLen, finishedEncSlices, pdp)
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to pack chain file")
+	}
+
+	// sign file info
+	s, err := json.Marshal(chainFile)
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to marshal File")
+	}
+	sig, err := ecdsa.Sign(e.monitor.challengingMonitor.PrivateKey, hash.Hash(s))
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to sign File")
+	}
+	publishFileOpt := blockchain.PublishFileOptions{
+		File:      chainFile,
+		Signature: sig[:],
+	}
+
+	pubCtx, pubSpan := tracing.Start(ctx, "publishFile")
+	err = e.chain.PublishFile(pubCtx, &publishFileOpt)
+	tracing.End(pubSpan, err)
+	if err != nil {
+		return resp, errorx.Wrap(err, "failed to write file to blockchain")
+	}
+
+	logger.WithField("file_id", fileID.String()).Debug("file uploaded")
+	metrics.WriteBytes.Add(float64(originalLen))
+	events.Publish(events.TypeFilePublished, map[string]string{
+		"file_id":     chainFile.ID,
+		"file_name":   chainFile.Name,
+		"namespace":   chainFile.Namespace,
+		"length":      strconv.FormatUint(chainFile.Length, 10),
+		"expire_time": strconv.FormatInt(chainFile.ExpireTime, 10),
+	})
+	resp.FileID = fileID.String()
+	return resp, nil
+}
+
+// pushCipher slices cipher text of a file, selects nodes for each slice, encrypts and pushes slices to storage nodes,
+//  slices failed to push are retried and then pushed to other nodes
+func (e *Engine) pushCipher(ctx context.Context, cipherText []byte, replica int, nodes blockchain.NodeHs,
+	owner string) ([]slicer.SliceMeta, []encryptor.EncryptedSlice, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var errOccurred error
+	nodesMap := common.ToNodeHsMap(nodes)
+
 	// Slice. sliceQueue will be closed when slicer get EOF
 	sliceOpts := slicer.SliceOptions{}
-	sliceQueue := e.slicer.Slice(ctx, r, &sliceOpts, func(err error) {
+	sliceQueue := e.slicer.Slice(ctx, bytes.NewReader(cipherText), &sliceOpts, func(err error) {
 		logger.WithError(err).Error("slicing stopped")
 		cancel()
 	})
@@ -131,7 +216,7 @@ func (e *Engine) Write(ctx context.Context, opt types.WriteOptions,
 	// Both sliceMetaQueue and locatedSliceQueue will be closed when sliceQueue is closed
 	sliceMetaQueue := make(chan slicer.SliceMeta, 10)
 	locatedSliceQueue := make(chan copier.LocatedSlice, defaultLocatorAmount*2)
-	go e.locateRoutine(ctx, ns.Replica, nodes, sliceQueue, locatedSliceQueue, sliceMetaQueue, func(err error) {
+	go e.locateRoutine(ctx, replica, nodes, sliceQueue, locatedSliceQueue, sliceMetaQueue, func(err error) {
 		logger.WithError(err).Error("slice location stopped")
 		errOccurred = err
 		cancel()
@@ -151,14 +236,11 @@ func (e *Engine) Write(ctx context.Context, opt types.WriteOptions,
 		cancel()
 	})
 
-	// get chanller
-	ca, pdp := e.challenger.GetChallengeConf()
-
 	// Setup challenging materials && Distribute
 	// both finishedQueue and failedQueue will be closed when encryptedSliceQueue is closed
 	finishedQueue := make(chan finishWritenSlice, 10)
 	failedQueue := make(chan encryptor.EncryptedSlice, 10)
-	go e.distributeRoutine(ctx, nodesMap, encryptedSliceQueue, finishedQueue, failedQueue, opt.User)
+	go e.distributeRoutine(bandwidth.WithPriority(ctx, bandwidth.PriorityWrite), nodesMap, encryptedSliceQueue, finishedQueue, failedQueue, owner)
 	var finishedEncSlices []encryptor.EncryptedSlice
 	for m := range finishedQueue {
 		finishedEncSlices = append(finishedEncSlices, m.eSlice)
@@ -171,7 +253,7 @@ func (e *Engine) Write(ctx context.Context, opt types.WriteOptions,
 	}
 	finishedQueue2 := make(chan finishWritenSlice, 10)
 	failedQueue2 := make(chan encryptor.EncryptedSlice, 10)
-	e.retryRoutine(ctx, failedSlices, finishedQueue2, failedQueue2, nodesMap, opt.User)
+	e.retryRoutine(ctx, failedSlices, finishedQueue2, failedQueue2, nodesMap, owner)
 	for m := range finishedQueue2 {
 		finishedEncSlices = append(finishedEncSlices, m.eSlice)
 	}
@@ -181,7 +263,7 @@ func (e *Engine) Write(ctx context.Context, opt types.WriteOptions,
 	}
 
 	// if push fails again, push to another node
-	finishedQueue3 := e.pushToOtherNode(ctx, opt.User,
+	finishedQueue3 := e.pushToOtherNode(ctx, owner,
 		failedTwice, finishedEncSlices, nodes, func(err error) {
 			logger.WithError(err).Error("pushToOtherNode failed")
 			errOccurred = err
@@ -190,49 +272,23 @@ func (e *Engine) Write(ctx context.Context, opt types.WriteOptions,
 
 	// check writing error
 	if errOccurred != nil {
-		return resp, errorx.Wrap(errOccurred, "error occurred in writing")
+		return nil, nil, errorx.Wrap(errOccurred, "error occurred in writing")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, errorx.NewCode(err, errorx.ErrCodeInternal, "writing canceled")
 	}
 
 	// all pushed slice info
 	finishedEncSlices = append(finishedEncSlices, finishedQueue3...)
-	if ca == types.MerkleChallengAlgorithm {
-		if err := e.generateAndSaveMerkle(ctx, finishedEncSlices, fileID.String(), opt.ExpireTime); err != nil {
-			return resp, err
-		}
-	}
-
-	// Write meta info to blockchain
-	chainFile, err := e.packChainFile(fileID.String(), ca, opt, sliceMetas, originalLen, finishedEncSlices, pdp)
-	if err != nil {
-		return resp, errorx.Wrap(err, "failed to pack chain file")
-	}
-
-	// sign file info
-	s, err := json.Marshal(chainFile)
-	if err != nil {
-		return resp, errorx.Wrap(err, "failed to marshal File")
-	}
-	sig, err := ecdsa.Sign(e.monitor.challengingMonitor.PrivateKey, hash.Hash(s))
-	if err != nil {
-		return resp, errorx.Wrap(err, "failed to sign File")
-	}
-	publishFileOpt := blockchain.PublishFileOptions{
-		File:      chainFile,
-		Signature: sig[:],
-	}
-
-	if err := e.chain.PublishFile(ctx, &publishFileOpt); err != nil {
-		return resp, errorx.Wrap(err, "failed to write file to blockchain")
-	}
-
-	logger.WithField("file_id", fileID.String()).Debug("file uploaded")
-	resp.FileID = fileID.String()
-	return resp, nil
+	return sliceMetas, finishedEncSlices, nil
 }
 
 // locateRoutine block current routine
 func (e *Engine) locateRoutine(ctx context.Context, replica int, nodes blockchain.NodeHs, sliceQueue <-chan slicer.Slice,
 	locatedQueue chan<- copier.LocatedSlice, metaQueue chan<- slicer.SliceMeta, onErr func(err error)) {
+	ctx, span := tracing.Start(ctx, "locateRoutine")
+	defer span.End()
+
 	wg := sync.WaitGroup{}
 
 	taskQueue := make(chan slicer.Slice, 10)
@@ -286,6 +342,9 @@ func (e *Engine) locateRoutine(ctx context.Context, replica int, nodes blockchai
 
 func (e *Engine) encryptRoutine(ctx context.Context, locatedQueue <-chan copier.LocatedSlice,
 	encryptedQueue chan<- encryptor.EncryptedSlice, onErr func(err error)) {
+	ctx, span := tracing.Start(ctx, "encryptRoutine")
+	defer span.End()
+
 	wg := sync.WaitGroup{}
 
 	type SliceNodePair struct {
@@ -353,6 +412,9 @@ func (e *Engine) encryptRoutine(ctx context.Context, locatedQueue <-chan copier.
 func (e *Engine) distributeRoutine(ctx context.Context, nodes map[string]blockchain.Node,
 	encryptedQueue <-chan encryptor.EncryptedSlice, finishedQueue chan<- finishWritenSlice,
 	failedQueue chan<- encryptor.EncryptedSlice, owner string) {
+	ctx, span := tracing.Start(ctx, "distributeRoutine")
+	defer span.End()
+
 	wg := sync.WaitGroup{}
 
 	wg.Add(defaultDistributorAmount)
@@ -374,7 +436,7 @@ func (e *Engine) distributeRoutine(ctx context.Context, nodes map[string]blockch
 				// push slice
 				node := nodes[string(es.NodeID)]
 				dataReader := bytes.NewReader(es.CipherText)
-				if err := e.copier.Push(ctx, es.SliceID, owner, dataReader, &node); err != nil {
+				if err := e.copier.Push(ctx, es.SliceID, owner, es.CipherHash, dataReader, &node); err != nil {
 					logger.WithError(err).Errorf("failed to push to: %v, slice: %s", node, es.SliceID)
 					failedQueue <- es
 					continue
@@ -400,6 +462,8 @@ func (e *Engine) distributeRoutine(ctx context.Context, nodes map[string]blockch
 func (e *Engine) retryRoutine(ctx context.Context, failedSlices []encryptor.EncryptedSlice,
 	finishedQueue chan<- finishWritenSlice, failedQueue chan<- encryptor.EncryptedSlice,
 	nodes map[string]blockchain.Node, owner string) {
+	ctx, span := tracing.Start(ctx, "retryRoutine", attribute.Int("failed_slices", len(failedSlices)))
+	defer span.End()
 
 	wg := sync.WaitGroup{}
 	wg.Add(len(failedSlices))
@@ -417,7 +481,7 @@ func (e *Engine) retryRoutine(ctx context.Context, failedSlices []encryptor.Encr
 			for time.Now().Unix() < endTime {
 				select {
 				case <-ticker.C:
-					if err := e.copier.Push(ctx, es.SliceID, owner, dataReader, &node); err == nil {
+					if err := e.copier.Push(ctx, es.SliceID, owner, es.CipherHash, dataReader, &node); err == nil {
 						logger.WithFields(logrus.Fields{
 							"target_node": node.Name,
 							"address":     node.Address,
@@ -448,6 +512,8 @@ func (e *Engine) retryRoutine(ctx context.Context, failedSlices []encryptor.Encr
 // pushToOtherNode re-push failed slice to another node
 func (e *Engine) pushToOtherNode(ctx context.Context, owner string, failedSlices []encryptor.EncryptedSlice,
 	finishedEncSlices []encryptor.EncryptedSlice, nodes blockchain.NodeHs, onErr func(error)) []encryptor.EncryptedSlice {
+	ctx, span := tracing.Start(ctx, "pushToOtherNode", attribute.Int("failed_slices", len(failedSlices)))
+	defer span.End()
 
 	var finishedSlices []encryptor.EncryptedSlice
 	alreadySelected := make(map[string][]string)
@@ -498,7 +564,7 @@ func (e *Engine) pushToOtherNode(ctx context.Context, owner string, failedSlices
 			}
 
 			// push to new node
-			if err := e.copier.Push(ctx, es.SliceID, owner, bytes.NewReader(es.CipherText), &node); err == nil {
+			if err := e.copier.Push(ctx, es.SliceID, owner, es.CipherHash, bytes.NewReader(es.CipherText), &node); err == nil {
 				logger.WithFields(logrus.Fields{
 					"slice_id":    es.SliceID,
 					"target_node": string(node.ID),
@@ -18,7 +18,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
-	"encoding/hex"
+	"fmt"
 	"math/big"
 	"time"
 
@@ -29,6 +29,7 @@ import (
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/slicer"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/merkle"
 )
 
@@ -36,35 +37,9 @@ import (
 func (e *Engine) packChainFile(fileID, challengAlgorithm string, opt types.WriteOptions, originalSlices slicer.SliceMetas,
 	originalLen int, encryptedSlices []encryptor.EncryptedSlice, pdp types.PDP) (blockchain.File, error) {
 
-	sliceIdxMap := make(map[string]int)
-	chainSlices := make([]blockchain.PublicSliceMeta, 0, len(originalSlices))
-
-	// encryptedSlices in random order
-	randEncSlices := rearrangeEncSlices(encryptedSlices)
-	for _, s := range randEncSlices { // dis-ordered
-		sps := blockchain.PublicSliceMeta{
-			ID:         s.SliceID,
-			Length:     s.Length,
-			NodeID:     s.NodeID,
-			CipherHash: s.CipherHash,
-		}
-		if challengAlgorithm == types.PDPChallengAlgorithm {
-			// denote slice index for each node (for pdp challenge)
-			nodeStr := base64.StdEncoding.EncodeToString(s.NodeID)
-			if _, ok := sliceIdxMap[nodeStr]; !ok {
-				sliceIdxMap[nodeStr] = 1
-			} else {
-				sliceIdxMap[nodeStr] += 1
-			}
-			idx := big.NewInt(int64(sliceIdxMap[nodeStr]))
-			sigmaI, err := xchainClient.CalculatePDPSigmaI(s.CipherText, idx.Bytes(), pdp.RandV, pdp.RandU, pdp.PdpPrivkey)
-			if err != nil {
-				return blockchain.File{}, errorx.Wrap(err, "CalculatePDPSigmaI failed")
-			}
-			sps.SliceIdx = sliceIdxMap[nodeStr]
-			sps.SigmaI = sigmaI
-		}
-		chainSlices = append(chainSlices, sps)
+	chainSlices, err := packChainSlices(challengAlgorithm, encryptedSlices, make(map[string]int), pdp)
+	if err != nil {
+		return blockchain.File{}, err
 	}
 	structure, err := e.packChainFileStructure(originalSlices)
 	if err != nil {
@@ -72,14 +47,14 @@ func (e *Engine) packChainFile(fileID, challengAlgorithm string, opt types.Write
 	}
 
 	merkleRoot := calculateMerkleRoot(originalSlices)
-	owner, _ := hex.DecodeString(opt.User)
+	owner := ecdsa.PublicKeyFromPrivateKey(e.monitor.challengingMonitor.PrivateKey)
 
 	chainFile := blockchain.File{
 		ID:          fileID,
 		Name:        opt.FileName,
 		Description: opt.Description,
 		Namespace:   opt.Namespace,
-		Owner:       owner,
+		Owner:       owner[:],
 		Length:      uint64(originalLen),
 		MerkleRoot:  merkleRoot,
 		Slices:      chainSlices,
@@ -87,6 +62,7 @@ func (e *Engine) packChainFile(fileID, challengAlgorithm string, opt types.Write
 		PublishTime: time.Now().UnixNano(),
 		ExpireTime:  opt.ExpireTime,
 		Ext:         []byte(opt.Extra),
+		Tags:        opt.Tags,
 	}
 	if challengAlgorithm == types.PDPChallengAlgorithm {
 		chainFile.PdpPubkey = pdp.PdpPubkey
@@ -97,6 +73,50 @@ func (e *Engine) packChainFile(fileID, challengAlgorithm string, opt types.Write
 	return chainFile, nil
 }
 
+// packChainSlices rearranges the encrypted slices and calculates sigma_i of each slice for pdp challenge,
+//  sliceIdxMap holds the last slice index on each node and is updated
+func packChainSlices(challengAlgorithm string, encryptedSlices []encryptor.EncryptedSlice,
+	sliceIdxMap map[string]int, pdp types.PDP) ([]blockchain.PublicSliceMeta, error) {
+	chainSlices := make([]blockchain.PublicSliceMeta, 0, len(encryptedSlices))
+
+	// encryptedSlices in random order
+	randEncSlices := rearrangeEncSlices(encryptedSlices)
+	for _, s := range randEncSlices { // dis-ordered
+		sps := blockchain.PublicSliceMeta{
+			ID:         s.SliceID,
+			Length:     s.Length,
+			NodeID:     s.NodeID,
+			CipherHash: s.CipherHash,
+		}
+		if challengAlgorithm == types.PDPChallengAlgorithm {
+			// denote slice index for each node (for pdp challenge)
+			nodeStr := base64.StdEncoding.EncodeToString(s.NodeID)
+			sliceIdxMap[nodeStr] += 1
+			idx := big.NewInt(int64(sliceIdxMap[nodeStr]))
+			sigmaI, err := xchainClient.CalculatePDPSigmaI(s.CipherText, idx.Bytes(), pdp.RandV, pdp.RandU, pdp.PdpPrivkey)
+			if err != nil {
+				return nil, errorx.Wrap(err, "CalculatePDPSigmaI failed")
+			}
+			sps.SliceIdx = sliceIdxMap[nodeStr]
+			sps.SigmaI = sigmaI
+		}
+		chainSlices = append(chainSlices, sps)
+	}
+	return chainSlices, nil
+}
+
+// lastSliceIdxMap gets the last slice index on each node of slices, slices appended later take indices after them
+func lastSliceIdxMap(slices []blockchain.PublicSliceMeta) map[string]int {
+	sliceIdxMap := make(map[string]int)
+	for _, s := range slices {
+		nodeStr := base64.StdEncoding.EncodeToString(s.NodeID)
+		if s.SliceIdx > sliceIdxMap[nodeStr] {
+			sliceIdxMap[nodeStr] = s.SliceIdx
+		}
+	}
+	return sliceIdxMap
+}
+
 // packChainFileStructure pack file private structure and encrypt it
 func (e *Engine) packChainFileStructure(originalSlices slicer.SliceMetas) ([]byte, error) {
 	structure := make(blockchain.FileStructure, 0, len(originalSlices))
@@ -106,6 +126,11 @@ func (e *Engine) packChainFileStructure(originalSlices slicer.SliceMetas) ([]byt
 			PlainHash: s.Hash,
 		})
 	}
+	return e.encryptChainFileStructure(structure)
+}
+
+// encryptChainFileStructure encrypts file private structure which will be sent onto blockchain
+func (e *Engine) encryptChainFileStructure(structure blockchain.FileStructure) ([]byte, error) {
 	raw, err := structure.Marshal()
 	if err != nil {
 		return nil, err
@@ -151,6 +176,26 @@ func calculateMerkleRoot(slices slicer.SliceMetas) []byte {
 	return merkle.GetMerkleRoot(hashes)
 }
 
+// calculateStructureMerkleRoot calculates merkle root of all slices of a file in order
+func calculateStructureMerkleRoot(structure blockchain.FileStructure) []byte {
+	hashes := make([][]byte, 0, len(structure))
+	for _, s := range structure {
+		hashes = append(hashes, s.PlainHash)
+	}
+
+	return merkle.GetMerkleRoot(hashes)
+}
+
+// segmentKeyID derives the id used to encrypt a segment of file, the first segment is encrypted
+//  using an empty one for compatibility, and segments appended later using one bound to file and segment,
+//  so that each segment is encrypted with a different key and nonce
+func segmentKeyID(fileID string, segment int) string {
+	if segment == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s/%d", fileID, segment)
+}
+
 // calculateFileMaxStructSize calculate a file's max structure size that will be saved on blockchain
 func calculateFileMaxStructSize(slicesNum, replica int) int {
 	// every slice size < 400
@@ -134,13 +134,17 @@ func (m *Monitor) Start(ctx context.Context) error {
 		switch serType {
 		case config.NodeTypeDataOwner:
 			m.challengingMonitor.StartChallRequest(ctx)
+			m.challengingMonitor.StartMaterialGC(ctx)
 			m.fileMaintainer.Migrate(ctx)
 			m.fileMaintainer.UpdateNsFilesCap(ctx)
+			m.fileMaintainer.Renew(ctx)
 		case config.NodeTypeStorage:
 			if err := m.nodeMaintainer.NodeAutoRegister(ctx); err != nil {
 				return err
 			}
 			m.nodeMaintainer.StartFileClear(ctx)
+			m.nodeMaintainer.StartSliceGC(ctx)
+			m.nodeMaintainer.StartScrub(ctx)
 			m.nodeMaintainer.HeartBeat(ctx)
 			m.challengingMonitor.StartChallAnswer(ctx)
 		}
@@ -155,15 +159,20 @@ func (m *Monitor) Close() {
 	if m.challengingMonitor != nil {
 		m.challengingMonitor.StopChallRequest()
 		m.challengingMonitor.StopChallAnswer()
+		m.challengingMonitor.StopMaterialGC()
 	}
 
 	if m.fileMaintainer != nil {
 		m.fileMaintainer.StopMigrate()
 		m.fileMaintainer.StopUpdateNsFilesCap()
+		m.fileMaintainer.StopRenew()
+		m.fileMaintainer.StopRebalance()
 	}
 
 	if m.nodeMaintainer != nil {
 		m.nodeMaintainer.StopFileClear()
+		m.nodeMaintainer.StopSliceGC()
+		m.nodeMaintainer.StopScrub()
 		m.nodeMaintainer.StopHeartBeat()
 	}
 }
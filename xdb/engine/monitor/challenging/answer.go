@@ -27,6 +27,7 @@ import (
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/common"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/metrics"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
 )
@@ -74,7 +75,7 @@ func (c *ChallengingMonitor) loopAnswer(ctx context.Context) {
 			Status:     blockchain.ChallengeToProve,
 			TimeEnd:    time.Now().UnixNano(),
 		}
-		requests, err := c.blockchain.ListChallengeRequests(ctx, &queryOpts)
+		requests, _, err := c.blockchain.ListChallengeRequests(ctx, &queryOpts)
 		if err != nil {
 			l.WithError(err).Warn("failed to list challenge requests from blockchain")
 			continue
@@ -101,6 +102,7 @@ func (c *ChallengingMonitor) doPDPChallengeAnswer(ctx context.Context, r blockch
 	l.WithField("challenge_id", r.ID).Infof("indices: %v, slices: %v", r.Indices, r.SliceIDs)
 	proof, err := c.doPDPCalculateProof(ctx, l, c.PrivateKey, &r)
 	if err != nil {
+		metrics.ChallengeAnswers.WithLabelValues(r.ChallengAlgorithm, metrics.StatusError).Inc()
 		l.WithError(err).Warn("failed to calculate pdp proof")
 		return err
 	}
@@ -115,11 +117,15 @@ func (c *ChallengingMonitor) doPDPChallengeAnswer(ctx context.Context, r blockch
 	}
 	resp, err := c.blockchain.ChallengeAnswer(ctx, &answerOpt)
 	if err != nil {
+		metrics.ChallengeAnswers.WithLabelValues(r.ChallengAlgorithm, metrics.StatusError).Inc()
 		l.WithError(err).Warn("failed to publish answer")
 		return err
 	}
 	if string(resp) != "answered" {
+		metrics.ChallengeAnswers.WithLabelValues(r.ChallengAlgorithm, metrics.StatusFailed).Inc()
 		l.WithField("request_id", r.ID).Errorf("ChallengeAnswer err: %s", string(resp))
+	} else {
+		metrics.ChallengeAnswers.WithLabelValues(r.ChallengAlgorithm, metrics.StatusSuccess).Inc()
 	}
 	l.WithField("request_id", r.ID).Debug("success to answer challenge request")
 	return nil
@@ -130,6 +136,7 @@ func (c *ChallengingMonitor) doMerkleChallengeAnswer(ctx context.Context, r bloc
 	// calculate
 	proof, err := c.doMerkleCalculation(ctx, l, c.PrivateKey, &r)
 	if err != nil {
+		metrics.ChallengeAnswers.WithLabelValues(r.ChallengAlgorithm, metrics.StatusError).Inc()
 		l.WithError(err).Warn("failed to calculate merkle proof")
 		return err
 	}
@@ -143,11 +150,15 @@ func (c *ChallengingMonitor) doMerkleChallengeAnswer(ctx context.Context, r bloc
 	}
 	resp, err := c.blockchain.ChallengeAnswer(ctx, &answerOpt)
 	if err != nil {
+		metrics.ChallengeAnswers.WithLabelValues(r.ChallengAlgorithm, metrics.StatusError).Inc()
 		l.WithError(err).Warn("failed to publish answer")
 		return err
 	}
 	if string(resp) != "answered" {
+		metrics.ChallengeAnswers.WithLabelValues(r.ChallengAlgorithm, metrics.StatusFailed).Inc()
 		l.WithField("request_id", r.ID).Errorf("ChallengeAnswer err: %s", string(resp))
+	} else {
+		metrics.ChallengeAnswers.WithLabelValues(r.ChallengAlgorithm, metrics.StatusSuccess).Inc()
 	}
 
 	l.WithField("request_id", r.ID).Debug("success to answer challenge request")
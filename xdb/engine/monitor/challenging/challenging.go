@@ -30,6 +30,8 @@ import (
 const (
 	DefaultRequestInterval = time.Minute * 67 // avoid file migrate time
 	defaultAnswerInterval  = time.Minute * 10
+	defaultGCInterval      = time.Hour * 24 // challenge materials dropping
+	defaultGCGrace         = time.Hour * 24 // challenge materials saved within are kept
 )
 
 var (
@@ -43,6 +45,9 @@ type ChallengeDB interface {
 	Setup(sliceData []byte, rangeAmount int) ([]ctype.RangeHash, error)
 	Save(ctx context.Context, cms []ctype.Material) error
 	Take(ctx context.Context, fileID string, sliceID string, nodeID []byte) (ctype.RangeHash, error)
+	MaterialStats(ctx context.Context) ([]ctype.MaterialStats, int64, error)
+	PruneMaterials(ctx context.Context, fileID string, before int64, keep func(sliceID string, nodeID []byte) bool) (int, error)
+	CompactMaterials(ctx context.Context) (int, error)
 
 	GetChallengeConf() (string, types.PDP)
 }
@@ -52,10 +57,11 @@ type SliceStorage interface {
 }
 
 type Blockchain interface {
-	ListFiles(ctx context.Context, opt *blockchain.ListFileOptions) ([]blockchain.File, error)
-	ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) ([]blockchain.Namespace, error)
+	ListFiles(ctx context.Context, opt *blockchain.ListFileOptions) ([]blockchain.File, string, error)
+	GetFileByID(ctx context.Context, id string) (blockchain.File, error)
+	ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) ([]blockchain.Namespace, string, error)
 	ListChallengeRequests(ctx context.Context, opt *blockchain.ListChallengeOptions) (
-		[]blockchain.Challenge, error)
+		[]blockchain.Challenge, string, error)
 	ChallengeRequest(ctx context.Context, opt *blockchain.ChallengeRequestOptions) error
 	ChallengeAnswer(ctx context.Context, opt *blockchain.ChallengeAnswerOptions) ([]byte, error)
 	NodeOffline(ctx context.Context, opt *blockchain.NodeOperateOptions) error
@@ -76,6 +82,8 @@ type ChallengingMonitor struct {
 
 	AnswerInterval  time.Duration
 	RequestInterval time.Duration
+	GCInterval      time.Duration // interval of dropping challenge materials no longer needed
+	GCGrace         time.Duration // challenge materials saved within the grace period are kept
 
 	blockchain   Blockchain
 	challengeDB  ChallengeDB
@@ -83,15 +91,26 @@ type ChallengingMonitor struct {
 
 	doneLoopReqC chan struct{} //will be closed when LoopRequest breaks
 	doneLoopAnsC chan struct{} //will be closed when LoopAnswer breaks
+	doneLoopGCC  chan struct{} //will be closed when loopMaterialGC breaks
 }
 
 func New(conf *config.MonitorConf, opt *NewChallengingMonitorOptions) (*ChallengingMonitor, error) {
 	requestInterval := DefaultRequestInterval
 	answerInterval := defaultAnswerInterval
+	gcInterval := time.Duration(int64(conf.MaterialgcInterval)) * time.Hour
+	if gcInterval <= 0 {
+		gcInterval = defaultGCInterval
+	}
+	gcGrace := time.Duration(int64(conf.MaterialgcGrace)) * time.Hour
+	if gcGrace <= 0 {
+		gcGrace = defaultGCGrace
+	}
 
 	logger.WithFields(logrus.Fields{
 		"request-interval": requestInterval.String(),
 		"answer-interval":  answerInterval.String(),
+		"gc-interval":      gcInterval.String(),
+		"gc-grace":         gcGrace.String(),
 	}).Info("monitor initialize...")
 
 	cm := &ChallengingMonitor{
@@ -99,6 +118,8 @@ func New(conf *config.MonitorConf, opt *NewChallengingMonitorOptions) (*Challeng
 
 		RequestInterval: requestInterval,
 		AnswerInterval:  answerInterval,
+		GCInterval:      gcInterval,
+		GCGrace:         gcGrace,
 
 		blockchain:   opt.Blockchain,
 		challengeDB:  opt.ChallengeDB,
@@ -151,3 +172,25 @@ func (c *ChallengingMonitor) StopChallAnswer() {
 
 	<-c.doneLoopAnsC
 }
+
+// StartMaterialGC starts to drop challenge materials no longer needed
+func (c *ChallengingMonitor) StartMaterialGC(ctx context.Context) {
+	go c.loopMaterialGC(ctx)
+}
+
+// StopMaterialGC breaks loop
+func (c *ChallengingMonitor) StopMaterialGC() {
+	if c.doneLoopGCC == nil {
+		return
+	}
+
+	logger.Info("stops dropping challenge materials ...")
+
+	select {
+	case <-c.doneLoopGCC:
+		return
+	default:
+	}
+
+	<-c.doneLoopGCC
+}
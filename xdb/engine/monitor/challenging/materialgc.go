@@ -0,0 +1,109 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package challenging
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// loopMaterialGC drops merkle challenge materials no longer needed regularly if local node is dataOwner-node,
+//  and blocks current routine
+func (c *ChallengingMonitor) loopMaterialGC(ctx context.Context) {
+	if challengeAlgorithm, _ := c.challengeDB.GetChallengeConf(); challengeAlgorithm != types.MerkleChallengAlgorithm {
+		return
+	}
+
+	l := logger.WithField("runner", "material gc loop")
+	defer l.Info("runner stopped")
+
+	ticker := time.NewTicker(c.GCInterval)
+	defer ticker.Stop()
+
+	c.doneLoopGCC = make(chan struct{})
+	defer close(c.doneLoopGCC)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := c.materialGC(ctx, l); err != nil {
+			l.WithError(err).Warn("failed to drop challenge materials")
+		}
+	}
+}
+
+// materialGC drops challenge materials of expired or deleted files and of slices migrated to other nodes,
+//  then drops ranges already used and compacts the storage.
+//  Materials saved within the grace period are kept, for they are saved before the file is published
+//  or the slice is migrated on blockchain
+func (c *ChallengingMonitor) materialGC(ctx context.Context, l *logrus.Entry) error {
+	stats, _, err := c.challengeDB.MaterialStats(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UnixNano()
+	before := now - c.GCGrace.Nanoseconds()
+	pruned := 0
+	for _, st := range stats {
+		file, err := c.blockchain.GetFileByID(ctx, st.FileID)
+		if err != nil && !errorx.Is(err, errorx.ErrCodeNotFound) {
+			l.WithError(err).WithField("file_id", st.FileID).Warn("failed to get file from blockchain")
+			continue
+		}
+		// materials of deleted or expired files are all dropped
+		var keep func(sliceID string, nodeID []byte) bool
+		if err == nil && file.ExpireTime > now {
+			keep = func(sliceID string, nodeID []byte) bool {
+				return sliceStoredOn(file, sliceID, nodeID)
+			}
+		}
+		n, err := c.challengeDB.PruneMaterials(ctx, st.FileID, before, keep)
+		if err != nil {
+			return err
+		}
+		pruned += n
+	}
+
+	dropped, err := c.challengeDB.CompactMaterials(ctx)
+	if err != nil {
+		return err
+	}
+	l.WithFields(logrus.Fields{
+		"files":          len(stats),
+		"records_pruned": pruned,
+		"ranges_dropped": dropped,
+	}).Info("challenge materials compacted")
+	return nil
+}
+
+// sliceStoredOn checks whether the slice of the file is still stored on the node
+func sliceStoredOn(file blockchain.File, sliceID string, nodeID []byte) bool {
+	for _, s := range file.Slices {
+		if s.ID == sliceID && bytes.Equal(s.NodeID, nodeID) {
+			return true
+		}
+	}
+	return false
+}
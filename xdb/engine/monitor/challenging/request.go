@@ -29,6 +29,7 @@ import (
 	ctype "github.com/PaddlePaddle/PaddleDTX/xdb/engine/challenger/merkle/types"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/metrics"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
 )
@@ -61,7 +62,7 @@ func (c *ChallengingMonitor) loopRequest(ctx context.Context) {
 			TimeEnd:     time.Now().UnixNano(),
 			CurrentTime: time.Now().UnixNano(),
 		}
-		nss, err := c.blockchain.ListFileNs(ctx, &nsopt)
+		nss, _, err := c.blockchain.ListFileNs(ctx, &nsopt)
 		if err != nil {
 			l.WithError(err).Warn("failed to list file ns from blockchain")
 			continue
@@ -90,7 +91,7 @@ func (c *ChallengingMonitor) loopRequest(ctx context.Context) {
 				TimeEnd:     time.Now().UnixNano(),
 				CurrentTime: time.Now().UnixNano(),
 			}
-			files, err = c.blockchain.ListFiles(ctx, &listOpt)
+			files, _, err = c.blockchain.ListFiles(ctx, &listOpt)
 			if err != nil {
 				l.WithError(err).Warn("failed to list files from blockchain")
 				isContinue = true
@@ -193,9 +194,11 @@ func (c *ChallengingMonitor) doPDPChallengeRequest(ctx context.Context, challeng
 	requestOpt.Sig = sig[:]
 
 	if err := c.blockchain.ChallengeRequest(ctx, &requestOpt); err != nil {
+		metrics.ChallengeRequests.WithLabelValues(challengeAlgorithm, metrics.StatusFailed).Inc()
 		l.WithField("challenge_id", requestOpt.ChallengeID).WithError(err).Warn("failed to publish challenge request")
 		return err
 	}
+	metrics.ChallengeRequests.WithLabelValues(challengeAlgorithm, metrics.StatusSuccess).Inc()
 	l.WithFields(logrus.Fields{
 		"challenge_id": requestOpt.ChallengeID,
 		"target_node":  string(requestOpt.TargetNode),
@@ -268,9 +271,11 @@ func (c *ChallengingMonitor) doMerkleChallengeRequest(ctx context.Context, chall
 	requestOpt.Sig = sig[:]
 
 	if err := c.blockchain.ChallengeRequest(ctx, &requestOpt); err != nil {
+		metrics.ChallengeRequests.WithLabelValues(challengeAlgorithm, metrics.StatusFailed).Inc()
 		l.WithField("challenge_id", requestOpt.ChallengeID).WithError(err).Warn("failed to publish challenge request")
 		return err
 	}
+	metrics.ChallengeRequests.WithLabelValues(challengeAlgorithm, metrics.StatusSuccess).Inc()
 
 	l.WithFields(logrus.Fields{
 		"challenge_id":  requestOpt.ChallengeID,
@@ -15,7 +15,9 @@ package filemaintainer
 
 import (
 	"context"
+	"encoding/hex"
 	"io"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -27,12 +29,15 @@ import (
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/copier"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/encryptor"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/peer"
 )
 
 const (
 	defaultFileMigrateInterval      = time.Hour * 1
 	defaultNsFilesCapUpdateInterval = time.Minute * 53
+	defaultRenewalInterval          = time.Hour * 1
+	defaultRenewalAhead             = time.Hour * 24
 )
 
 var (
@@ -40,7 +45,7 @@ var (
 )
 
 type Copier interface {
-	Push(ctx context.Context, id, sourceId string, r io.Reader, node *blockchain.Node) error
+	Push(ctx context.Context, id, sourceId string, cipherHash []byte, r io.Reader, node *blockchain.Node) error
 	Pull(ctx context.Context, id, fileId string, node *blockchain.Node) (io.ReadCloser, error)
 	ReplicaExpansion(ctx context.Context, opt *copier.ReplicaExpOptions, enc common.MigrateEncryptor,
 		challengAlgorithm, sourceId, fileId string) ([]blockchain.PublicSliceMeta, []encryptor.EncryptedSlice, error)
@@ -55,17 +60,22 @@ type Encryptor interface {
 
 type Blockchain interface {
 	PublishFile(ctx context.Context, file *blockchain.PublishFileOptions) error
-	ListFiles(ctx context.Context, opt *blockchain.ListFileOptions) ([]blockchain.File, error)
+	ListFiles(ctx context.Context, opt *blockchain.ListFileOptions) ([]blockchain.File, string, error)
 	GetFileByID(ctx context.Context, id string) (blockchain.File, error)
+	ListExpiredFiles(ctx context.Context, opt *blockchain.ListFileOptions) ([]blockchain.File, string, error)
+	UpdateFileExpireTime(ctx context.Context, opt *blockchain.UpdatExptimeOptions) (blockchain.File, error)
 	UpdateNsFilesCap(ctx context.Context, opt *blockchain.UpdateNsFilesCapOptions) (blockchain.Namespace, error)
-	ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) ([]blockchain.Namespace, error)
+	ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) ([]blockchain.Namespace, string, error)
 	UpdateFilePublicSliceMeta(ctx context.Context, opt *blockchain.UpdateFilePSMOptions) error
 	SliceMigrateRecord(ctx context.Context, nodeID, sig []byte, fileID, sliceID string, ctime int64) error
+	ListCorruptedSlices(ctx context.Context, owner []byte) ([]blockchain.CorruptedSlice, error)
 
 	ListNodes(ctx context.Context) (blockchain.Nodes, error)
 	GetNode(ctx context.Context, id []byte) (blockchain.Node, error)
 	GetNodeHealth(ctx context.Context, id []byte) (string, error)
 	GetHeartbeatNum(ctx context.Context, id []byte, timestamp int64) (int, error)
+
+	ListDaiTasks(ctx context.Context, opt *blockchain.ListDaiTaskOptions) ([]blockchain.DaiTask, error)
 }
 
 type Challenger interface {
@@ -89,7 +99,8 @@ type NewFileMaintainerOptions struct {
 
 // FileMaintainer runs if local node is dataOwner-node, and its main work is to check storage-nodes health conditions
 //  and migrate slices from bad nodes to healthy nodes.
-//  The other part of its main work is to update files capacity of namespaces on blockchain
+//  The other part of its main work is to update files capacity of namespaces on blockchain,
+//  to renew files under renewal policies of namespaces, and to rebalance slices across storage-nodes on demand
 type FileMaintainer struct {
 	localNode  peer.Local
 	blockchain Blockchain
@@ -102,8 +113,18 @@ type FileMaintainer struct {
 	fileMigrateInterval  time.Duration
 	nsFilesCapUpInterval time.Duration
 
+	renewalOn         bool
+	renewalInterval   time.Duration
+	renewalAhead      time.Duration
+	renewalTaskOwners [][]byte
+
 	doneMigrateC       chan struct{} //doneMigrateC will be closed when loop breaks
 	doneUpdNsFilesCapC chan struct{} //doneUpdNsFilesCapC will be closed when loop breaks
+	doneRenewC         chan struct{} //doneRenewC will be closed when loop breaks
+
+	rebalanceLock   sync.Mutex
+	cancelRebalance context.CancelFunc
+	doneRebalanceC  chan struct{} //doneRebalanceC will be closed when rebalance finishes
 }
 
 func New(conf *config.MonitorConf, opt *NewFileMaintainerOptions, interval int64) (*FileMaintainer, error) {
@@ -113,9 +134,28 @@ func New(conf *config.MonitorConf, opt *NewFileMaintainerOptions, interval int64
 		fileMigrateInterval = defaultFileMigrateInterval
 	}
 
+	renewalInterval := time.Duration(conf.RenewalInterval) * time.Hour
+	if renewalInterval == 0 {
+		renewalInterval = defaultRenewalInterval
+	}
+	renewalAhead := time.Duration(conf.RenewalAhead) * time.Hour
+	if renewalAhead == 0 {
+		renewalAhead = defaultRenewalAhead
+	}
+	var renewalTaskOwners [][]byte
+	for _, o := range conf.RenewalTaskOwners {
+		pubkey, err := hex.DecodeString(o)
+		if err != nil {
+			return nil, errorx.NewCode(err, errorx.ErrCodeConfig, "bad renewal task owner %s", o)
+		}
+		renewalTaskOwners = append(renewalTaskOwners, pubkey)
+	}
+
 	logger.WithFields(logrus.Fields{
 		"filemigrate-interval":  fileMigrateInterval,
 		"nsfilescapup-interval": defaultNsFilesCapUpdateInterval,
+		"renewal-switch":        conf.RenewalSwitch,
+		"renewal-interval":      renewalInterval,
 	}).Info("monitor initialize...")
 
 	return &FileMaintainer{
@@ -127,6 +167,10 @@ func New(conf *config.MonitorConf, opt *NewFileMaintainerOptions, interval int64
 		challengerInterval:   interval,
 		fileMigrateInterval:  fileMigrateInterval,
 		nsFilesCapUpInterval: defaultNsFilesCapUpdateInterval,
+		renewalOn:            conf.RenewalSwitch == "on",
+		renewalInterval:      renewalInterval,
+		renewalAhead:         renewalAhead,
+		renewalTaskOwners:    renewalTaskOwners,
 	}, nil
 }
 
@@ -173,3 +217,62 @@ func (m *FileMaintainer) StopUpdateNsFilesCap() {
 
 	<-m.doneUpdNsFilesCapC
 }
+
+// Renew starts task renewing files under renewal policies of namespaces if renewal switch is on
+func (m *FileMaintainer) Renew(ctx context.Context) {
+	if !m.renewalOn {
+		return
+	}
+	go m.renew(ctx)
+}
+
+// StopRenew stops task renewing files
+func (m *FileMaintainer) StopRenew() {
+	if m.doneRenewC == nil {
+		return
+	}
+
+	logger.Info("stops task renewing files ...")
+
+	select {
+	case <-m.doneRenewC:
+		return
+	default:
+	}
+
+	<-m.doneRenewC
+}
+
+// listAllNs lists namespaces matching opt page by page until no cursor returned
+func (m *FileMaintainer) listAllNs(ctx context.Context, opt blockchain.ListNsOptions) ([]blockchain.Namespace, error) {
+	var nsList []blockchain.Namespace
+	for {
+		ns, next, err := m.blockchain.ListFileNs(ctx, &opt)
+		if err != nil {
+			return nil, err
+		}
+		nsList = append(nsList, ns...)
+		if next == "" {
+			return nsList, nil
+		}
+		opt.Cursor = next
+	}
+}
+
+// listAllFiles lists files matching opt page by page until no cursor returned,
+//  list is ListFiles or ListExpiredFiles of blockchain
+func listAllFiles(ctx context.Context, list func(context.Context, *blockchain.ListFileOptions) ([]blockchain.File, string, error),
+	opt blockchain.ListFileOptions) ([]blockchain.File, error) {
+	var files []blockchain.File
+	for {
+		fs, next, err := list(ctx, &opt)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, fs...)
+		if next == "" {
+			return files, nil
+		}
+		opt.Cursor = next
+	}
+}
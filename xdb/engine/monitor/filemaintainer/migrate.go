@@ -19,6 +19,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -29,18 +30,25 @@ import (
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/encryptor"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/events"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/metrics"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/bandwidth"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
 )
 
 var l = logger.WithField("runner", "file migrate loop")
 
-// migrate checks storage-nodes health conditions and migrate slices from bad nodes to healthy nodes.
+// migrate checks storage-nodes health conditions and migrate slices from bad or draining nodes to healthy nodes,
+//  slices reported corrupted by storage nodes are migrated as well.
 func (m *FileMaintainer) migrate(ctx context.Context) {
 	pubkey := ecdsa.PublicKeyFromPrivateKey(m.localNode.PrivateKey)
 
 	defer l.Info("file migrate stopped")
 
+	// migration gives way to user reads and file writing
+	ctx = bandwidth.WithPriority(ctx, bandwidth.PriorityBackground)
+
 	ticker := time.NewTicker(m.fileMigrateInterval)
 	interval := m.challengerInterval
 	chanllengeAlgorithm, pdp := m.challenger.GetChallengeConf()
@@ -61,7 +69,7 @@ func (m *FileMaintainer) migrate(ctx context.Context) {
 			Owner:   pubkey[:],
 			TimeEnd: time.Now().UnixNano(),
 		}
-		nsList, err := m.blockchain.ListFileNs(ctx, &listNsOpt)
+		nsList, err := m.listAllNs(ctx, listNsOpt)
 		if err != nil {
 			l.WithError(err).Error("failed to find ns list")
 			continue
@@ -82,15 +90,30 @@ func (m *FileMaintainer) migrate(ctx context.Context) {
 			continue
 		}
 		healthNodesMap := make(map[string]blockchain.NodeH)
+		drainingNodes := make(map[string]bool)
 		var greenNodes blockchain.NodeHs
 
 		for _, node := range healthNodes {
 			healthNodesMap[string(node.Node.ID)] = node
+			if node.Node.Draining {
+				drainingNodes[string(node.Node.ID)] = true
+				continue
+			}
 			if node.Health == blockchain.NodeHealthGood {
 				greenNodes = append(greenNodes, node)
 			}
 		}
 
+		// 2.1 find slices reported corrupted by storage nodes
+		corruptedSlices := make(map[string]bool)
+		csl, err := m.blockchain.ListCorruptedSlices(ctx, pubkey[:])
+		if err != nil {
+			l.WithError(err).Warn("failed to find corrupted slices")
+		}
+		for _, cs := range csl {
+			corruptedSlices[corruptedSliceKey(cs.FileID, cs.SliceID, cs.NodeID)] = true
+		}
+
 		wg := sync.WaitGroup{}
 		wg.Add(len(nsList))
 		for _, ns := range nsList {
@@ -103,7 +126,7 @@ func (m *FileMaintainer) migrate(ctx context.Context) {
 					TimeEnd:     time.Now().UnixNano(),
 					CurrentTime: time.Now().UnixNano(),
 				}
-				files, err := m.blockchain.ListFiles(ctx, &listFileOpt)
+				files, err := listAllFiles(ctx, m.blockchain.ListFiles, listFileOpt)
 				if err != nil {
 					l.WithError(err).Error("failed to find file list")
 					return
@@ -126,7 +149,8 @@ func (m *FileMaintainer) migrate(ctx context.Context) {
 							l.WithField("file_id", file.ID).WithError(err).Error("failed to get file health")
 							return
 						}
-						if health == blockchain.NodeHealthGood {
+						if health == blockchain.NodeHealthGood && !onDrainingNodes(file, drainingNodes) &&
+							!hasCorruptedSlices(file, corruptedSlices) {
 							return
 						}
 
@@ -176,7 +200,10 @@ func (m *FileMaintainer) migrate(ctx context.Context) {
 								l.WithField("slice_id", slice.ID).WithError(err).Error("failed to get slice node health")
 								continue
 							}
-							if nh == blockchain.NodeHealthBad {
+							// slices on draining nodes or reported corrupted are migrated as those on red nodes
+							draining := drainingNodes[string(slice.NodeID)]
+							corrupted := corruptedSlices[corruptedSliceKey(file.ID, slice.ID, slice.NodeID)]
+							if nh == blockchain.NodeHealthBad || draining || corrupted {
 								newSlices, mSlice, selectedNodes, err = m.migrateNode(ctx, slice, nodeSliceMap, healthNodes,
 									healthNodesMap, selectedNodes, file.ID, newSlices, chanllengeAlgorithm,
 									hex.EncodeToString(file.Owner), pdp)
@@ -184,13 +211,13 @@ func (m *FileMaintainer) migrate(ctx context.Context) {
 									l.WithFields(logrus.Fields{
 										"file_id":  file.ID,
 										"slice_id": slice.ID,
-									}).WithError(err).Error("migrate red node failed")
+									}).WithError(err).Error("migrate red, draining or corrupted slice failed")
 								} else {
 									fileUpdated = true
 									migrateEnSlices = append(migrateEnSlices, mSlice)
 								}
 							}
-							if nh == blockchain.NodeHealthMedium {
+							if nh == blockchain.NodeHealthMedium && !draining && !corrupted {
 								yellowNodeSlices = append(yellowNodeSlices, slice)
 							}
 						}
@@ -231,6 +258,12 @@ func (m *FileMaintainer) migrate(ctx context.Context) {
 							// update file slices
 							if err := m.updateFileSlicesOnChain(ctx, file.ID, file.Owner, newSlices); err == nil {
 								l.WithField("file_id", file.ID).Info("file migrate finished")
+								events.Publish(events.TypeMigrationDone, map[string]string{
+									"file_id":         file.ID,
+									"file_name":       file.Name,
+									"namespace":       file.Namespace,
+									"migrated_slices": strconv.Itoa(len(migrateEnSlices)),
+								})
 							} else {
 								l.WithField("file_id", file.ID).WithError(err).Error("updateFileSlicesOnChain failed")
 							}
@@ -251,7 +284,7 @@ func (m *FileMaintainer) migrate(ctx context.Context) {
 }
 
 // migrateNode find available healthy node and migrate a slice from bad node to it
-func (m FileMaintainer) migrateNode(ctx context.Context, slice blockchain.PublicSliceMeta,
+func (m *FileMaintainer) migrateNode(ctx context.Context, slice blockchain.PublicSliceMeta,
 	nodeSliceMap map[string]blockchain.PublicSliceMeta, healthNodes blockchain.NodeHs,
 	healthNodesMap map[string]blockchain.NodeH, selectedNodes map[string][]string, fileID string,
 	slices []blockchain.PublicSliceMeta, chanllengeAlgorithm, sourceId string,
@@ -349,11 +382,37 @@ func (m FileMaintainer) migrateNode(ctx context.Context, slice blockchain.Public
 		}
 	}
 	if !success {
+		metrics.SliceMigrations.WithLabelValues(metrics.StatusFailed).Inc()
 		return slices, newMigrateEnSlice, selectedNodes, errorx.New(errorx.ErrCodeInternal, "failed to migrate slice")
 	}
+	metrics.SliceMigrations.WithLabelValues(metrics.StatusSuccess).Inc()
 	return slices, newMigrateEnSlice, selectedNodes, nil
 }
 
+// onDrainingNodes checks if any slice of the file is on draining nodes
+func onDrainingNodes(file blockchain.File, drainingNodes map[string]bool) bool {
+	for _, slice := range file.Slices {
+		if drainingNodes[string(slice.NodeID)] {
+			return true
+		}
+	}
+	return false
+}
+
+// hasCorruptedSlices checks if any slice of the file is reported corrupted
+func hasCorruptedSlices(file blockchain.File, corruptedSlices map[string]bool) bool {
+	for _, slice := range file.Slices {
+		if corruptedSlices[corruptedSliceKey(file.ID, slice.ID, slice.NodeID)] {
+			return true
+		}
+	}
+	return false
+}
+
+func corruptedSliceKey(fileID, sliceID string, nodeID []byte) string {
+	return fmt.Sprintf("%s/%s/%s", fileID, sliceID, nodeID)
+}
+
 // nodeSliceMap map node->sliceMeta for specific sliceID
 func nodeSliceMap(sliceMetas []blockchain.PublicSliceMeta, sliceID string) map[string]blockchain.PublicSliceMeta {
 	ret := make(map[string]blockchain.PublicSliceMeta)
@@ -366,7 +425,7 @@ func nodeSliceMap(sliceMetas []blockchain.PublicSliceMeta, sliceID string) map[s
 }
 
 // rearrangeSlices update slices in file structure saved on blockchain
-func (m FileMaintainer) rearrangeSlices(ctx context.Context, oldSlices []blockchain.PublicSliceMeta, sliceID, badNode,
+func (m *FileMaintainer) rearrangeSlices(ctx context.Context, oldSlices []blockchain.PublicSliceMeta, sliceID, badNode,
 	newNode, fileId string, ciphertext []byte, healthNodesMap map[string]blockchain.NodeH,
 	selectedNodes map[string][]string, pdp types.PDP) ([]blockchain.PublicSliceMeta, error) {
 
@@ -460,7 +519,7 @@ func (m FileMaintainer) rearrangeSlices(ctx context.Context, oldSlices []blockch
 }
 
 // migrateRecordOnChain put migrate record on blockchain
-func (m FileMaintainer) migrateRecordOnChain(ctx context.Context, nodeID, fileID, sliceID string) {
+func (m *FileMaintainer) migrateRecordOnChain(ctx context.Context, nodeID, fileID, sliceID string) {
 	now := time.Now().UnixNano()
 	msg := fileID + sliceID + nodeID + fmt.Sprintf("%d", now)
 	sign, err := ecdsa.Sign(m.localNode.PrivateKey, hash.Hash([]byte(msg)))
@@ -482,7 +541,7 @@ func (m FileMaintainer) migrateRecordOnChain(ctx context.Context, nodeID, fileID
 }
 
 // updateFileSlicesOnChain update file slices structure on blockchain
-func (m FileMaintainer) updateFileSlicesOnChain(ctx context.Context, fileID string, owner []byte, slices []blockchain.PublicSliceMeta) error {
+func (m *FileMaintainer) updateFileSlicesOnChain(ctx context.Context, fileID string, owner []byte, slices []blockchain.PublicSliceMeta) error {
 	msg, err := json.Marshal(slices)
 	if err != nil {
 		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal slices")
@@ -0,0 +1,371 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filemaintainer
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/common"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/encryptor"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/bandwidth"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+)
+
+const defaultRebalanceMoveInterval = time.Second * 5
+
+var rl = logger.WithField("runner", "rebalance")
+
+// Rebalance plans slice moves from heavily loaded storage-nodes to lightly loaded ones,
+//  so that newly joined nodes share the storage of existing files.
+//  Only green nodes take part in rebalance, slices on red, yellow or draining nodes are left to file migration.
+//  If opt.DryRun is false, the planned moves run in background one by one with opt.MoveInterval between them.
+func (m *FileMaintainer) Rebalance(ctx context.Context, opt *types.RebalanceOptions) (types.RebalancePlan, error) {
+	plan := types.RebalancePlan{DryRun: opt.DryRun}
+
+	m.rebalanceLock.Lock()
+	defer m.rebalanceLock.Unlock()
+	if m.doneRebalanceC != nil {
+		select {
+		case <-m.doneRebalanceC:
+		default:
+			return plan, errorx.New(errorx.ErrCodeAlreadyExists, "rebalance is already running")
+		}
+	}
+
+	files, err := m.listLocalFiles(ctx, opt.Namespace)
+	if err != nil {
+		return plan, err
+	}
+	healthNodes, err := common.GetHealthNodes(ctx, m.blockchain)
+	if err != nil {
+		return plan, errorx.Wrap(err, "failed to find healthy nodes")
+	}
+	var greenNodes blockchain.NodeHs
+	for _, node := range healthNodes {
+		if node.Health == blockchain.NodeHealthGood && !node.Node.Draining {
+			greenNodes = append(greenNodes, node)
+		}
+	}
+	if len(greenNodes) < 2 {
+		return plan, errorx.New(errorx.ErrCodeNotFound, "at least two green nodes are needed to rebalance")
+	}
+
+	plan.Moves, plan.Nodes = planRebalance(files, greenNodes, opt.MaxMoves)
+	if opt.DryRun || len(plan.Moves) == 0 {
+		return plan, nil
+	}
+
+	interval := opt.MoveInterval
+	if interval <= 0 {
+		interval = defaultRebalanceMoveInterval
+	}
+
+	// rebalance may last for a long time, so it doesn't depend on the request context
+	rctx, cancel := context.WithCancel(context.Background())
+	m.cancelRebalance = cancel
+	m.doneRebalanceC = make(chan struct{})
+	go m.runRebalance(rctx, plan.Moves, healthNodes, interval, m.doneRebalanceC)
+
+	return plan, nil
+}
+
+// StopRebalance stops running rebalance
+func (m *FileMaintainer) StopRebalance() {
+	m.rebalanceLock.Lock()
+	defer m.rebalanceLock.Unlock()
+	if m.doneRebalanceC == nil {
+		return
+	}
+
+	rl.Info("stops rebalance ...")
+
+	m.cancelRebalance()
+	<-m.doneRebalanceC
+}
+
+// listLocalFiles lists unexpired files of local dataOwner-node in the namespace, or in all namespaces if ns is empty
+func (m *FileMaintainer) listLocalFiles(ctx context.Context, ns string) ([]blockchain.File, error) {
+	pubkey := ecdsa.PublicKeyFromPrivateKey(m.localNode.PrivateKey)
+
+	var nsNames []string
+	if len(ns) > 0 {
+		nsNames = append(nsNames, ns)
+	} else {
+		listNsOpt := blockchain.ListNsOptions{
+			Owner:   pubkey[:],
+			TimeEnd: time.Now().UnixNano(),
+		}
+		nsList, err := m.listAllNs(ctx, listNsOpt)
+		if err != nil {
+			return nil, errorx.Wrap(err, "failed to find ns list")
+		}
+		for _, n := range nsList {
+			nsNames = append(nsNames, n.Name)
+		}
+	}
+
+	var files []blockchain.File
+	for _, name := range nsNames {
+		listFileOpt := blockchain.ListFileOptions{
+			Owner:       pubkey[:],
+			Namespace:   name,
+			TimeEnd:     time.Now().UnixNano(),
+			CurrentTime: time.Now().UnixNano(),
+		}
+		fs, err := listAllFiles(ctx, m.blockchain.ListFiles, listFileOpt)
+		if err != nil {
+			return nil, errorx.Wrap(err, "failed to find file list of namespace %s", name)
+		}
+		files = append(files, fs...)
+	}
+	return files, nil
+}
+
+// runRebalance runs planned slice moves file by file, and updates file slices on blockchain after
+//  all moves of a file finished
+func (m *FileMaintainer) runRebalance(ctx context.Context, moves []types.SliceMove, healthNodes blockchain.NodeHs,
+	interval time.Duration, doneC chan struct{}) {
+
+	defer close(doneC)
+	defer rl.Info("rebalance stopped")
+
+	ctx = bandwidth.WithPriority(ctx, bandwidth.PriorityBackground)
+
+	chanllengeAlgorithm, pdp := m.challenger.GetChallengeConf()
+	healthNodesMap := make(map[string]blockchain.NodeH)
+	for _, node := range healthNodes {
+		healthNodesMap[string(node.Node.ID)] = node
+	}
+
+	// group moves by file and keep the planned order
+	var fileIDs []string
+	fileMoves := make(map[string][]types.SliceMove)
+	for _, move := range moves {
+		if _, exist := fileMoves[move.FileID]; !exist {
+			fileIDs = append(fileIDs, move.FileID)
+		}
+		fileMoves[move.FileID] = append(fileMoves[move.FileID], move)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	finished := 0
+	for _, fileID := range fileIDs {
+		file, err := m.blockchain.GetFileByID(ctx, fileID)
+		if err != nil {
+			rl.WithField("file_id", fileID).WithError(err).Error("failed to get file")
+			continue
+		}
+
+		newSlices := file.Slices
+		selectedNodes := make(map[string][]string)
+		for _, slice := range file.Slices {
+			selectedNodes[slice.ID] = append(selectedNodes[slice.ID], string(slice.NodeID))
+		}
+
+		fileUpdated := false
+		var migrateEnSlices []encryptor.EncryptedSlice
+		for _, move := range fileMoves[fileID] {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			logEntry := rl.WithFields(logrus.Fields{
+				"file_id":  move.FileID,
+				"slice_id": move.SliceID,
+				"from":     move.From,
+				"to":       move.To,
+			})
+			target, exist := healthNodesMap[move.To]
+			if !exist {
+				logEntry.Warn("target node is no longer healthy, skip")
+				continue
+			}
+			nodeSliceMap := nodeSliceMap(newSlices, move.SliceID)
+			slice, exist := nodeSliceMap[move.From]
+			if !exist {
+				logEntry.Warn("slice is no longer on source node, skip")
+				continue
+			}
+
+			var mSlice encryptor.EncryptedSlice
+			newSlices, mSlice, selectedNodes, err = m.migrateNode(ctx, slice, nodeSliceMap, blockchain.NodeHs{target},
+				healthNodesMap, selectedNodes, file.ID, newSlices, chanllengeAlgorithm,
+				hex.EncodeToString(file.Owner), pdp)
+			if err != nil {
+				logEntry.WithError(err).Error("failed to move slice")
+				continue
+			}
+			fileUpdated = true
+			migrateEnSlices = append(migrateEnSlices, mSlice)
+			finished++
+			logEntry.Info("slice moved")
+		}
+
+		if !fileUpdated {
+			continue
+		}
+		// add new challenge
+		if chanllengeAlgorithm == types.MerkleChallengAlgorithm {
+			if err := common.AddSlicesNewMerkleChallenge(ctx, m.challenger, m.copier,
+				file, migrateEnSlices, m.challengerInterval, rl); err != nil {
+				rl.WithField("file_id", file.ID).WithError(err).Error("failed to add slices merkle challenge")
+				continue
+			}
+		}
+		// update file slices
+		if err := m.updateFileSlicesOnChain(ctx, file.ID, file.Owner, newSlices); err != nil {
+			rl.WithField("file_id", file.ID).WithError(err).Error("updateFileSlicesOnChain failed")
+			continue
+		}
+		rl.WithField("file_id", file.ID).Info("file rebalanced")
+	}
+
+	rl.WithFields(logrus.Fields{
+		"planned":  len(moves),
+		"finished": finished,
+	}).Info("rebalance finished")
+}
+
+// rebalanceSlice a slice which could be moved by rebalance
+type rebalanceSlice struct {
+	file  *blockchain.File
+	slice blockchain.PublicSliceMeta
+}
+
+// planRebalance plans slice moves among nodes to even out their usage.
+//  It moves a slice from the most used node to the least used one each time, as long as the move
+//  narrows the usage gap between them and no replica of the slice is already there.
+//  Each slice moves once at most, and maxMoves limits the number of moves if it's larger than zero.
+func planRebalance(files []blockchain.File, nodes blockchain.NodeHs, maxMoves int) (
+	[]types.SliceMove, []types.NodeUsage) {
+
+	usage := make(map[string]uint64)
+	nodeSlices := make(map[string][]rebalanceSlice)
+	holders := make(map[string]map[string]bool) // fileID+sliceID -> node set
+	for _, node := range nodes {
+		usage[string(node.Node.ID)] = 0
+	}
+	for i := range files {
+		file := &files[i]
+		for _, slice := range file.Slices {
+			key := file.ID + slice.ID
+			if holders[key] == nil {
+				holders[key] = make(map[string]bool)
+			}
+			holders[key][string(slice.NodeID)] = true
+
+			if _, exist := usage[string(slice.NodeID)]; !exist {
+				continue
+			}
+			usage[string(slice.NodeID)] += slice.Length
+			nodeSlices[string(slice.NodeID)] = append(nodeSlices[string(slice.NodeID)], rebalanceSlice{file, slice})
+		}
+	}
+
+	before := make(map[string]uint64)
+	for id, u := range usage {
+		before[id] = u
+	}
+
+	var moves []types.SliceMove
+	for maxMoves <= 0 || len(moves) < maxMoves {
+		src, dst, idx := findRebalanceMove(nodes, usage, nodeSlices, holders)
+		if idx < 0 {
+			break
+		}
+		rs := nodeSlices[src][idx]
+		nodeSlices[src] = append(nodeSlices[src][:idx], nodeSlices[src][idx+1:]...)
+		usage[src] -= rs.slice.Length
+		usage[dst] += rs.slice.Length
+		key := rs.file.ID + rs.slice.ID
+		delete(holders[key], src)
+		holders[key][dst] = true
+
+		moves = append(moves, types.SliceMove{
+			FileID:    rs.file.ID,
+			Namespace: rs.file.Namespace,
+			SliceID:   rs.slice.ID,
+			Length:    rs.slice.Length,
+			From:      src,
+			To:        dst,
+		})
+	}
+
+	var nodeUsage []types.NodeUsage
+	for _, node := range nodes {
+		id := string(node.Node.ID)
+		nodeUsage = append(nodeUsage, types.NodeUsage{
+			NodeID: id,
+			Name:   node.Node.Name,
+			Before: before[id],
+			After:  usage[id],
+		})
+	}
+	return moves, nodeUsage
+}
+
+// findRebalanceMove finds the source node, the target node, and the index of the slice in nodeSlices[source]
+//  for the next move, returns -1 as index if no move could narrow the usage gap
+func findRebalanceMove(nodes blockchain.NodeHs, usage map[string]uint64, nodeSlices map[string][]rebalanceSlice,
+	holders map[string]map[string]bool) (string, string, int) {
+
+	ids := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		ids = append(ids, string(node.Node.ID))
+	}
+	// order by usage in descending order, node id makes the order stable
+	sort.Slice(ids, func(i, j int) bool {
+		if usage[ids[i]] != usage[ids[j]] {
+			return usage[ids[i]] > usage[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+
+	for i := 0; i < len(ids); i++ {
+		src := ids[i]
+		for j := len(ids) - 1; j > i; j-- {
+			dst := ids[j]
+			gap := usage[src] - usage[dst]
+			best := -1
+			for k, rs := range nodeSlices[src] {
+				// a move helps only if the gap is still not reversed after it
+				if rs.slice.Length == 0 || rs.slice.Length*2 > gap {
+					continue
+				}
+				if holders[rs.file.ID+rs.slice.ID][dst] {
+					continue
+				}
+				if best < 0 || rs.slice.Length > nodeSlices[src][best].slice.Length {
+					best = k
+				}
+			}
+			if best >= 0 {
+				return src, dst, best
+			}
+		}
+	}
+	return "", "", -1
+}
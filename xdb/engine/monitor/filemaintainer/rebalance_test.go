@@ -0,0 +1,133 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filemaintainer
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/peer"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+)
+
+// pageChain lists namespaces and files one per page, the cursor is the index of the next item
+type pageChain struct {
+	Blockchain
+	ns    []blockchain.Namespace
+	files map[string][]blockchain.File
+
+	expired map[string][]blockchain.File
+}
+
+func nextPage(cursor string, total int) (int, string) {
+	i, _ := strconv.Atoi(cursor)
+	if i+1 >= total {
+		return i, ""
+	}
+	return i, strconv.Itoa(i + 1)
+}
+
+func (c *pageChain) ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) (
+	[]blockchain.Namespace, string, error) {
+	i, next := nextPage(opt.Cursor, len(c.ns))
+	return c.ns[i : i+1], next, nil
+}
+
+func (c *pageChain) ListFiles(ctx context.Context, opt *blockchain.ListFileOptions) (
+	[]blockchain.File, string, error) {
+	fs := c.files[opt.Namespace]
+	i, next := nextPage(opt.Cursor, len(fs))
+	return fs[i : i+1], next, nil
+}
+
+func (c *pageChain) ListExpiredFiles(ctx context.Context, opt *blockchain.ListFileOptions) (
+	[]blockchain.File, string, error) {
+	fs := c.expired[opt.Namespace]
+	i, next := nextPage(opt.Cursor, len(fs))
+	return fs[i : i+1], next, nil
+}
+
+func TestListLocalFiles(t *testing.T) {
+	privkey, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	chain := &pageChain{
+		ns: []blockchain.Namespace{{Name: "ns1"}, {Name: "ns2"}},
+		files: map[string][]blockchain.File{
+			"ns1": {{ID: "f1"}, {ID: "f2"}},
+			"ns2": {{ID: "f3"}, {ID: "f4"}, {ID: "f5"}},
+		},
+	}
+	m := &FileMaintainer{localNode: peer.Local{PrivateKey: privkey}, blockchain: chain}
+
+	// all pages of namespaces and files are listed
+	files, err := m.listLocalFiles(context.Background(), "")
+	require.NoError(t, err)
+	var ids []string
+	for _, f := range files {
+		ids = append(ids, f.ID)
+	}
+	require.Equal(t, []string{"f1", "f2", "f3", "f4", "f5"}, ids)
+
+	files, err = m.listLocalFiles(context.Background(), "ns2")
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+}
+
+func TestPlanRebalance(t *testing.T) {
+	nodes := blockchain.NodeHs{
+		{Node: blockchain.Node{ID: []byte("n1"), Name: "n1"}, Health: blockchain.NodeHealthGood},
+		{Node: blockchain.Node{ID: []byte("n2"), Name: "n2"}, Health: blockchain.NodeHealthGood},
+		{Node: blockchain.Node{ID: []byte("n3"), Name: "n3"}, Health: blockchain.NodeHealthGood},
+	}
+	// node 3 just joined, two replicas of each slice are on node 1 and node 2
+	var files []blockchain.File
+	for _, fid := range []string{"f1", "f2", "f3"} {
+		file := blockchain.File{ID: fid, Namespace: "ns"}
+		for _, sid := range []string{"s1", "s2"} {
+			for _, nid := range []string{"n1", "n2"} {
+				file.Slices = append(file.Slices, blockchain.PublicSliceMeta{ID: sid, NodeID: []byte(nid), Length: 10})
+			}
+		}
+		files = append(files, file)
+	}
+
+	moves, usage := planRebalance(files, nodes, 0)
+	require.Equal(t, 4, len(moves))
+	for _, u := range usage {
+		require.Equal(t, uint64(40), u.After)
+	}
+	require.Equal(t, uint64(60), usage[0].Before)
+	require.Equal(t, uint64(0), usage[2].Before)
+
+	// target never holds another replica of the moved slice
+	for _, m := range moves {
+		require.Equal(t, "n3", m.To)
+		for _, other := range moves {
+			if m != other {
+				require.False(t, m.FileID == other.FileID && m.SliceID == other.SliceID)
+			}
+		}
+	}
+
+	moves, _ = planRebalance(files, nodes, 1)
+	require.Equal(t, 1, len(moves))
+
+	// nothing to do on balanced nodes
+	moves, _ = planRebalance(files, nodes[:2], 0)
+	require.Equal(t, 0, len(moves))
+}
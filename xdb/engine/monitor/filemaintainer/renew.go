@@ -0,0 +1,207 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filemaintainer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/common"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/events"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+// fileRenewal a file to renew and its new expire time
+type fileRenewal struct {
+	file       blockchain.File
+	expireTime int64
+}
+
+// renew extends expire time of files about to expire under renewal policies of their namespaces
+func (m *FileMaintainer) renew(ctx context.Context) {
+	pubkey := ecdsa.PublicKeyFromPrivateKey(m.localNode.PrivateKey)
+
+	l := logger.WithField("runner", "file renewal loop")
+	defer l.Info("runner stopped")
+
+	ticker := time.NewTicker(m.renewalInterval)
+	defer ticker.Stop()
+
+	m.doneRenewC = make(chan struct{})
+	defer close(m.doneRenewC)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now().UnixNano()
+		nsList, err := m.listAllNs(ctx, blockchain.ListNsOptions{
+			Owner:       pubkey[:],
+			TimeEnd:     now,
+			CurrentTime: now,
+		})
+		if err != nil {
+			l.WithError(err).Error("failed to find ns list")
+			continue
+		}
+
+		// files referenced by active dai tasks, listed once per round when needed
+		var taskFiles map[string]bool
+		for _, ns := range nsList {
+			if ns.Renewal.Policy == blockchain.NsRenewalNone {
+				continue
+			}
+			if ns.Renewal.Policy == blockchain.NsRenewalTask && taskFiles == nil {
+				if taskFiles, err = m.listTaskFiles(ctx, pubkey[:], now); err != nil {
+					l.WithField("namespace", ns.Name).WithError(err).Warn("failed to list files used by dai tasks")
+					taskFiles = nil
+					continue
+				}
+			}
+
+			files, err := m.listRenewableFiles(ctx, pubkey[:], ns.Name, now)
+			if err != nil {
+				l.WithField("namespace", ns.Name).WithError(err).Warn("failed to list files of ns")
+				continue
+			}
+			for _, r := range renewalTargets(ns.Renewal, files, taskFiles, now, m.renewalAhead.Nanoseconds()) {
+				if err := m.renewFile(ctx, r); err != nil {
+					l.WithFields(logrus.Fields{
+						"namespace": ns.Name,
+						"file_id":   r.file.ID,
+					}).WithError(err).Warn("failed to renew file")
+					continue
+				}
+				l.WithFields(logrus.Fields{
+					"namespace":       ns.Name,
+					"file_id":         r.file.ID,
+					"file_name":       r.file.Name,
+					"policy":          ns.Renewal.Policy,
+					"old_expire_time": time.Unix(0, r.file.ExpireTime).Format("2006-01-02 15:04:05"),
+					"new_expire_time": time.Unix(0, r.expireTime).Format("2006-01-02 15:04:05"),
+				}).Info("file renewed")
+				events.Publish(events.TypeFileRenewed, map[string]string{
+					"file_id":     r.file.ID,
+					"file_name":   r.file.Name,
+					"namespace":   ns.Name,
+					"policy":      ns.Renewal.Policy,
+					"expire_time": fmt.Sprintf("%d", r.expireTime),
+				})
+			}
+		}
+	}
+}
+
+// renewalTargets picks files expiring within ahead nanoseconds and computes their new expire time,
+//  files not referenced by taskFiles are skipped if policy is "task"
+func renewalTargets(renewal blockchain.NsRenewal, files []blockchain.File, taskFiles map[string]bool,
+	now, ahead int64) []fileRenewal {
+	var rs []fileRenewal
+	for _, f := range files {
+		if f.ExpireTime > now+ahead {
+			continue
+		}
+		if renewal.Policy == blockchain.NsRenewalTask && !taskFiles[f.ID] {
+			continue
+		}
+		if to := renewal.RenewTo(f.ExpireTime, now); to > 0 {
+			rs = append(rs, fileRenewal{file: f, expireTime: to})
+		}
+	}
+	return rs
+}
+
+// listRenewableFiles lists files of namespace not expired yet, and those expired but still in retain period
+func (m *FileMaintainer) listRenewableFiles(ctx context.Context, owner []byte, ns string, now int64) (
+	[]blockchain.File, error) {
+	opt := blockchain.ListFileOptions{
+		Owner:       owner,
+		Namespace:   ns,
+		TimeEnd:     now,
+		CurrentTime: now,
+	}
+	files, err := listAllFiles(ctx, m.blockchain.ListFiles, opt)
+	if err != nil {
+		return nil, err
+	}
+	expired, err := listAllFiles(ctx, m.blockchain.ListExpiredFiles, opt)
+	if err != nil {
+		return nil, err
+	}
+	return append(files, expired...), nil
+}
+
+// listTaskFiles lists ids of files used by active dai tasks of the local node and task owners configured
+func (m *FileMaintainer) listTaskFiles(ctx context.Context, pubkey []byte, now int64) (map[string]bool, error) {
+	taskFiles := make(map[string]bool)
+	for _, owner := range append([][]byte{pubkey}, m.renewalTaskOwners...) {
+		tasks, err := m.blockchain.ListDaiTasks(ctx, &blockchain.ListDaiTaskOptions{
+			PubKey:  owner,
+			TimeEnd: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tasks {
+			if !blockchain.DaiTaskActive(t.Status) {
+				continue
+			}
+			for _, d := range t.DataSets {
+				taskFiles[d.DataID] = true
+			}
+		}
+	}
+	return taskFiles, nil
+}
+
+// renewFile updates expire time of file on blockchain, and adds merkle challenges for the extended period
+func (m *FileMaintainer) renewFile(ctx context.Context, r fileRenewal) error {
+	ctime := time.Now().UnixNano()
+	msg := fmt.Sprintf("%s,%d,%d", r.file.ID, r.expireTime, ctime)
+	sig, err := ecdsa.Sign(m.localNode.PrivateKey, hash.Hash([]byte(msg)))
+	if err != nil {
+		return err
+	}
+	newFile, err := m.blockchain.UpdateFileExpireTime(ctx, &blockchain.UpdatExptimeOptions{
+		FileId:        r.file.ID,
+		NewExpireTime: r.expireTime,
+		CurrentTime:   ctime,
+		Signature:     sig[:],
+	})
+	if err != nil {
+		return err
+	}
+
+	if challengeAlgorithm, _ := m.challenger.GetChallengeConf(); challengeAlgorithm == types.MerkleChallengAlgorithm {
+		startTime := r.file.ExpireTime
+		if startTime < ctime {
+			startTime = ctime
+		}
+		l := logger.WithField("runner", "file renewal loop")
+		if err := common.AddFileNewMerkleChallenge(ctx, m.challenger, m.blockchain, m.copier, m.encryptor,
+			newFile, startTime, m.challengerInterval, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filemaintainer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+)
+
+func TestRenewalTargets(t *testing.T) {
+	day := (24 * time.Hour).Nanoseconds()
+	now := time.Now().UnixNano()
+	files := []blockchain.File{
+		{ID: "expiring", ExpireTime: now + day/2},
+		{ID: "later", ExpireTime: now + 3*day},
+		{ID: "expired", ExpireTime: now - day/2},
+	}
+
+	// until policy renews expiring and expired files, capped at Until
+	renewal := blockchain.NsRenewal{Policy: blockchain.NsRenewalUntil, Days: 7, Until: now + 2*day}
+	rs := renewalTargets(renewal, files, nil, now, day)
+	require.Equal(t, 2, len(rs))
+	require.Equal(t, "expiring", rs[0].file.ID)
+	require.Equal(t, now+2*day, rs[0].expireTime)
+	require.Equal(t, "expired", rs[1].file.ID)
+	require.Equal(t, now+2*day, rs[1].expireTime)
+
+	// nothing to renew once files reach Until
+	renewal.Until = now + day/4
+	rs = renewalTargets(renewal, files, nil, now, day)
+	require.Equal(t, 1, len(rs))
+	require.Equal(t, "expired", rs[0].file.ID)
+
+	// task policy only renews files used by active tasks, from the time they expire
+	renewal = blockchain.NsRenewal{Policy: blockchain.NsRenewalTask, Days: 1}
+	rs = renewalTargets(renewal, files, map[string]bool{"expiring": true, "later": true}, now, day)
+	require.Equal(t, 1, len(rs))
+	require.Equal(t, "expiring", rs[0].file.ID)
+	require.Equal(t, now+day/2+day, rs[0].expireTime)
+
+	rs = renewalTargets(blockchain.NsRenewal{}, files, nil, now, day)
+	require.Equal(t, 0, len(rs))
+}
+
+func TestListRenewableFiles(t *testing.T) {
+	chain := &pageChain{
+		files: map[string][]blockchain.File{
+			"ns1": {{ID: "f1"}, {ID: "f2"}},
+		},
+		expired: map[string][]blockchain.File{
+			"ns1": {{ID: "e1"}, {ID: "e2"}, {ID: "e3"}},
+		},
+	}
+	m := &FileMaintainer{blockchain: chain}
+
+	// all pages of unexpired and expired files are listed
+	files, err := m.listRenewableFiles(context.Background(), []byte("owner"), "ns1", time.Now().UnixNano())
+	require.NoError(t, err)
+	var ids []string
+	for _, f := range files {
+		ids = append(ids, f.ID)
+	}
+	require.Equal(t, []string{"f1", "f2", "e1", "e2", "e3"}, ids)
+}
@@ -51,7 +51,7 @@ func (m *FileMaintainer) updateNsFilesCap(ctx context.Context) {
 			TimeEnd:     time.Now().UnixNano(),
 			CurrentTime: time.Now().UnixNano(),
 		}
-		nsList, err := m.blockchain.ListFileNs(ctx, &listNsOpt)
+		nsList, err := m.listAllNs(ctx, listNsOpt)
 		if err != nil {
 			l.WithError(err).Error("failed to find ns list")
 			continue
@@ -88,7 +88,9 @@ func (m *FileMaintainer) updateNsFilesCap(ctx context.Context) {
 				"namespace":  ns.Name,
 				"ns_old_cap": ns.FilesStruSize,
 				"ns_new_cap": newNs.FilesStruSize,
-			}).Info("success to update ns files struct size")
+				"used_bytes": newNs.FilesTotalSize,
+				"used_files": newNs.FileActiveNum,
+			}).Info("success to update ns files struct size and quota usage")
 		}
 	}
 }
@@ -0,0 +1,71 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodemaintainer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+// confirmDrained records draining local node drained on chain once it holds no live slices,
+//  returns true if the node is drained
+func (m *NodeMaintainer) confirmDrained(ctx context.Context, pubkey ecdsa.PublicKey) (bool, error) {
+	node, err := m.blockchain.GetNode(ctx, []byte(pubkey.String()))
+	if err != nil {
+		return false, errorx.Wrap(err, "failed to get node info")
+	}
+	if !node.Draining {
+		return false, nil
+	}
+	if node.DrainedTime > 0 {
+		return true, nil
+	}
+
+	nonce := time.Now().UnixNano()
+	num, err := m.blockchain.GetNodeSliceNum(ctx, []byte(pubkey.String()), nonce)
+	if err != nil {
+		return false, errorx.Wrap(err, "failed to get node slice number")
+	}
+	if num > 0 {
+		logger.WithField("live_slices", num).Debug("draining node still holds slices")
+		return false, nil
+	}
+
+	mes := fmt.Sprintf("%s,%d", pubkey.String(), nonce)
+	sig, err := ecdsa.Sign(m.localNode.PrivateKey, hash.Hash([]byte(mes)))
+	if err != nil {
+		return false, errorx.Wrap(err, "failed to sign node drain")
+	}
+	opt := &blockchain.NodeOperateOptions{
+		NodeID: []byte(pubkey.String()),
+		Nonce:  nonce,
+		Sig:    sig[:],
+	}
+	if err := m.blockchain.NodeDrain(ctx, opt); err != nil {
+		return false, errorx.Wrap(err, "failed to record node drained")
+	}
+	logger.WithFields(logrus.Fields{
+		"node_id":      pubkey.String(),
+		"drained_time": nonce,
+	}).Info("draining node holds no live slices, recorded drained on chain")
+	return true, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodemaintainer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/peer"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+type drainChain struct {
+	Blockchain
+	node    blockchain.Node
+	slices  int
+	drained []blockchain.NodeOperateOptions
+}
+
+func (c *drainChain) GetNode(ctx context.Context, id []byte) (blockchain.Node, error) {
+	return c.node, nil
+}
+
+func (c *drainChain) GetNodeSliceNum(ctx context.Context, id []byte, timestamp int64) (int, error) {
+	return c.slices, nil
+}
+
+func (c *drainChain) NodeDrain(ctx context.Context, opt *blockchain.NodeOperateOptions) error {
+	c.drained = append(c.drained, *opt)
+	c.node.DrainedTime = opt.Nonce
+	return nil
+}
+
+func TestConfirmDrained(t *testing.T) {
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	chain := &drainChain{node: blockchain.Node{ID: []byte(pubkey.String())}, slices: 1}
+	m := &NodeMaintainer{
+		localNode:  peer.Local{PrivateKey: privkey},
+		blockchain: chain,
+	}
+	ctx := context.Background()
+
+	// nothing to confirm if node is not draining
+	drained, err := m.confirmDrained(ctx, pubkey)
+	require.NoError(t, err)
+	require.False(t, drained)
+
+	// draining node holding live slices is not drained yet
+	chain.node.Draining = true
+	drained, err = m.confirmDrained(ctx, pubkey)
+	require.NoError(t, err)
+	require.False(t, drained)
+	require.Equal(t, 0, len(chain.drained))
+
+	// node recorded drained with a signed request once no slices left, and only once
+	chain.slices = 0
+	drained, err = m.confirmDrained(ctx, pubkey)
+	require.NoError(t, err)
+	require.True(t, drained)
+	require.Equal(t, 1, len(chain.drained))
+	opt := chain.drained[0]
+	require.Equal(t, []byte(pubkey.String()), opt.NodeID)
+	mes := fmt.Sprintf("%s,%d", opt.NodeID, opt.Nonce)
+	var sig ecdsa.Signature
+	copy(sig[:], opt.Sig)
+	require.NoError(t, ecdsa.Verify(pubkey, hash.Hash([]byte(mes)), sig))
+
+	drained, err = m.confirmDrained(ctx, pubkey)
+	require.NoError(t, err)
+	require.True(t, drained)
+	require.Equal(t, 1, len(chain.drained))
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodemaintainer
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/common"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// heartbeatLog keeps signed heartbeats in local files, one file a day named by the beginning of the day,
+//  so that heartbeats aggregated in commits on chain could be verified by others
+type heartbeatLog struct {
+	dir  string
+	lock sync.Mutex
+}
+
+func newHeartbeatLog(dir string) (*heartbeatLog, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeConfig, "failed to create heartbeat log %s", dir)
+	}
+	return &heartbeatLog{dir: dir}, nil
+}
+
+// append writes a heartbeat into the file of its day
+func (l *heartbeatLog) append(b blockchain.SignedHeartbeat) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	s, err := json.Marshal(b)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal heartbeat")
+	}
+	name := filepath.Join(l.dir, strconv.FormatInt(common.TodayBeginning(b.Timestamp), 10))
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to open heartbeat log")
+	}
+	defer f.Close()
+	if _, err := f.Write(append(s, '\n')); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to write heartbeat log")
+	}
+	return nil
+}
+
+// list returns heartbeats between start and end sorted by time
+func (l *heartbeatLog) list(start, end int64) ([]blockchain.SignedHeartbeat, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	days, err := l.days()
+	if err != nil {
+		return nil, err
+	}
+	var beats []blockchain.SignedHeartbeat
+	for _, day := range days {
+		if day < common.TodayBeginning(start) || day > end {
+			continue
+		}
+		bs, err := l.read(day)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range bs {
+			if b.Timestamp >= start && b.Timestamp <= end {
+				beats = append(beats, b)
+			}
+		}
+	}
+	sort.Slice(beats, func(i, j int) bool { return beats[i].Timestamp < beats[j].Timestamp })
+	return beats, nil
+}
+
+// prune removes files of days before the given time
+func (l *heartbeatLog) prune(before int64) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	days, err := l.days()
+	if err != nil {
+		return err
+	}
+	for _, day := range days {
+		if day < common.TodayBeginning(before) {
+			if err := os.Remove(filepath.Join(l.dir, strconv.FormatInt(day, 10))); err != nil {
+				return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to remove heartbeat log")
+			}
+		}
+	}
+	return nil
+}
+
+func (l *heartbeatLog) days() ([]int64, error) {
+	fs, err := ioutil.ReadDir(l.dir)
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to read heartbeat log")
+	}
+	var days []int64
+	for _, f := range fs {
+		if day, err := strconv.ParseInt(f.Name(), 10, 64); err == nil && !f.IsDir() {
+			days = append(days, day)
+		}
+	}
+	return days, nil
+}
+
+func (l *heartbeatLog) read(day int64) ([]blockchain.SignedHeartbeat, error) {
+	f, err := os.Open(filepath.Join(l.dir, strconv.FormatInt(day, 10)))
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to open heartbeat log")
+	}
+	defer f.Close()
+
+	var beats []blockchain.SignedHeartbeat
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var b blockchain.SignedHeartbeat
+		// a line partially written before crash is skipped
+		if err := json.Unmarshal(scanner.Bytes(), &b); err != nil {
+			continue
+		}
+		beats = append(beats, b)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to read heartbeat log")
+	}
+	return beats, nil
+}
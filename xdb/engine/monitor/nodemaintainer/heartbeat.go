@@ -21,6 +21,10 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/common"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/metrics"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
 )
@@ -51,10 +55,30 @@ func (m *NodeMaintainer) heartbeat(ctx context.Context) {
 			l.WithError(err).Warn("failed to sign heartbeat")
 			continue
 		}
+		// keep heartbeat in local log and commit them regularly in aggregate mode
+		if m.hbLog != nil {
+			if err := m.hbLog.append(blockchain.SignedHeartbeat{Timestamp: timestamp, Signature: sig[:]}); err != nil {
+				metrics.Heartbeats.WithLabelValues(metrics.StatusFailed).Inc()
+				l.WithError(err).Warn("failed to keep heartbeat")
+				continue
+			}
+			metrics.Heartbeats.WithLabelValues(metrics.StatusSuccess).Inc()
+			if timestamp-m.lastHbCommit >= int64(m.hbCommitInterval) ||
+				common.TodayBeginning(timestamp) != common.TodayBeginning(m.lastHbCommit) {
+				if err := m.commitHeartbeats(ctx, timestamp); err != nil {
+					l.WithError(err).Warn("failed to commit heartbeats")
+					continue
+				}
+				m.lastHbCommit = timestamp
+			}
+			continue
+		}
 		if err := m.blockchain.Heartbeat(ctx, []byte(pubkey.String()), sig[:], timestamp); err != nil {
+			metrics.Heartbeats.WithLabelValues(metrics.StatusFailed).Inc()
 			l.WithError(err).Warn("failed to update heartbeat")
 			continue
 		}
+		metrics.Heartbeats.WithLabelValues(metrics.StatusSuccess).Inc()
 
 		l.WithFields(logrus.Fields{
 			"target_node": hex.EncodeToString(pubkey[:4]),
@@ -63,3 +87,64 @@ func (m *NodeMaintainer) heartbeat(ctx context.Context) {
 	}
 
 }
+
+// commitHeartbeats posts heartbeats kept in local log since the last commit onto blockchain,
+//  one commit for heartbeats of each day, and removes logs out of retention period
+func (m *NodeMaintainer) commitHeartbeats(ctx context.Context, now int64) error {
+	nodeID := []byte(ecdsa.PublicKeyFromPrivateKey(m.localNode.PrivateKey).String())
+	if m.lastHbEnd < 0 {
+		c, err := m.blockchain.GetLastHeartbeatCommit(ctx, nodeID)
+		if err != nil && !errorx.Is(err, errorx.ErrCodeNotFound) {
+			return err
+		}
+		m.lastHbEnd = c.End
+	}
+
+	beats, err := m.hbLog.list(m.lastHbEnd+1, now)
+	if err != nil {
+		return err
+	}
+	var days []int64
+	group := make(map[int64][]blockchain.SignedHeartbeat)
+	for _, b := range beats {
+		day := common.TodayBeginning(b.Timestamp)
+		if _, ok := group[day]; !ok {
+			days = append(days, day)
+		}
+		group[day] = append(group[day], b)
+	}
+
+	for _, day := range days {
+		c := blockchain.NewHeartbeatCommit(nodeID, group[day], time.Now().UnixNano())
+		sig, err := ecdsa.Sign(m.localNode.PrivateKey, hash.Hash([]byte(c.Message())))
+		if err != nil {
+			return errorx.Wrap(err, "failed to sign heartbeat commit")
+		}
+		opt := &blockchain.CommitHeartbeatsOptions{
+			Commit:    c,
+			DayBegin:  day,
+			Signature: sig[:],
+		}
+		if err := m.blockchain.CommitHeartbeats(ctx, opt); err != nil {
+			metrics.HeartbeatCommits.WithLabelValues(metrics.StatusFailed).Inc()
+			// heartbeats may have been committed before restart, reload the last commit next time
+			if errorx.Is(err, errorx.ErrCodeAlreadyExists) {
+				m.lastHbEnd = -1
+			}
+			return err
+		}
+		metrics.HeartbeatCommits.WithLabelValues(metrics.StatusSuccess).Inc()
+		m.lastHbEnd = c.End
+
+		logger.WithFields(logrus.Fields{
+			"day":   day,
+			"count": c.Count,
+			"root":  hex.EncodeToString(c.Root),
+		}).Info("success to commit heartbeats of node")
+	}
+
+	if err := m.hbLog.prune(now - int64(heartbeatRetention)); err != nil {
+		logger.WithError(err).Warn("failed to prune heartbeat log")
+	}
+	return nil
+}
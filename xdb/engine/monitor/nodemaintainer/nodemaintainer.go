@@ -22,11 +22,22 @@ import (
 
 	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/config"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/peer"
 )
 
 const (
 	defaultFileClearInterval = time.Hour * 24
+	defaultSliceGCInterval   = time.Hour * 24
+	defaultSliceGCGrace      = time.Hour * 24
+	defaultScrubInterval     = time.Hour * 24
+	defaultScrubRate         = 10 // MB/s
+	defaultHbCommitInterval  = time.Hour
+	defaultHeartbeatLogPath  = "./heartbeats"
+	heartbeatRetention       = time.Hour * 24 * 90
+
+	heartbeatModeChain     = "chain"
+	heartbeatModeAggregate = "aggregate"
 )
 
 var (
@@ -37,14 +48,21 @@ type Blockchain interface {
 	AddNode(ctx context.Context, opt *blockchain.AddNodeOptions) error
 	GetNode(ctx context.Context, id []byte) (blockchain.Node, error)
 	NodeOnline(ctx context.Context, opt *blockchain.NodeOperateOptions) error
+	NodeDrain(ctx context.Context, opt *blockchain.NodeOperateOptions) error
+	GetNodeSliceNum(ctx context.Context, id []byte, timestamp int64) (int, error)
 	Heartbeat(ctx context.Context, id, sig []byte, timestamp int64) error
+	CommitHeartbeats(ctx context.Context, opt *blockchain.CommitHeartbeatsOptions) error
+	GetLastHeartbeatCommit(ctx context.Context, id []byte) (blockchain.HeartbeatCommit, error)
 	ListNodesExpireSlice(ctx context.Context, opt *blockchain.ListNodeSliceOptions) ([]string, error)
+	ListNodeSlices(ctx context.Context, opt *blockchain.ListNodeSliceOptions) ([]blockchain.NodeSlice, string, error)
+	ReportCorruptedSlice(ctx context.Context, opt *blockchain.ReportCorruptedSliceOptions) error
 }
 
 type SliceStorage interface {
 	Load(ctx context.Context, key string) (io.ReadCloser, error)
 	Delete(key string) (bool, error)
 	Exist(key string) (bool, error)
+	List(ctx context.Context) (map[string]time.Time, error)
 
 	LoadStr(ctx context.Context, key string) (string, error)
 	SaveAndUpdate(ctx context.Context, key, value string) error
@@ -71,8 +89,25 @@ type NodeMaintainer struct {
 	fileClearInterval  time.Duration
 	fileRetainInterval time.Duration
 
+	sliceGCSwitch     bool
+	sliceGCInterval   time.Duration
+	sliceGCGrace      time.Duration
+	sliceGCReportOnly bool // only log orphan slices if true, otherwise delete them
+
+	scrubSwitch   bool
+	scrubInterval time.Duration
+	scrubRate     int64 // bytes per second
+
+	// heartbeats are kept in hbLog and committed every hbCommitInterval if not nil, otherwise sent one by one
+	hbLog            *heartbeatLog
+	hbCommitInterval time.Duration
+	lastHbCommit     int64 // time of the last commit
+	lastHbEnd        int64 // time of the last heartbeat committed, -1 if not loaded from chain
+
 	doneHbC         chan struct{} //doneHbC will be closed when loop breaks
 	doneSliceClearC chan struct{} //doneSliceClearC will be closed when loop breaks
+	doneSliceGCC    chan struct{} //doneSliceGCC will be closed when loop breaks
+	doneScrubC      chan struct{} //doneScrubC will be closed when loop breaks
 }
 
 func New(conf *config.MonitorConf, opt *NewNodeMaintainerOptions) (*NodeMaintainer, error) {
@@ -81,11 +116,57 @@ func New(conf *config.MonitorConf, opt *NewNodeMaintainerOptions) (*NodeMaintain
 	if fileClearInterval == 0 {
 		fileClearInterval = defaultFileClearInterval
 	}
+	sliceGCInterval := time.Duration(int64(conf.SlicegcInterval)) * time.Hour
+	if sliceGCInterval == 0 {
+		sliceGCInterval = defaultSliceGCInterval
+	}
+	sliceGCGrace := time.Duration(int64(conf.SlicegcGracePeriod)) * time.Hour
+	if sliceGCGrace == 0 {
+		sliceGCGrace = defaultSliceGCGrace
+	}
+	// delete orphan slices only if configured explicitly
+	sliceGCReportOnly := conf.SlicegcMode != "delete"
+	scrubInterval := time.Duration(int64(conf.ScrubInterval)) * time.Hour
+	if scrubInterval == 0 {
+		scrubInterval = defaultScrubInterval
+	}
+	scrubRate := int64(conf.ScrubRate)
+	if scrubRate <= 0 {
+		scrubRate = defaultScrubRate
+	}
+	var hbLog *heartbeatLog
+	hbCommitInterval := time.Duration(int64(conf.HbcommitInterval)) * time.Minute
+	switch conf.HeartbeatMode {
+	case "", heartbeatModeChain:
+	case heartbeatModeAggregate:
+		if hbCommitInterval <= 0 {
+			hbCommitInterval = defaultHbCommitInterval
+		}
+		logPath := conf.HeartbeatLogPath
+		if logPath == "" {
+			logPath = defaultHeartbeatLogPath
+		}
+		var err error
+		if hbLog, err = newHeartbeatLog(logPath); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errorx.New(errorx.ErrCodeConfig, "wrong config: heartbeatMode %s", conf.HeartbeatMode)
+	}
 
 	logger.WithFields(logrus.Fields{
 		"heartbeat-interval":  heartbeatInterval,
 		"fileclear-interval":  fileClearInterval,
 		"fileretain-interval": blockchain.FileRetainPeriod,
+		"slicegc-switch":      conf.SlicegcSwitch,
+		"slicegc-interval":    sliceGCInterval,
+		"slicegc-grace":       sliceGCGrace,
+		"slicegc-report-only": sliceGCReportOnly,
+		"scrub-switch":        conf.ScrubSwitch,
+		"scrub-interval":      scrubInterval,
+		"scrub-rate":          scrubRate,
+		"heartbeat-mode":      conf.HeartbeatMode,
+		"hbcommit-interval":   hbCommitInterval,
 	}).Info("monitor initialize...")
 
 	mm := &NodeMaintainer{
@@ -95,6 +176,16 @@ func New(conf *config.MonitorConf, opt *NewNodeMaintainerOptions) (*NodeMaintain
 		heartbeatInterval:  heartbeatInterval,
 		fileClearInterval:  fileClearInterval,
 		fileRetainInterval: blockchain.FileRetainPeriod,
+		sliceGCSwitch:      conf.SlicegcSwitch == "on",
+		sliceGCInterval:    sliceGCInterval,
+		sliceGCGrace:       sliceGCGrace,
+		sliceGCReportOnly:  sliceGCReportOnly,
+		scrubSwitch:        conf.ScrubSwitch == "on",
+		scrubInterval:      scrubInterval,
+		scrubRate:          scrubRate << 20,
+		hbLog:              hbLog,
+		hbCommitInterval:   hbCommitInterval,
+		lastHbEnd:          -1,
 	}
 
 	return mm, nil
@@ -122,6 +213,15 @@ func (m *NodeMaintainer) StopHeartBeat() {
 	<-m.doneHbC
 }
 
+// ListHeartbeats lists heartbeats kept in local log between start and end in aggregate mode,
+//  which could be verified against heartbeat commits on blockchain
+func (m *NodeMaintainer) ListHeartbeats(start, end int64) ([]blockchain.SignedHeartbeat, error) {
+	if m.hbLog == nil {
+		return nil, errorx.New(errorx.ErrCodeNotFound, "heartbeats are not aggregated by the node")
+	}
+	return m.hbLog.list(start, end)
+}
+
 // NodeAutoRegister storage-node automatically register in blockchain
 func (m *NodeMaintainer) NodeAutoRegister(ctx context.Context) error {
 	return m.autoregister(ctx)
@@ -148,3 +248,53 @@ func (m *NodeMaintainer) StopFileClear() {
 
 	<-m.doneSliceClearC
 }
+
+// StartSliceGC starts task to collect orphan slices if enabled
+func (m *NodeMaintainer) StartSliceGC(ctx context.Context) {
+	if !m.sliceGCSwitch {
+		return
+	}
+	go m.slicegc(ctx)
+}
+
+// StopSliceGC stops task collecting orphan slices
+func (m *NodeMaintainer) StopSliceGC() {
+	if m.doneSliceGCC == nil {
+		return
+	}
+
+	logger.Info("stops task collecting orphan slices ...")
+
+	select {
+	case <-m.doneSliceGCC:
+		return
+	default:
+	}
+
+	<-m.doneSliceGCC
+}
+
+// StartScrub starts task to verify local slices if enabled
+func (m *NodeMaintainer) StartScrub(ctx context.Context) {
+	if !m.scrubSwitch {
+		return
+	}
+	go m.scrub(ctx)
+}
+
+// StopScrub stops task verifying local slices
+func (m *NodeMaintainer) StopScrub() {
+	if m.doneScrubC == nil {
+		return
+	}
+
+	logger.Info("stops task verifying local slices ...")
+
+	select {
+	case <-m.doneScrubC:
+		return
+	default:
+	}
+
+	<-m.doneScrubC
+}
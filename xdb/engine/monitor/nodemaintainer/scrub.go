@@ -0,0 +1,141 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodemaintainer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+// scrub walks local slices regularly and verifies them against CipherHash on chain,
+//  corrupted or missing slices are reported onto blockchain, so the dataOwner could migrate them
+func (m *NodeMaintainer) scrub(ctx context.Context) {
+	pubkey := ecdsa.PublicKeyFromPrivateKey(m.localNode.PrivateKey)
+
+	l := logger.WithField("runner", "slice scrub loop")
+	defer l.Info("slice scrub stopped")
+
+	ticker := time.NewTicker(m.scrubInterval)
+	defer ticker.Stop()
+
+	m.doneScrubC = make(chan struct{})
+	defer close(m.doneScrubC)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		checked, corrupted, err := m.scrubSlices(ctx, pubkey)
+		if err != nil {
+			l.WithError(err).Warn("failed to scrub slices")
+			continue
+		}
+
+		l.WithFields(logrus.Fields{
+			"checked_num":   checked,
+			"corrupted_num": corrupted,
+			"update_at":     time.Now().Format("2006-01-02 15:04:05"),
+		}).Info("success to scrub slices of node")
+	}
+}
+
+// scrubSlices verifies unexpired slices stored on local node and reports corrupted ones,
+//  returns the number of slices checked and found corrupted
+func (m *NodeMaintainer) scrubSlices(ctx context.Context, pubkey ecdsa.PublicKey) (checked, corrupted int, err error) {
+	// slices may be listed again in following pages, verify each of them once
+	verified := make(map[string]bool)
+	err = m.listNodeSlices(ctx, pubkey, func(slices []blockchain.NodeSlice) error {
+		for _, slice := range slices {
+			if verified[slice.ID] || slice.ExpireTime <= time.Now().UnixNano() {
+				continue
+			}
+			verified[slice.ID] = true
+			n, err := m.verifySlice(ctx, slice)
+			checked++
+			if err != nil {
+				corrupted++
+				l := logger.WithFields(logrus.Fields{
+					"file_id":  slice.FileID,
+					"slice_id": slice.ID,
+				})
+				l.WithError(err).Warn("found corrupted slice")
+				if err := m.reportCorruptedSlice(ctx, pubkey, slice); err != nil {
+					l.WithError(err).Warn("failed to report corrupted slice")
+				}
+			}
+
+			// limit reading rate
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(n * int64(time.Second) / m.scrubRate)):
+			}
+		}
+		return nil
+	})
+	return checked, corrupted, err
+}
+
+// verifySlice checks length and hash of local slice, and returns the number of bytes read
+func (m *NodeMaintainer) verifySlice(ctx context.Context, slice blockchain.NodeSlice) (int64, error) {
+	r, err := m.sliceStorage.Load(ctx, slice.ID)
+	if err != nil {
+		return 0, errorx.Wrap(err, "failed to load slice")
+	}
+	defer r.Close()
+
+	cipherText, err := ioutil.ReadAll(r)
+	if err != nil {
+		return 0, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to read slice")
+	}
+	if uint64(len(cipherText)) != slice.Length {
+		return int64(len(cipherText)), errorx.New(errorx.ErrCodeCrypto,
+			"length not match, expected %d, got %d", slice.Length, len(cipherText))
+	}
+	if !bytes.Equal(hash.Hash(cipherText), slice.CipherHash) {
+		return int64(len(cipherText)), errorx.New(errorx.ErrCodeCrypto, "hash not match")
+	}
+	return int64(len(cipherText)), nil
+}
+
+func (m *NodeMaintainer) reportCorruptedSlice(ctx context.Context, pubkey ecdsa.PublicKey,
+	slice blockchain.NodeSlice) error {
+	nonce := time.Now().UnixNano()
+	mes := fmt.Sprintf("%s,%s,%s,%d", pubkey.String(), slice.FileID, slice.ID, nonce)
+	sig, err := ecdsa.Sign(m.localNode.PrivateKey, hash.Hash([]byte(mes)))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign report")
+	}
+	opt := &blockchain.ReportCorruptedSliceOptions{
+		FileID:    slice.FileID,
+		SliceID:   slice.ID,
+		NodeID:    []byte(pubkey.String()),
+		Nonce:     nonce,
+		Signature: sig[:],
+	}
+	return m.blockchain.ReportCorruptedSlice(ctx, opt)
+}
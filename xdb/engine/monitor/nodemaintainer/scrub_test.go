@@ -0,0 +1,131 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodemaintainer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/peer"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+type scrubChain struct {
+	Blockchain
+	slices   []blockchain.NodeSlice
+	reported []blockchain.ReportCorruptedSliceOptions
+}
+
+// ListNodeSlices lists slices in pages of two, the cursor is the index of the next slice
+func (c *scrubChain) ListNodeSlices(ctx context.Context, opt *blockchain.ListNodeSliceOptions) (
+	[]blockchain.NodeSlice, string, error) {
+	start := 0
+	if len(opt.Cursor) > 0 {
+		var err error
+		if start, err = strconv.Atoi(opt.Cursor); err != nil {
+			return nil, "", err
+		}
+	}
+	if start+2 >= len(c.slices) {
+		return c.slices[start:], "", nil
+	}
+	return c.slices[start : start+2], strconv.Itoa(start + 2), nil
+}
+
+func (c *scrubChain) ReportCorruptedSlice(ctx context.Context, opt *blockchain.ReportCorruptedSliceOptions) error {
+	c.reported = append(c.reported, *opt)
+	return nil
+}
+
+type scrubStorage struct {
+	SliceStorage
+	slices map[string][]byte
+}
+
+func (s *scrubStorage) Load(ctx context.Context, key string) (io.ReadCloser, error) {
+	b, ok := s.slices[key]
+	if !ok {
+		return nil, errorx.New(errorx.ErrCodeNotFound, "slice not found")
+	}
+	return ioutil.NopCloser(bytes.NewReader(b)), nil
+}
+
+func newNodeSlice(fileID, sliceID string, content []byte, expireTime int64) blockchain.NodeSlice {
+	return blockchain.NodeSlice{
+		FileID:     fileID,
+		ExpireTime: expireTime,
+		PublicSliceMeta: blockchain.PublicSliceMeta{
+			ID:         sliceID,
+			CipherHash: hash.Hash(content),
+			Length:     uint64(len(content)),
+		},
+	}
+}
+
+func TestScrubSlices(t *testing.T) {
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+
+	expireTime := time.Now().Add(time.Hour).UnixNano()
+	chain := &scrubChain{slices: []blockchain.NodeSlice{
+		newNodeSlice("f1", "s1", []byte("intact"), expireTime),
+		newNodeSlice("f1", "s2", []byte("tampered"), expireTime),
+		newNodeSlice("f2", "s3", []byte("missing"), expireTime),
+		newNodeSlice("f2", "s4", []byte("truncated"), expireTime),
+		newNodeSlice("f3", "s5", []byte("expired"), time.Now().UnixNano()),
+		// listed again in a following page
+		newNodeSlice("f1", "s2", []byte("tampered"), expireTime),
+	}}
+	storage := &scrubStorage{slices: map[string][]byte{
+		"s1": []byte("intact"),
+		"s2": []byte("tampere!"),
+		"s4": []byte("trunc"),
+		"s5": []byte("expire!"),
+	}}
+	m := &NodeMaintainer{
+		localNode:    peer.Local{PrivateKey: privkey},
+		blockchain:   chain,
+		sliceStorage: storage,
+		scrubRate:    defaultScrubRate << 20,
+	}
+
+	checked, corrupted, err := m.scrubSlices(context.Background(), pubkey)
+	require.NoError(t, err)
+	require.Equal(t, 4, checked)
+	require.Equal(t, 3, corrupted)
+
+	// corrupted slices are reported with signature of local node, expired ones are skipped
+	require.Equal(t, 3, len(chain.reported))
+	for i, want := range []string{"s2", "s3", "s4"} {
+		r := chain.reported[i]
+		require.Equal(t, want, r.SliceID)
+		require.Equal(t, pubkey.String(), string(r.NodeID))
+
+		m := fmt.Sprintf("%s,%s,%s,%d", r.NodeID, r.FileID, r.SliceID, r.Nonce)
+		var sig ecdsa.Signature
+		copy(sig[:], r.Signature)
+		require.NoError(t, ecdsa.Verify(pubkey, hash.Hash([]byte(m)), sig))
+	}
+}
@@ -52,6 +52,11 @@ func (m *NodeMaintainer) sliceclear(ctx context.Context) {
 			return
 		case <-ticker.C:
 		}
+		// slices are migrated away from draining node, record it drained once none left
+		if _, err := m.confirmDrained(ctx, pubkey); err != nil {
+			l.WithError(err).Warn("failed to confirm node drained")
+		}
+
 		latestTime := time.Now().UnixNano()
 		if node.RegTime > (latestTime - m.fileRetainInterval.Nanoseconds()) {
 			l.Info("node register time is later than slice retain time, no slice to clear")
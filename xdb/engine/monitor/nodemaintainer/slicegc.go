@@ -0,0 +1,143 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodemaintainer
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+)
+
+// slicegc collects orphan slices, which are saved locally but not referenced by any file on chain,
+//  slices saved within the grace period are skipped, for their files may not have been published yet
+func (m *NodeMaintainer) slicegc(ctx context.Context) {
+	pubkey := ecdsa.PublicKeyFromPrivateKey(m.localNode.PrivateKey)
+	clearKey := m.getClearKey(pubkey)
+
+	l := logger.WithFields(logrus.Fields{
+		"runner":      "slice gc loop",
+		"report_only": m.sliceGCReportOnly,
+	})
+	defer l.Info("slice gc stopped")
+
+	ticker := time.NewTicker(m.sliceGCInterval)
+	defer ticker.Stop()
+
+	m.doneSliceGCC = make(chan struct{})
+	defer close(m.doneSliceGCC)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// list local slices before querying chain, so that slices saved meanwhile are not misjudged
+		keys, err := m.sliceStorage.List(ctx)
+		if err != nil {
+			l.WithError(err).Warn("failed to list local slices")
+			continue
+		}
+		var referenced []string
+		err = m.listNodeSlices(ctx, pubkey, func(slices []blockchain.NodeSlice) error {
+			for _, slice := range slices {
+				referenced = append(referenced, slice.ID)
+			}
+			return nil
+		})
+		if err != nil {
+			l.WithError(err).Warn("failed to list slices on chain")
+			continue
+		}
+
+		orphans := findOrphanSlices(keys, referenced, time.Now().Add(-m.sliceGCGrace), clearKey)
+		if len(orphans) == 0 {
+			l.Debug("no orphan slice found")
+			continue
+		}
+		if m.sliceGCReportOnly {
+			l.WithFields(logrus.Fields{
+				"orphan_num":     len(orphans),
+				"orphan_id_list": strings.Join(orphans, ","),
+			}).Info("found orphan slices")
+			continue
+		}
+
+		var deleted []string
+		for _, slice := range orphans {
+			if ok, err := m.sliceStorage.Delete(slice); !ok {
+				l.WithError(err).WithField("slice_id", slice).Warn("failed to delete orphan slice")
+				continue
+			}
+			deleted = append(deleted, slice)
+		}
+		l.WithFields(logrus.Fields{
+			"orphan_num":     len(orphans),
+			"dslice_id_list": strings.Join(deleted, ","),
+		}).Info("success to collect orphan slices")
+	}
+}
+
+// findOrphanSlices returns sorted keys which are not referenced and modified before deadline,
+//  reserved is the key storing slice clear progress and never collected
+func findOrphanSlices(keys map[string]time.Time, referenced []string, deadline time.Time,
+	reserved string) []string {
+	refs := make(map[string]struct{}, len(referenced))
+	for _, id := range referenced {
+		refs[id] = struct{}{}
+	}
+
+	var orphans []string
+	for key, modTime := range keys {
+		if key == reserved || !modTime.Before(deadline) {
+			continue
+		}
+		if _, ok := refs[key]; ok {
+			continue
+		}
+		orphans = append(orphans, key)
+	}
+	sort.Strings(orphans)
+	return orphans
+}
+
+// listNodeSlices lists slices on local node referenced by files on chain page by page,
+//  and calls fn with each page until all pages are listed or fn returns an error
+func (m *NodeMaintainer) listNodeSlices(ctx context.Context, pubkey ecdsa.PublicKey,
+	fn func([]blockchain.NodeSlice) error) error {
+	opt := &blockchain.ListNodeSliceOptions{
+		Target: []byte(pubkey.String()),
+	}
+	for {
+		slices, next, err := m.blockchain.ListNodeSlices(ctx, opt)
+		if err != nil {
+			return errorx.Wrap(err, "failed to list slices on chain")
+		}
+		if err := fn(slices); err != nil {
+			return err
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		opt.Cursor = next
+	}
+}
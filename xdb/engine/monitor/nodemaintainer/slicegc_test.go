@@ -0,0 +1,39 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodemaintainer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindOrphanSlices(t *testing.T) {
+	now := time.Now()
+	deadline := now.Add(-time.Hour)
+	keys := map[string]time.Time{
+		"s1":       now.Add(-2 * time.Hour), // referenced
+		"s2":       now.Add(-2 * time.Hour), // orphan
+		"s3":       now,                     // within grace period
+		"s4":       now.Add(-3 * time.Hour), // orphan
+		"clearKey": now.Add(-3 * time.Hour), // reserved
+	}
+
+	orphans := findOrphanSlices(keys, []string{"s1", "s5"}, deadline, "clearKey")
+	require.Equal(t, []string{"s2", "s4"}, orphans)
+
+	orphans = findOrphanSlices(keys, []string{"s1", "s2", "s4"}, deadline, "clearKey")
+	require.Equal(t, 0, len(orphans))
+}
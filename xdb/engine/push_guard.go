@@ -0,0 +1,144 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+// pushReplayWindow is the time a signed push is valid for, pushes beyond it are rejected
+//  as expired, and pushes within it are remembered to reject replays
+const pushReplayWindow = 5 * time.Minute
+
+// defaultMaxSliceSize is the default block size of slicers plus the tag of AES-GCM
+const defaultMaxSliceSize = 4*1024*1024 + 16
+
+// pushGuard rejects replayed pushes and pushes from dataOwner nodes not allowed,
+//  and remembers dataOwner nodes known to be registered on blockchain
+type pushGuard struct {
+	nodeID       []byte // id of the local storage node, pushes must be signed for it
+	lock         sync.Mutex
+	seen         map[string]int64 // digest of signed push message -> timestamp
+	lastPurge    time.Time
+	owners       map[string]struct{}
+	allowed      map[string]struct{} // any dataOwner having a namespace is allowed if empty
+	maxSliceSize int64
+}
+
+func newPushGuard(nodeID []byte, allowed [][]byte, maxSliceSize int64) *pushGuard {
+	g := &pushGuard{
+		nodeID:       nodeID,
+		seen:         make(map[string]int64),
+		lastPurge:    time.Now(),
+		owners:       make(map[string]struct{}),
+		allowed:      make(map[string]struct{}),
+		maxSliceSize: maxSliceSize,
+	}
+	for _, owner := range allowed {
+		g.allowed[string(owner)] = struct{}{}
+	}
+	if g.maxSliceSize <= 0 {
+		g.maxSliceSize = defaultMaxSliceSize
+	}
+	return g
+}
+
+// pushDigest returns digest of the message signed by dataOwner node for the push, the message carries
+//  id of the local storage node, so a push signed for another storage node fails verification here
+func (g *pushGuard) pushDigest(opt types.PushOptions) []byte {
+	msg := fmt.Sprintf("%s,%s,%s,%d", opt.SliceID, g.nodeID, hex.EncodeToString(opt.CipherHash), opt.Timestamp)
+	return hash.Hash([]byte(msg))
+}
+
+// checkReplay returns an error if the timestamp is out of replay window or the push was seen before
+func (g *pushGuard) checkReplay(digest []byte, timestamp int64) error {
+	now := time.Now()
+	if d := time.Duration(now.UnixNano() - timestamp); d > pushReplayWindow || d < -pushReplayWindow {
+		return errorx.New(errorx.ErrCodeExpired, "push request has expired")
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if now.Sub(g.lastPurge) > pushReplayWindow {
+		for k, ts := range g.seen {
+			if now.UnixNano()-ts > int64(2*pushReplayWindow) {
+				delete(g.seen, k)
+			}
+		}
+		g.lastPurge = now
+	}
+
+	if _, ok := g.seen[string(digest)]; ok {
+		return errorx.New(errorx.ErrCodeAlreadyExists, "push request replayed")
+	}
+	g.seen[string(digest)] = timestamp
+	return nil
+}
+
+// checkOwner returns an error if the dataOwner node is not allowed by the storage node, or has no namespace
+//  on blockchain. Anyone could add a namespace with a new key, so only allowed nodes should push slices
+//  unless the network is open to all dataOwners. Nodes with namespaces are remembered as namespaces are never removed
+func (g *pushGuard) checkOwner(ctx context.Context, chain Blockchain, owner []byte) error {
+	if _, ok := g.allowed[string(owner)]; len(g.allowed) > 0 && !ok {
+		return errorx.New(errorx.ErrCodeNotAuthorized, "source node is not an allowed dataOwner")
+	}
+
+	g.lock.Lock()
+	_, ok := g.owners[string(owner)]
+	g.lock.Unlock()
+	if ok {
+		return nil
+	}
+
+	nss, _, err := chain.ListFileNs(ctx, &blockchain.ListNsOptions{
+		Owner:       owner,
+		TimeEnd:     time.Now().UnixNano(),
+		CurrentTime: time.Now().UnixNano(),
+		Limit:       1,
+	})
+	if err != nil {
+		return errorx.Wrap(err, "failed to read blockchain")
+	}
+	if len(nss) == 0 {
+		return errorx.New(errorx.ErrCodeNotAuthorized, "source node is not a registered dataOwner")
+	}
+
+	g.lock.Lock()
+	g.owners[string(owner)] = struct{}{}
+	g.lock.Unlock()
+	return nil
+}
+
+// readSlice reads a pushed slice, slices larger than the maximum size are rejected without being read through
+func (g *pushGuard) readSlice(r io.Reader) ([]byte, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, g.maxSliceSize+1))
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to receive slice")
+	}
+	if int64(len(data)) > g.maxSliceSize {
+		return nil, errorx.New(errorx.ErrCodeParam, "slice exceeds the maximum size %d", g.maxSliceSize)
+	}
+	return data, nil
+}
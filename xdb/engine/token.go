@@ -14,6 +14,10 @@
 package engine
 
 import (
+	"context"
+	"encoding/hex"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
 )
@@ -46,3 +50,27 @@ func (e *Engine) verifyUserID(userID string) error {
 	}
 	return nil
 }
+
+// verifyNsRole verify if user has the required role in the namespace owned by local node,
+//  local node is the namespace owner and has all permissions
+func (e *Engine) verifyNsRole(ctx context.Context, userID, ns, required string) error {
+	localPub := ecdsa.PublicKeyFromPrivateKey(e.monitor.challengingMonitor.PrivateKey)
+	if userID == localPub.String() {
+		return nil
+	}
+	member, err := hex.DecodeString(userID)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeParam, "bad user id")
+	}
+	m, err := e.chain.GetNsMember(ctx, localPub[:], ns, member)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrCodeNotFound) {
+			return errorx.New(errorx.ErrCodeNotAuthorized, "user is not a member of namespace")
+		}
+		return errorx.Wrap(err, "failed to get namespace member from blockchain")
+	}
+	if !blockchain.NsRoleAllows(m.Role, required) {
+		return errorx.New(errorx.ErrCodeNotAuthorized, "%s role required, but user is %s", required, m.Role)
+	}
+	return nil
+}
@@ -16,18 +16,20 @@ package types
 import (
 	"time"
 
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
 )
 
 // WriteOptions options for writing file to system
 type WriteOptions struct {
-	User        string `json:"user"`
-	Token       string `json:"token"`
-	Namespace   string `json:"namespace"`
-	FileName    string `json:"file_name"`
-	ExpireTime  int64  `json:"expire_time"`
-	Description string `json:"description"`
-	Extra       string `json:"extra"`
+	User        string            `json:"user"`
+	Token       string            `json:"token"`
+	Namespace   string            `json:"namespace"`
+	FileName    string            `json:"file_name"`
+	ExpireTime  int64             `json:"expire_time"`
+	Description string            `json:"description"`
+	Extra       string            `json:"extra"`
+	Tags        map[string]string `json:"tags"`
 }
 
 // Valid checks if WriteOptions is valid
@@ -52,6 +54,35 @@ func (o *WriteOptions) Valid() error {
 		return errorx.New(errorx.ErrCodeParam, "invalid file expire time")
 	}
 
+	if err := blockchain.CheckFileTags(o.Tags); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeParam, "invalid file tags")
+	}
+
+	return nil
+}
+
+// AppendOptions options for appending data to an existing file
+type AppendOptions struct {
+	User      string `json:"user"`
+	Token     string `json:"token"`
+	FileID    string `json:"file_id"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// Valid checks if AppendOptions is valid
+func (o *AppendOptions) Valid() error {
+	if len(o.User) == 0 {
+		return errorx.New(errorx.ErrCodeParam, "empty user")
+	}
+	if len(o.Token) == 0 {
+		return errorx.New(errorx.ErrCodeParam, "empty token")
+	}
+	if len(o.FileID) == 0 {
+		return errorx.New(errorx.ErrCodeParam, "empty file id")
+	}
+	if o.Timestamp == 0 {
+		return errorx.New(errorx.ErrCodeParam, "empty timestamp")
+	}
 	return nil
 }
 
@@ -95,8 +126,12 @@ func (r *ReadOptions) Valid() error {
 
 // PushOptions options for pushing slice to storage node
 type PushOptions struct {
-	SliceID  string `json:"slice_id"`
-	SourceId string `json:"source_id"` // dataOwner node id
+	SliceID    string `json:"slice_id"`
+	SourceId   string `json:"source_id"` // dataOwner node id
+	CipherHash []byte `json:"cipher_hash"`
+	Timestamp  int64  `json:"timestamp"`
+	Signature  string `json:"signature"` // signature of dataOwner node over slice id, target node id, cipher hash and timestamp
+	PeerID     []byte `json:"-"`         // node id bound to TLS certificate of the client, empty if not presented
 }
 
 // PullOptions options for pulling slice from storage node
@@ -105,6 +140,7 @@ type PullOptions struct {
 	FileID    string
 	Timestamp uint64
 	Signature string
+	PeerID    []byte // node id bound to TLS certificate of the client, empty if not presented
 }
 
 // AddNodeOptions options for adding storage node to blockchain
@@ -130,6 +166,13 @@ type NodeOnlineOptions struct {
 	Token  string
 }
 
+// NodeDrainOptions options for setting storage node with draining status on blockchain
+type NodeDrainOptions struct {
+	NodeID string
+	Nonce  int64
+	Token  string
+}
+
 // ListFileOptions options for listing files from blockchain
 type ListFileOptions struct {
 	Owner     []byte // file owner
@@ -139,6 +182,25 @@ type ListFileOptions struct {
 	TimeEnd     int64
 	CurrentTime int64  // current time
 	Limit       uint64 // file limit
+	Cursor      string // continuation cursor returned by the previous page
+}
+
+// QueryFileOptions options for querying files by metadata, zero values are not used as filters
+type QueryFileOptions struct {
+	Owner      []byte            // file owner
+	Namespace  string            // file namespace
+	Tags       map[string]string // files must have all the tags
+	NamePrefix string            // prefix of file name
+
+	MinSize uint64 // plain text length range
+	MaxSize uint64
+
+	ExpireTimeStart int64 // file expire time range
+	ExpireTimeEnd   int64
+
+	CurrentTime int64  // current time
+	Limit       uint64 // file limit
+	Cursor      string // continuation cursor returned by the previous page
 }
 
 // UpdateFileEtimeOptions options for updating file expire time
@@ -169,4 +231,123 @@ type UpdateNsOptions struct {
 	Token       string
 }
 
+// UpdateNsQuotaOptions options for updating namespace quota, zero means unlimited
+type UpdateNsQuotaOptions struct {
+	Owner       string
+	Namespace   string
+	MaxBytes    uint64
+	MaxFiles    int64
+	MaxFileSize uint64
+	CurrentTime int64
+	Token       string
+}
+
+// UpdateNsRenewalOptions options for updating namespace renewal policy, see blockchain.NsRenewal
+type UpdateNsRenewalOptions struct {
+	Owner       string
+	Namespace   string
+	Policy      string
+	Days        int
+	Until       int64
+	CurrentTime int64
+	Token       string
+}
+
 type ListNsOptions ListFileOptions
+
+// NsMemberOptions options for adding or removing namespace members,
+//  User should be namespace owner or admin
+type NsMemberOptions struct {
+	User        string
+	Namespace   string
+	Member      string
+	Role        string // empty if removing
+	CurrentTime int64
+	Token       string
+}
+
+// ProposeTransferOptions options for proposing an ownership transfer of a file or a whole namespace,
+//  User should be the owner of local node
+type ProposeTransferOptions struct {
+	User         string
+	Namespace    string
+	FileID       string // the whole namespace is transferred if empty
+	NewOwner     string
+	NewNamespace string
+	CurrentTime  int64
+	Token        string
+}
+
+// AcceptTransferOptions options for accepting an ownership transfer, User should be the new owner
+//  and the owner of local node
+type AcceptTransferOptions struct {
+	User        string
+	ID          string
+	CurrentTime int64
+	Token       string
+}
+
+// CancelTransferOptions options for canceling an ownership transfer not completed yet,
+//  User should be the owner or the new owner, and the owner of local node
+type CancelTransferOptions struct {
+	User        string
+	ID          string
+	CurrentTime int64
+	Token       string
+}
+
+// HandoverOptions options for fetching a file of an accepted transfer from the node of the old owner,
+//  User should be the new owner
+type HandoverOptions struct {
+	User        string
+	ID          string
+	FileID      string
+	CurrentTime int64
+	Token       string
+}
+
+// CompleteTransferOptions options for recording that FileID has been re-uploaded as NewFileID,
+//  an empty FileID finishes a namespace transfer. User should be the new owner and the owner of local node.
+//  ContentHash and Receipt are taken from the HandoverResponse of FileID, both in hex
+type CompleteTransferOptions struct {
+	User        string
+	ID          string
+	FileID      string
+	NewFileID   string
+	ContentHash string
+	Receipt     string
+	CurrentTime int64
+	Token       string
+}
+
+// ListTransferOptions lists transfers proposed by or to User, owner of local node if empty
+type ListTransferOptions struct {
+	User  string
+	Limit uint64
+}
+
+// UpdateHealthPolicyOptions options for setting a new version of node health policy,
+//  Token is signed by the network admin, the node only relays it to blockchain
+type UpdateHealthPolicyOptions struct {
+	Policy      blockchain.HealthPolicy
+	CurrentTime int64
+	Token       string
+}
+
+// UpdateBandwidthOptions options for changing bandwidth limits of the dataOwner node at runtime
+type UpdateBandwidthOptions struct {
+	Limit       int64 // unit: byte per second, total bandwidth, no limit if 0
+	NodeLimit   int64 // unit: byte per second, bandwidth to each storage node, no limit if 0
+	CurrentTime int64
+	Token       string
+}
+
+// RebalanceOptions options for rebalancing file slices across healthy storage nodes
+type RebalanceOptions struct {
+	Namespace    string        // only rebalance files in the namespace, all namespaces if empty
+	MaxMoves     int           // maximum number of slices to move, no limit if zero
+	MoveInterval time.Duration // time to wait between two slice moves
+	DryRun       bool          // only plan slice moves without running them
+	CurrentTime  int64
+	Token        string
+}
@@ -18,4 +18,86 @@ type WriteResponse struct {
 	FileID string `json:"file_id"`
 }
 
+// AppendResponse response of appending data to a file, length is plain text length of the whole file
+type AppendResponse struct {
+	FileID string `json:"file_id"`
+	Length uint64 `json:"length"`
+}
+
 type PushResponse struct{}
+
+// SliceMove a slice migration planned by rebalance
+type SliceMove struct {
+	FileID    string `json:"file_id"`
+	Namespace string `json:"namespace"`
+	SliceID   string `json:"slice_id"`
+	Length    uint64 `json:"length"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+}
+
+// NodeUsage storage usage of a node, counted by slices of local files
+type NodeUsage struct {
+	NodeID string `json:"node_id"`
+	Name   string `json:"name"`
+	Before uint64 `json:"before"`
+	After  uint64 `json:"after"`
+}
+
+// Bandwidth bandwidth limits of the dataOwner node in effect, unit: byte per second, no limit if 0
+type Bandwidth struct {
+	Limit     int64 `json:"limit"`
+	NodeLimit int64 `json:"node_limit"`
+}
+
+// NsMaterialStats statistics of merkle challenge materials kept for files of a namespace,
+//  Namespace is empty for files not found on blockchain
+type NsMaterialStats struct {
+	Namespace string `json:"namespace"`
+	Files     int    `json:"files"`
+	Records   int    `json:"records"`
+	Ranges    int    `json:"ranges"` // ranges not used yet
+	Used      int    `json:"used"`   // ranges used but not compacted yet
+	Size      int64  `json:"size"`   // unit: byte
+}
+
+// MaterialReport statistics of merkle challenge materials kept by the dataOwner node
+type MaterialReport struct {
+	Namespaces []NsMaterialStats `json:"namespaces"`
+	DiskUsage  int64             `json:"disk_usage"` // unit: byte
+}
+
+// RebalancePlan response of rebalance, slice moves and node usage before and after them
+type RebalancePlan struct {
+	DryRun bool        `json:"dry_run"`
+	Moves  []SliceMove `json:"moves"`
+	Nodes  []NodeUsage `json:"nodes"`
+}
+
+// NodeHealthScore health of a node scored by the current policy and by a proposed one
+type NodeHealthScore struct {
+	NodeID         string  `json:"node_id"`
+	Name           string  `json:"name"`
+	CurrentScore   float64 `json:"current_score"`
+	CurrentHealth  string  `json:"current_health"`
+	ProposedScore  float64 `json:"proposed_score"`
+	ProposedHealth string  `json:"proposed_health"`
+}
+
+// HealthSimulation response of simulating a proposed health policy on existing nodes
+type HealthSimulation struct {
+	CurrentVersion int64             `json:"current_version"`
+	Nodes          []NodeHealthScore `json:"nodes"`
+}
+
+// HandoverResponse a file of an accepted transfer handed over by the node of the old owner,
+//  Ciphertext is the plaintext encrypted with public key of the new owner. Receipt is signed by the old owner
+//  over ContentHash, the new owner submits it when completing the transfer of the file
+type HandoverResponse struct {
+	TransferID  string `json:"transfer_id"`
+	FileID      string `json:"file_id"`
+	Length      uint64 `json:"length"`
+	ContentHash string `json:"content_hash"`
+	Receipt     string `json:"receipt"`
+	Ciphertext  []byte `json:"ciphertext"`
+}
@@ -30,4 +30,5 @@ const (
 	ErrCodeReadBlockchain  = "XDAT0011" // errors occurred when reading data from blockchain
 	ErrCodeWriteBlockchain = "XDAT0012" // errors occurred when writing data to blockchain
 	ErrCodeAlreadyUpdate   = "XDAT0013" // duplicate updating error
+	ErrCodeQuotaExceeded   = "XDAT0014" // quota exceeded
 )
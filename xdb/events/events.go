@@ -0,0 +1,148 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// event types
+const (
+	TypeFilePublished     = "file.published"      // file published on blockchain by dataOwner node
+	TypeFileExpiring      = "file.expiring"       // file expires within the expiring window
+	TypeChallengeFailed   = "challenge.failed"    // challenge answered with wrong proof
+	TypeNodeHealthChanged = "node.health_changed" // storage node health status changed
+	TypeMigrationDone     = "file.migrated"       // file slices migrated from unhealthy nodes
+	TypeFileRenewed       = "file.renewed"        // file expire time extended under renewal policy of namespace
+	TypeFileAppended      = "file.appended"       // data appended to file by dataOwner node
+)
+
+const (
+	recentEventsNum = 256
+	subscribeBuffer = 128
+)
+
+var (
+	logger = logrus.WithField("module", "events")
+
+	defaultHub = &hub{subs: make(map[chan Event]struct{})}
+)
+
+// Event lifecycle event emitted by an xdb node
+type Event struct {
+	ID     uint64            `json:"id"` // sequence number on the emitting node, restarts from 1 when node restarts
+	Type   string            `json:"type"`
+	Time   int64             `json:"time"`
+	Node   string            `json:"node"` // public key of the emitting node
+	Fields map[string]string `json:"fields"`
+}
+
+// hub dispatches events to subscribers and keeps recent events for replaying
+type hub struct {
+	lock   sync.Mutex
+	node   string
+	seq    uint64
+	recent []Event
+	subs   map[chan Event]struct{}
+}
+
+// SetNode sets public key of local node which is attached to events
+func SetNode(node string) {
+	defaultHub.lock.Lock()
+	defer defaultHub.lock.Unlock()
+	defaultHub.node = node
+}
+
+// Publish emits an event to all subscribers,
+//  events are dropped for subscribers who are too slow to receive
+func Publish(typ string, fields map[string]string) {
+	defaultHub.publish(typ, fields)
+}
+
+// Subscribe returns a channel receiving events after lastID, and a function to cancel the subscription
+//  recent events are replayed if lastID is smaller than the latest sequence number
+func Subscribe(lastID uint64) (<-chan Event, func()) {
+	return defaultHub.subscribe(lastID)
+}
+
+// Match checks if event type is in types, any type matches if types is empty
+//  type prefix ending with '.' like "file." matches all file events
+func Match(typ string, types []string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if t == typ || (strings.HasSuffix(t, ".") && strings.HasPrefix(typ, t)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *hub) publish(typ string, fields map[string]string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.seq++
+	e := Event{
+		ID:     h.seq,
+		Type:   typ,
+		Time:   time.Now().UnixNano(),
+		Node:   h.node,
+		Fields: fields,
+	}
+	h.recent = append(h.recent, e)
+	if len(h.recent) > recentEventsNum {
+		h.recent = h.recent[len(h.recent)-recentEventsNum:]
+	}
+	for c := range h.subs {
+		select {
+		case c <- e:
+		default:
+			logger.WithFields(logrus.Fields{
+				"event_id": e.ID,
+				"type":     e.Type,
+			}).Warn("subscriber is too slow, event dropped")
+		}
+	}
+}
+
+func (h *hub) subscribe(lastID uint64) (<-chan Event, func()) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	c := make(chan Event, subscribeBuffer+len(h.recent))
+	if lastID > 0 {
+		for _, e := range h.recent {
+			if e.ID > lastID {
+				c <- e
+			}
+		}
+	}
+	h.subs[c] = struct{}{}
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			h.lock.Lock()
+			defer h.lock.Unlock()
+			delete(h.subs, c)
+		})
+	}
+	return c, cancel
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribe(t *testing.T) {
+	h := &hub{subs: make(map[chan Event]struct{})}
+	h.publish(TypeFilePublished, map[string]string{"file_id": "1"})
+	h.publish(TypeFileExpiring, map[string]string{"file_id": "2"})
+
+	// replay events after lastID
+	c, cancel := h.subscribe(1)
+	defer cancel()
+	e := <-c
+	require.Equal(t, uint64(2), e.ID)
+	require.Equal(t, TypeFileExpiring, e.Type)
+
+	h.publish(TypeChallengeFailed, nil)
+	e = <-c
+	require.Equal(t, uint64(3), e.ID)
+
+	cancel()
+	require.Empty(t, h.subs)
+}
+
+func TestMatch(t *testing.T) {
+	require.True(t, Match(TypeFilePublished, nil))
+	require.True(t, Match(TypeFilePublished, []string{"file."}))
+	require.True(t, Match(TypeChallengeFailed, []string{"file.", TypeChallengeFailed}))
+	require.False(t, Match(TypeNodeHealthChanged, []string{"file.", "node"}))
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/config"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+)
+
+const (
+	defaultWatchInterval  = time.Minute
+	defaultExpiringWindow = 24 * time.Hour
+
+	// failed challenges published in the period are watched
+	failedChallengeLookback = 24 * time.Hour
+)
+
+// Blockchain defines blockchain methods used by the watcher
+type Blockchain interface {
+	ListFileNs(ctx context.Context, opt *blockchain.ListNsOptions) ([]blockchain.Namespace, string, error)
+	ListFiles(ctx context.Context, opt *blockchain.ListFileOptions) ([]blockchain.File, string, error)
+	ListChallengeRequests(ctx context.Context, opt *blockchain.ListChallengeOptions) ([]blockchain.Challenge, string, error)
+	ListNodes(ctx context.Context) (blockchain.Nodes, error)
+	GetNodeHealth(ctx context.Context, id []byte) (string, error)
+}
+
+// Start starts the watcher emitting events derived from blockchain, and the webhook if configured,
+//  both stop when ctx is done
+func Start(ctx context.Context, conf *config.EventsConf, privateKey ecdsa.PrivateKey, chain Blockchain,
+	serverType string) error {
+	interval := time.Duration(conf.WatchInterval) * time.Second
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+	expiringWindow := time.Duration(conf.ExpiringWindow) * time.Hour
+	if expiringWindow <= 0 {
+		expiringWindow = defaultExpiringWindow
+	}
+	if conf.Webhook != nil {
+		w, err := newWebhook(conf.Webhook, privateKey)
+		if err != nil {
+			return err
+		}
+		go w.run(ctx)
+	}
+
+	w := &watcher{
+		chain:          chain,
+		pubkey:         ecdsa.PublicKeyFromPrivateKey(privateKey),
+		dataOwner:      serverType == config.NodeTypeDataOwner,
+		interval:       interval,
+		expiringWindow: expiringWindow,
+		expiring:       make(map[string]int64),
+	}
+	go w.run(ctx)
+	return nil
+}
+
+// watcher polls blockchain regularly and emits events on changes,
+//  files expiring are watched only on dataOwner nodes
+type watcher struct {
+	chain          Blockchain
+	pubkey         ecdsa.PublicKey
+	dataOwner      bool
+	interval       time.Duration
+	expiringWindow time.Duration
+
+	nodeHealth       map[string]string // node health of last round, nil before the first round
+	failedChallenges map[string]int64  // failed challenges already seen, challenge ID -> challenge time
+	expiring         map[string]int64  // expiring files already reported, file ID -> expire time
+}
+
+func (w *watcher) run(ctx context.Context) {
+	l := logger.WithField("runner", "events watch loop")
+	defer l.Info("runner stopped")
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.watchNodeHealth(ctx); err != nil {
+			l.WithError(err).Warn("failed to watch node health")
+		}
+		if err := w.watchFailedChallenges(ctx); err != nil {
+			l.WithError(err).Warn("failed to watch failed challenges")
+		}
+		if w.dataOwner {
+			if err := w.watchExpiringFiles(ctx); err != nil {
+				l.WithError(err).Warn("failed to watch expiring files")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchNodeHealth emits an event when health of a node differs from last round
+func (w *watcher) watchNodeHealth(ctx context.Context) error {
+	nodes, err := w.chain.ListNodes(ctx)
+	if err != nil {
+		return errorx.Wrap(err, "failed to list nodes")
+	}
+	health := make(map[string]string, len(nodes))
+	for _, n := range nodes {
+		h, err := w.chain.GetNodeHealth(ctx, n.ID)
+		if err != nil {
+			return errorx.Wrap(err, "failed to get node health")
+		}
+		health[string(n.ID)] = h
+
+		// the first round is the baseline
+		if w.nodeHealth == nil {
+			continue
+		}
+		if old, ok := w.nodeHealth[string(n.ID)]; ok && old != h {
+			Publish(TypeNodeHealthChanged, map[string]string{
+				"node_id":    string(n.ID),
+				"node_name":  n.Name,
+				"old_health": old,
+				"new_health": h,
+			})
+		}
+	}
+	w.nodeHealth = health
+	return nil
+}
+
+// watchFailedChallenges emits an event for each failed challenge of local node's files on dataOwner nodes,
+//  or challenges targeting local node on storage nodes
+func (w *watcher) watchFailedChallenges(ctx context.Context) error {
+	now := time.Now().UnixNano()
+	opt := blockchain.ListChallengeOptions{
+		Status:    blockchain.ChallengeFailed,
+		TimeStart: now - failedChallengeLookback.Nanoseconds(),
+		TimeEnd:   now,
+	}
+	if w.dataOwner {
+		opt.FileOwner = w.pubkey[:]
+	} else {
+		opt.TargetNode = []byte(w.pubkey.String())
+	}
+	challenges, _, err := w.chain.ListChallengeRequests(ctx, &opt)
+	if err != nil {
+		return errorx.Wrap(err, "failed to list failed challenges")
+	}
+
+	baseline := w.failedChallenges == nil
+	if baseline {
+		w.failedChallenges = make(map[string]int64)
+	}
+	for _, c := range challenges {
+		if _, ok := w.failedChallenges[c.ID]; ok {
+			continue
+		}
+		w.failedChallenges[c.ID] = c.ChallengeTime
+		// failed challenges found in the first round are not emitted
+		if baseline {
+			continue
+		}
+		Publish(TypeChallengeFailed, map[string]string{
+			"challenge_id":   c.ID,
+			"file_id":        c.FileID,
+			"target_node":    string(c.TargetNode),
+			"challenge_time": fmt.Sprintf("%d", c.ChallengeTime),
+		})
+	}
+	for id, t := range w.failedChallenges {
+		if t < opt.TimeStart {
+			delete(w.failedChallenges, id)
+		}
+	}
+	return nil
+}
+
+// watchExpiringFiles emits an event once for each file expiring within the window
+func (w *watcher) watchExpiringFiles(ctx context.Context) error {
+	now := time.Now().UnixNano()
+	nsList, _, err := w.chain.ListFileNs(ctx, &blockchain.ListNsOptions{
+		Owner:   w.pubkey[:],
+		TimeEnd: now,
+	})
+	if err != nil {
+		return errorx.Wrap(err, "failed to list namespaces")
+	}
+	deadline := now + w.expiringWindow.Nanoseconds()
+	for _, ns := range nsList {
+		files, _, err := w.chain.ListFiles(ctx, &blockchain.ListFileOptions{
+			Owner:       w.pubkey[:],
+			Namespace:   ns.Name,
+			TimeEnd:     now,
+			CurrentTime: now,
+		})
+		if err != nil {
+			return errorx.Wrap(err, "failed to list files")
+		}
+		for _, f := range files {
+			if f.ExpireTime > deadline {
+				continue
+			}
+			// expire time may be updated, report again then
+			if t, ok := w.expiring[f.ID]; ok && t == f.ExpireTime {
+				continue
+			}
+			w.expiring[f.ID] = f.ExpireTime
+			Publish(TypeFileExpiring, map[string]string{
+				"file_id":     f.ID,
+				"file_name":   f.Name,
+				"namespace":   f.Namespace,
+				"expire_time": fmt.Sprintf("%d", f.ExpireTime),
+			})
+		}
+	}
+	for id, t := range w.expiring {
+		if t <= now {
+			delete(w.expiring, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/config"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+const (
+	defaultWebhookMaxRetries = 3
+	defaultWebhookTimeout    = 10 * time.Second
+	webhookRetryBackoff      = time.Second
+
+	// HeaderNode carries public key of the emitting node in webhook requests
+	HeaderNode = "X-Xdb-Node"
+	// HeaderSignature carries signature of the request body in webhook requests,
+	//  receivers verify it by ecdsa.Verify(node, hash.Hash(body), signature)
+	HeaderSignature = "X-Xdb-Signature"
+)
+
+// webhook posts events as JSON to an outbound url with signed payloads,
+//  failed deliveries are retried with exponential backoff
+type webhook struct {
+	url        string
+	types      []string
+	maxRetries int
+	privateKey ecdsa.PrivateKey
+	client     *http.Client
+}
+
+func newWebhook(conf *config.WebhookConf, privateKey ecdsa.PrivateKey) (*webhook, error) {
+	if conf.URL == "" {
+		return nil, errorx.New(errorx.ErrCodeConfig, "missing config: webhook url")
+	}
+	maxRetries := conf.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultWebhookMaxRetries
+	}
+	timeout := time.Duration(conf.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	return &webhook{
+		url:        conf.URL,
+		types:      conf.Types,
+		maxRetries: maxRetries,
+		privateKey: privateKey,
+		client:     &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// run posts events in order until ctx is done
+func (w *webhook) run(ctx context.Context) {
+	l := logger.WithFields(logrus.Fields{
+		"runner": "webhook loop",
+		"url":    w.url,
+	})
+	defer l.Info("runner stopped")
+
+	ec, cancel := Subscribe(0)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-ec:
+			if !Match(e.Type, w.types) {
+				continue
+			}
+			if err := w.deliver(ctx, e); err != nil {
+				l.WithFields(logrus.Fields{
+					"event_id": e.ID,
+					"type":     e.Type,
+				}).WithError(err).Warn("failed to post event, dropped")
+			}
+		}
+	}
+}
+
+// deliver posts an event, retries at most maxRetries times
+func (w *webhook) deliver(ctx context.Context, e Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal event")
+	}
+	sig, err := ecdsa.Sign(w.privateKey, hash.Hash(body))
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeCrypto, "failed to sign event")
+	}
+	node := ecdsa.PublicKeyFromPrivateKey(w.privateKey)
+
+	backoff := webhookRetryBackoff
+	for i := 0; ; i++ {
+		err = w.post(ctx, body, node.String(), sig.String())
+		if err == nil || i >= w.maxRetries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (w *webhook) post(ctx context.Context, body []byte, node, sig string) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", w.url, bytes.NewReader(body))
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderNode, node)
+	req.Header.Set(HeaderSignature, sig)
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to do request")
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errorx.New(errorx.ErrCodeInternal, "http status: %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ require (
 	github.com/PaddlePaddle/PaddleDTX/crypto v0.0.0-20211117095239-166020adc84f
 	github.com/Shopify/sarama v1.30.0 // indirect
 	github.com/cjqpker/slidewindow v1.0.2
+	github.com/golang/protobuf v1.5.2
 	github.com/google/go-querystring v1.1.0 // indirect
 	github.com/google/uuid v1.2.0
 	github.com/hashicorp/go-version v1.3.0 // indirect
@@ -18,6 +19,8 @@ require (
 	github.com/lestrrat-go/file-rotatelogs v2.4.0+incompatible
 	github.com/lestrrat-go/strftime v1.0.5 // indirect
 	github.com/op/go-logging v0.0.0-20160315200505-970db520ece7 // indirect
+	github.com/prometheus/client_golang v1.1.0
+	github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4
 	github.com/sergi/go-diff v1.2.0 // indirect
 	github.com/sirupsen/logrus v1.8.1
 	github.com/spf13/cobra v1.1.3
@@ -30,7 +33,15 @@ require (
 	github.com/xuperchain/xuper-sdk-go v0.0.0-20210430070222-16051cc40b09
 	github.com/xuperchain/xuperchain v0.0.0-20210208123615-2d08ff11de3e
 	github.com/yudai/pp v2.0.1+incompatible // indirect
+	go.etcd.io/bbolt v1.3.5
+	go.opentelemetry.io/otel v1.0.1
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp v1.0.1
+	go.opentelemetry.io/otel/exporters/stdout/stdouttrace v1.0.1
+	go.opentelemetry.io/otel/sdk v1.0.1
+	go.opentelemetry.io/otel/trace v1.0.1
 	golang.org/x/crypto v0.0.0-20210920023735-84f357641f63
+	google.golang.org/grpc v1.41.0
+	google.golang.org/protobuf v1.27.1
 )
 
 replace github.com/go-kit/kit => github.com/go-kit/kit v0.8.0
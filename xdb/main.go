@@ -15,6 +15,7 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -28,20 +29,27 @@ import (
 	"github.com/sirupsen/logrus"
 	flag "github.com/spf13/pflag"
 
+	embeddedblockchain "github.com/PaddlePaddle/PaddleDTX/xdb/blockchain/embedded"
 	fabricblockchain "github.com/PaddlePaddle/PaddleDTX/xdb/blockchain/fabric"
 	xchainblockchain "github.com/PaddlePaddle/PaddleDTX/xdb/blockchain/xchain"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/config"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/engine"
+	slicecache "github.com/PaddlePaddle/PaddleDTX/xdb/engine/cache"
 	merklechallenger "github.com/PaddlePaddle/PaddleDTX/xdb/engine/challenger/merkle"
 	pdpchallenger "github.com/PaddlePaddle/PaddleDTX/xdb/engine/challenger/pdp"
 	randomcopier "github.com/PaddlePaddle/PaddleDTX/xdb/engine/copier/random"
 	softencryptor "github.com/PaddlePaddle/PaddleDTX/xdb/engine/encryptor/soft"
 	simpleslicer "github.com/PaddlePaddle/PaddleDTX/xdb/engine/slicer/simple"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/events"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/metrics"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/peer"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/bandwidth"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	httpkg "github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/http"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/server"
 	localstorage "github.com/PaddlePaddle/PaddleDTX/xdb/storage/local"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/tracing"
 )
 
 var (
@@ -82,9 +90,20 @@ func main() {
 	}()
 
 	serverConf := config.GetServerConf()
+
+	// init tracing if configured
+	if tracingConf := config.GetTracingConf(); tracingConf != nil {
+		shutdown, err := tracing.Init(ctx, tracingConf, "xdb-"+config.GetServerType(), serverConf.Name)
+		if err != nil {
+			appExit(err)
+		}
+		defer shutdown(context.Background())
+	}
 	blockchainConf := config.GetBlockchainConf()
 	localNode := mustGetNode(serverConf)
+	tlsConf := mustGetTLSConfig(serverConf.TLS, localNode.PrivateKey)
 	blockchainEngine := mustGetBlockchain(blockchainConf)
+	events.SetNode(ecdsa.PublicKeyFromPrivateKey(localNode.PrivateKey).String())
 	var e *engine.Engine
 	switch config.GetServerType() {
 	case config.NodeTypeDataOwner:
@@ -100,8 +119,38 @@ func main() {
 	}
 	defer e.Close()
 
+	// start events watcher and webhook if configured
+	if eventsConf := config.GetEventsConf(); eventsConf != nil {
+		if err := events.Start(ctx, eventsConf, localNode.PrivateKey, blockchainEngine, config.GetServerType()); err != nil {
+			appExit(err)
+		}
+	}
+
+	// start metrics server if configured
+	if metricsConf := config.GetMetricsConf(); metricsConf != nil {
+		go func() {
+			if err := metrics.Serve(ctx, metricsConf.ListenAddress); err != nil && err != context.Canceled {
+				logrus.WithError(err).Error("failed to start metrics server")
+			}
+		}()
+	}
+
+	// start grpc server if configured, it shares handlers with http server
+	if serverConf.GRPCListenAddress != "" {
+		grpcSrv, err := server.NewGRPC(serverConf.GRPCListenAddress, e, tlsConf)
+		if err != nil {
+			appExit(err)
+		}
+		go func() {
+			if err := grpcSrv.Serve(ctx); err != nil && err != context.Canceled {
+				logrus.WithError(err).Error("failed to start grpc server")
+				cancel()
+			}
+		}()
+	}
+
 	// start http server
-	if srv, err := server.New(serverConf.ListenAddress, e); err != nil {
+	if srv, err := server.New(serverConf.ListenAddress, e, tlsConf); err != nil {
 		logrus.WithError(err).Error("failed to initiate server")
 		cancel()
 	} else {
@@ -121,7 +170,12 @@ func getDataOwnerEngine(localNode peer.Local, blockchain engine.Blockchain, conf
 	engineOption.Slicer = mustGetSlicer(conf.Slicer)
 	engineOption.Encryptor = mustGetEncryptor(conf.Encryptor)
 	engineOption.Challenger = mustGetChallenger(conf.Challenger, localNode.PrivateKey)
-	engineOption.Copier = mustGetCopier(conf.Copier, localNode.PrivateKey)
+	limiter := getBandwidthLimiter(conf.Bandwidth)
+	engineOption.Copier = mustGetCopier(conf.Copier, localNode.PrivateKey, limiter)
+	engineOption.Bandwidth = limiter
+	if conf.Cache != nil {
+		engineOption.SliceCache = mustGetSliceCache(conf.Cache)
+	}
 	engine, err := engine.NewEngine(conf.Monitor, &engineOption)
 	if err != nil {
 		appExit(err)
@@ -137,6 +191,17 @@ func getStorageEngine(localNode peer.Local, blockchain engine.Blockchain, conf *
 		Chain:     blockchain,
 	}
 	engineOption.Storage = mustGetStorage(conf)
+	engineOption.MaxSliceSize = conf.MaxSliceSize
+	for _, owner := range conf.AllowedOwners {
+		pubkey, err := ecdsa.DecodePublicKeyFromString(owner)
+		if err != nil {
+			appExit(errorx.NewCode(err, errorx.ErrCodeConfig, "bad allowed owner %s", owner))
+		}
+		engineOption.AllowedOwners = append(engineOption.AllowedOwners, pubkey[:])
+	}
+	if len(engineOption.AllowedOwners) == 0 {
+		logrus.Warn("allowedOwners not set, any dataOwner having a namespace on blockchain could push slices")
+	}
 	engine, err := engine.NewEngine(conf.Monitor, &engineOption)
 	if err != nil {
 		appExit(err)
@@ -201,7 +266,7 @@ func mustGetChallenger(conf *config.DataOwnerChallenger, signer ecdsa.PrivateKey
 }
 
 // mustGetBlockchain initiates XChain client which is used for connecting and requesting blockchain
-// XChain and Fabric are both supported
+// XChain and Fabric are both supported, and the embedded one runs in process for offline development
 func mustGetBlockchain(conf *config.BlockchainConf) engine.Blockchain {
 	var b engine.Blockchain
 	var err error
@@ -210,6 +275,8 @@ func mustGetBlockchain(conf *config.BlockchainConf) engine.Blockchain {
 		b, err = xchainblockchain.New(conf.Xchain)
 	case "fabric":
 		b, err = fabricblockchain.New(conf.Fabric)
+	case "embedded":
+		b, err = embeddedblockchain.New(conf.Embedded)
 	default:
 		appExit(errors.New("invalid blockchain type: " + conf.Type))
 	}
@@ -221,12 +288,13 @@ func mustGetBlockchain(conf *config.BlockchainConf) engine.Blockchain {
 
 // mustGetCopier initiates Copier,
 // and see more from engine.copier
-func mustGetCopier(conf *config.DataOwnerCopierConf, signer ecdsa.PrivateKey) engine.Copier {
+func mustGetCopier(conf *config.DataOwnerCopierConf, signer ecdsa.PrivateKey,
+	limiter *bandwidth.Limiter) engine.Copier {
 	var c engine.Copier
 	copierType := conf.Type
 	switch copierType {
 	case "random-copier":
-		c = randomcopier.New(signer)
+		c = randomcopier.New(signer, limiter)
 	default:
 		appExit(errors.New("invalid copier type: " + copierType))
 	}
@@ -234,6 +302,25 @@ func mustGetCopier(conf *config.DataOwnerCopierConf, signer ecdsa.PrivateKey) en
 	return c
 }
 
+// getBandwidthLimiter initiates bandwidth limiter of slices transferred to and from Storage Nodes,
+// there is no limit if conf is nil, and limits can be set at runtime anyway
+func getBandwidthLimiter(conf *config.DataOwnerBandwidthConf) *bandwidth.Limiter {
+	var limits bandwidth.Limits
+	if conf != nil {
+		limits = bandwidth.Limits{Limit: conf.Limit, NodeLimit: conf.NodeLimit}
+	}
+	return bandwidth.NewLimiter(limits)
+}
+
+// mustGetSliceCache initiates SliceCache to keep verified slices on the read path
+func mustGetSliceCache(conf *config.DataOwnerCacheConf) engine.SliceCache {
+	c, err := slicecache.New(conf)
+	if err != nil {
+		appExit(errorx.Wrap(err, "failed to create slice cache"))
+	}
+	return c
+}
+
 // mustGetStorage initiates local storage
 func mustGetStorage(conf *config.StorageConf) engine.Storage {
 
@@ -253,6 +340,27 @@ func mustGetStorage(conf *config.StorageConf) engine.Storage {
 	return s
 }
 
+// mustGetTLSConfig loads certificate bound to the node and CAs, returns TLS config of servers,
+// and makes slices pushed to and pulled from storage nodes go over TLS. It returns nil if conf is nil.
+func mustGetTLSConfig(conf *config.TLSConf, privkey ecdsa.PrivateKey) *tls.Config {
+	if conf == nil {
+		return nil
+	}
+	if conf.CertFile == "" || conf.CAFile == "" {
+		appExit(errors.New("missing config: tls.certFile or tls.caFile"))
+	}
+	cert, err := httpkg.LoadCertificate(conf.CertFile, privkey)
+	if err != nil {
+		appExit(errorx.Wrap(err, "failed to load tls certificate"))
+	}
+	cas, err := httpkg.LoadCertPool(conf.CAFile)
+	if err != nil {
+		appExit(errorx.Wrap(err, "failed to load tls CA certificates"))
+	}
+	httpkg.SetTLSConfig(httpkg.NewClientTLSConfig(&cert, cas))
+	return httpkg.NewServerTLSConfig(cert, cas, conf.ClientAuth)
+}
+
 // mustGetNode initiates local account
 func mustGetNode(conf *config.ServerConf) peer.Local {
 	if conf == nil {
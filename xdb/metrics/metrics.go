@@ -0,0 +1,141 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "xdb"
+
+// label values shared by collectors
+const (
+	StatusSuccess = "success"
+	StatusFailed  = "failed"
+	StatusError   = "error"
+
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+)
+
+var (
+	// WriteDuration observes latency of Engine.Write on dataOwner nodes
+	WriteDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "file_write_duration_seconds",
+		Help:      "Latency of file writing, from receiving request to publishing file on blockchain.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 14),
+	}, []string{"status"})
+
+	// WriteBytes counts plaintext bytes written by dataOwner nodes
+	WriteBytes = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "file_write_bytes_total",
+		Help:      "Plaintext bytes of files successfully written.",
+	})
+
+	// ReadDuration observes latency of Engine.Read on dataOwner nodes
+	ReadDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "file_read_duration_seconds",
+		Help:      "Latency of file reading, from receiving request to recovering all slices.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 14),
+	}, []string{"status"})
+
+	// ReadBytes counts plaintext bytes read by dataOwner nodes
+	ReadBytes = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "file_read_bytes_total",
+		Help:      "Plaintext bytes of files successfully read.",
+	})
+
+	// SlicesPushed counts slices pushed to storage nodes, labeled by target node name
+	SlicesPushed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "slices_pushed_total",
+		Help:      "Number of slices pushed to storage nodes.",
+	}, []string{"node", "status"})
+
+	// SlicesPulled counts slices pulled from storage nodes, labeled by source node name
+	SlicesPulled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "slices_pulled_total",
+		Help:      "Number of slices pulled from storage nodes.",
+	}, []string{"node", "status"})
+
+	// SliceCacheRequests counts lookups of the slice cache on the read path, labeled by tier and result
+	SliceCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "slice_cache_requests_total",
+		Help:      "Number of slice cache lookups, disk tier is looked up after missing in memory.",
+	}, []string{"tier", "result"})
+
+	// SliceCacheBytes observes size of slices kept in the slice cache, labeled by tier
+	SliceCacheBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "slice_cache_bytes",
+		Help:      "Size of slices kept in the slice cache.",
+	}, []string{"tier"})
+
+	// ChallengeRequests counts challenges published by dataOwner nodes
+	ChallengeRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "challenge_requests_total",
+		Help:      "Number of challenge requests published onto blockchain.",
+	}, []string{"algorithm", "status"})
+
+	// ChallengeAnswers counts challenge answers of storage nodes, labeled by outcome
+	ChallengeAnswers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "challenge_answers_total",
+		Help:      "Number of challenge answers, result is success or failed when verified by contract, otherwise error.",
+	}, []string{"algorithm", "result"})
+
+	// SliceMigrations counts slices migrated from unhealthy nodes
+	SliceMigrations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "slice_migrations_total",
+		Help:      "Number of slices migrated from unhealthy storage nodes.",
+	}, []string{"status"})
+
+	// Heartbeats counts heartbeats sent by storage nodes
+	Heartbeats = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "heartbeats_total",
+		Help:      "Number of heartbeats sent onto blockchain.",
+	}, []string{"status"})
+
+	// HeartbeatCommits counts commits of aggregated heartbeats posted by storage nodes
+	HeartbeatCommits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "heartbeat_commits_total",
+		Help:      "Number of aggregated heartbeat commits posted onto blockchain.",
+	}, []string{"status"})
+)
+
+// Status returns the status label value according to err
+func Status(err error) string {
+	if err != nil {
+		return StatusFailed
+	}
+	return StatusSuccess
+}
+
+// Since returns seconds elapsed since start, used to observe histograms
+func Since(start time.Time) float64 {
+	return time.Since(start).Seconds()
+}
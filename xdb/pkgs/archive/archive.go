@@ -0,0 +1,244 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package archive packs files of a namespace and their metadata into a signed tar archive,
+//  so that the namespace could be restored into another namespace or another XuperDB network.
+//  Plain text of files is stored under "files/" in order, followed by "manifest.json" which lists
+//  metadata and hashes of the files, and "manifest.sig" which is the signature of the manifest by the exporter
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+// Version of archive format
+const Version = 1
+
+const (
+	filePrefix    = "files/"
+	manifestName  = "manifest.json"
+	signatureName = "manifest.sig"
+)
+
+// FileMeta metadata of a file in archive
+type FileMeta struct {
+	Name        string
+	Description string
+	Ext         string
+	Tags        map[string]string
+	ExpireTime  int64
+	Length      uint64 // plain text length
+	Hash        []byte // SHA2-256 of plain text
+}
+
+// Manifest lists files in archive
+type Manifest struct {
+	Version    int
+	Owner      string // public key of the exporter, who signs the manifest
+	Namespace  string
+	ExportTime int64
+	Files      []FileMeta
+}
+
+// Writer writes files into an archive, Close must be called to sign the archive
+type Writer struct {
+	tw       *tar.Writer
+	privkey  ecdsa.PrivateKey
+	manifest Manifest
+}
+
+// NewWriter creates Writer writing files of namespace ns into w, the archive is signed by privkey
+func NewWriter(w io.Writer, privkey ecdsa.PrivateKey, ns string) *Writer {
+	pubkey := ecdsa.PublicKeyFromPrivateKey(privkey)
+	return &Writer{
+		tw:      tar.NewWriter(w),
+		privkey: privkey,
+		manifest: Manifest{
+			Version:    Version,
+			Owner:      pubkey.String(),
+			Namespace:  ns,
+			ExportTime: time.Now().UnixNano(),
+		},
+	}
+}
+
+// Add streams plain text of a file into archive, r must provide exactly meta.Length bytes,
+//  the archive is broken if Add fails
+func (w *Writer) Add(meta FileMeta, r io.Reader) error {
+	hdr := &tar.Header{
+		Name:    fmt.Sprintf("%s%08d", filePrefix, len(w.manifest.Files)),
+		Mode:    0600,
+		Size:    int64(meta.Length),
+		ModTime: time.Unix(0, w.manifest.ExportTime),
+	}
+	if err := w.tw.WriteHeader(hdr); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to write header of file %s", meta.Name)
+	}
+	h := sha256.New()
+	n, err := io.Copy(w.tw, io.TeeReader(r, h))
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to write file %s", meta.Name)
+	}
+	if uint64(n) != meta.Length {
+		return errorx.New(errorx.ErrCodeInternal, "file %s has %d bytes, expected %d", meta.Name, n, meta.Length)
+	}
+	meta.Hash = h.Sum(nil)
+	w.manifest.Files = append(w.manifest.Files, meta)
+	return nil
+}
+
+// Close writes the manifest and its signature, and closes the archive, the underlying writer is not closed
+func (w *Writer) Close() error {
+	m, err := json.Marshal(w.manifest)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to marshal manifest")
+	}
+	sig, err := ecdsa.Sign(w.privkey, hash.Hash(m))
+	if err != nil {
+		return errorx.Wrap(err, "failed to sign manifest")
+	}
+	if err := w.writeEntry(manifestName, m); err != nil {
+		return err
+	}
+	if err := w.writeEntry(signatureName, []byte(sig.String())); err != nil {
+		return err
+	}
+	if err := w.tw.Close(); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to close archive")
+	}
+	return nil
+}
+
+func (w *Writer) writeEntry(name string, data []byte) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: time.Unix(0, w.manifest.ExportTime),
+	}
+	if err := w.tw.WriteHeader(hdr); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to write header of %s", name)
+	}
+	if _, err := w.tw.Write(data); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to write %s", name)
+	}
+	return nil
+}
+
+// Verify reads through an archive, checks the signature of its manifest and hashes of its files,
+//  and returns the manifest. The signer is the owner in manifest, callers should check if it's trusted
+func Verify(r io.Reader) (Manifest, error) {
+	var m Manifest
+	var files []FileMeta
+	var mbs, sig []byte
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return m, errorx.NewCode(err, errorx.ErrCodeEncoding, "failed to read archive")
+		}
+		switch {
+		case hdr.Name == fmt.Sprintf("%s%08d", filePrefix, len(files)):
+			h := sha256.New()
+			n, err := io.Copy(h, tr)
+			if err != nil {
+				return m, errorx.NewCode(err, errorx.ErrCodeEncoding, "failed to read %s", hdr.Name)
+			}
+			files = append(files, FileMeta{Length: uint64(n), Hash: h.Sum(nil)})
+		case hdr.Name == manifestName:
+			if mbs, err = ioutil.ReadAll(tr); err != nil {
+				return m, errorx.NewCode(err, errorx.ErrCodeEncoding, "failed to read manifest")
+			}
+		case hdr.Name == signatureName:
+			if sig, err = ioutil.ReadAll(tr); err != nil {
+				return m, errorx.NewCode(err, errorx.ErrCodeEncoding, "failed to read signature")
+			}
+		default:
+			return m, errorx.New(errorx.ErrCodeEncoding, "unexpected entry %s in archive", hdr.Name)
+		}
+	}
+	if mbs == nil || sig == nil {
+		return m, errorx.New(errorx.ErrCodeEncoding, "manifest or its signature not found in archive")
+	}
+
+	if err := json.Unmarshal(mbs, &m); err != nil {
+		return m, errorx.NewCode(err, errorx.ErrCodeEncoding, "failed to unmarshal manifest")
+	}
+	if m.Version != Version {
+		return m, errorx.New(errorx.ErrCodeEncoding, "unsupported archive version %d", m.Version)
+	}
+	owner, err := ecdsa.DecodePublicKeyFromString(m.Owner)
+	if err != nil {
+		return m, errorx.NewCode(err, errorx.ErrCodeEncoding, "bad owner in manifest")
+	}
+	signature, err := ecdsa.DecodeSignatureFromString(strings.TrimSpace(string(sig)))
+	if err != nil {
+		return m, errorx.NewCode(err, errorx.ErrCodeEncoding, "bad signature of manifest")
+	}
+	if err := ecdsa.Verify(owner, hash.Hash(mbs), signature); err != nil {
+		return m, errorx.NewCode(err, errorx.ErrCodeBadSignature, "failed to verify signature of manifest")
+	}
+
+	if len(files) != len(m.Files) {
+		return m, errorx.New(errorx.ErrCodeBadSignature, "archive has %d files, manifest lists %d", len(files), len(m.Files))
+	}
+	for i, f := range files {
+		if f.Length != m.Files[i].Length || !bytes.Equal(f.Hash, m.Files[i].Hash) {
+			return m, errorx.New(errorx.ErrCodeBadSignature, "file %s does not match manifest", m.Files[i].Name)
+		}
+	}
+	return m, nil
+}
+
+// Walk calls fn with metadata and plain text of each file in an archive verified by Verify, in order.
+//  The remaining of a file not consumed by fn is skipped, and Walk fails if the file turns out to be changed
+func Walk(r io.Reader, m Manifest, fn func(meta FileMeta, r io.Reader) error) error {
+	tr := tar.NewReader(r)
+	for i := 0; i < len(m.Files); i++ {
+		hdr, err := tr.Next()
+		if err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeEncoding, "failed to read archive")
+		}
+		if hdr.Name != fmt.Sprintf("%s%08d", filePrefix, i) {
+			return errorx.New(errorx.ErrCodeEncoding, "unexpected entry %s in archive", hdr.Name)
+		}
+		h := sha256.New()
+		tee := io.TeeReader(tr, h)
+		if err := fn(m.Files[i], tee); err != nil {
+			return err
+		}
+		if _, err := io.Copy(ioutil.Discard, tee); err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeEncoding, "failed to read %s", hdr.Name)
+		}
+		if !bytes.Equal(h.Sum(nil), m.Files[i].Hash) {
+			return errorx.New(errorx.ErrCodeBadSignature, "file %s changed after verification", m.Files[i].Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+)
+
+func TestArchive(t *testing.T) {
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+
+	contents := []string{"hello", "", strings.Repeat("xdb", 4096)}
+	var buf bytes.Buffer
+	w := NewWriter(&buf, privkey, "ns")
+	for i, c := range contents {
+		meta := FileMeta{
+			Name:       string(rune('a' + i)),
+			Tags:       map[string]string{"k": "v"},
+			ExpireTime: int64(i),
+			Length:     uint64(len(c)),
+		}
+		require.NoError(t, w.Add(meta, strings.NewReader(c)))
+	}
+	require.NoError(t, w.Close())
+
+	// length mismatch
+	bad := NewWriter(ioutil.Discard, privkey, "ns")
+	require.Error(t, bad.Add(FileMeta{Name: "bad", Length: 10}, strings.NewReader("short")))
+
+	m, err := Verify(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, pubkey.String(), m.Owner)
+	require.Equal(t, "ns", m.Namespace)
+	require.Equal(t, len(contents), len(m.Files))
+
+	var got []string
+	err = Walk(bytes.NewReader(buf.Bytes()), m, func(meta FileMeta, r io.Reader) error {
+		bs, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "v", meta.Tags["k"])
+		got = append(got, string(bs))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, contents, got)
+
+	// tampered content fails verification
+	tampered := bytes.Replace(buf.Bytes(), []byte("hello"), []byte("hellO"), 1)
+	_, err = Verify(bytes.NewReader(tampered))
+	require.True(t, errorx.Is(err, errorx.ErrCodeBadSignature))
+}
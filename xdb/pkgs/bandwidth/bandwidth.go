@@ -0,0 +1,119 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bandwidth limits bytes per second of slices transferred between dataOwner node and storage nodes,
+//  user reads take priority over file writing, and file writing over background slice migration
+package bandwidth
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// chunkSize is the maximum bytes read at a time, so that traffic is smooth
+const chunkSize = 32 * 1024
+
+// Limits of bandwidth, unit: byte per second, no limit if 0
+type Limits struct {
+	Limit     int64 // total bandwidth of the dataOwner node
+	NodeLimit int64 // bandwidth to each storage node
+}
+
+// Limiter limits total bandwidth and bandwidth to each storage node
+type Limiter struct {
+	lock sync.Mutex
+
+	total     *Bucket
+	nodeLimit int64
+	nodes     map[string]*Bucket
+}
+
+// NewLimiter creates Limiter
+func NewLimiter(limits Limits) *Limiter {
+	return &Limiter{
+		total:     NewBucket(limits.Limit),
+		nodeLimit: limits.NodeLimit,
+		nodes:     make(map[string]*Bucket),
+	}
+}
+
+// Limits returns limits in effect
+func (l *Limiter) Limits() Limits {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return Limits{Limit: l.total.Rate(), NodeLimit: l.nodeLimit}
+}
+
+// SetLimits changes limits at runtime, transfers in progress are affected immediately
+func (l *Limiter) SetLimits(limits Limits) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.total.SetRate(limits.Limit)
+	l.nodeLimit = limits.NodeLimit
+	for _, b := range l.nodes {
+		b.SetRate(limits.NodeLimit)
+	}
+}
+
+// Reader returns a reader limited by total bandwidth and bandwidth to the node,
+//  priority is taken from ctx, see WithPriority
+func (l *Limiter) Reader(ctx context.Context, node string, r io.Reader) io.Reader {
+	return &reader{
+		ctx:      ctx,
+		r:        r,
+		priority: PriorityFrom(ctx),
+		buckets:  []*Bucket{l.node(node), l.total},
+	}
+}
+
+// ReadCloser is the same as Reader, and closes rc when closed
+func (l *Limiter) ReadCloser(ctx context.Context, node string, rc io.ReadCloser) io.ReadCloser {
+	return struct {
+		io.Reader
+		io.Closer
+	}{l.Reader(ctx, node, rc), rc}
+}
+
+func (l *Limiter) node(node string) *Bucket {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	b, ok := l.nodes[node]
+	if !ok {
+		b = NewBucket(l.nodeLimit)
+		l.nodes[node] = b
+	}
+	return b
+}
+
+type reader struct {
+	ctx      context.Context
+	r        io.Reader
+	priority Priority
+	buckets  []*Bucket
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	if len(p) > chunkSize {
+		p = p[:chunkSize]
+	}
+	n, err := r.r.Read(p)
+	if n > 0 {
+		for _, b := range r.buckets {
+			if werr := b.Wait(r.ctx, n, r.priority); werr != nil {
+				return n, werr
+			}
+		}
+	}
+	return n, err
+}
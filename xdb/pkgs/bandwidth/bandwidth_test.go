@@ -0,0 +1,74 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bandwidth
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	data := bytes.Repeat([]byte("x"), 4*chunkSize)
+
+	// no limit
+	l := NewLimiter(Limits{})
+	start := time.Now()
+	out, err := ioutil.ReadAll(l.Reader(context.Background(), "node1", bytes.NewReader(data)))
+	require.NoError(t, err)
+	require.Equal(t, data, out)
+	require.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+
+	// burst of one chunk, so three more chunks take about 300ms
+	l.SetLimits(Limits{NodeLimit: 10 * chunkSize})
+	require.Equal(t, Limits{NodeLimit: 10 * chunkSize}, l.Limits())
+	start = time.Now()
+	out, err = ioutil.ReadAll(l.Reader(context.Background(), "node2", bytes.NewReader(data)))
+	require.NoError(t, err)
+	require.Equal(t, data, out)
+	require.GreaterOrEqual(t, int64(time.Since(start)), int64(250*time.Millisecond))
+
+	// waiting is canceled with context
+	l.SetLimits(Limits{Limit: 1})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = ioutil.ReadAll(l.Reader(ctx, "node1", bytes.NewReader(data)))
+	require.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestPriority(t *testing.T) {
+	require.Equal(t, PriorityWrite, PriorityFrom(context.Background()))
+	require.Equal(t, PriorityRead, PriorityFrom(WithPriority(context.Background(), PriorityRead)))
+
+	b := NewBucket(10 * chunkSize)
+	require.NoError(t, b.Wait(context.Background(), chunkSize, PriorityWrite))
+
+	// background waiter starts first, but read waiter takes tokens first
+	done := make(chan Priority, 2)
+	go func() {
+		b.Wait(context.Background(), chunkSize, PriorityBackground)
+		done <- PriorityBackground
+	}()
+	time.Sleep(10 * time.Millisecond)
+	go func() {
+		b.Wait(context.Background(), chunkSize, PriorityRead)
+		done <- PriorityRead
+	}()
+	require.Equal(t, PriorityRead, <-done)
+	require.Equal(t, PriorityBackground, <-done)
+}
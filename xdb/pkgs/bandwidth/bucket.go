@@ -0,0 +1,159 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bandwidth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Priority of traffic, higher priority traffic takes tokens first when waiting
+type Priority int
+
+const (
+	PriorityBackground Priority = iota // slice migration, rebalance and replica expansion
+	PriorityWrite                      // slice distribution of file writing
+	PriorityRead                       // file reading of users
+
+	numPriorities = 3
+)
+
+// minWait is the minimum time to wait before trying to take tokens again
+const minWait = 5 * time.Millisecond
+
+type priorityKey struct{}
+
+// WithPriority returns a context carrying priority of traffic
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// PriorityFrom returns priority carried by context, PriorityWrite if not set
+func PriorityFrom(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityKey{}).(Priority); ok && p >= 0 && p < numPriorities {
+		return p
+	}
+	return PriorityWrite
+}
+
+// Bucket is a token bucket limiting bytes per second,
+//  tokens are taken by waiters of higher priority first
+type Bucket struct {
+	lock sync.Mutex
+
+	rate    float64 // unit: byte per second, no limit if 0
+	burst   float64
+	tokens  float64
+	last    time.Time
+	waiting [numPriorities]int
+}
+
+// NewBucket creates Bucket, rate is bytes per second and no limit if 0
+func NewBucket(rate int64) *Bucket {
+	b := &Bucket{last: time.Now()}
+	b.SetRate(rate)
+	return b
+}
+
+// Rate returns bytes per second limited, 0 means no limit
+func (b *Bucket) Rate() int64 {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return int64(b.rate)
+}
+
+// SetRate changes the rate, it takes effect on waiters immediately
+func (b *Bucket) SetRate(rate int64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if rate < 0 {
+		rate = 0
+	}
+	b.refill(time.Now())
+	b.rate = float64(rate)
+	// allow bursts of one second, and at least one chunk
+	b.burst = b.rate
+	if b.burst < chunkSize {
+		b.burst = chunkSize
+	}
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+// Wait blocks until n bytes are allowed or ctx is done
+func (b *Bucket) Wait(ctx context.Context, n int, p Priority) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.rate <= 0 {
+		return nil
+	}
+
+	b.waiting[p]++
+	defer func() { b.waiting[p]-- }()
+
+	for {
+		if b.rate <= 0 {
+			return nil
+		}
+		b.refill(time.Now())
+		need := float64(n)
+		if need > b.burst {
+			need = b.burst
+		}
+		wait := minWait
+		if !b.higherWaiting(p) {
+			if b.tokens >= need {
+				b.tokens -= need
+				return nil
+			}
+			if d := time.Duration((need - b.tokens) / b.rate * float64(time.Second)); d > wait {
+				wait = d
+			}
+		}
+
+		b.lock.Unlock()
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			b.lock.Lock()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		b.lock.Lock()
+	}
+}
+
+// higherWaiting tells if waiters of higher priority exist, must be called with lock held
+func (b *Bucket) higherWaiting(p Priority) bool {
+	for i := p + 1; i < numPriorities; i++ {
+		if b.waiting[i] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// refill adds tokens generated since last refilling, must be called with lock held
+func (b *Bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+}
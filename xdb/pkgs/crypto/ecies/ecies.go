@@ -0,0 +1,79 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ecies encrypts data for the owner of an ecdsa public key, the sender generates an ephemeral key pair,
+//  derives an AES-256-GCM key from the shared point of ECDH, and prepends the ephemeral public key to ciphertext
+package ecies
+
+import (
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/aes"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/hash"
+)
+
+var info = []byte("xdb-ecies")
+
+// Encrypt encrypts plaintext for the owner of pubkey
+func Encrypt(pubkey ecdsa.PublicKey, plaintext []byte) ([]byte, error) {
+	ephPrv, ephPub, err := ecdsa.GenerateKeyPair()
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeCrypto, "failed to generate ephemeral key pair")
+	}
+	key, err := deriveKey(ephPrv, pubkey, ephPub, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	return aes.EncryptUsingAESGCM(key, plaintext, append([]byte{}, ephPub[:]...))
+}
+
+// Decrypt decrypts ciphertext made by Encrypt using private key of the receiver
+func Decrypt(privkey ecdsa.PrivateKey, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < ecdsa.PublicKeyLength {
+		return nil, errorx.New(errorx.ErrCodeCrypto, "ciphertext too short")
+	}
+	var ephPub ecdsa.PublicKey
+	copy(ephPub[:], ciphertext[:ecdsa.PublicKeyLength])
+	key, err := deriveKey(privkey, ephPub, ephPub, ecdsa.PublicKeyFromPrivateKey(privkey))
+	if err != nil {
+		return nil, err
+	}
+	return aes.DecryptUsingAESGCM(key, ciphertext[ecdsa.PublicKeyLength:], nil)
+}
+
+// deriveKey derives AES key and nonce from the shared point of privkey and pubkey,
+//  bound to both the ephemeral public key and the receiver's. The ephemeral key is never reused,
+//  so is the derived nonce
+func deriveKey(privkey ecdsa.PrivateKey, pubkey, ephPub, receiver ecdsa.PublicKey) (aes.AESKey, error) {
+	pub, err := ecdsa.ParsePublicKey(pubkey)
+	if err != nil {
+		return aes.AESKey{}, errorx.NewCode(err, errorx.ErrCodeCrypto, "bad public key")
+	}
+	x, _ := pub.Curve.ScalarMult(pub.X, pub.Y, privkey[:])
+	salt := append(append([]byte{}, ephPub[:]...), receiver[:]...)
+	r := hkdf.New(hash.DefaultHasher, crypto.PadStart(x.Bytes(), 32), salt, info)
+
+	key := aes.AESKey{Key: make([]byte, 32), Nonce: make([]byte, 12)}
+	if _, err := io.ReadFull(r, key.Key); err != nil {
+		return key, errorx.NewCode(err, errorx.ErrCodeCrypto, "failed to derive key")
+	}
+	if _, err := io.ReadFull(r, key.Nonce); err != nil {
+		return key, errorx.NewCode(err, errorx.ErrCodeCrypto, "failed to derive nonce")
+	}
+	return key, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+)
+
+func TestECIES(t *testing.T) {
+	privkey, pubkey, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	plaintext := []byte("ecies plaintext")
+
+	c1, err := Encrypt(pubkey, plaintext)
+	require.NoError(t, err)
+	c2, err := Encrypt(pubkey, plaintext)
+	require.NoError(t, err)
+	require.NotEqual(t, c1, c2)
+
+	plain, err := Decrypt(privkey, c1)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, plain)
+
+	// only the receiver decrypts, and tampered ciphertext is rejected
+	other, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	_, err = Decrypt(other, c1)
+	require.Error(t, err)
+	c1[len(c1)-1] ^= 1
+	_, err = Decrypt(privkey, c1)
+	require.Error(t, err)
+	_, err = Decrypt(privkey, c1[:10])
+	require.Error(t, err)
+}
@@ -21,6 +21,9 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
 )
 
@@ -28,6 +31,7 @@ type response struct {
 	Code    string          `json:"code"`
 	Message string          `json:"message"`
 	Data    json.RawMessage `json:"data"`
+	Next    string          `json:"next"`
 }
 
 func Post(ctx context.Context, url string, input io.Reader) (io.ReadCloser, error) {
@@ -49,33 +53,40 @@ func Get(ctx context.Context, url string) (io.ReadCloser, error) {
 }
 
 func GetResponse(ctx context.Context, url string, output interface{}) error {
+	_, err := doResponse(ctx, "GET", url, nil, output)
+	return err
+}
+
+// GetPageResponse gets a page of a listing, returns cursor of the next page
+func GetPageResponse(ctx context.Context, url string, output interface{}) (string, error) {
 	return doResponse(ctx, "GET", url, nil, output)
 }
 
 func PostResponse(ctx context.Context, url string, input io.Reader, output interface{}) error {
-	return doResponse(ctx, "POST", url, input, output)
+	_, err := doResponse(ctx, "POST", url, input, output)
+	return err
 }
 
-func doResponse(ctx context.Context, method string, url string, input io.Reader, output interface{}) error {
+func doResponse(ctx context.Context, method string, url string, input io.Reader, output interface{}) (string, error) {
 	body, err := do(ctx, method, url, input)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer body.Close()
 
 	var result response
 	if err := json.NewDecoder(body).Decode(&result); err != nil {
-		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to decode response")
+		return "", errorx.NewCode(err, errorx.ErrCodeInternal, "failed to decode response")
 	}
 
 	if len(result.Code) > 0 {
-		return errorx.New(result.Code, result.Message)
+		return "", errorx.New(result.Code, result.Message)
 	}
 
 	if err := json.Unmarshal(result.Data, output); err != nil {
-		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to decode response data")
+		return "", errorx.NewCode(err, errorx.ErrCodeInternal, "failed to decode response data")
 	}
-	return nil
+	return result.Next, nil
 }
 
 func do(ctx context.Context, method string, url string, input io.Reader) (io.ReadCloser, error) {
@@ -83,7 +94,9 @@ func do(ctx context.Context, method string, url string, input io.Reader) (io.Rea
 	if err != nil {
 		return nil, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to new request")
 	}
-	resp, err := http.DefaultClient.Do(req)
+	// propagate span context to remote node
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	resp, err := clientFor(ctx).Do(req)
 	if err != nil {
 		return nil, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to do request")
 	}
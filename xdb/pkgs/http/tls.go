@@ -0,0 +1,234 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	stdecdsa "crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+)
+
+var (
+	client = http.DefaultClient
+	scheme = "http"
+
+	// clients of requests bound to node ids by WithPeer, each verifies the node id during TLS handshake,
+	//  so that connections kept alive for one node are never reused for another
+	tlsConf     *tls.Config
+	peerClients = make(map[string]*http.Client)
+	peerLock    sync.Mutex
+)
+
+type peerKey struct{}
+
+// SetTLSConfig makes requests of the package go over TLS with conf,
+//  it should be called once at startup before any request
+func SetTLSConfig(conf *tls.Config) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = conf
+	client = &http.Client{Transport: transport}
+	scheme = "https"
+
+	peerLock.Lock()
+	defer peerLock.Unlock()
+	tlsConf = conf
+	peerClients = make(map[string]*http.Client)
+}
+
+// Scheme returns "https" if TLS is set by SetTLSConfig, otherwise "http"
+func Scheme() string {
+	return scheme
+}
+
+// WithPeer returns a context requiring the certificate of the server to be bound to node id,
+//  that is, public key of the certificate must be the node id registered on blockchain
+func WithPeer(ctx context.Context, id []byte) context.Context {
+	return context.WithValue(ctx, peerKey{}, id)
+}
+
+// PeerID returns node id bound to the verified certificate of the peer, false if there is no such certificate
+func PeerID(state *tls.ConnectionState) ([]byte, bool) {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return nil, false
+	}
+	pubkey, err := PublicKeyFromCertificate(state.PeerCertificates[0])
+	if err != nil {
+		return nil, false
+	}
+	return pubkey[:], true
+}
+
+// PublicKeyFromCertificate returns the node public key of cert
+func PublicKeyFromCertificate(cert *x509.Certificate) (ecdsa.PublicKey, error) {
+	pub, ok := cert.PublicKey.(*stdecdsa.PublicKey)
+	if !ok || pub.Curve != elliptic.P256() {
+		return ecdsa.PublicKey{}, errorx.New(errorx.ErrCodeCrypto, "public key of certificate is not of P-256 curve")
+	}
+	return ecdsa.MarshalPublicKey(pub), nil
+}
+
+// LoadCertificate loads PEM certificate from certFile, its private key is the node private key,
+//  so the certificate is bound to node id, or an error is returned
+func LoadCertificate(certFile string, privkey ecdsa.PrivateKey) (tls.Certificate, error) {
+	bs, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return tls.Certificate{}, errorx.NewCode(err, errorx.ErrCodeConfig, "failed to read certificate")
+	}
+	var chain tls.Certificate
+	for block, rest := pem.Decode(bs); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type == "CERTIFICATE" {
+			chain.Certificate = append(chain.Certificate, block.Bytes)
+		}
+	}
+	if len(chain.Certificate) == 0 {
+		return tls.Certificate{}, errorx.New(errorx.ErrCodeConfig, "no certificate found in %s", certFile)
+	}
+	leaf, err := x509.ParseCertificate(chain.Certificate[0])
+	if err != nil {
+		return tls.Certificate{}, errorx.NewCode(err, errorx.ErrCodeConfig, "failed to parse certificate")
+	}
+	pubkey, err := PublicKeyFromCertificate(leaf)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	if local := ecdsa.PublicKeyFromPrivateKey(privkey); !bytes.Equal(pubkey[:], local[:]) {
+		return tls.Certificate{}, errorx.New(errorx.ErrCodeConfig, "certificate is not bound to node id %s", local.String())
+	}
+
+	key := ecdsa.ParsePrivateKey(privkey)
+	chain.PrivateKey = &key
+	chain.Leaf = leaf
+	return chain, nil
+}
+
+// LoadCertPool loads PEM certificates of CAs from caFile
+func LoadCertPool(caFile string) (*x509.CertPool, error) {
+	bs, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeConfig, "failed to read CA certificates")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bs) {
+		return nil, errorx.New(errorx.ErrCodeConfig, "no CA certificate found in %s", caFile)
+	}
+	return pool, nil
+}
+
+// NewServerTLSConfig creates TLS config of servers, certificates of clients are verified by CAs
+//  if given, and required if clientAuth is true, that is mutual TLS
+func NewServerTLSConfig(cert tls.Certificate, cas *x509.CertPool, clientAuth bool) *tls.Config {
+	conf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    cas,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientAuth {
+		conf.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return conf
+}
+
+// NewClientTLSConfig creates TLS config of clients, cert is presented to servers requiring it if not nil.
+//  Certificates of servers are verified by CAs without checking host names,
+//  as nodes are identified by node ids bound to certificates, see WithPeer
+func NewClientTLSConfig(cert *tls.Certificate, cas *x509.CertPool) *tls.Config {
+	conf := &tls.Config{
+		RootCAs:            cas,
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: true, // verified by VerifyPeerCertificate below
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verifyChain(rawCerts, cas)
+		},
+	}
+	if cert != nil {
+		conf.Certificates = []tls.Certificate{*cert}
+	}
+	return conf
+}
+
+// verifyChain verifies certificates presented by server with CAs
+func verifyChain(rawCerts [][]byte, cas *x509.CertPool) error {
+	if len(rawCerts) == 0 {
+		return errorx.New(errorx.ErrCodeNotAuthorized, "no certificate presented by server")
+	}
+	opts := x509.VerifyOptions{
+		Roots:         cas,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	var leaf *x509.Certificate
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeNotAuthorized, "failed to parse certificate of server")
+		}
+		if i == 0 {
+			leaf = cert
+		} else {
+			opts.Intermediates.AddCert(cert)
+		}
+	}
+	if _, err := leaf.Verify(opts); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeNotAuthorized, "failed to verify certificate of server")
+	}
+	return nil
+}
+
+// clientFor returns the client for requests with ctx, a request bound to a node id by WithPeer
+//  fails in TLS handshake if the certificate of the server is not bound to the node id,
+//  before anything is sent to the server
+func clientFor(ctx context.Context) *http.Client {
+	id, ok := ctx.Value(peerKey{}).([]byte)
+	if !ok || scheme != "https" {
+		return client
+	}
+
+	peerLock.Lock()
+	defer peerLock.Unlock()
+	if c, ok := peerClients[string(id)]; ok {
+		return c
+	}
+	conf := tlsConf.Clone()
+	conf.VerifyConnection = func(state tls.ConnectionState) error {
+		return verifyPeer(&state, id)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = conf
+	c := &http.Client{Transport: transport}
+	peerClients[string(id)] = c
+	return c
+}
+
+// verifyPeer verifies that certificate of the server is bound to node id
+func verifyPeer(state *tls.ConnectionState, id []byte) error {
+	peer, ok := PeerID(state)
+	if !ok {
+		return errorx.New(errorx.ErrCodeNotAuthorized, "no certificate presented by server")
+	}
+	if !bytes.Equal(peer, id) {
+		return errorx.New(errorx.ErrCodeNotAuthorized, "certificate of server is not bound to node id")
+	}
+	return nil
+}
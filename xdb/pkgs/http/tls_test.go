@@ -0,0 +1,122 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+)
+
+// issue issues a certificate for node key signed by CA, and writes it into dir
+func issue(t *testing.T, dir, name string, privkey ecdsa.PrivateKey, ca *x509.Certificate, caKey ecdsa.PrivateKey) string {
+	key := ecdsa.ParsePrivateKey(privkey)
+	signer := ecdsa.ParsePrivateKey(caKey)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	parent := template
+	if ca != nil {
+		parent = ca
+	} else {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, &signer)
+	require.NoError(t, err)
+
+	file := filepath.Join(dir, name+".crt")
+	err = ioutil.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644)
+	require.NoError(t, err)
+	return file
+}
+
+func TestTLS(t *testing.T) {
+	dir := t.TempDir()
+	caKey, _, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	caFile := issue(t, dir, "ca", caKey, nil, caKey)
+	cas, err := LoadCertPool(caFile)
+	require.NoError(t, err)
+	caCert, err := LoadCertificate(caFile, caKey)
+	require.NoError(t, err)
+
+	serverKey, serverID, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	clientKey, clientID, err := ecdsa.GenerateKeyPair()
+	require.NoError(t, err)
+	serverFile := issue(t, dir, "server", serverKey, caCert.Leaf, caKey)
+	clientFile := issue(t, dir, "client", clientKey, caCert.Leaf, caKey)
+
+	// certificate must be bound to the node key
+	_, err = LoadCertificate(serverFile, clientKey)
+	require.Error(t, err)
+	serverCert, err := LoadCertificate(serverFile, serverKey)
+	require.NoError(t, err)
+	clientCert, err := LoadCertificate(clientFile, clientKey)
+	require.NoError(t, err)
+
+	var served int32
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&served, 1)
+		id, ok := PeerID(r.TLS)
+		require.True(t, ok)
+		require.Equal(t, clientID[:], id)
+		w.Write([]byte(`{"data":"ok"}`))
+	}))
+	srv.TLS = NewServerTLSConfig(serverCert, cas, true)
+	srv.StartTLS()
+	defer srv.Close()
+
+	// no client certificate
+	SetTLSConfig(NewClientTLSConfig(nil, cas))
+	var resp string
+	err = GetResponse(context.Background(), srv.URL, &resp)
+	require.Error(t, err)
+
+	SetTLSConfig(NewClientTLSConfig(&clientCert, cas))
+	require.Equal(t, "https", Scheme())
+	err = GetResponse(WithPeer(context.Background(), serverID[:]), srv.URL, &resp)
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&served))
+
+	// server is not the node expected, the request is never sent even over the connection kept alive
+	err = GetResponse(WithPeer(context.Background(), clientID[:]), srv.URL, &resp)
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&served))
+	err = GetResponse(WithPeer(context.Background(), serverID[:]), srv.URL, &resp)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&served))
+}
@@ -0,0 +1,729 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xuperdb
+
+import (
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	etype "github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/events"
+	servertypes "github.com/PaddlePaddle/PaddleDTX/xdb/server/types"
+)
+
+// FromFile converts blockchain.File into protobuf message
+func FromFile(f blockchain.File) *File {
+	slices := make([]*PublicSliceMeta, 0, len(f.Slices))
+	for _, s := range f.Slices {
+		slices = append(slices, &PublicSliceMeta{
+			Id:         s.ID,
+			CipherHash: s.CipherHash,
+			Length:     s.Length,
+			NodeID:     s.NodeID,
+			SliceIdx:   int64(s.SliceIdx),
+			SigmaI:     s.SigmaI,
+		})
+	}
+	return &File{
+		Id:          f.ID,
+		Name:        f.Name,
+		Description: f.Description,
+		Namespace:   f.Namespace,
+		Owner:       f.Owner,
+		Length:      f.Length,
+		MerkleRoot:  f.MerkleRoot,
+		Slices:      slices,
+		Structure:   f.Structure,
+		PublishTime: f.PublishTime,
+		ExpireTime:  f.ExpireTime,
+		PdpPubkey:   f.PdpPubkey,
+		RandU:       f.RandU,
+		RandV:       f.RandV,
+		Ext:         f.Ext,
+		Tags:        f.Tags,
+	}
+}
+
+// ToFile converts protobuf message into blockchain.File
+func ToFile(f *File) blockchain.File {
+	slices := make([]blockchain.PublicSliceMeta, 0, len(f.GetSlices()))
+	for _, s := range f.GetSlices() {
+		slices = append(slices, blockchain.PublicSliceMeta{
+			ID:         s.GetId(),
+			CipherHash: s.GetCipherHash(),
+			Length:     s.GetLength(),
+			NodeID:     s.GetNodeID(),
+			SliceIdx:   int(s.GetSliceIdx()),
+			SigmaI:     s.GetSigmaI(),
+		})
+	}
+	return blockchain.File{
+		ID:          f.GetId(),
+		Name:        f.GetName(),
+		Description: f.GetDescription(),
+		Namespace:   f.GetNamespace(),
+		Owner:       f.GetOwner(),
+		Length:      f.GetLength(),
+		MerkleRoot:  f.GetMerkleRoot(),
+		Slices:      slices,
+		Structure:   f.GetStructure(),
+		PublishTime: f.GetPublishTime(),
+		ExpireTime:  f.GetExpireTime(),
+		PdpPubkey:   f.GetPdpPubkey(),
+		RandU:       f.GetRandU(),
+		RandV:       f.GetRandV(),
+		Ext:         f.GetExt(),
+		Tags:        f.GetTags(),
+	}
+}
+
+// FromFiles converts a page of files into protobuf message
+func FromFiles(fs []blockchain.File, next string) *Files {
+	files := make([]*File, 0, len(fs))
+	for _, f := range fs {
+		files = append(files, FromFile(f))
+	}
+	return &Files{Files: files, Next: next}
+}
+
+// ToFiles converts protobuf message into files
+func ToFiles(fs *Files) []blockchain.File {
+	files := make([]blockchain.File, 0, len(fs.GetFiles()))
+	for _, f := range fs.GetFiles() {
+		files = append(files, ToFile(f))
+	}
+	return files
+}
+
+// FromFileH converts blockchain.FileH into protobuf message
+func FromFileH(fh blockchain.FileH) *FileH {
+	return &FileH{
+		File:   FromFile(fh.File),
+		Health: fh.Health,
+	}
+}
+
+// ToFileH converts protobuf message into blockchain.FileH
+func ToFileH(fh *FileH) blockchain.FileH {
+	return blockchain.FileH{
+		File:   ToFile(fh.GetFile()),
+		Health: fh.GetHealth(),
+	}
+}
+
+// FromNsQuota converts blockchain.NsQuota into protobuf message
+func FromNsQuota(q blockchain.NsQuota) *NsQuota {
+	return &NsQuota{
+		MaxBytes:    q.MaxBytes,
+		MaxFiles:    q.MaxFiles,
+		MaxFileSize: q.MaxFileSize,
+	}
+}
+
+// ToNsQuota converts protobuf message into blockchain.NsQuota
+func ToNsQuota(q *NsQuota) blockchain.NsQuota {
+	return blockchain.NsQuota{
+		MaxBytes:    q.GetMaxBytes(),
+		MaxFiles:    q.GetMaxFiles(),
+		MaxFileSize: q.GetMaxFileSize(),
+	}
+}
+
+// FromNsRenewal converts blockchain.NsRenewal into protobuf message
+func FromNsRenewal(r blockchain.NsRenewal) *NsRenewal {
+	return &NsRenewal{
+		Policy: r.Policy,
+		Days:   int64(r.Days),
+		Until:  r.Until,
+	}
+}
+
+// ToNsRenewal converts protobuf message into blockchain.NsRenewal
+func ToNsRenewal(r *NsRenewal) blockchain.NsRenewal {
+	return blockchain.NsRenewal{
+		Policy: r.GetPolicy(),
+		Days:   int(r.GetDays()),
+		Until:  r.GetUntil(),
+	}
+}
+
+// FromNamespace converts blockchain.Namespace into protobuf message
+func FromNamespace(ns blockchain.Namespace) *Namespace {
+	return &Namespace{
+		Name:           ns.Name,
+		Description:    ns.Description,
+		Owner:          ns.Owner,
+		Replica:        int64(ns.Replica),
+		FilesStruSize:  int64(ns.FilesStruSize),
+		FileTotalNum:   ns.FileTotalNum,
+		CreateTime:     ns.CreateTime,
+		UpdateTime:     ns.UpdateTime,
+		Quota:          FromNsQuota(ns.Quota),
+		FilesTotalSize: ns.FilesTotalSize,
+		FileActiveNum:  ns.FileActiveNum,
+		Renewal:        FromNsRenewal(ns.Renewal),
+	}
+}
+
+// ToNamespace converts protobuf message into blockchain.Namespace
+func ToNamespace(ns *Namespace) blockchain.Namespace {
+	return blockchain.Namespace{
+		Name:           ns.GetName(),
+		Description:    ns.GetDescription(),
+		Owner:          ns.GetOwner(),
+		Replica:        int(ns.GetReplica()),
+		FilesStruSize:  int(ns.GetFilesStruSize()),
+		FileTotalNum:   ns.GetFileTotalNum(),
+		CreateTime:     ns.GetCreateTime(),
+		UpdateTime:     ns.GetUpdateTime(),
+		Quota:          ToNsQuota(ns.GetQuota()),
+		FilesTotalSize: ns.GetFilesTotalSize(),
+		FileActiveNum:  ns.GetFileActiveNum(),
+		Renewal:        ToNsRenewal(ns.GetRenewal()),
+	}
+}
+
+// FromNamespaces converts a page of namespaces into protobuf message
+func FromNamespaces(nss []blockchain.Namespace, next string) *Namespaces {
+	namespaces := make([]*Namespace, 0, len(nss))
+	for _, ns := range nss {
+		namespaces = append(namespaces, FromNamespace(ns))
+	}
+	return &Namespaces{Namespaces: namespaces, Next: next}
+}
+
+// ToNamespaces converts protobuf message into namespaces
+func ToNamespaces(nss *Namespaces) []blockchain.Namespace {
+	namespaces := make([]blockchain.Namespace, 0, len(nss.GetNamespaces()))
+	for _, ns := range nss.GetNamespaces() {
+		namespaces = append(namespaces, ToNamespace(ns))
+	}
+	return namespaces
+}
+
+// FromNamespaceH converts blockchain.NamespaceH into protobuf message
+func FromNamespaceH(nsh blockchain.NamespaceH) *NamespaceH {
+	return &NamespaceH{
+		Namespace:      FromNamespace(nsh.Namespace),
+		FileNormalNum:  int64(nsh.FileNormalNum),
+		FileExpiredNum: int64(nsh.FileExpiredNum),
+		GreenFileNum:   int64(nsh.GreenFileNum),
+		YellowFileNum:  int64(nsh.YellowFileNum),
+		RedFileNum:     int64(nsh.RedFileNum),
+	}
+}
+
+// ToNamespaceH converts protobuf message into blockchain.NamespaceH
+func ToNamespaceH(nsh *NamespaceH) blockchain.NamespaceH {
+	return blockchain.NamespaceH{
+		Namespace:      ToNamespace(nsh.GetNamespace()),
+		FileNormalNum:  int(nsh.GetFileNormalNum()),
+		FileExpiredNum: int(nsh.GetFileExpiredNum()),
+		GreenFileNum:   int(nsh.GetGreenFileNum()),
+		YellowFileNum:  int(nsh.GetYellowFileNum()),
+		RedFileNum:     int(nsh.GetRedFileNum()),
+	}
+}
+
+// FromNsMembers converts namespace members into protobuf message
+func FromNsMembers(ms []blockchain.NsMember) *NsMembers {
+	members := make([]*NsMember, 0, len(ms))
+	for _, m := range ms {
+		members = append(members, &NsMember{
+			Owner:     m.Owner,
+			Namespace: m.Namespace,
+			Member:    m.Member,
+			Role:      m.Role,
+			AddTime:   m.AddTime,
+		})
+	}
+	return &NsMembers{Members: members}
+}
+
+// ToNsMembers converts protobuf message into namespace members
+func ToNsMembers(ms *NsMembers) []blockchain.NsMember {
+	members := make([]blockchain.NsMember, 0, len(ms.GetMembers()))
+	for _, m := range ms.GetMembers() {
+		members = append(members, blockchain.NsMember{
+			Owner:     m.GetOwner(),
+			Namespace: m.GetNamespace(),
+			Member:    m.GetMember(),
+			Role:      m.GetRole(),
+			AddTime:   m.GetAddTime(),
+		})
+	}
+	return members
+}
+
+// FromTransfer converts blockchain.Transfer into protobuf message
+func FromTransfer(t blockchain.Transfer) *Transfer {
+	return &Transfer{
+		Id:           t.ID,
+		Owner:        t.Owner,
+		Namespace:    t.Namespace,
+		FileID:       t.FileID,
+		NewOwner:     t.NewOwner,
+		NewNamespace: t.NewNamespace,
+		Status:       t.Status,
+		ProposeTime:  t.ProposeTime,
+		AcceptTime:   t.AcceptTime,
+		CompleteTime: t.CompleteTime,
+		CancelTime:   t.CancelTime,
+		Files:        t.Files,
+	}
+}
+
+// ToTransfer converts protobuf message into blockchain.Transfer
+func ToTransfer(t *Transfer) blockchain.Transfer {
+	return blockchain.Transfer{
+		ID:           t.GetId(),
+		Owner:        t.GetOwner(),
+		Namespace:    t.GetNamespace(),
+		FileID:       t.GetFileID(),
+		NewOwner:     t.GetNewOwner(),
+		NewNamespace: t.GetNewNamespace(),
+		Status:       t.GetStatus(),
+		ProposeTime:  t.GetProposeTime(),
+		AcceptTime:   t.GetAcceptTime(),
+		CompleteTime: t.GetCompleteTime(),
+		CancelTime:   t.GetCancelTime(),
+		Files:        t.GetFiles(),
+	}
+}
+
+// FromTransfers converts transfers into protobuf message
+func FromTransfers(ts []blockchain.Transfer) *Transfers {
+	transfers := make([]*Transfer, 0, len(ts))
+	for _, t := range ts {
+		transfers = append(transfers, FromTransfer(t))
+	}
+	return &Transfers{Transfers: transfers}
+}
+
+// ToTransfers converts protobuf message into transfers
+func ToTransfers(ts *Transfers) []blockchain.Transfer {
+	transfers := make([]blockchain.Transfer, 0, len(ts.GetTransfers()))
+	for _, t := range ts.GetTransfers() {
+		transfers = append(transfers, ToTransfer(t))
+	}
+	return transfers
+}
+
+// FromFileSysHealth converts blockchain.FileSysHealth into protobuf message
+func FromFileSysHealth(h blockchain.FileSysHealth) *FileSysHealth {
+	return &FileSysHealth{
+		FileNum:         int64(h.FileNum),
+		FileExpiredNum:  int64(h.FileExpiredNum),
+		NsNum:           int64(h.NsNum),
+		GreenFileNum:    int64(h.GreenFileNum),
+		YellowFileNum:   int64(h.YellowFileNum),
+		RedFileNum:      int64(h.RedFileNum),
+		SysHealth:       h.SysHealth,
+		FilesHealthRate: h.FilesHealthRate,
+		NodeNum:         int64(h.NodeNum),
+		GreenNodeNum:    int64(h.GreenNodeNum),
+		YellowNodeNum:   int64(h.YellowNodeNum),
+		RedNodeNum:      int64(h.RedNodeNum),
+		NodeHealthRate:  h.NodeHealthRate,
+	}
+}
+
+// ToFileSysHealth converts protobuf message into blockchain.FileSysHealth
+func ToFileSysHealth(h *FileSysHealth) blockchain.FileSysHealth {
+	return blockchain.FileSysHealth{
+		FileNum:         int(h.GetFileNum()),
+		FileExpiredNum:  int(h.GetFileExpiredNum()),
+		NsNum:           int(h.GetNsNum()),
+		GreenFileNum:    int(h.GetGreenFileNum()),
+		YellowFileNum:   int(h.GetYellowFileNum()),
+		RedFileNum:      int(h.GetRedFileNum()),
+		SysHealth:       h.GetSysHealth(),
+		FilesHealthRate: h.GetFilesHealthRate(),
+		NodeNum:         int(h.GetNodeNum()),
+		GreenNodeNum:    int(h.GetGreenNodeNum()),
+		YellowNodeNum:   int(h.GetYellowNodeNum()),
+		RedNodeNum:      int(h.GetRedNodeNum()),
+		NodeHealthRate:  h.GetNodeHealthRate(),
+	}
+}
+
+// FromChallenge converts blockchain.Challenge into protobuf message
+func FromChallenge(c blockchain.Challenge) *Challenge {
+	ranges := make([]*Range, 0, len(c.Ranges))
+	for _, r := range c.Ranges {
+		ranges = append(ranges, &Range{Start: r.Start, End: r.End})
+	}
+	return &Challenge{
+		Id:                c.ID,
+		FileOwner:         c.FileOwner,
+		TargetNode:        c.TargetNode,
+		FileID:            c.FileID,
+		SliceIDs:          c.SliceIDs,
+		Indices:           c.Indices,
+		Vs:                c.Vs,
+		Sigmas:            c.Sigmas,
+		SliceID:           c.SliceID,
+		Ranges:            ranges,
+		HashOfProof:       c.HashOfProof,
+		ChallengAlgorithm: c.ChallengAlgorithm,
+		Status:            c.Status,
+		ChallengeTime:     c.ChallengeTime,
+		AnswerTime:        c.AnswerTime,
+	}
+}
+
+// ToChallenge converts protobuf message into blockchain.Challenge
+func ToChallenge(c *Challenge) blockchain.Challenge {
+	ranges := make([]blockchain.Range, 0, len(c.GetRanges()))
+	for _, r := range c.GetRanges() {
+		ranges = append(ranges, blockchain.Range{Start: r.GetStart(), End: r.GetEnd()})
+	}
+	return blockchain.Challenge{
+		ID:                c.GetId(),
+		FileOwner:         c.GetFileOwner(),
+		TargetNode:        c.GetTargetNode(),
+		FileID:            c.GetFileID(),
+		SliceIDs:          c.GetSliceIDs(),
+		Indices:           c.GetIndices(),
+		Vs:                c.GetVs(),
+		Sigmas:            c.GetSigmas(),
+		SliceID:           c.GetSliceID(),
+		Ranges:            ranges,
+		HashOfProof:       c.GetHashOfProof(),
+		ChallengAlgorithm: c.GetChallengAlgorithm(),
+		Status:            c.GetStatus(),
+		ChallengeTime:     c.GetChallengeTime(),
+		AnswerTime:        c.GetAnswerTime(),
+	}
+}
+
+// FromChallenges converts a page of challenges into protobuf message
+func FromChallenges(cs []blockchain.Challenge, next string) *Challenges {
+	challenges := make([]*Challenge, 0, len(cs))
+	for _, c := range cs {
+		challenges = append(challenges, FromChallenge(c))
+	}
+	return &Challenges{Challenges: challenges, Next: next}
+}
+
+// ToChallenges converts protobuf message into challenges
+func ToChallenges(cs *Challenges) []blockchain.Challenge {
+	challenges := make([]blockchain.Challenge, 0, len(cs.GetChallenges()))
+	for _, c := range cs.GetChallenges() {
+		challenges = append(challenges, ToChallenge(c))
+	}
+	return challenges
+}
+
+// FromNode converts blockchain.Node into protobuf message
+func FromNode(n blockchain.Node) *Node {
+	return &Node{
+		Id:        n.ID,
+		Name:      n.Name,
+		Address:   n.Address,
+		Online:    n.Online,
+		RegTime:   n.RegTime,
+		UpdateAt:  n.UpdateAt,
+		Draining:  n.Draining,
+		DrainTime: n.DrainTime,
+	}
+}
+
+// ToNode converts protobuf message into blockchain.Node
+func ToNode(n *Node) blockchain.Node {
+	return blockchain.Node{
+		ID:        n.GetId(),
+		Name:      n.GetName(),
+		Address:   n.GetAddress(),
+		Online:    n.GetOnline(),
+		RegTime:   n.GetRegTime(),
+		UpdateAt:  n.GetUpdateAt(),
+		Draining:  n.GetDraining(),
+		DrainTime: n.GetDrainTime(),
+	}
+}
+
+// FromNodes converts blockchain.Nodes into protobuf message
+func FromNodes(ns blockchain.Nodes) *Nodes {
+	nodes := make([]*Node, 0, len(ns))
+	for _, n := range ns {
+		nodes = append(nodes, FromNode(n))
+	}
+	return &Nodes{Nodes: nodes}
+}
+
+// ToNodes converts protobuf message into blockchain.Nodes
+func ToNodes(ns *Nodes) blockchain.Nodes {
+	nodes := make(blockchain.Nodes, 0, len(ns.GetNodes()))
+	for _, n := range ns.GetNodes() {
+		nodes = append(nodes, ToNode(n))
+	}
+	return nodes
+}
+
+// FromNodeDrainStatus converts blockchain.NodeDrainStatus into protobuf message
+func FromNodeDrainStatus(s blockchain.NodeDrainStatus) *NodeDrainStatus {
+	return &NodeDrainStatus{
+		NodeID:         s.NodeID,
+		Draining:       s.Draining,
+		DrainTime:      s.DrainTime,
+		LiveSlices:     int64(s.LiveSlices),
+		MigratedSlices: int64(s.MigratedSlices),
+		Removable:      s.Removable,
+	}
+}
+
+// ToNodeDrainStatus converts protobuf message into blockchain.NodeDrainStatus
+func ToNodeDrainStatus(s *NodeDrainStatus) blockchain.NodeDrainStatus {
+	return blockchain.NodeDrainStatus{
+		NodeID:         s.GetNodeID(),
+		Draining:       s.GetDraining(),
+		DrainTime:      s.GetDrainTime(),
+		LiveSlices:     int(s.GetLiveSlices()),
+		MigratedSlices: int(s.GetMigratedSlices()),
+		Removable:      s.GetRemovable(),
+	}
+}
+
+// FromHealthPolicy converts blockchain.HealthPolicy into protobuf message
+func FromHealthPolicy(p blockchain.HealthPolicy) *HealthPolicy {
+	return &HealthPolicy{
+		Version:              p.Version,
+		Window:               int64(p.Window),
+		ChallengeWeight:      p.ChallengeWeight,
+		HeartbeatWeight:      p.HeartbeatWeight,
+		LatencyWeight:        p.LatencyWeight,
+		GoodBound:            p.GoodBound,
+		MediumBound:          p.MediumBound,
+		DefaultChallengeRate: p.DefaultChallengeRate,
+		DefaultHeartbeatRate: p.DefaultHeartbeatRate,
+		MaxLatency:           p.MaxLatency,
+		UpdateTime:           p.UpdateTime,
+	}
+}
+
+// ToHealthPolicy converts protobuf message into blockchain.HealthPolicy
+func ToHealthPolicy(p *HealthPolicy) blockchain.HealthPolicy {
+	return blockchain.HealthPolicy{
+		Version:              p.GetVersion(),
+		Window:               int(p.GetWindow()),
+		ChallengeWeight:      p.GetChallengeWeight(),
+		HeartbeatWeight:      p.GetHeartbeatWeight(),
+		LatencyWeight:        p.GetLatencyWeight(),
+		GoodBound:            p.GetGoodBound(),
+		MediumBound:          p.GetMediumBound(),
+		DefaultChallengeRate: p.GetDefaultChallengeRate(),
+		DefaultHeartbeatRate: p.GetDefaultHeartbeatRate(),
+		MaxLatency:           p.GetMaxLatency(),
+		UpdateTime:           p.GetUpdateTime(),
+	}
+}
+
+// FromSignedHeartbeats converts heartbeats into protobuf message
+func FromSignedHeartbeats(beats []blockchain.SignedHeartbeat) *SignedHeartbeats {
+	hs := &SignedHeartbeats{}
+	for _, b := range beats {
+		hs.Heartbeats = append(hs.Heartbeats, &SignedHeartbeat{
+			Timestamp: b.Timestamp,
+			Signature: b.Signature,
+		})
+	}
+	return hs
+}
+
+// ToSignedHeartbeats converts protobuf message into heartbeats
+func ToSignedHeartbeats(hs *SignedHeartbeats) []blockchain.SignedHeartbeat {
+	var beats []blockchain.SignedHeartbeat
+	for _, b := range hs.GetHeartbeats() {
+		beats = append(beats, blockchain.SignedHeartbeat{
+			Timestamp: b.GetTimestamp(),
+			Signature: b.GetSignature(),
+		})
+	}
+	return beats
+}
+
+// FromHeartbeatCommits converts heartbeat commits into protobuf message
+func FromHeartbeatCommits(commits []blockchain.HeartbeatCommit) *HeartbeatCommits {
+	cs := &HeartbeatCommits{}
+	for _, c := range commits {
+		cs.Commits = append(cs.Commits, &HeartbeatCommit{
+			NodeID:     c.NodeID,
+			Start:      c.Start,
+			End:        c.End,
+			Count:      int64(c.Count),
+			Root:       c.Root,
+			CommitTime: c.CommitTime,
+		})
+	}
+	return cs
+}
+
+// ToHeartbeatCommits converts protobuf message into heartbeat commits
+func ToHeartbeatCommits(cs *HeartbeatCommits) []blockchain.HeartbeatCommit {
+	var commits []blockchain.HeartbeatCommit
+	for _, c := range cs.GetCommits() {
+		commits = append(commits, blockchain.HeartbeatCommit{
+			NodeID:     c.GetNodeID(),
+			Start:      c.GetStart(),
+			End:        c.GetEnd(),
+			Count:      int(c.GetCount()),
+			Root:       c.GetRoot(),
+			CommitTime: c.GetCommitTime(),
+		})
+	}
+	return commits
+}
+
+// FromHealthSimulation converts health simulation of engine into protobuf message
+func FromHealthSimulation(s etype.HealthSimulation) *HealthSimulation {
+	sim := &HealthSimulation{CurrentVersion: s.CurrentVersion}
+	for _, n := range s.Nodes {
+		sim.Nodes = append(sim.Nodes, &NodeHealthScore{
+			NodeID:         n.NodeID,
+			Name:           n.Name,
+			CurrentScore:   n.CurrentScore,
+			CurrentHealth:  n.CurrentHealth,
+			ProposedScore:  n.ProposedScore,
+			ProposedHealth: n.ProposedHealth,
+		})
+	}
+	return sim
+}
+
+// ToHealthSimulation converts protobuf message into health simulation of server
+func ToHealthSimulation(s *HealthSimulation) servertypes.HealthSimulation {
+	sim := servertypes.HealthSimulation{CurrentVersion: s.GetCurrentVersion()}
+	for _, n := range s.GetNodes() {
+		sim.Nodes = append(sim.Nodes, servertypes.NodeHealthScore{
+			NodeID:         n.GetNodeID(),
+			Name:           n.GetName(),
+			CurrentScore:   n.GetCurrentScore(),
+			CurrentHealth:  n.GetCurrentHealth(),
+			ProposedScore:  n.GetProposedScore(),
+			ProposedHealth: n.GetProposedHealth(),
+		})
+	}
+	return sim
+}
+
+// FromRebalancePlan converts rebalance plan of engine into protobuf message
+func FromRebalancePlan(p etype.RebalancePlan) *RebalancePlan {
+	plan := &RebalancePlan{DryRun: p.DryRun}
+	for _, m := range p.Moves {
+		plan.Moves = append(plan.Moves, &SliceMove{
+			FileID:    m.FileID,
+			Namespace: m.Namespace,
+			SliceID:   m.SliceID,
+			Length:    m.Length,
+			From:      m.From,
+			To:        m.To,
+		})
+	}
+	for _, n := range p.Nodes {
+		plan.Nodes = append(plan.Nodes, &NodeUsage{
+			NodeID: n.NodeID,
+			Name:   n.Name,
+			Before: n.Before,
+			After:  n.After,
+		})
+	}
+	return plan
+}
+
+// ToRebalancePlan converts protobuf message into rebalance plan of server
+func ToRebalancePlan(p *RebalancePlan) servertypes.RebalancePlan {
+	plan := servertypes.RebalancePlan{DryRun: p.GetDryRun()}
+	for _, m := range p.GetMoves() {
+		plan.Moves = append(plan.Moves, servertypes.SliceMove{
+			FileID:    m.GetFileID(),
+			Namespace: m.GetNamespace(),
+			SliceID:   m.GetSliceID(),
+			Length:    m.GetLength(),
+			From:      m.GetFrom(),
+			To:        m.GetTo(),
+		})
+	}
+	for _, n := range p.GetNodes() {
+		plan.Nodes = append(plan.Nodes, servertypes.NodeUsage{
+			NodeID: n.GetNodeID(),
+			Name:   n.GetName(),
+			Before: n.GetBefore(),
+			After:  n.GetAfter(),
+		})
+	}
+	return plan
+}
+
+// FromBandwidth converts bandwidth limits of engine into protobuf message
+func FromBandwidth(b etype.Bandwidth) *Bandwidth {
+	return &Bandwidth{Limit: b.Limit, NodeLimit: b.NodeLimit}
+}
+
+// ToBandwidth converts protobuf message into bandwidth limits of server
+func ToBandwidth(b *Bandwidth) servertypes.Bandwidth {
+	return servertypes.Bandwidth{Limit: b.GetLimit(), NodeLimit: b.GetNodeLimit()}
+}
+
+// FromMaterialReport converts challenge material report of engine into protobuf message
+func FromMaterialReport(r etype.MaterialReport) *MaterialReport {
+	report := &MaterialReport{DiskUsage: r.DiskUsage}
+	for _, s := range r.Namespaces {
+		report.Namespaces = append(report.Namespaces, &NsMaterialStats{
+			Namespace: s.Namespace,
+			Files:     int64(s.Files),
+			Records:   int64(s.Records),
+			Ranges:    int64(s.Ranges),
+			Used:      int64(s.Used),
+			Size:      s.Size,
+		})
+	}
+	return report
+}
+
+// ToMaterialReport converts protobuf message into challenge material report of server
+func ToMaterialReport(r *MaterialReport) servertypes.MaterialReport {
+	report := servertypes.MaterialReport{DiskUsage: r.GetDiskUsage()}
+	for _, s := range r.GetNamespaces() {
+		report.Namespaces = append(report.Namespaces, servertypes.NsMaterialStats{
+			Namespace: s.GetNamespace(),
+			Files:     int(s.GetFiles()),
+			Records:   int(s.GetRecords()),
+			Ranges:    int(s.GetRanges()),
+			Used:      int(s.GetUsed()),
+			Size:      s.GetSize(),
+		})
+	}
+	return report
+}
+
+// FromEvent converts events.Event into protobuf message
+func FromEvent(e events.Event) *Event {
+	return &Event{
+		Id:     e.ID,
+		Type:   e.Type,
+		Time:   e.Time,
+		Node:   e.Node,
+		Fields: e.Fields,
+	}
+}
+
+// ToEvent converts protobuf message into events.Event
+func ToEvent(e *Event) events.Event {
+	return events.Event{
+		ID:     e.GetId(),
+		Type:   e.GetType(),
+		Time:   e.GetTime(),
+		Node:   e.GetNode(),
+		Fields: e.GetFields(),
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xuperdb
+
+import (
+	"io"
+)
+
+// ChunkSize max size of file or slice content carried by a message
+const ChunkSize = 64 * 1024
+
+// chunkReader reads content from a stream of chunks
+type chunkReader struct {
+	recv func() ([]byte, error)
+	buf  []byte
+}
+
+// NewChunkReader returns a reader on chunks received by recv,
+//  recv should return io.EOF when the stream ends
+func NewChunkReader(recv func() ([]byte, error)) io.Reader {
+	return &chunkReader{recv: recv}
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		data, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// SendChunks reads r until EOF and sends content by chunks
+func SendChunks(r io.Reader, send func([]byte) error) error {
+	buf := make([]byte, ChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if serr := send(buf[:n]); serr != nil {
+				return serr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
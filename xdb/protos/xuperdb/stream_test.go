@@ -0,0 +1,47 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xuperdb
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunks(t *testing.T) {
+	content := bytes.Repeat([]byte("xuperdb"), ChunkSize/3)
+
+	var chunks [][]byte
+	err := SendChunks(bytes.NewReader(content), func(data []byte) error {
+		chunks = append(chunks, append([]byte{}, data...))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+
+	r := NewChunkReader(func() ([]byte, error) {
+		if len(chunks) == 0 {
+			return nil, io.EOF
+		}
+		c := chunks[0]
+		chunks = chunks[1:]
+		return c, nil
+	})
+	got, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, content, got)
+}
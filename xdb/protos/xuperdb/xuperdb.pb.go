@@ -0,0 +1,10698 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        (unknown)
+// source: xuperdb/xuperdb.proto
+
+package xuperdb
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{0}
+}
+
+// Chunk is a piece of file or slice content.
+type Chunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Chunk) Reset() {
+	*x = Chunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Chunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chunk) ProtoMessage() {}
+
+func (x *Chunk) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chunk.ProtoReflect.Descriptor instead.
+func (*Chunk) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Chunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WriteOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User        string            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Token       string            `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Namespace   string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FileName    string            `protobuf:"bytes,4,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	ExpireTime  int64             `protobuf:"varint,5,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	Description string            `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Extra       string            `protobuf:"bytes,7,opt,name=extra,proto3" json:"extra,omitempty"`
+	Tags        map[string]string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *WriteOptions) Reset() {
+	*x = WriteOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteOptions) ProtoMessage() {}
+
+func (x *WriteOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteOptions.ProtoReflect.Descriptor instead.
+func (*WriteOptions) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WriteOptions) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *WriteOptions) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *WriteOptions) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WriteOptions) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *WriteOptions) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *WriteOptions) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WriteOptions) GetExtra() string {
+	if x != nil {
+		return x.Extra
+	}
+	return ""
+}
+
+func (x *WriteOptions) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type WriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*WriteRequest_Options
+	//	*WriteRequest_Chunk
+	Data isWriteRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *WriteRequest) Reset() {
+	*x = WriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteRequest) ProtoMessage() {}
+
+func (x *WriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteRequest.ProtoReflect.Descriptor instead.
+func (*WriteRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *WriteRequest) GetData() isWriteRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *WriteRequest) GetOptions() *WriteOptions {
+	if x, ok := x.GetData().(*WriteRequest_Options); ok {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *WriteRequest) GetChunk() []byte {
+	if x, ok := x.GetData().(*WriteRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isWriteRequest_Data interface {
+	isWriteRequest_Data()
+}
+
+type WriteRequest_Options struct {
+	Options *WriteOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type WriteRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*WriteRequest_Options) isWriteRequest_Data() {}
+
+func (*WriteRequest_Chunk) isWriteRequest_Data() {}
+
+type WriteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileID string `protobuf:"bytes,1,opt,name=fileID,proto3" json:"fileID,omitempty"`
+}
+
+func (x *WriteResponse) Reset() {
+	*x = WriteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteResponse) ProtoMessage() {}
+
+func (x *WriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteResponse.ProtoReflect.Descriptor instead.
+func (*WriteResponse) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WriteResponse) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+type AppendOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User      string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	FileID    string `protobuf:"bytes,3,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	Timestamp int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // unit: nanosecond
+}
+
+func (x *AppendOptions) Reset() {
+	*x = AppendOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendOptions) ProtoMessage() {}
+
+func (x *AppendOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendOptions.ProtoReflect.Descriptor instead.
+func (*AppendOptions) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AppendOptions) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AppendOptions) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AppendOptions) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *AppendOptions) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type AppendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*AppendRequest_Options
+	//	*AppendRequest_Chunk
+	Data isAppendRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *AppendRequest) Reset() {
+	*x = AppendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendRequest) ProtoMessage() {}
+
+func (x *AppendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendRequest.ProtoReflect.Descriptor instead.
+func (*AppendRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *AppendRequest) GetData() isAppendRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *AppendRequest) GetOptions() *AppendOptions {
+	if x, ok := x.GetData().(*AppendRequest_Options); ok {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *AppendRequest) GetChunk() []byte {
+	if x, ok := x.GetData().(*AppendRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isAppendRequest_Data interface {
+	isAppendRequest_Data()
+}
+
+type AppendRequest_Options struct {
+	Options *AppendOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type AppendRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*AppendRequest_Options) isAppendRequest_Data() {}
+
+func (*AppendRequest_Chunk) isAppendRequest_Data() {}
+
+type AppendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileID string `protobuf:"bytes,1,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	Length uint64 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"` // plain text length of the whole file
+}
+
+func (x *AppendResponse) Reset() {
+	*x = AppendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendResponse) ProtoMessage() {}
+
+func (x *AppendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendResponse.ProtoReflect.Descriptor instead.
+func (*AppendResponse) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AppendResponse) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *AppendResponse) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// ReadRequest uses fileID or namespace+fileName.
+type ReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User      string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FileName  string `protobuf:"bytes,4,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	FileID    string `protobuf:"bytes,5,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	Timestamp uint64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ReadRequest) Reset() {
+	*x = ReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRequest) ProtoMessage() {}
+
+func (x *ReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
+func (*ReadRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReadRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ReadRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReadRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ReadRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ReadRequest) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *ReadRequest) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type PushOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SliceID    string `protobuf:"bytes,1,opt,name=sliceID,proto3" json:"sliceID,omitempty"`
+	SourceID   string `protobuf:"bytes,2,opt,name=sourceID,proto3" json:"sourceID,omitempty"`
+	CipherHash []byte `protobuf:"bytes,3,opt,name=cipherHash,proto3" json:"cipherHash,omitempty"`
+	Timestamp  int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // unit: nanosecond
+	Signature  string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`  // signature of dataOwner node over slice id, cipher hash in hex and timestamp
+}
+
+func (x *PushOptions) Reset() {
+	*x = PushOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushOptions) ProtoMessage() {}
+
+func (x *PushOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushOptions.ProtoReflect.Descriptor instead.
+func (*PushOptions) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PushOptions) GetSliceID() string {
+	if x != nil {
+		return x.SliceID
+	}
+	return ""
+}
+
+func (x *PushOptions) GetSourceID() string {
+	if x != nil {
+		return x.SourceID
+	}
+	return ""
+}
+
+func (x *PushOptions) GetCipherHash() []byte {
+	if x != nil {
+		return x.CipherHash
+	}
+	return nil
+}
+
+func (x *PushOptions) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PushOptions) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type PushRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*PushRequest_Options
+	//	*PushRequest_Chunk
+	Data isPushRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *PushRequest) Reset() {
+	*x = PushRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushRequest) ProtoMessage() {}
+
+func (x *PushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushRequest.ProtoReflect.Descriptor instead.
+func (*PushRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *PushRequest) GetData() isPushRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *PushRequest) GetOptions() *PushOptions {
+	if x, ok := x.GetData().(*PushRequest_Options); ok {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *PushRequest) GetChunk() []byte {
+	if x, ok := x.GetData().(*PushRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isPushRequest_Data interface {
+	isPushRequest_Data()
+}
+
+type PushRequest_Options struct {
+	Options *PushOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type PushRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*PushRequest_Options) isPushRequest_Data() {}
+
+func (*PushRequest_Chunk) isPushRequest_Data() {}
+
+type PushResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PushResponse) Reset() {
+	*x = PushResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushResponse) ProtoMessage() {}
+
+func (x *PushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushResponse.ProtoReflect.Descriptor instead.
+func (*PushResponse) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{11}
+}
+
+type PullRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SliceID   string `protobuf:"bytes,1,opt,name=sliceID,proto3" json:"sliceID,omitempty"`
+	FileID    string `protobuf:"bytes,2,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	Timestamp uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PullRequest) GetSliceID() string {
+	if x != nil {
+		return x.SliceID
+	}
+	return ""
+}
+
+func (x *PullRequest) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *PullRequest) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PullRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// ListFileRequest is used to list files, owner is a public key in hex.
+type ListFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace   string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TimeStart   int64  `protobuf:"varint,3,opt,name=timeStart,proto3" json:"timeStart,omitempty"`
+	TimeEnd     int64  `protobuf:"varint,4,opt,name=timeEnd,proto3" json:"timeEnd,omitempty"`
+	CurrentTime int64  `protobuf:"varint,5,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Limit       uint64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor      string `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"` // next cursor of the previous page
+}
+
+func (x *ListFileRequest) Reset() {
+	*x = ListFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFileRequest) ProtoMessage() {}
+
+func (x *ListFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFileRequest.ProtoReflect.Descriptor instead.
+func (*ListFileRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListFileRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListFileRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListFileRequest) GetTimeStart() int64 {
+	if x != nil {
+		return x.TimeStart
+	}
+	return 0
+}
+
+func (x *ListFileRequest) GetTimeEnd() int64 {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return 0
+}
+
+func (x *ListFileRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *ListFileRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFileRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type QueryFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner           string            `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace       string            `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Tags            map[string]string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NamePrefix      string            `protobuf:"bytes,4,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
+	MinSize         uint64            `protobuf:"varint,5,opt,name=minSize,proto3" json:"minSize,omitempty"`
+	MaxSize         uint64            `protobuf:"varint,6,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	ExpireTimeStart int64             `protobuf:"varint,7,opt,name=expireTimeStart,proto3" json:"expireTimeStart,omitempty"`
+	ExpireTimeEnd   int64             `protobuf:"varint,8,opt,name=expireTimeEnd,proto3" json:"expireTimeEnd,omitempty"`
+	CurrentTime     int64             `protobuf:"varint,9,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Limit           uint64            `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor          string            `protobuf:"bytes,11,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *QueryFileRequest) Reset() {
+	*x = QueryFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFileRequest) ProtoMessage() {}
+
+func (x *QueryFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryFileRequest.ProtoReflect.Descriptor instead.
+func (*QueryFileRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryFileRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *QueryFileRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *QueryFileRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *QueryFileRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *QueryFileRequest) GetMinSize() uint64 {
+	if x != nil {
+		return x.MinSize
+	}
+	return 0
+}
+
+func (x *QueryFileRequest) GetMaxSize() uint64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *QueryFileRequest) GetExpireTimeStart() int64 {
+	if x != nil {
+		return x.ExpireTimeStart
+	}
+	return 0
+}
+
+func (x *QueryFileRequest) GetExpireTimeEnd() int64 {
+	if x != nil {
+		return x.ExpireTimeEnd
+	}
+	return 0
+}
+
+func (x *QueryFileRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *QueryFileRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryFileRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// GetFileRequest uses id or owner+namespace+name.
+type GetFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner     string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetFileRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *GetFileRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetFileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateFileEtimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	FileID      string `protobuf:"bytes,2,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	ExpireTime  int64  `protobuf:"varint,3,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	CurrentTime int64  `protobuf:"varint,4,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token       string `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *UpdateFileEtimeRequest) Reset() {
+	*x = UpdateFileEtimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFileEtimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFileEtimeRequest) ProtoMessage() {}
+
+func (x *UpdateFileEtimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFileEtimeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFileEtimeRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateFileEtimeRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *UpdateFileEtimeRequest) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *UpdateFileEtimeRequest) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *UpdateFileEtimeRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *UpdateFileEtimeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AddNsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace   string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Replica     int64  `protobuf:"varint,4,opt,name=replica,proto3" json:"replica,omitempty"`
+	CreateTime  int64  `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	Token       string `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *AddNsRequest) Reset() {
+	*x = AddNsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddNsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNsRequest) ProtoMessage() {}
+
+func (x *AddNsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNsRequest.ProtoReflect.Descriptor instead.
+func (*AddNsRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddNsRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *AddNsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AddNsRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddNsRequest) GetReplica() int64 {
+	if x != nil {
+		return x.Replica
+	}
+	return 0
+}
+
+func (x *AddNsRequest) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *AddNsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UpdateNsReplicaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace   string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Replica     int64  `protobuf:"varint,3,opt,name=replica,proto3" json:"replica,omitempty"`
+	CurrentTime int64  `protobuf:"varint,4,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token       string `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *UpdateNsReplicaRequest) Reset() {
+	*x = UpdateNsReplicaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNsReplicaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNsReplicaRequest) ProtoMessage() {}
+
+func (x *UpdateNsReplicaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNsReplicaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNsReplicaRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateNsReplicaRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *UpdateNsReplicaRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateNsReplicaRequest) GetReplica() int64 {
+	if x != nil {
+		return x.Replica
+	}
+	return 0
+}
+
+func (x *UpdateNsReplicaRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *UpdateNsReplicaRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UpdateNsQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner       string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace   string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Quota       *NsQuota `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	CurrentTime int64    `protobuf:"varint,4,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token       string   `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *UpdateNsQuotaRequest) Reset() {
+	*x = UpdateNsQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNsQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNsQuotaRequest) ProtoMessage() {}
+
+func (x *UpdateNsQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNsQuotaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNsQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateNsQuotaRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *UpdateNsQuotaRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateNsQuotaRequest) GetQuota() *NsQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *UpdateNsQuotaRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *UpdateNsQuotaRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UpdateNsRenewalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner       string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace   string     `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Renewal     *NsRenewal `protobuf:"bytes,3,opt,name=renewal,proto3" json:"renewal,omitempty"`
+	CurrentTime int64      `protobuf:"varint,4,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token       string     `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *UpdateNsRenewalRequest) Reset() {
+	*x = UpdateNsRenewalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNsRenewalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNsRenewalRequest) ProtoMessage() {}
+
+func (x *UpdateNsRenewalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNsRenewalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNsRenewalRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateNsRenewalRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *UpdateNsRenewalRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateNsRenewalRequest) GetRenewal() *NsRenewal {
+	if x != nil {
+		return x.Renewal
+	}
+	return nil
+}
+
+func (x *UpdateNsRenewalRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *UpdateNsRenewalRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type NsMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User        string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Namespace   string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Member      string `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+	Role        string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	CurrentTime int64  `protobuf:"varint,5,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token       string `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *NsMemberRequest) Reset() {
+	*x = NsMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NsMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NsMemberRequest) ProtoMessage() {}
+
+func (x *NsMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NsMemberRequest.ProtoReflect.Descriptor instead.
+func (*NsMemberRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NsMemberRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *NsMemberRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *NsMemberRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *NsMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *NsMemberRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *NsMemberRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListNsMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *ListNsMembersRequest) Reset() {
+	*x = ListNsMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNsMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNsMembersRequest) ProtoMessage() {}
+
+func (x *ListNsMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNsMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListNsMembersRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListNsMembersRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ProposeTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User         string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FileID       string `protobuf:"bytes,3,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	NewOwner     string `protobuf:"bytes,4,opt,name=newOwner,proto3" json:"newOwner,omitempty"`
+	NewNamespace string `protobuf:"bytes,5,opt,name=newNamespace,proto3" json:"newNamespace,omitempty"`
+	CurrentTime  int64  `protobuf:"varint,6,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token        string `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ProposeTransferRequest) Reset() {
+	*x = ProposeTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposeTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeTransferRequest) ProtoMessage() {}
+
+func (x *ProposeTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeTransferRequest.ProtoReflect.Descriptor instead.
+func (*ProposeTransferRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ProposeTransferRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ProposeTransferRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ProposeTransferRequest) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *ProposeTransferRequest) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+func (x *ProposeTransferRequest) GetNewNamespace() string {
+	if x != nil {
+		return x.NewNamespace
+	}
+	return ""
+}
+
+func (x *ProposeTransferRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *ProposeTransferRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ProposeTransferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ProposeTransferResponse) Reset() {
+	*x = ProposeTransferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposeTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeTransferResponse) ProtoMessage() {}
+
+func (x *ProposeTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeTransferResponse.ProtoReflect.Descriptor instead.
+func (*ProposeTransferResponse) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ProposeTransferResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AcceptTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User        string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CurrentTime int64  `protobuf:"varint,3,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token       string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *AcceptTransferRequest) Reset() {
+	*x = AcceptTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTransferRequest) ProtoMessage() {}
+
+func (x *AcceptTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTransferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTransferRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AcceptTransferRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AcceptTransferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AcceptTransferRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *AcceptTransferRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CancelTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User        string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CurrentTime int64  `protobuf:"varint,3,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token       string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *CancelTransferRequest) Reset() {
+	*x = CancelTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTransferRequest) ProtoMessage() {}
+
+func (x *CancelTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTransferRequest.ProtoReflect.Descriptor instead.
+func (*CancelTransferRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CancelTransferRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *CancelTransferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelTransferRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *CancelTransferRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type HandoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User        string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	FileID      string `protobuf:"bytes,3,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	CurrentTime int64  `protobuf:"varint,4,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token       string `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *HandoverRequest) Reset() {
+	*x = HandoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoverRequest) ProtoMessage() {}
+
+func (x *HandoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoverRequest.ProtoReflect.Descriptor instead.
+func (*HandoverRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HandoverRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *HandoverRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HandoverRequest) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *HandoverRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *HandoverRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type HandoverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferID  string `protobuf:"bytes,1,opt,name=transferID,proto3" json:"transferID,omitempty"`
+	FileID      string `protobuf:"bytes,2,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	Length      uint64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	ContentHash string `protobuf:"bytes,4,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	Receipt     string `protobuf:"bytes,5,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Ciphertext  []byte `protobuf:"bytes,6,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+}
+
+func (x *HandoverResponse) Reset() {
+	*x = HandoverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoverResponse) ProtoMessage() {}
+
+func (x *HandoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoverResponse.ProtoReflect.Descriptor instead.
+func (*HandoverResponse) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HandoverResponse) GetTransferID() string {
+	if x != nil {
+		return x.TransferID
+	}
+	return ""
+}
+
+func (x *HandoverResponse) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *HandoverResponse) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *HandoverResponse) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *HandoverResponse) GetReceipt() string {
+	if x != nil {
+		return x.Receipt
+	}
+	return ""
+}
+
+func (x *HandoverResponse) GetCiphertext() []byte {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return nil
+}
+
+type CompleteTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User        string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	FileID      string `protobuf:"bytes,3,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	NewFileID   string `protobuf:"bytes,4,opt,name=newFileID,proto3" json:"newFileID,omitempty"`
+	CurrentTime int64  `protobuf:"varint,5,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token       string `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	ContentHash string `protobuf:"bytes,7,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	Receipt     string `protobuf:"bytes,8,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (x *CompleteTransferRequest) Reset() {
+	*x = CompleteTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTransferRequest) ProtoMessage() {}
+
+func (x *CompleteTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTransferRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTransferRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CompleteTransferRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *CompleteTransferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteTransferRequest) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *CompleteTransferRequest) GetNewFileID() string {
+	if x != nil {
+		return x.NewFileID
+	}
+	return ""
+}
+
+func (x *CompleteTransferRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *CompleteTransferRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CompleteTransferRequest) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *CompleteTransferRequest) GetReceipt() string {
+	if x != nil {
+		return x.Receipt
+	}
+	return ""
+}
+
+type GetTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetTransferRequest) Reset() {
+	*x = GetTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferRequest) ProtoMessage() {}
+
+func (x *GetTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetTransferRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTransferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTransfersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User  string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersRequest) ProtoMessage() {}
+
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListTransfersRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ListTransfersRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RebalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace    string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	MaxMoves     int64  `protobuf:"varint,2,opt,name=maxMoves,proto3" json:"maxMoves,omitempty"`
+	MoveInterval int64  `protobuf:"varint,3,opt,name=moveInterval,proto3" json:"moveInterval,omitempty"` // unit: nanosecond
+	DryRun       bool   `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	CurrentTime  int64  `protobuf:"varint,5,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token        string `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *RebalanceRequest) Reset() {
+	*x = RebalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceRequest) ProtoMessage() {}
+
+func (x *RebalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceRequest.ProtoReflect.Descriptor instead.
+func (*RebalanceRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RebalanceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RebalanceRequest) GetMaxMoves() int64 {
+	if x != nil {
+		return x.MaxMoves
+	}
+	return 0
+}
+
+func (x *RebalanceRequest) GetMoveInterval() int64 {
+	if x != nil {
+		return x.MoveInterval
+	}
+	return 0
+}
+
+func (x *RebalanceRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RebalanceRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *RebalanceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UpdateBandwidthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit       int64  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`         // unit: byte per second, no limit if 0
+	NodeLimit   int64  `protobuf:"varint,2,opt,name=nodeLimit,proto3" json:"nodeLimit,omitempty"` // unit: byte per second, no limit if 0
+	CurrentTime int64  `protobuf:"varint,3,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token       string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *UpdateBandwidthRequest) Reset() {
+	*x = UpdateBandwidthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBandwidthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBandwidthRequest) ProtoMessage() {}
+
+func (x *UpdateBandwidthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBandwidthRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBandwidthRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateBandwidthRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *UpdateBandwidthRequest) GetNodeLimit() int64 {
+	if x != nil {
+		return x.NodeLimit
+	}
+	return 0
+}
+
+func (x *UpdateBandwidthRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *UpdateBandwidthRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListNsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	TimeStart int64  `protobuf:"varint,2,opt,name=timeStart,proto3" json:"timeStart,omitempty"`
+	TimeEnd   int64  `protobuf:"varint,3,opt,name=timeEnd,proto3" json:"timeEnd,omitempty"`
+	Limit     uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor    string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListNsRequest) Reset() {
+	*x = ListNsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNsRequest) ProtoMessage() {}
+
+func (x *ListNsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNsRequest.ProtoReflect.Descriptor instead.
+func (*ListNsRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListNsRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListNsRequest) GetTimeStart() int64 {
+	if x != nil {
+		return x.TimeStart
+	}
+	return 0
+}
+
+func (x *ListNsRequest) GetTimeEnd() int64 {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return 0
+}
+
+func (x *ListNsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetNsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetNsRequest) Reset() {
+	*x = GetNsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNsRequest) ProtoMessage() {}
+
+func (x *GetNsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNsRequest.ProtoReflect.Descriptor instead.
+func (*GetNsRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetNsRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *GetNsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetFileSysHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *GetFileSysHealthRequest) Reset() {
+	*x = GetFileSysHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileSysHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileSysHealthRequest) ProtoMessage() {}
+
+func (x *GetFileSysHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileSysHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetFileSysHealthRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetFileSysHealthRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type GetChallengeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetChallengeRequest) Reset() {
+	*x = GetChallengeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeRequest) ProtoMessage() {}
+
+func (x *GetChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeRequest.ProtoReflect.Descriptor instead.
+func (*GetChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetChallengeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListChallengeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner      string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	TargetNode string `protobuf:"bytes,2,opt,name=targetNode,proto3" json:"targetNode,omitempty"`
+	FileID     string `protobuf:"bytes,3,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	Status     string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	TimeStart  int64  `protobuf:"varint,5,opt,name=timeStart,proto3" json:"timeStart,omitempty"`
+	TimeEnd    int64  `protobuf:"varint,6,opt,name=timeEnd,proto3" json:"timeEnd,omitempty"`
+	Limit      uint64 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor     string `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListChallengeRequest) Reset() {
+	*x = ListChallengeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChallengeRequest) ProtoMessage() {}
+
+func (x *ListChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChallengeRequest.ProtoReflect.Descriptor instead.
+func (*ListChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListChallengeRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListChallengeRequest) GetTargetNode() string {
+	if x != nil {
+		return x.TargetNode
+	}
+	return ""
+}
+
+func (x *ListChallengeRequest) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *ListChallengeRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListChallengeRequest) GetTimeStart() int64 {
+	if x != nil {
+		return x.TimeStart
+	}
+	return 0
+}
+
+func (x *ListChallengeRequest) GetTimeEnd() int64 {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return 0
+}
+
+func (x *ListChallengeRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListChallengeRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type AddNodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID  string `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Token   string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *AddNodeRequest) Reset() {
+	*x = AddNodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNodeRequest) ProtoMessage() {}
+
+func (x *AddNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNodeRequest.ProtoReflect.Descriptor instead.
+func (*AddNodeRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AddNodeRequest) GetNodeID() string {
+	if x != nil {
+		return x.NodeID
+	}
+	return ""
+}
+
+func (x *AddNodeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddNodeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddNodeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetNodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetNodeRequest) Reset() {
+	*x = GetNodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeRequest) ProtoMessage() {}
+
+func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetNodeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetHeartbeatNumRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CurrentTime int64  `protobuf:"varint,2,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+}
+
+func (x *GetHeartbeatNumRequest) Reset() {
+	*x = GetHeartbeatNumRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHeartbeatNumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeartbeatNumRequest) ProtoMessage() {}
+
+func (x *GetHeartbeatNumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeartbeatNumRequest.ProtoReflect.Descriptor instead.
+func (*GetHeartbeatNumRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetHeartbeatNumRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetHeartbeatNumRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+type HeartbeatNum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeartBeatTotal int64 `protobuf:"varint,1,opt,name=heartBeatTotal,proto3" json:"heartBeatTotal,omitempty"`
+	HeartBeatMax   int64 `protobuf:"varint,2,opt,name=heartBeatMax,proto3" json:"heartBeatMax,omitempty"`
+}
+
+func (x *HeartbeatNum) Reset() {
+	*x = HeartbeatNum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatNum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatNum) ProtoMessage() {}
+
+func (x *HeartbeatNum) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatNum.ProtoReflect.Descriptor instead.
+func (*HeartbeatNum) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *HeartbeatNum) GetHeartBeatTotal() int64 {
+	if x != nil {
+		return x.HeartBeatTotal
+	}
+	return 0
+}
+
+func (x *HeartbeatNum) GetHeartBeatMax() int64 {
+	if x != nil {
+		return x.HeartBeatMax
+	}
+	return 0
+}
+
+type NodeHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *NodeHealth) Reset() {
+	*x = NodeHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeHealth) ProtoMessage() {}
+
+func (x *NodeHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeHealth.ProtoReflect.Descriptor instead.
+func (*NodeHealth) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *NodeHealth) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type NodeOperateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID string `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Nonce  int64  `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Token  string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *NodeOperateRequest) Reset() {
+	*x = NodeOperateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeOperateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeOperateRequest) ProtoMessage() {}
+
+func (x *NodeOperateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeOperateRequest.ProtoReflect.Descriptor instead.
+func (*NodeOperateRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *NodeOperateRequest) GetNodeID() string {
+	if x != nil {
+		return x.NodeID
+	}
+	return ""
+}
+
+func (x *NodeOperateRequest) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *NodeOperateRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetMigrateRecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartTime int64  `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime   int64  `protobuf:"varint,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Limit     uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetMigrateRecordsRequest) Reset() {
+	*x = GetMigrateRecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMigrateRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMigrateRecordsRequest) ProtoMessage() {}
+
+func (x *GetMigrateRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMigrateRecordsRequest.ProtoReflect.Descriptor instead.
+func (*GetMigrateRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetMigrateRecordsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetMigrateRecordsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetMigrateRecordsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *GetMigrateRecordsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type MigrateRecords struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records string `protobuf:"bytes,1,opt,name=records,proto3" json:"records,omitempty"` // records encoded in json, same as returned by blockchain
+}
+
+func (x *MigrateRecords) Reset() {
+	*x = MigrateRecords{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrateRecords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateRecords) ProtoMessage() {}
+
+func (x *MigrateRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateRecords.ProtoReflect.Descriptor instead.
+func (*MigrateRecords) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MigrateRecords) GetRecords() string {
+	if x != nil {
+		return x.Records
+	}
+	return ""
+}
+
+type WatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastID uint64   `protobuf:"varint,1,opt,name=lastID,proto3" json:"lastID,omitempty"`
+	Types  []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WatchRequest) GetLastID() uint64 {
+	if x != nil {
+		return x.LastID
+	}
+	return 0
+}
+
+func (x *WatchRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type   string            `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Time   int64             `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Node   string            `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	Fields map[string]string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Event) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Event) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *Event) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type PublicSliceMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CipherHash []byte `protobuf:"bytes,2,opt,name=cipherHash,proto3" json:"cipherHash,omitempty"`
+	Length     uint64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	NodeID     []byte `protobuf:"bytes,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	SliceIdx   int64  `protobuf:"varint,5,opt,name=sliceIdx,proto3" json:"sliceIdx,omitempty"`
+	SigmaI     []byte `protobuf:"bytes,6,opt,name=sigmaI,proto3" json:"sigmaI,omitempty"`
+}
+
+func (x *PublicSliceMeta) Reset() {
+	*x = PublicSliceMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicSliceMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicSliceMeta) ProtoMessage() {}
+
+func (x *PublicSliceMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicSliceMeta.ProtoReflect.Descriptor instead.
+func (*PublicSliceMeta) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PublicSliceMeta) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublicSliceMeta) GetCipherHash() []byte {
+	if x != nil {
+		return x.CipherHash
+	}
+	return nil
+}
+
+func (x *PublicSliceMeta) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *PublicSliceMeta) GetNodeID() []byte {
+	if x != nil {
+		return x.NodeID
+	}
+	return nil
+}
+
+func (x *PublicSliceMeta) GetSliceIdx() int64 {
+	if x != nil {
+		return x.SliceIdx
+	}
+	return 0
+}
+
+func (x *PublicSliceMeta) GetSigmaI() []byte {
+	if x != nil {
+		return x.SigmaI
+	}
+	return nil
+}
+
+type File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Namespace   string             `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Owner       []byte             `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	Length      uint64             `protobuf:"varint,6,opt,name=length,proto3" json:"length,omitempty"`
+	MerkleRoot  []byte             `protobuf:"bytes,7,opt,name=merkleRoot,proto3" json:"merkleRoot,omitempty"`
+	Slices      []*PublicSliceMeta `protobuf:"bytes,8,rep,name=slices,proto3" json:"slices,omitempty"`
+	Structure   []byte             `protobuf:"bytes,9,opt,name=structure,proto3" json:"structure,omitempty"`
+	PublishTime int64              `protobuf:"varint,10,opt,name=publishTime,proto3" json:"publishTime,omitempty"`
+	ExpireTime  int64              `protobuf:"varint,11,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	PdpPubkey   []byte             `protobuf:"bytes,12,opt,name=pdpPubkey,proto3" json:"pdpPubkey,omitempty"`
+	RandU       []byte             `protobuf:"bytes,13,opt,name=randU,proto3" json:"randU,omitempty"`
+	RandV       []byte             `protobuf:"bytes,14,opt,name=randV,proto3" json:"randV,omitempty"`
+	Ext         []byte             `protobuf:"bytes,15,opt,name=ext,proto3" json:"ext,omitempty"`
+	Tags        map[string]string  `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *File) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *File) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *File) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *File) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *File) GetOwner() []byte {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *File) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *File) GetMerkleRoot() []byte {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return nil
+}
+
+func (x *File) GetSlices() []*PublicSliceMeta {
+	if x != nil {
+		return x.Slices
+	}
+	return nil
+}
+
+func (x *File) GetStructure() []byte {
+	if x != nil {
+		return x.Structure
+	}
+	return nil
+}
+
+func (x *File) GetPublishTime() int64 {
+	if x != nil {
+		return x.PublishTime
+	}
+	return 0
+}
+
+func (x *File) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *File) GetPdpPubkey() []byte {
+	if x != nil {
+		return x.PdpPubkey
+	}
+	return nil
+}
+
+func (x *File) GetRandU() []byte {
+	if x != nil {
+		return x.RandU
+	}
+	return nil
+}
+
+func (x *File) GetRandV() []byte {
+	if x != nil {
+		return x.RandV
+	}
+	return nil
+}
+
+func (x *File) GetExt() []byte {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *File) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type FileH struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File   *File  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Health string `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (x *FileH) Reset() {
+	*x = FileH{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileH) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileH) ProtoMessage() {}
+
+func (x *FileH) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileH.ProtoReflect.Descriptor instead.
+func (*FileH) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *FileH) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *FileH) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+type Files struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files []*File `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Next  string  `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"` // empty if there are no more files
+}
+
+func (x *Files) Reset() {
+	*x = Files{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Files) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Files) ProtoMessage() {}
+
+func (x *Files) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Files.ProtoReflect.Descriptor instead.
+func (*Files) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Files) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *Files) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+type NsQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxBytes    uint64 `protobuf:"varint,1,opt,name=maxBytes,proto3" json:"maxBytes,omitempty"`
+	MaxFiles    int64  `protobuf:"varint,2,opt,name=maxFiles,proto3" json:"maxFiles,omitempty"`
+	MaxFileSize uint64 `protobuf:"varint,3,opt,name=maxFileSize,proto3" json:"maxFileSize,omitempty"`
+}
+
+func (x *NsQuota) Reset() {
+	*x = NsQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NsQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NsQuota) ProtoMessage() {}
+
+func (x *NsQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NsQuota.ProtoReflect.Descriptor instead.
+func (*NsQuota) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *NsQuota) GetMaxBytes() uint64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *NsQuota) GetMaxFiles() int64 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
+}
+
+func (x *NsQuota) GetMaxFileSize() uint64 {
+	if x != nil {
+		return x.MaxFileSize
+	}
+	return 0
+}
+
+type NsRenewal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Days   int64  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	Until  int64  `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (x *NsRenewal) Reset() {
+	*x = NsRenewal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NsRenewal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NsRenewal) ProtoMessage() {}
+
+func (x *NsRenewal) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NsRenewal.ProtoReflect.Descriptor instead.
+func (*NsRenewal) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *NsRenewal) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *NsRenewal) GetDays() int64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *NsRenewal) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+type Namespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Owner          []byte     `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Replica        int64      `protobuf:"varint,4,opt,name=replica,proto3" json:"replica,omitempty"`
+	FilesStruSize  int64      `protobuf:"varint,5,opt,name=filesStruSize,proto3" json:"filesStruSize,omitempty"`
+	FileTotalNum   int64      `protobuf:"varint,6,opt,name=fileTotalNum,proto3" json:"fileTotalNum,omitempty"`
+	CreateTime     int64      `protobuf:"varint,7,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	UpdateTime     int64      `protobuf:"varint,8,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+	Quota          *NsQuota   `protobuf:"bytes,9,opt,name=quota,proto3" json:"quota,omitempty"`
+	FilesTotalSize uint64     `protobuf:"varint,10,opt,name=filesTotalSize,proto3" json:"filesTotalSize,omitempty"`
+	FileActiveNum  int64      `protobuf:"varint,11,opt,name=fileActiveNum,proto3" json:"fileActiveNum,omitempty"`
+	Renewal        *NsRenewal `protobuf:"bytes,12,opt,name=renewal,proto3" json:"renewal,omitempty"`
+}
+
+func (x *Namespace) Reset() {
+	*x = Namespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Namespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Namespace) ProtoMessage() {}
+
+func (x *Namespace) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Namespace.ProtoReflect.Descriptor instead.
+func (*Namespace) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Namespace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Namespace) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Namespace) GetOwner() []byte {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Namespace) GetReplica() int64 {
+	if x != nil {
+		return x.Replica
+	}
+	return 0
+}
+
+func (x *Namespace) GetFilesStruSize() int64 {
+	if x != nil {
+		return x.FilesStruSize
+	}
+	return 0
+}
+
+func (x *Namespace) GetFileTotalNum() int64 {
+	if x != nil {
+		return x.FileTotalNum
+	}
+	return 0
+}
+
+func (x *Namespace) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *Namespace) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *Namespace) GetQuota() *NsQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *Namespace) GetFilesTotalSize() uint64 {
+	if x != nil {
+		return x.FilesTotalSize
+	}
+	return 0
+}
+
+func (x *Namespace) GetFileActiveNum() int64 {
+	if x != nil {
+		return x.FileActiveNum
+	}
+	return 0
+}
+
+func (x *Namespace) GetRenewal() *NsRenewal {
+	if x != nil {
+		return x.Renewal
+	}
+	return nil
+}
+
+type Namespaces struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespaces []*Namespace `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	Next       string       `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *Namespaces) Reset() {
+	*x = Namespaces{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Namespaces) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Namespaces) ProtoMessage() {}
+
+func (x *Namespaces) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Namespaces.ProtoReflect.Descriptor instead.
+func (*Namespaces) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Namespaces) GetNamespaces() []*Namespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *Namespaces) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+type NamespaceH struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace      *Namespace `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FileNormalNum  int64      `protobuf:"varint,2,opt,name=fileNormalNum,proto3" json:"fileNormalNum,omitempty"`
+	FileExpiredNum int64      `protobuf:"varint,3,opt,name=fileExpiredNum,proto3" json:"fileExpiredNum,omitempty"`
+	GreenFileNum   int64      `protobuf:"varint,4,opt,name=greenFileNum,proto3" json:"greenFileNum,omitempty"`
+	YellowFileNum  int64      `protobuf:"varint,5,opt,name=yellowFileNum,proto3" json:"yellowFileNum,omitempty"`
+	RedFileNum     int64      `protobuf:"varint,6,opt,name=redFileNum,proto3" json:"redFileNum,omitempty"`
+}
+
+func (x *NamespaceH) Reset() {
+	*x = NamespaceH{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceH) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceH) ProtoMessage() {}
+
+func (x *NamespaceH) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceH.ProtoReflect.Descriptor instead.
+func (*NamespaceH) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *NamespaceH) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *NamespaceH) GetFileNormalNum() int64 {
+	if x != nil {
+		return x.FileNormalNum
+	}
+	return 0
+}
+
+func (x *NamespaceH) GetFileExpiredNum() int64 {
+	if x != nil {
+		return x.FileExpiredNum
+	}
+	return 0
+}
+
+func (x *NamespaceH) GetGreenFileNum() int64 {
+	if x != nil {
+		return x.GreenFileNum
+	}
+	return 0
+}
+
+func (x *NamespaceH) GetYellowFileNum() int64 {
+	if x != nil {
+		return x.YellowFileNum
+	}
+	return 0
+}
+
+func (x *NamespaceH) GetRedFileNum() int64 {
+	if x != nil {
+		return x.RedFileNum
+	}
+	return 0
+}
+
+type NsMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner     []byte `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Member    []byte `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+	Role      string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	AddTime   int64  `protobuf:"varint,5,opt,name=addTime,proto3" json:"addTime,omitempty"`
+}
+
+func (x *NsMember) Reset() {
+	*x = NsMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NsMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NsMember) ProtoMessage() {}
+
+func (x *NsMember) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NsMember.ProtoReflect.Descriptor instead.
+func (*NsMember) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *NsMember) GetOwner() []byte {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *NsMember) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *NsMember) GetMember() []byte {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *NsMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *NsMember) GetAddTime() int64 {
+	if x != nil {
+		return x.AddTime
+	}
+	return 0
+}
+
+type NsMembers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*NsMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *NsMembers) Reset() {
+	*x = NsMembers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NsMembers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NsMembers) ProtoMessage() {}
+
+func (x *NsMembers) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NsMembers.ProtoReflect.Descriptor instead.
+func (*NsMembers) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *NsMembers) GetMembers() []*NsMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type Transfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner        []byte            `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace    string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FileID       string            `protobuf:"bytes,4,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	NewOwner     []byte            `protobuf:"bytes,5,opt,name=newOwner,proto3" json:"newOwner,omitempty"`
+	NewNamespace string            `protobuf:"bytes,6,opt,name=newNamespace,proto3" json:"newNamespace,omitempty"`
+	Status       string            `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ProposeTime  int64             `protobuf:"varint,8,opt,name=proposeTime,proto3" json:"proposeTime,omitempty"`
+	AcceptTime   int64             `protobuf:"varint,9,opt,name=acceptTime,proto3" json:"acceptTime,omitempty"`
+	CompleteTime int64             `protobuf:"varint,10,opt,name=completeTime,proto3" json:"completeTime,omitempty"`
+	Files        map[string]string `protobuf:"bytes,11,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // id of old file => id of new file
+	CancelTime   int64             `protobuf:"varint,12,opt,name=cancelTime,proto3" json:"cancelTime,omitempty"`
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Transfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Transfer) GetOwner() []byte {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Transfer) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Transfer) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *Transfer) GetNewOwner() []byte {
+	if x != nil {
+		return x.NewOwner
+	}
+	return nil
+}
+
+func (x *Transfer) GetNewNamespace() string {
+	if x != nil {
+		return x.NewNamespace
+	}
+	return ""
+}
+
+func (x *Transfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Transfer) GetProposeTime() int64 {
+	if x != nil {
+		return x.ProposeTime
+	}
+	return 0
+}
+
+func (x *Transfer) GetAcceptTime() int64 {
+	if x != nil {
+		return x.AcceptTime
+	}
+	return 0
+}
+
+func (x *Transfer) GetCompleteTime() int64 {
+	if x != nil {
+		return x.CompleteTime
+	}
+	return 0
+}
+
+func (x *Transfer) GetFiles() map[string]string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *Transfer) GetCancelTime() int64 {
+	if x != nil {
+		return x.CancelTime
+	}
+	return 0
+}
+
+type Transfers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfers []*Transfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+}
+
+func (x *Transfers) Reset() {
+	*x = Transfers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transfers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfers) ProtoMessage() {}
+
+func (x *Transfers) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfers.ProtoReflect.Descriptor instead.
+func (*Transfers) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Transfers) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type FileSysHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileNum         int64   `protobuf:"varint,1,opt,name=fileNum,proto3" json:"fileNum,omitempty"`
+	FileExpiredNum  int64   `protobuf:"varint,2,opt,name=fileExpiredNum,proto3" json:"fileExpiredNum,omitempty"`
+	NsNum           int64   `protobuf:"varint,3,opt,name=nsNum,proto3" json:"nsNum,omitempty"`
+	GreenFileNum    int64   `protobuf:"varint,4,opt,name=greenFileNum,proto3" json:"greenFileNum,omitempty"`
+	YellowFileNum   int64   `protobuf:"varint,5,opt,name=yellowFileNum,proto3" json:"yellowFileNum,omitempty"`
+	RedFileNum      int64   `protobuf:"varint,6,opt,name=redFileNum,proto3" json:"redFileNum,omitempty"`
+	SysHealth       string  `protobuf:"bytes,7,opt,name=sysHealth,proto3" json:"sysHealth,omitempty"`
+	FilesHealthRate float64 `protobuf:"fixed64,8,opt,name=filesHealthRate,proto3" json:"filesHealthRate,omitempty"`
+	NodeNum         int64   `protobuf:"varint,9,opt,name=nodeNum,proto3" json:"nodeNum,omitempty"`
+	GreenNodeNum    int64   `protobuf:"varint,10,opt,name=greenNodeNum,proto3" json:"greenNodeNum,omitempty"`
+	YellowNodeNum   int64   `protobuf:"varint,11,opt,name=yellowNodeNum,proto3" json:"yellowNodeNum,omitempty"`
+	RedNodeNum      int64   `protobuf:"varint,12,opt,name=redNodeNum,proto3" json:"redNodeNum,omitempty"`
+	NodeHealthRate  float64 `protobuf:"fixed64,13,opt,name=nodeHealthRate,proto3" json:"nodeHealthRate,omitempty"`
+}
+
+func (x *FileSysHealth) Reset() {
+	*x = FileSysHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileSysHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSysHealth) ProtoMessage() {}
+
+func (x *FileSysHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSysHealth.ProtoReflect.Descriptor instead.
+func (*FileSysHealth) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *FileSysHealth) GetFileNum() int64 {
+	if x != nil {
+		return x.FileNum
+	}
+	return 0
+}
+
+func (x *FileSysHealth) GetFileExpiredNum() int64 {
+	if x != nil {
+		return x.FileExpiredNum
+	}
+	return 0
+}
+
+func (x *FileSysHealth) GetNsNum() int64 {
+	if x != nil {
+		return x.NsNum
+	}
+	return 0
+}
+
+func (x *FileSysHealth) GetGreenFileNum() int64 {
+	if x != nil {
+		return x.GreenFileNum
+	}
+	return 0
+}
+
+func (x *FileSysHealth) GetYellowFileNum() int64 {
+	if x != nil {
+		return x.YellowFileNum
+	}
+	return 0
+}
+
+func (x *FileSysHealth) GetRedFileNum() int64 {
+	if x != nil {
+		return x.RedFileNum
+	}
+	return 0
+}
+
+func (x *FileSysHealth) GetSysHealth() string {
+	if x != nil {
+		return x.SysHealth
+	}
+	return ""
+}
+
+func (x *FileSysHealth) GetFilesHealthRate() float64 {
+	if x != nil {
+		return x.FilesHealthRate
+	}
+	return 0
+}
+
+func (x *FileSysHealth) GetNodeNum() int64 {
+	if x != nil {
+		return x.NodeNum
+	}
+	return 0
+}
+
+func (x *FileSysHealth) GetGreenNodeNum() int64 {
+	if x != nil {
+		return x.GreenNodeNum
+	}
+	return 0
+}
+
+func (x *FileSysHealth) GetYellowNodeNum() int64 {
+	if x != nil {
+		return x.YellowNodeNum
+	}
+	return 0
+}
+
+func (x *FileSysHealth) GetRedNodeNum() int64 {
+	if x != nil {
+		return x.RedNodeNum
+	}
+	return 0
+}
+
+func (x *FileSysHealth) GetNodeHealthRate() float64 {
+	if x != nil {
+		return x.NodeHealthRate
+	}
+	return 0
+}
+
+type Range struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *Range) Reset() {
+	*x = Range{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Range) ProtoMessage() {}
+
+func (x *Range) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Range.ProtoReflect.Descriptor instead.
+func (*Range) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Range) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Range) GetEnd() uint64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type Challenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileOwner         []byte   `protobuf:"bytes,2,opt,name=fileOwner,proto3" json:"fileOwner,omitempty"`
+	TargetNode        []byte   `protobuf:"bytes,3,opt,name=targetNode,proto3" json:"targetNode,omitempty"`
+	FileID            string   `protobuf:"bytes,4,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	SliceIDs          []string `protobuf:"bytes,5,rep,name=sliceIDs,proto3" json:"sliceIDs,omitempty"`
+	Indices           [][]byte `protobuf:"bytes,6,rep,name=indices,proto3" json:"indices,omitempty"`
+	Vs                [][]byte `protobuf:"bytes,7,rep,name=vs,proto3" json:"vs,omitempty"`
+	Sigmas            [][]byte `protobuf:"bytes,8,rep,name=sigmas,proto3" json:"sigmas,omitempty"`
+	SliceID           string   `protobuf:"bytes,9,opt,name=sliceID,proto3" json:"sliceID,omitempty"`
+	Ranges            []*Range `protobuf:"bytes,10,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	HashOfProof       []byte   `protobuf:"bytes,11,opt,name=hashOfProof,proto3" json:"hashOfProof,omitempty"`
+	ChallengAlgorithm string   `protobuf:"bytes,12,opt,name=challengAlgorithm,proto3" json:"challengAlgorithm,omitempty"`
+	Status            string   `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	ChallengeTime     int64    `protobuf:"varint,14,opt,name=challengeTime,proto3" json:"challengeTime,omitempty"`
+	AnswerTime        int64    `protobuf:"varint,15,opt,name=answerTime,proto3" json:"answerTime,omitempty"`
+}
+
+func (x *Challenge) Reset() {
+	*x = Challenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Challenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Challenge) ProtoMessage() {}
+
+func (x *Challenge) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Challenge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Challenge) GetFileOwner() []byte {
+	if x != nil {
+		return x.FileOwner
+	}
+	return nil
+}
+
+func (x *Challenge) GetTargetNode() []byte {
+	if x != nil {
+		return x.TargetNode
+	}
+	return nil
+}
+
+func (x *Challenge) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *Challenge) GetSliceIDs() []string {
+	if x != nil {
+		return x.SliceIDs
+	}
+	return nil
+}
+
+func (x *Challenge) GetIndices() [][]byte {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *Challenge) GetVs() [][]byte {
+	if x != nil {
+		return x.Vs
+	}
+	return nil
+}
+
+func (x *Challenge) GetSigmas() [][]byte {
+	if x != nil {
+		return x.Sigmas
+	}
+	return nil
+}
+
+func (x *Challenge) GetSliceID() string {
+	if x != nil {
+		return x.SliceID
+	}
+	return ""
+}
+
+func (x *Challenge) GetRanges() []*Range {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+func (x *Challenge) GetHashOfProof() []byte {
+	if x != nil {
+		return x.HashOfProof
+	}
+	return nil
+}
+
+func (x *Challenge) GetChallengAlgorithm() string {
+	if x != nil {
+		return x.ChallengAlgorithm
+	}
+	return ""
+}
+
+func (x *Challenge) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Challenge) GetChallengeTime() int64 {
+	if x != nil {
+		return x.ChallengeTime
+	}
+	return 0
+}
+
+func (x *Challenge) GetAnswerTime() int64 {
+	if x != nil {
+		return x.AnswerTime
+	}
+	return 0
+}
+
+type Challenges struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Challenges []*Challenge `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges,omitempty"`
+	Next       string       `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *Challenges) Reset() {
+	*x = Challenges{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Challenges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Challenges) ProtoMessage() {}
+
+func (x *Challenges) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Challenges.ProtoReflect.Descriptor instead.
+func (*Challenges) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Challenges) GetChallenges() []*Challenge {
+	if x != nil {
+		return x.Challenges
+	}
+	return nil
+}
+
+func (x *Challenges) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+type Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Online    bool   `protobuf:"varint,4,opt,name=online,proto3" json:"online,omitempty"`
+	RegTime   int64  `protobuf:"varint,5,opt,name=regTime,proto3" json:"regTime,omitempty"`
+	UpdateAt  int64  `protobuf:"varint,6,opt,name=updateAt,proto3" json:"updateAt,omitempty"`
+	Draining  bool   `protobuf:"varint,7,opt,name=draining,proto3" json:"draining,omitempty"`
+	DrainTime int64  `protobuf:"varint,8,opt,name=drainTime,proto3" json:"drainTime,omitempty"`
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Node) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Node) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Node) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *Node) GetRegTime() int64 {
+	if x != nil {
+		return x.RegTime
+	}
+	return 0
+}
+
+func (x *Node) GetUpdateAt() int64 {
+	if x != nil {
+		return x.UpdateAt
+	}
+	return 0
+}
+
+func (x *Node) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *Node) GetDrainTime() int64 {
+	if x != nil {
+		return x.DrainTime
+	}
+	return 0
+}
+
+type Nodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *Nodes) Reset() {
+	*x = Nodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nodes) ProtoMessage() {}
+
+func (x *Nodes) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nodes.ProtoReflect.Descriptor instead.
+func (*Nodes) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Nodes) GetNodes() []*Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type NodeDrainStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID         string `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Draining       bool   `protobuf:"varint,2,opt,name=draining,proto3" json:"draining,omitempty"`
+	DrainTime      int64  `protobuf:"varint,3,opt,name=drainTime,proto3" json:"drainTime,omitempty"`
+	LiveSlices     int64  `protobuf:"varint,4,opt,name=liveSlices,proto3" json:"liveSlices,omitempty"`
+	MigratedSlices int64  `protobuf:"varint,5,opt,name=migratedSlices,proto3" json:"migratedSlices,omitempty"`
+	Removable      bool   `protobuf:"varint,6,opt,name=removable,proto3" json:"removable,omitempty"`
+}
+
+func (x *NodeDrainStatus) Reset() {
+	*x = NodeDrainStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeDrainStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDrainStatus) ProtoMessage() {}
+
+func (x *NodeDrainStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDrainStatus.ProtoReflect.Descriptor instead.
+func (*NodeDrainStatus) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *NodeDrainStatus) GetNodeID() string {
+	if x != nil {
+		return x.NodeID
+	}
+	return ""
+}
+
+func (x *NodeDrainStatus) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *NodeDrainStatus) GetDrainTime() int64 {
+	if x != nil {
+		return x.DrainTime
+	}
+	return 0
+}
+
+func (x *NodeDrainStatus) GetLiveSlices() int64 {
+	if x != nil {
+		return x.LiveSlices
+	}
+	return 0
+}
+
+func (x *NodeDrainStatus) GetMigratedSlices() int64 {
+	if x != nil {
+		return x.MigratedSlices
+	}
+	return 0
+}
+
+func (x *NodeDrainStatus) GetRemovable() bool {
+	if x != nil {
+		return x.Removable
+	}
+	return false
+}
+
+type HealthPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version              int64   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Window               int64   `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"` // unit: day
+	ChallengeWeight      float64 `protobuf:"fixed64,3,opt,name=challengeWeight,proto3" json:"challengeWeight,omitempty"`
+	HeartbeatWeight      float64 `protobuf:"fixed64,4,opt,name=heartbeatWeight,proto3" json:"heartbeatWeight,omitempty"`
+	LatencyWeight        float64 `protobuf:"fixed64,5,opt,name=latencyWeight,proto3" json:"latencyWeight,omitempty"`
+	GoodBound            float64 `protobuf:"fixed64,6,opt,name=goodBound,proto3" json:"goodBound,omitempty"`
+	MediumBound          float64 `protobuf:"fixed64,7,opt,name=mediumBound,proto3" json:"mediumBound,omitempty"`
+	DefaultChallengeRate float64 `protobuf:"fixed64,8,opt,name=defaultChallengeRate,proto3" json:"defaultChallengeRate,omitempty"`
+	DefaultHeartbeatRate float64 `protobuf:"fixed64,9,opt,name=defaultHeartbeatRate,proto3" json:"defaultHeartbeatRate,omitempty"`
+	MaxLatency           int64   `protobuf:"varint,10,opt,name=maxLatency,proto3" json:"maxLatency,omitempty"` // unit: nanosecond
+	UpdateTime           int64   `protobuf:"varint,11,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+}
+
+func (x *HealthPolicy) Reset() {
+	*x = HealthPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthPolicy) ProtoMessage() {}
+
+func (x *HealthPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthPolicy.ProtoReflect.Descriptor instead.
+func (*HealthPolicy) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *HealthPolicy) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *HealthPolicy) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *HealthPolicy) GetChallengeWeight() float64 {
+	if x != nil {
+		return x.ChallengeWeight
+	}
+	return 0
+}
+
+func (x *HealthPolicy) GetHeartbeatWeight() float64 {
+	if x != nil {
+		return x.HeartbeatWeight
+	}
+	return 0
+}
+
+func (x *HealthPolicy) GetLatencyWeight() float64 {
+	if x != nil {
+		return x.LatencyWeight
+	}
+	return 0
+}
+
+func (x *HealthPolicy) GetGoodBound() float64 {
+	if x != nil {
+		return x.GoodBound
+	}
+	return 0
+}
+
+func (x *HealthPolicy) GetMediumBound() float64 {
+	if x != nil {
+		return x.MediumBound
+	}
+	return 0
+}
+
+func (x *HealthPolicy) GetDefaultChallengeRate() float64 {
+	if x != nil {
+		return x.DefaultChallengeRate
+	}
+	return 0
+}
+
+func (x *HealthPolicy) GetDefaultHeartbeatRate() float64 {
+	if x != nil {
+		return x.DefaultHeartbeatRate
+	}
+	return 0
+}
+
+func (x *HealthPolicy) GetMaxLatency() int64 {
+	if x != nil {
+		return x.MaxLatency
+	}
+	return 0
+}
+
+func (x *HealthPolicy) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type GetHealthPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *GetHealthPolicyRequest) Reset() {
+	*x = GetHealthPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHealthPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthPolicyRequest) ProtoMessage() {}
+
+func (x *GetHealthPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetHealthPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetHealthPolicyRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateHealthPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy      *HealthPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	CurrentTime int64         `protobuf:"varint,2,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	Token       string        `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *UpdateHealthPolicyRequest) Reset() {
+	*x = UpdateHealthPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHealthPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHealthPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateHealthPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHealthPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHealthPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateHealthPolicyRequest) GetPolicy() *HealthPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *UpdateHealthPolicyRequest) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *UpdateHealthPolicyRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type NodeHealthScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID         string  `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Name           string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CurrentScore   float64 `protobuf:"fixed64,3,opt,name=currentScore,proto3" json:"currentScore,omitempty"`
+	CurrentHealth  string  `protobuf:"bytes,4,opt,name=currentHealth,proto3" json:"currentHealth,omitempty"`
+	ProposedScore  float64 `protobuf:"fixed64,5,opt,name=proposedScore,proto3" json:"proposedScore,omitempty"`
+	ProposedHealth string  `protobuf:"bytes,6,opt,name=proposedHealth,proto3" json:"proposedHealth,omitempty"`
+}
+
+func (x *NodeHealthScore) Reset() {
+	*x = NodeHealthScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeHealthScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeHealthScore) ProtoMessage() {}
+
+func (x *NodeHealthScore) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeHealthScore.ProtoReflect.Descriptor instead.
+func (*NodeHealthScore) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *NodeHealthScore) GetNodeID() string {
+	if x != nil {
+		return x.NodeID
+	}
+	return ""
+}
+
+func (x *NodeHealthScore) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodeHealthScore) GetCurrentScore() float64 {
+	if x != nil {
+		return x.CurrentScore
+	}
+	return 0
+}
+
+func (x *NodeHealthScore) GetCurrentHealth() string {
+	if x != nil {
+		return x.CurrentHealth
+	}
+	return ""
+}
+
+func (x *NodeHealthScore) GetProposedScore() float64 {
+	if x != nil {
+		return x.ProposedScore
+	}
+	return 0
+}
+
+func (x *NodeHealthScore) GetProposedHealth() string {
+	if x != nil {
+		return x.ProposedHealth
+	}
+	return ""
+}
+
+type HealthSimulation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentVersion int64              `protobuf:"varint,1,opt,name=currentVersion,proto3" json:"currentVersion,omitempty"`
+	Nodes          []*NodeHealthScore `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *HealthSimulation) Reset() {
+	*x = HealthSimulation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthSimulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthSimulation) ProtoMessage() {}
+
+func (x *HealthSimulation) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthSimulation.ProtoReflect.Descriptor instead.
+func (*HealthSimulation) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *HealthSimulation) GetCurrentVersion() int64 {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return 0
+}
+
+func (x *HealthSimulation) GetNodes() []*NodeHealthScore {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type ListHeartbeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *ListHeartbeatsRequest) Reset() {
+	*x = ListHeartbeatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHeartbeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHeartbeatsRequest) ProtoMessage() {}
+
+func (x *ListHeartbeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHeartbeatsRequest.ProtoReflect.Descriptor instead.
+func (*ListHeartbeatsRequest) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListHeartbeatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListHeartbeatsRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ListHeartbeatsRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type SignedHeartbeat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SignedHeartbeat) Reset() {
+	*x = SignedHeartbeat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedHeartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedHeartbeat) ProtoMessage() {}
+
+func (x *SignedHeartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedHeartbeat.ProtoReflect.Descriptor instead.
+func (*SignedHeartbeat) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SignedHeartbeat) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *SignedHeartbeat) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type SignedHeartbeats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Heartbeats []*SignedHeartbeat `protobuf:"bytes,1,rep,name=heartbeats,proto3" json:"heartbeats,omitempty"`
+}
+
+func (x *SignedHeartbeats) Reset() {
+	*x = SignedHeartbeats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedHeartbeats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedHeartbeats) ProtoMessage() {}
+
+func (x *SignedHeartbeats) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedHeartbeats.ProtoReflect.Descriptor instead.
+func (*SignedHeartbeats) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SignedHeartbeats) GetHeartbeats() []*SignedHeartbeat {
+	if x != nil {
+		return x.Heartbeats
+	}
+	return nil
+}
+
+type HeartbeatCommit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID     []byte `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Start      int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End        int64  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Count      int64  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Root       []byte `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+	CommitTime int64  `protobuf:"varint,6,opt,name=commitTime,proto3" json:"commitTime,omitempty"`
+}
+
+func (x *HeartbeatCommit) Reset() {
+	*x = HeartbeatCommit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatCommit) ProtoMessage() {}
+
+func (x *HeartbeatCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatCommit.ProtoReflect.Descriptor instead.
+func (*HeartbeatCommit) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *HeartbeatCommit) GetNodeID() []byte {
+	if x != nil {
+		return x.NodeID
+	}
+	return nil
+}
+
+func (x *HeartbeatCommit) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *HeartbeatCommit) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *HeartbeatCommit) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *HeartbeatCommit) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *HeartbeatCommit) GetCommitTime() int64 {
+	if x != nil {
+		return x.CommitTime
+	}
+	return 0
+}
+
+type HeartbeatCommits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commits []*HeartbeatCommit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+}
+
+func (x *HeartbeatCommits) Reset() {
+	*x = HeartbeatCommits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatCommits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatCommits) ProtoMessage() {}
+
+func (x *HeartbeatCommits) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatCommits.ProtoReflect.Descriptor instead.
+func (*HeartbeatCommits) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *HeartbeatCommits) GetCommits() []*HeartbeatCommit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+type SliceMove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileID    string `protobuf:"bytes,1,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	SliceID   string `protobuf:"bytes,3,opt,name=sliceID,proto3" json:"sliceID,omitempty"`
+	Length    uint64 `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	From      string `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *SliceMove) Reset() {
+	*x = SliceMove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SliceMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SliceMove) ProtoMessage() {}
+
+func (x *SliceMove) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SliceMove.ProtoReflect.Descriptor instead.
+func (*SliceMove) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SliceMove) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *SliceMove) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SliceMove) GetSliceID() string {
+	if x != nil {
+		return x.SliceID
+	}
+	return ""
+}
+
+func (x *SliceMove) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *SliceMove) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SliceMove) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type NodeUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID string `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Before uint64 `protobuf:"varint,3,opt,name=before,proto3" json:"before,omitempty"`
+	After  uint64 `protobuf:"varint,4,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *NodeUsage) Reset() {
+	*x = NodeUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeUsage) ProtoMessage() {}
+
+func (x *NodeUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeUsage.ProtoReflect.Descriptor instead.
+func (*NodeUsage) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *NodeUsage) GetNodeID() string {
+	if x != nil {
+		return x.NodeID
+	}
+	return ""
+}
+
+func (x *NodeUsage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodeUsage) GetBefore() uint64 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+func (x *NodeUsage) GetAfter() uint64 {
+	if x != nil {
+		return x.After
+	}
+	return 0
+}
+
+type RebalancePlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun bool         `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Moves  []*SliceMove `protobuf:"bytes,2,rep,name=moves,proto3" json:"moves,omitempty"`
+	Nodes  []*NodeUsage `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *RebalancePlan) Reset() {
+	*x = RebalancePlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalancePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalancePlan) ProtoMessage() {}
+
+func (x *RebalancePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalancePlan.ProtoReflect.Descriptor instead.
+func (*RebalancePlan) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RebalancePlan) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RebalancePlan) GetMoves() []*SliceMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *RebalancePlan) GetNodes() []*NodeUsage {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type Bandwidth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit     int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	NodeLimit int64 `protobuf:"varint,2,opt,name=nodeLimit,proto3" json:"nodeLimit,omitempty"`
+}
+
+func (x *Bandwidth) Reset() {
+	*x = Bandwidth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bandwidth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bandwidth) ProtoMessage() {}
+
+func (x *Bandwidth) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bandwidth.ProtoReflect.Descriptor instead.
+func (*Bandwidth) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Bandwidth) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Bandwidth) GetNodeLimit() int64 {
+	if x != nil {
+		return x.NodeLimit
+	}
+	return 0
+}
+
+type NsMaterialStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Files     int64  `protobuf:"varint,2,opt,name=files,proto3" json:"files,omitempty"`
+	Records   int64  `protobuf:"varint,3,opt,name=records,proto3" json:"records,omitempty"`
+	Ranges    int64  `protobuf:"varint,4,opt,name=ranges,proto3" json:"ranges,omitempty"`
+	Used      int64  `protobuf:"varint,5,opt,name=used,proto3" json:"used,omitempty"`
+	Size      int64  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *NsMaterialStats) Reset() {
+	*x = NsMaterialStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NsMaterialStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NsMaterialStats) ProtoMessage() {}
+
+func (x *NsMaterialStats) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NsMaterialStats.ProtoReflect.Descriptor instead.
+func (*NsMaterialStats) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *NsMaterialStats) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *NsMaterialStats) GetFiles() int64 {
+	if x != nil {
+		return x.Files
+	}
+	return 0
+}
+
+func (x *NsMaterialStats) GetRecords() int64 {
+	if x != nil {
+		return x.Records
+	}
+	return 0
+}
+
+func (x *NsMaterialStats) GetRanges() int64 {
+	if x != nil {
+		return x.Ranges
+	}
+	return 0
+}
+
+func (x *NsMaterialStats) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *NsMaterialStats) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type MaterialReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespaces []*NsMaterialStats `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	DiskUsage  int64              `protobuf:"varint,2,opt,name=diskUsage,proto3" json:"diskUsage,omitempty"`
+}
+
+func (x *MaterialReport) Reset() {
+	*x = MaterialReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xuperdb_xuperdb_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaterialReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterialReport) ProtoMessage() {}
+
+func (x *MaterialReport) ProtoReflect() protoreflect.Message {
+	mi := &file_xuperdb_xuperdb_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterialReport.ProtoReflect.Descriptor instead.
+func (*MaterialReport) Descriptor() ([]byte, []int) {
+	return file_xuperdb_xuperdb_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *MaterialReport) GetNamespaces() []*NsMaterialStats {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *MaterialReport) GetDiskUsage() int64 {
+	if x != nil {
+		return x.DiskUsage
+	}
+	return 0
+}
+
+var File_xuperdb_xuperdb_proto protoreflect.FileDescriptor
+
+var file_xuperdb_xuperdb_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2f, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64,
+	0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1b, 0x0a, 0x05, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb8, 0x02, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x61, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x31, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x27, 0x0a, 0x0d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x22, 0x6f, 0x0a,
+	0x0d, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x63,
+	0x0a, 0x0d, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xa7, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x9f, 0x01, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65,
+	0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x5f, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x7b, 0x0a, 0x0b, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0xcd, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0xac, 0x03, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61,
+	0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x68,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb4, 0x01, 0x0a, 0x0c, 0x41, 0x64,
+	0x64, 0x4e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x9e, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x73, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x26,
+	0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x73, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52,
+	0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb2,
+	0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x73, 0x52, 0x65, 0x6e, 0x65, 0x77,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2c, 0x0a,
+	0x07, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x73, 0x52, 0x65, 0x6e, 0x65, 0x77,
+	0x61, 0x6c, 0x52, 0x07, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x0f, 0x4e, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x34, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x29, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x15, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x73, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbe, 0x01,
+	0x0a, 0x10, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe7,
+	0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c,
+	0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x46, 0x69,
+	0x6c, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0xc0, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x4e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45,
+	0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x6c, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x20, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x4a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x4e,
+	0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x0c, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x68,
+	0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x68, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74,
+	0x4d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x72, 0x74,
+	0x42, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x78, 0x22, 0x24, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x58, 0x0a,
+	0x12, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x2a, 0x0a, 0x0e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x3c, 0x0a,
+	0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c,
+	0x61, 0x73, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x05,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x32, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x6c, 0x69, 0x63, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x49, 0x64, 0x78,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x49, 0x64, 0x78,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6d, 0x61, 0x49, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6d, 0x61, 0x49, 0x22, 0x8c, 0x04, 0x0a, 0x04, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x06, 0x73,
+	0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x64, 0x70, 0x50, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x64, 0x70, 0x50, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x64, 0x55, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x64, 0x55, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e,
+	0x64, 0x56, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x64, 0x56, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x65, 0x78,
+	0x74, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x54,
+	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x37,
+	0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x42, 0x0a, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x48,
+	0x12, 0x21, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x40, 0x0a, 0x05, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x63, 0x0a,
+	0x07, 0x4e, 0x73, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x4d, 0x0a, 0x09, 0x4e, 0x73, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x22, 0x9f, 0x03, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x53, 0x74,
+	0x72, 0x75, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x53, 0x74, 0x72, 0x75, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x66,
+	0x69, 0x6c, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x73, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x75, 0x6d,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x4e, 0x73, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x52, 0x07, 0x72, 0x65, 0x6e, 0x65,
+	0x77, 0x61, 0x6c, 0x22, 0x54, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x12, 0x32, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0xf6, 0x01, 0x0a, 0x0a, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x48, 0x12, 0x30, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x78, 0x75,
+	0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x4e, 0x75, 0x6d,
+	0x12, 0x26, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x4e,
+	0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x72, 0x65, 0x65,
+	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x67, 0x72, 0x65, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x24, 0x0a, 0x0d,
+	0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x4e,
+	0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x4e,
+	0x75, 0x6d, 0x22, 0x84, 0x01, 0x0a, 0x08, 0x4e, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x38, 0x0a, 0x09, 0x4e, 0x73, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64,
+	0x62, 0x2e, 0x4e, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x22, 0xb2, 0x03, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x75, 0x70, 0x65,
+	0x72, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x38,
+	0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3c, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72,
+	0x64, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0xc5, 0x03, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x65, 0x53,
+	0x79, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65,
+	0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x75, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x4e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x65,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x73,
+	0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x73, 0x4e, 0x75, 0x6d,
+	0x12, 0x22, 0x0a, 0x0c, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x46, 0x69, 0x6c,
+	0x65, 0x4e, 0x75, 0x6d, 0x12, 0x24, 0x0a, 0x0d, 0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69,
+	0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x79, 0x65, 0x6c,
+	0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x79,
+	0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x79, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x22, 0x0a, 0x0c,
+	0x67, 0x72, 0x65, 0x65, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d,
+	0x12, 0x24, 0x0a, 0x0d, 0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x75,
+	0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x4e,
+	0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x4e, 0x75, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x4e,
+	0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x6e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x61, 0x74, 0x65, 0x22, 0x2f,
+	0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22,
+	0xbf, 0x03, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x49, 0x44, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x49, 0x44, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x76, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x02, 0x76, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6d, 0x61, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6d, 0x61,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x06, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x78, 0x75,
+	0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x4f, 0x66, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x4f, 0x66,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x54, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x32, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x67, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x72, 0x61, 0x69,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x72, 0x61,
+	0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x72, 0x61,
+	0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69,
+	0x76, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6c, 0x69, 0x76, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65,
+	0x22, 0xa2, 0x03, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a,
+	0x0f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x67, 0x6f, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x67, 0x6f, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d,
+	0x65, 0x64, 0x69, 0x75, 0x6d, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a,
+	0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x32, 0x0a, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x4c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64,
+	0x62, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd5,
+	0x01, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x6a, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x22, 0x4f, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x22, 0x4d, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x78, 0x75, 0x70,
+	0x65, 0x72, 0x64, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73,
+	0x22, 0x9b, 0x01, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x46,
+	0x0a, 0x10, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x53, 0x6c, 0x69, 0x63, 0x65,
+	0x4d, 0x6f, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6c,
+	0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6c, 0x69,
+	0x63, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f,
+	0x22, 0x65, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x7b, 0x0a, 0x0d, 0x52, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x12, 0x28, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x4d,
+	0x6f, 0x76, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x78, 0x75, 0x70, 0x65,
+	0x72, 0x64, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x22, 0x3f, 0x0a, 0x09, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x4e, 0x73, 0x4d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x68, 0x0a, 0x0e, 0x4d, 0x61, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x73, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x32, 0x85, 0x19, 0x0a, 0x07, 0x58, 0x75, 0x70, 0x65, 0x72, 0x44, 0x42, 0x12, 0x38, 0x0a,
+	0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x3b, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x12, 0x16, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x78, 0x75, 0x70, 0x65,
+	0x72, 0x64, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x28, 0x01, 0x12, 0x2e, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x14, 0x2e, 0x78,
+	0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x18, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75,
+	0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x78, 0x75, 0x70, 0x65,
+	0x72, 0x64, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72,
+	0x64, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49,
+	0x44, 0x12, 0x17, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70,
+	0x65, 0x72, 0x64, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x12, 0x38, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x78, 0x75,
+	0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x48, 0x12, 0x47, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x78,
+	0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x45, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a,
+	0x09, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x73, 0x12, 0x15, 0x2e, 0x78, 0x75, 0x70,
+	0x65, 0x72, 0x64, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x4e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x42, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x12, 0x1f, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
+	0x73, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x73, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
+	0x73, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72,
+	0x64, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x73, 0x52, 0x65, 0x6e, 0x65, 0x77,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65,
+	0x72, 0x64, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0b, 0x41, 0x64, 0x64,
+	0x4e, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72,
+	0x64, 0x62, 0x2e, 0x4e, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x73, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e,
+	0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x1d, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x73,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x54, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x78, 0x75, 0x70,
+	0x65, 0x72, 0x64, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70,
+	0x65, 0x72, 0x64, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x78,
+	0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78,
+	0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x08,
+	0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72,
+	0x64, 0x62, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x48, 0x61, 0x6e,
+	0x64, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x10, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x12, 0x20, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x12, 0x1b, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x12, 0x42, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x52, 0x65,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x32, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e,
+	0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x46, 0x0a, 0x0f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1f, 0x2e,
+	0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x12, 0x3b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x73, 0x12, 0x16, 0x2e,
+	0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x4e, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x78, 0x75, 0x70, 0x65,
+	0x72, 0x64, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x48, 0x12, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x79, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x78, 0x75, 0x70, 0x65,
+	0x72, 0x64, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x78, 0x75,
+	0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x44, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1c, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x78, 0x75,
+	0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x78, 0x75, 0x70,
+	0x65, 0x72, 0x64, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x35, 0x0a, 0x04, 0x50, 0x75, 0x73, 0x68, 0x12, 0x14, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64,
+	0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x2e, 0x0a, 0x04, 0x50, 0x75, 0x6c, 0x6c, 0x12, 0x14,
+	0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x17, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70,
+	0x65, 0x72, 0x64, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64,
+	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64,
+	0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x17, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x78, 0x75,
+	0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x49, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x1f, 0x2e,
+	0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x3d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x17, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x12, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72,
+	0x64, 0x62, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x48, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65,
+	0x72, 0x64, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x12, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
+	0x15, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x19, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x4b, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x12, 0x51,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62,
+	0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x1b, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a,
+	0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x2e, 0x78, 0x75,
+	0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72,
+	0x64, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65,
+	0x44, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x1b, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x72, 0x61,
+	0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72,
+	0x64, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x54, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x21, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72,
+	0x64, 0x62, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x36, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x15, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64,
+	0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x50, 0x61,
+	0x64, 0x64, 0x6c, 0x65, 0x2f, 0x50, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x44, 0x54, 0x58, 0x2f, 0x78,
+	0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x78, 0x75, 0x70, 0x65, 0x72, 0x64,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_xuperdb_xuperdb_proto_rawDescOnce sync.Once
+	file_xuperdb_xuperdb_proto_rawDescData = file_xuperdb_xuperdb_proto_rawDesc
+)
+
+func file_xuperdb_xuperdb_proto_rawDescGZIP() []byte {
+	file_xuperdb_xuperdb_proto_rawDescOnce.Do(func() {
+		file_xuperdb_xuperdb_proto_rawDescData = protoimpl.X.CompressGZIP(file_xuperdb_xuperdb_proto_rawDescData)
+	})
+	return file_xuperdb_xuperdb_proto_rawDescData
+}
+
+var file_xuperdb_xuperdb_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
+var file_xuperdb_xuperdb_proto_goTypes = []interface{}{
+	(*Empty)(nil),                     // 0: xuperdb.Empty
+	(*Chunk)(nil),                     // 1: xuperdb.Chunk
+	(*WriteOptions)(nil),              // 2: xuperdb.WriteOptions
+	(*WriteRequest)(nil),              // 3: xuperdb.WriteRequest
+	(*WriteResponse)(nil),             // 4: xuperdb.WriteResponse
+	(*AppendOptions)(nil),             // 5: xuperdb.AppendOptions
+	(*AppendRequest)(nil),             // 6: xuperdb.AppendRequest
+	(*AppendResponse)(nil),            // 7: xuperdb.AppendResponse
+	(*ReadRequest)(nil),               // 8: xuperdb.ReadRequest
+	(*PushOptions)(nil),               // 9: xuperdb.PushOptions
+	(*PushRequest)(nil),               // 10: xuperdb.PushRequest
+	(*PushResponse)(nil),              // 11: xuperdb.PushResponse
+	(*PullRequest)(nil),               // 12: xuperdb.PullRequest
+	(*ListFileRequest)(nil),           // 13: xuperdb.ListFileRequest
+	(*QueryFileRequest)(nil),          // 14: xuperdb.QueryFileRequest
+	(*GetFileRequest)(nil),            // 15: xuperdb.GetFileRequest
+	(*UpdateFileEtimeRequest)(nil),    // 16: xuperdb.UpdateFileEtimeRequest
+	(*AddNsRequest)(nil),              // 17: xuperdb.AddNsRequest
+	(*UpdateNsReplicaRequest)(nil),    // 18: xuperdb.UpdateNsReplicaRequest
+	(*UpdateNsQuotaRequest)(nil),      // 19: xuperdb.UpdateNsQuotaRequest
+	(*UpdateNsRenewalRequest)(nil),    // 20: xuperdb.UpdateNsRenewalRequest
+	(*NsMemberRequest)(nil),           // 21: xuperdb.NsMemberRequest
+	(*ListNsMembersRequest)(nil),      // 22: xuperdb.ListNsMembersRequest
+	(*ProposeTransferRequest)(nil),    // 23: xuperdb.ProposeTransferRequest
+	(*ProposeTransferResponse)(nil),   // 24: xuperdb.ProposeTransferResponse
+	(*AcceptTransferRequest)(nil),     // 25: xuperdb.AcceptTransferRequest
+	(*CancelTransferRequest)(nil),     // 26: xuperdb.CancelTransferRequest
+	(*HandoverRequest)(nil),           // 27: xuperdb.HandoverRequest
+	(*HandoverResponse)(nil),          // 28: xuperdb.HandoverResponse
+	(*CompleteTransferRequest)(nil),   // 29: xuperdb.CompleteTransferRequest
+	(*GetTransferRequest)(nil),        // 30: xuperdb.GetTransferRequest
+	(*ListTransfersRequest)(nil),      // 31: xuperdb.ListTransfersRequest
+	(*RebalanceRequest)(nil),          // 32: xuperdb.RebalanceRequest
+	(*UpdateBandwidthRequest)(nil),    // 33: xuperdb.UpdateBandwidthRequest
+	(*ListNsRequest)(nil),             // 34: xuperdb.ListNsRequest
+	(*GetNsRequest)(nil),              // 35: xuperdb.GetNsRequest
+	(*GetFileSysHealthRequest)(nil),   // 36: xuperdb.GetFileSysHealthRequest
+	(*GetChallengeRequest)(nil),       // 37: xuperdb.GetChallengeRequest
+	(*ListChallengeRequest)(nil),      // 38: xuperdb.ListChallengeRequest
+	(*AddNodeRequest)(nil),            // 39: xuperdb.AddNodeRequest
+	(*GetNodeRequest)(nil),            // 40: xuperdb.GetNodeRequest
+	(*GetHeartbeatNumRequest)(nil),    // 41: xuperdb.GetHeartbeatNumRequest
+	(*HeartbeatNum)(nil),              // 42: xuperdb.HeartbeatNum
+	(*NodeHealth)(nil),                // 43: xuperdb.NodeHealth
+	(*NodeOperateRequest)(nil),        // 44: xuperdb.NodeOperateRequest
+	(*GetMigrateRecordsRequest)(nil),  // 45: xuperdb.GetMigrateRecordsRequest
+	(*MigrateRecords)(nil),            // 46: xuperdb.MigrateRecords
+	(*WatchRequest)(nil),              // 47: xuperdb.WatchRequest
+	(*Event)(nil),                     // 48: xuperdb.Event
+	(*PublicSliceMeta)(nil),           // 49: xuperdb.PublicSliceMeta
+	(*File)(nil),                      // 50: xuperdb.File
+	(*FileH)(nil),                     // 51: xuperdb.FileH
+	(*Files)(nil),                     // 52: xuperdb.Files
+	(*NsQuota)(nil),                   // 53: xuperdb.NsQuota
+	(*NsRenewal)(nil),                 // 54: xuperdb.NsRenewal
+	(*Namespace)(nil),                 // 55: xuperdb.Namespace
+	(*Namespaces)(nil),                // 56: xuperdb.Namespaces
+	(*NamespaceH)(nil),                // 57: xuperdb.NamespaceH
+	(*NsMember)(nil),                  // 58: xuperdb.NsMember
+	(*NsMembers)(nil),                 // 59: xuperdb.NsMembers
+	(*Transfer)(nil),                  // 60: xuperdb.Transfer
+	(*Transfers)(nil),                 // 61: xuperdb.Transfers
+	(*FileSysHealth)(nil),             // 62: xuperdb.FileSysHealth
+	(*Range)(nil),                     // 63: xuperdb.Range
+	(*Challenge)(nil),                 // 64: xuperdb.Challenge
+	(*Challenges)(nil),                // 65: xuperdb.Challenges
+	(*Node)(nil),                      // 66: xuperdb.Node
+	(*Nodes)(nil),                     // 67: xuperdb.Nodes
+	(*NodeDrainStatus)(nil),           // 68: xuperdb.NodeDrainStatus
+	(*HealthPolicy)(nil),              // 69: xuperdb.HealthPolicy
+	(*GetHealthPolicyRequest)(nil),    // 70: xuperdb.GetHealthPolicyRequest
+	(*UpdateHealthPolicyRequest)(nil), // 71: xuperdb.UpdateHealthPolicyRequest
+	(*NodeHealthScore)(nil),           // 72: xuperdb.NodeHealthScore
+	(*HealthSimulation)(nil),          // 73: xuperdb.HealthSimulation
+	(*ListHeartbeatsRequest)(nil),     // 74: xuperdb.ListHeartbeatsRequest
+	(*SignedHeartbeat)(nil),           // 75: xuperdb.SignedHeartbeat
+	(*SignedHeartbeats)(nil),          // 76: xuperdb.SignedHeartbeats
+	(*HeartbeatCommit)(nil),           // 77: xuperdb.HeartbeatCommit
+	(*HeartbeatCommits)(nil),          // 78: xuperdb.HeartbeatCommits
+	(*SliceMove)(nil),                 // 79: xuperdb.SliceMove
+	(*NodeUsage)(nil),                 // 80: xuperdb.NodeUsage
+	(*RebalancePlan)(nil),             // 81: xuperdb.RebalancePlan
+	(*Bandwidth)(nil),                 // 82: xuperdb.Bandwidth
+	(*NsMaterialStats)(nil),           // 83: xuperdb.NsMaterialStats
+	(*MaterialReport)(nil),            // 84: xuperdb.MaterialReport
+	nil,                               // 85: xuperdb.WriteOptions.TagsEntry
+	nil,                               // 86: xuperdb.QueryFileRequest.TagsEntry
+	nil,                               // 87: xuperdb.Event.FieldsEntry
+	nil,                               // 88: xuperdb.File.TagsEntry
+	nil,                               // 89: xuperdb.Transfer.FilesEntry
+}
+var file_xuperdb_xuperdb_proto_depIdxs = []int32{
+	85, // 0: xuperdb.WriteOptions.tags:type_name -> xuperdb.WriteOptions.TagsEntry
+	2,  // 1: xuperdb.WriteRequest.options:type_name -> xuperdb.WriteOptions
+	5,  // 2: xuperdb.AppendRequest.options:type_name -> xuperdb.AppendOptions
+	9,  // 3: xuperdb.PushRequest.options:type_name -> xuperdb.PushOptions
+	86, // 4: xuperdb.QueryFileRequest.tags:type_name -> xuperdb.QueryFileRequest.TagsEntry
+	53, // 5: xuperdb.UpdateNsQuotaRequest.quota:type_name -> xuperdb.NsQuota
+	54, // 6: xuperdb.UpdateNsRenewalRequest.renewal:type_name -> xuperdb.NsRenewal
+	87, // 7: xuperdb.Event.fields:type_name -> xuperdb.Event.FieldsEntry
+	49, // 8: xuperdb.File.slices:type_name -> xuperdb.PublicSliceMeta
+	88, // 9: xuperdb.File.tags:type_name -> xuperdb.File.TagsEntry
+	50, // 10: xuperdb.FileH.file:type_name -> xuperdb.File
+	50, // 11: xuperdb.Files.files:type_name -> xuperdb.File
+	53, // 12: xuperdb.Namespace.quota:type_name -> xuperdb.NsQuota
+	54, // 13: xuperdb.Namespace.renewal:type_name -> xuperdb.NsRenewal
+	55, // 14: xuperdb.Namespaces.namespaces:type_name -> xuperdb.Namespace
+	55, // 15: xuperdb.NamespaceH.namespace:type_name -> xuperdb.Namespace
+	58, // 16: xuperdb.NsMembers.members:type_name -> xuperdb.NsMember
+	89, // 17: xuperdb.Transfer.files:type_name -> xuperdb.Transfer.FilesEntry
+	60, // 18: xuperdb.Transfers.transfers:type_name -> xuperdb.Transfer
+	63, // 19: xuperdb.Challenge.ranges:type_name -> xuperdb.Range
+	64, // 20: xuperdb.Challenges.challenges:type_name -> xuperdb.Challenge
+	66, // 21: xuperdb.Nodes.nodes:type_name -> xuperdb.Node
+	69, // 22: xuperdb.UpdateHealthPolicyRequest.policy:type_name -> xuperdb.HealthPolicy
+	72, // 23: xuperdb.HealthSimulation.nodes:type_name -> xuperdb.NodeHealthScore
+	75, // 24: xuperdb.SignedHeartbeats.heartbeats:type_name -> xuperdb.SignedHeartbeat
+	77, // 25: xuperdb.HeartbeatCommits.commits:type_name -> xuperdb.HeartbeatCommit
+	79, // 26: xuperdb.RebalancePlan.moves:type_name -> xuperdb.SliceMove
+	80, // 27: xuperdb.RebalancePlan.nodes:type_name -> xuperdb.NodeUsage
+	83, // 28: xuperdb.MaterialReport.namespaces:type_name -> xuperdb.NsMaterialStats
+	3,  // 29: xuperdb.XuperDB.Write:input_type -> xuperdb.WriteRequest
+	6,  // 30: xuperdb.XuperDB.Append:input_type -> xuperdb.AppendRequest
+	8,  // 31: xuperdb.XuperDB.Read:input_type -> xuperdb.ReadRequest
+	13, // 32: xuperdb.XuperDB.ListFiles:input_type -> xuperdb.ListFileRequest
+	14, // 33: xuperdb.XuperDB.QueryFiles:input_type -> xuperdb.QueryFileRequest
+	13, // 34: xuperdb.XuperDB.ListExpiredFiles:input_type -> xuperdb.ListFileRequest
+	15, // 35: xuperdb.XuperDB.GetFileByID:input_type -> xuperdb.GetFileRequest
+	15, // 36: xuperdb.XuperDB.GetFileByName:input_type -> xuperdb.GetFileRequest
+	16, // 37: xuperdb.XuperDB.UpdateFileExpireTime:input_type -> xuperdb.UpdateFileEtimeRequest
+	17, // 38: xuperdb.XuperDB.AddFileNs:input_type -> xuperdb.AddNsRequest
+	18, // 39: xuperdb.XuperDB.UpdateNsReplica:input_type -> xuperdb.UpdateNsReplicaRequest
+	19, // 40: xuperdb.XuperDB.UpdateNsQuota:input_type -> xuperdb.UpdateNsQuotaRequest
+	20, // 41: xuperdb.XuperDB.UpdateNsRenewal:input_type -> xuperdb.UpdateNsRenewalRequest
+	21, // 42: xuperdb.XuperDB.AddNsMember:input_type -> xuperdb.NsMemberRequest
+	21, // 43: xuperdb.XuperDB.RemoveNsMember:input_type -> xuperdb.NsMemberRequest
+	22, // 44: xuperdb.XuperDB.ListNsMembers:input_type -> xuperdb.ListNsMembersRequest
+	23, // 45: xuperdb.XuperDB.ProposeTransfer:input_type -> xuperdb.ProposeTransferRequest
+	25, // 46: xuperdb.XuperDB.AcceptTransfer:input_type -> xuperdb.AcceptTransferRequest
+	26, // 47: xuperdb.XuperDB.CancelTransfer:input_type -> xuperdb.CancelTransferRequest
+	27, // 48: xuperdb.XuperDB.Handover:input_type -> xuperdb.HandoverRequest
+	29, // 49: xuperdb.XuperDB.CompleteTransfer:input_type -> xuperdb.CompleteTransferRequest
+	30, // 50: xuperdb.XuperDB.GetTransfer:input_type -> xuperdb.GetTransferRequest
+	31, // 51: xuperdb.XuperDB.ListTransfers:input_type -> xuperdb.ListTransfersRequest
+	32, // 52: xuperdb.XuperDB.Rebalance:input_type -> xuperdb.RebalanceRequest
+	0,  // 53: xuperdb.XuperDB.GetBandwidth:input_type -> xuperdb.Empty
+	33, // 54: xuperdb.XuperDB.UpdateBandwidth:input_type -> xuperdb.UpdateBandwidthRequest
+	0,  // 55: xuperdb.XuperDB.GetMaterialStats:input_type -> xuperdb.Empty
+	34, // 56: xuperdb.XuperDB.ListFileNs:input_type -> xuperdb.ListNsRequest
+	35, // 57: xuperdb.XuperDB.GetNsByName:input_type -> xuperdb.GetNsRequest
+	36, // 58: xuperdb.XuperDB.GetFileSysHealth:input_type -> xuperdb.GetFileSysHealthRequest
+	37, // 59: xuperdb.XuperDB.GetChallengeByID:input_type -> xuperdb.GetChallengeRequest
+	38, // 60: xuperdb.XuperDB.ListChallenges:input_type -> xuperdb.ListChallengeRequest
+	10, // 61: xuperdb.XuperDB.Push:input_type -> xuperdb.PushRequest
+	12, // 62: xuperdb.XuperDB.Pull:input_type -> xuperdb.PullRequest
+	39, // 63: xuperdb.XuperDB.AddNode:input_type -> xuperdb.AddNodeRequest
+	0,  // 64: xuperdb.XuperDB.ListNodes:input_type -> xuperdb.Empty
+	40, // 65: xuperdb.XuperDB.GetNode:input_type -> xuperdb.GetNodeRequest
+	41, // 66: xuperdb.XuperDB.GetHeartbeatNum:input_type -> xuperdb.GetHeartbeatNumRequest
+	40, // 67: xuperdb.XuperDB.GetNodeHealth:input_type -> xuperdb.GetNodeRequest
+	70, // 68: xuperdb.XuperDB.GetHealthPolicy:input_type -> xuperdb.GetHealthPolicyRequest
+	71, // 69: xuperdb.XuperDB.UpdateHealthPolicy:input_type -> xuperdb.UpdateHealthPolicyRequest
+	69, // 70: xuperdb.XuperDB.SimulateNodeHealth:input_type -> xuperdb.HealthPolicy
+	74, // 71: xuperdb.XuperDB.ListHeartbeats:input_type -> xuperdb.ListHeartbeatsRequest
+	74, // 72: xuperdb.XuperDB.ListHeartbeatCommits:input_type -> xuperdb.ListHeartbeatsRequest
+	44, // 73: xuperdb.XuperDB.NodeOffline:input_type -> xuperdb.NodeOperateRequest
+	44, // 74: xuperdb.XuperDB.NodeOnline:input_type -> xuperdb.NodeOperateRequest
+	44, // 75: xuperdb.XuperDB.NodeDrain:input_type -> xuperdb.NodeOperateRequest
+	40, // 76: xuperdb.XuperDB.GetNodeDrainStatus:input_type -> xuperdb.GetNodeRequest
+	45, // 77: xuperdb.XuperDB.GetSliceMigrateRecords:input_type -> xuperdb.GetMigrateRecordsRequest
+	47, // 78: xuperdb.XuperDB.WatchEvents:input_type -> xuperdb.WatchRequest
+	4,  // 79: xuperdb.XuperDB.Write:output_type -> xuperdb.WriteResponse
+	7,  // 80: xuperdb.XuperDB.Append:output_type -> xuperdb.AppendResponse
+	1,  // 81: xuperdb.XuperDB.Read:output_type -> xuperdb.Chunk
+	52, // 82: xuperdb.XuperDB.ListFiles:output_type -> xuperdb.Files
+	52, // 83: xuperdb.XuperDB.QueryFiles:output_type -> xuperdb.Files
+	52, // 84: xuperdb.XuperDB.ListExpiredFiles:output_type -> xuperdb.Files
+	51, // 85: xuperdb.XuperDB.GetFileByID:output_type -> xuperdb.FileH
+	51, // 86: xuperdb.XuperDB.GetFileByName:output_type -> xuperdb.FileH
+	0,  // 87: xuperdb.XuperDB.UpdateFileExpireTime:output_type -> xuperdb.Empty
+	0,  // 88: xuperdb.XuperDB.AddFileNs:output_type -> xuperdb.Empty
+	0,  // 89: xuperdb.XuperDB.UpdateNsReplica:output_type -> xuperdb.Empty
+	0,  // 90: xuperdb.XuperDB.UpdateNsQuota:output_type -> xuperdb.Empty
+	0,  // 91: xuperdb.XuperDB.UpdateNsRenewal:output_type -> xuperdb.Empty
+	0,  // 92: xuperdb.XuperDB.AddNsMember:output_type -> xuperdb.Empty
+	0,  // 93: xuperdb.XuperDB.RemoveNsMember:output_type -> xuperdb.Empty
+	59, // 94: xuperdb.XuperDB.ListNsMembers:output_type -> xuperdb.NsMembers
+	24, // 95: xuperdb.XuperDB.ProposeTransfer:output_type -> xuperdb.ProposeTransferResponse
+	0,  // 96: xuperdb.XuperDB.AcceptTransfer:output_type -> xuperdb.Empty
+	0,  // 97: xuperdb.XuperDB.CancelTransfer:output_type -> xuperdb.Empty
+	28, // 98: xuperdb.XuperDB.Handover:output_type -> xuperdb.HandoverResponse
+	0,  // 99: xuperdb.XuperDB.CompleteTransfer:output_type -> xuperdb.Empty
+	60, // 100: xuperdb.XuperDB.GetTransfer:output_type -> xuperdb.Transfer
+	61, // 101: xuperdb.XuperDB.ListTransfers:output_type -> xuperdb.Transfers
+	81, // 102: xuperdb.XuperDB.Rebalance:output_type -> xuperdb.RebalancePlan
+	82, // 103: xuperdb.XuperDB.GetBandwidth:output_type -> xuperdb.Bandwidth
+	82, // 104: xuperdb.XuperDB.UpdateBandwidth:output_type -> xuperdb.Bandwidth
+	84, // 105: xuperdb.XuperDB.GetMaterialStats:output_type -> xuperdb.MaterialReport
+	56, // 106: xuperdb.XuperDB.ListFileNs:output_type -> xuperdb.Namespaces
+	57, // 107: xuperdb.XuperDB.GetNsByName:output_type -> xuperdb.NamespaceH
+	62, // 108: xuperdb.XuperDB.GetFileSysHealth:output_type -> xuperdb.FileSysHealth
+	64, // 109: xuperdb.XuperDB.GetChallengeByID:output_type -> xuperdb.Challenge
+	65, // 110: xuperdb.XuperDB.ListChallenges:output_type -> xuperdb.Challenges
+	11, // 111: xuperdb.XuperDB.Push:output_type -> xuperdb.PushResponse
+	1,  // 112: xuperdb.XuperDB.Pull:output_type -> xuperdb.Chunk
+	0,  // 113: xuperdb.XuperDB.AddNode:output_type -> xuperdb.Empty
+	67, // 114: xuperdb.XuperDB.ListNodes:output_type -> xuperdb.Nodes
+	66, // 115: xuperdb.XuperDB.GetNode:output_type -> xuperdb.Node
+	42, // 116: xuperdb.XuperDB.GetHeartbeatNum:output_type -> xuperdb.HeartbeatNum
+	43, // 117: xuperdb.XuperDB.GetNodeHealth:output_type -> xuperdb.NodeHealth
+	69, // 118: xuperdb.XuperDB.GetHealthPolicy:output_type -> xuperdb.HealthPolicy
+	0,  // 119: xuperdb.XuperDB.UpdateHealthPolicy:output_type -> xuperdb.Empty
+	73, // 120: xuperdb.XuperDB.SimulateNodeHealth:output_type -> xuperdb.HealthSimulation
+	76, // 121: xuperdb.XuperDB.ListHeartbeats:output_type -> xuperdb.SignedHeartbeats
+	78, // 122: xuperdb.XuperDB.ListHeartbeatCommits:output_type -> xuperdb.HeartbeatCommits
+	0,  // 123: xuperdb.XuperDB.NodeOffline:output_type -> xuperdb.Empty
+	0,  // 124: xuperdb.XuperDB.NodeOnline:output_type -> xuperdb.Empty
+	0,  // 125: xuperdb.XuperDB.NodeDrain:output_type -> xuperdb.Empty
+	68, // 126: xuperdb.XuperDB.GetNodeDrainStatus:output_type -> xuperdb.NodeDrainStatus
+	46, // 127: xuperdb.XuperDB.GetSliceMigrateRecords:output_type -> xuperdb.MigrateRecords
+	48, // 128: xuperdb.XuperDB.WatchEvents:output_type -> xuperdb.Event
+	79, // [79:129] is the sub-list for method output_type
+	29, // [29:79] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_xuperdb_xuperdb_proto_init() }
+func file_xuperdb_xuperdb_proto_init() {
+	if File_xuperdb_xuperdb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_xuperdb_xuperdb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppendOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFileEtimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddNsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNsReplicaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNsQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNsRenewalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NsMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNsMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeTransferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandoverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandoverResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransfersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBandwidthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileSysHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChallengeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChallengeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddNodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHeartbeatNumRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatNum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeOperateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMigrateRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrateRecords); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublicSliceMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileH); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Files); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NsQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NsRenewal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Namespace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Namespaces); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceH); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NsMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NsMembers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transfers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileSysHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Range); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Challenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Challenges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nodes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeDrainStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHealthPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeHealthScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthSimulation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHeartbeatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedHeartbeat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedHeartbeats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatCommits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SliceMove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebalancePlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bandwidth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NsMaterialStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xuperdb_xuperdb_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaterialReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_xuperdb_xuperdb_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*WriteRequest_Options)(nil),
+		(*WriteRequest_Chunk)(nil),
+	}
+	file_xuperdb_xuperdb_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*AppendRequest_Options)(nil),
+		(*AppendRequest_Chunk)(nil),
+	}
+	file_xuperdb_xuperdb_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*PushRequest_Options)(nil),
+		(*PushRequest_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_xuperdb_xuperdb_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   90,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_xuperdb_xuperdb_proto_goTypes,
+		DependencyIndexes: file_xuperdb_xuperdb_proto_depIdxs,
+		MessageInfos:      file_xuperdb_xuperdb_proto_msgTypes,
+	}.Build()
+	File_xuperdb_xuperdb_proto = out.File
+	file_xuperdb_xuperdb_proto_rawDesc = nil
+	file_xuperdb_xuperdb_proto_goTypes = nil
+	file_xuperdb_xuperdb_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// XuperDBClient is the client API for XuperDB service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type XuperDBClient interface {
+	// Write is provided by dataOwner node to upload a file,
+	// the first message carries options and the following ones carry file content.
+	Write(ctx context.Context, opts ...grpc.CallOption) (XuperDB_WriteClient, error)
+	// Append is provided by dataOwner node to append data to an existing file,
+	// the first message carries options and the following ones carry data appended.
+	Append(ctx context.Context, opts ...grpc.CallOption) (XuperDB_AppendClient, error)
+	// Read is provided by dataOwner node to download a file.
+	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (XuperDB_ReadClient, error)
+	// ListFiles is provided by dataOwner node to list unexpired files.
+	ListFiles(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*Files, error)
+	// QueryFiles is provided by dataOwner node to query files by tags, name prefix, size range and expire time range.
+	QueryFiles(ctx context.Context, in *QueryFileRequest, opts ...grpc.CallOption) (*Files, error)
+	// ListExpiredFiles is provided by dataOwner node to list expired but valid files.
+	ListExpiredFiles(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*Files, error)
+	// GetFileByID is provided by dataOwner node to get a file by id.
+	GetFileByID(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*FileH, error)
+	// GetFileByName is provided by dataOwner node to get a file by owner, namespace and name.
+	GetFileByName(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*FileH, error)
+	// UpdateFileExpireTime is provided by dataOwner node to extend expire time of a file.
+	UpdateFileExpireTime(ctx context.Context, in *UpdateFileEtimeRequest, opts ...grpc.CallOption) (*Empty, error)
+	// AddFileNs is provided by dataOwner node to add a file namespace.
+	AddFileNs(ctx context.Context, in *AddNsRequest, opts ...grpc.CallOption) (*Empty, error)
+	// UpdateNsReplica is provided by dataOwner node to update replica of a namespace.
+	UpdateNsReplica(ctx context.Context, in *UpdateNsReplicaRequest, opts ...grpc.CallOption) (*Empty, error)
+	// UpdateNsQuota is provided by dataOwner node to update quota of a namespace.
+	UpdateNsQuota(ctx context.Context, in *UpdateNsQuotaRequest, opts ...grpc.CallOption) (*Empty, error)
+	// UpdateNsRenewal is provided by dataOwner node to update renewal policy of a namespace.
+	UpdateNsRenewal(ctx context.Context, in *UpdateNsRenewalRequest, opts ...grpc.CallOption) (*Empty, error)
+	// AddNsMember is provided by dataOwner node to add a member into namespace.
+	AddNsMember(ctx context.Context, in *NsMemberRequest, opts ...grpc.CallOption) (*Empty, error)
+	// RemoveNsMember is provided by dataOwner node to remove a member from namespace.
+	RemoveNsMember(ctx context.Context, in *NsMemberRequest, opts ...grpc.CallOption) (*Empty, error)
+	// ListNsMembers is provided by dataOwner node to list members of a namespace.
+	ListNsMembers(ctx context.Context, in *ListNsMembersRequest, opts ...grpc.CallOption) (*NsMembers, error)
+	// ProposeTransfer is provided by dataOwner node to propose an ownership transfer of a file or a namespace.
+	ProposeTransfer(ctx context.Context, in *ProposeTransferRequest, opts ...grpc.CallOption) (*ProposeTransferResponse, error)
+	// AcceptTransfer is provided by dataOwner node to accept a transfer proposed to it.
+	AcceptTransfer(ctx context.Context, in *AcceptTransferRequest, opts ...grpc.CallOption) (*Empty, error)
+	// CancelTransfer is provided by dataOwner node to cancel a transfer not completed yet.
+	CancelTransfer(ctx context.Context, in *CancelTransferRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Handover is provided by dataOwner node to hand over a file of an accepted transfer to the new owner.
+	Handover(ctx context.Context, in *HandoverRequest, opts ...grpc.CallOption) (*HandoverResponse, error)
+	// CompleteTransfer is provided by dataOwner node to record a re-uploaded file or finish a namespace transfer.
+	CompleteTransfer(ctx context.Context, in *CompleteTransferRequest, opts ...grpc.CallOption) (*Empty, error)
+	// GetTransfer is provided by dataOwner node to get a transfer by id.
+	GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*Transfer, error)
+	// ListTransfers is provided by dataOwner node to list transfers proposed by or to a dataOwner.
+	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*Transfers, error)
+	// Rebalance is provided by dataOwner node to plan and run slice moves across storage nodes.
+	Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalancePlan, error)
+	// GetBandwidth is provided by dataOwner node to get bandwidth limits in effect.
+	GetBandwidth(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Bandwidth, error)
+	// UpdateBandwidth is provided by dataOwner node to change bandwidth limits at runtime.
+	UpdateBandwidth(ctx context.Context, in *UpdateBandwidthRequest, opts ...grpc.CallOption) (*Bandwidth, error)
+	// GetMaterialStats is provided by dataOwner node to report challenge materials of each namespace.
+	GetMaterialStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MaterialReport, error)
+	// ListFileNs is provided by dataOwner node to list namespaces of an owner.
+	ListFileNs(ctx context.Context, in *ListNsRequest, opts ...grpc.CallOption) (*Namespaces, error)
+	// GetNsByName is provided by dataOwner node to get a namespace by owner and name.
+	GetNsByName(ctx context.Context, in *GetNsRequest, opts ...grpc.CallOption) (*NamespaceH, error)
+	// GetFileSysHealth is provided by dataOwner node to get system health status of an owner.
+	GetFileSysHealth(ctx context.Context, in *GetFileSysHealthRequest, opts ...grpc.CallOption) (*FileSysHealth, error)
+	// GetChallengeByID is provided by dataOwner node to get a challenge by id.
+	GetChallengeByID(ctx context.Context, in *GetChallengeRequest, opts ...grpc.CallOption) (*Challenge, error)
+	// ListChallenges is provided by dataOwner node to list challenges with a status.
+	ListChallenges(ctx context.Context, in *ListChallengeRequest, opts ...grpc.CallOption) (*Challenges, error)
+	// Push is provided by storage node to receive a slice,
+	// the first message carries options and the following ones carry slice content.
+	Push(ctx context.Context, opts ...grpc.CallOption) (XuperDB_PushClient, error)
+	// Pull is provided by storage node to offer a slice to its owner.
+	Pull(ctx context.Context, in *PullRequest, opts ...grpc.CallOption) (XuperDB_PullClient, error)
+	// AddNode is provided by storage node to register itself on blockchain.
+	AddNode(ctx context.Context, in *AddNodeRequest, opts ...grpc.CallOption) (*Empty, error)
+	// ListNodes lists all storage nodes.
+	ListNodes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Nodes, error)
+	// GetNode gets a storage node by id.
+	GetNode(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*Node, error)
+	// GetHeartbeatNum gets heartbeat number of a storage node in the day of ctime.
+	GetHeartbeatNum(ctx context.Context, in *GetHeartbeatNumRequest, opts ...grpc.CallOption) (*HeartbeatNum, error)
+	// GetNodeHealth gets health status of a storage node.
+	GetNodeHealth(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*NodeHealth, error)
+	// GetHealthPolicy gets node health policy of a version, the current one if version is 0.
+	GetHealthPolicy(ctx context.Context, in *GetHealthPolicyRequest, opts ...grpc.CallOption) (*HealthPolicy, error)
+	// UpdateHealthPolicy sets a new version of node health policy signed by the network admin.
+	UpdateHealthPolicy(ctx context.Context, in *UpdateHealthPolicyRequest, opts ...grpc.CallOption) (*Empty, error)
+	// SimulateNodeHealth scores existing storage nodes by the current and a proposed health policy.
+	SimulateNodeHealth(ctx context.Context, in *HealthPolicy, opts ...grpc.CallOption) (*HealthSimulation, error)
+	// ListHeartbeats is provided by storage node to list heartbeats kept locally in aggregate mode.
+	ListHeartbeats(ctx context.Context, in *ListHeartbeatsRequest, opts ...grpc.CallOption) (*SignedHeartbeats, error)
+	// ListHeartbeatCommits lists aggregated heartbeat commits of a storage node on blockchain.
+	ListHeartbeatCommits(ctx context.Context, in *ListHeartbeatsRequest, opts ...grpc.CallOption) (*HeartbeatCommits, error)
+	// NodeOffline is provided by storage node to set itself offline.
+	NodeOffline(ctx context.Context, in *NodeOperateRequest, opts ...grpc.CallOption) (*Empty, error)
+	// NodeOnline is provided by storage node to set itself online.
+	NodeOnline(ctx context.Context, in *NodeOperateRequest, opts ...grpc.CallOption) (*Empty, error)
+	// NodeDrain is provided by storage node to set itself draining.
+	NodeDrain(ctx context.Context, in *NodeOperateRequest, opts ...grpc.CallOption) (*Empty, error)
+	// GetNodeDrainStatus gets draining progress of a storage node.
+	GetNodeDrainStatus(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*NodeDrainStatus, error)
+	// GetSliceMigrateRecords gets slice migration records of a storage node.
+	GetSliceMigrateRecords(ctx context.Context, in *GetMigrateRecordsRequest, opts ...grpc.CallOption) (*MigrateRecords, error)
+	// WatchEvents streams lifecycle events of the node.
+	WatchEvents(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (XuperDB_WatchEventsClient, error)
+}
+
+type xuperDBClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewXuperDBClient(cc grpc.ClientConnInterface) XuperDBClient {
+	return &xuperDBClient{cc}
+}
+
+func (c *xuperDBClient) Write(ctx context.Context, opts ...grpc.CallOption) (XuperDB_WriteClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_XuperDB_serviceDesc.Streams[0], "/xuperdb.XuperDB/Write", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &xuperDBWriteClient{stream}
+	return x, nil
+}
+
+type XuperDB_WriteClient interface {
+	Send(*WriteRequest) error
+	CloseAndRecv() (*WriteResponse, error)
+	grpc.ClientStream
+}
+
+type xuperDBWriteClient struct {
+	grpc.ClientStream
+}
+
+func (x *xuperDBWriteClient) Send(m *WriteRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *xuperDBWriteClient) CloseAndRecv() (*WriteResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(WriteResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *xuperDBClient) Append(ctx context.Context, opts ...grpc.CallOption) (XuperDB_AppendClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_XuperDB_serviceDesc.Streams[1], "/xuperdb.XuperDB/Append", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &xuperDBAppendClient{stream}
+	return x, nil
+}
+
+type XuperDB_AppendClient interface {
+	Send(*AppendRequest) error
+	CloseAndRecv() (*AppendResponse, error)
+	grpc.ClientStream
+}
+
+type xuperDBAppendClient struct {
+	grpc.ClientStream
+}
+
+func (x *xuperDBAppendClient) Send(m *AppendRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *xuperDBAppendClient) CloseAndRecv() (*AppendResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(AppendResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *xuperDBClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (XuperDB_ReadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_XuperDB_serviceDesc.Streams[2], "/xuperdb.XuperDB/Read", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &xuperDBReadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type XuperDB_ReadClient interface {
+	Recv() (*Chunk, error)
+	grpc.ClientStream
+}
+
+type xuperDBReadClient struct {
+	grpc.ClientStream
+}
+
+func (x *xuperDBReadClient) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *xuperDBClient) ListFiles(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*Files, error) {
+	out := new(Files)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/ListFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) QueryFiles(ctx context.Context, in *QueryFileRequest, opts ...grpc.CallOption) (*Files, error) {
+	out := new(Files)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/QueryFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) ListExpiredFiles(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*Files, error) {
+	out := new(Files)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/ListExpiredFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetFileByID(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*FileH, error) {
+	out := new(FileH)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetFileByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetFileByName(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*FileH, error) {
+	out := new(FileH)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetFileByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) UpdateFileExpireTime(ctx context.Context, in *UpdateFileEtimeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/UpdateFileExpireTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) AddFileNs(ctx context.Context, in *AddNsRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/AddFileNs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) UpdateNsReplica(ctx context.Context, in *UpdateNsReplicaRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/UpdateNsReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) UpdateNsQuota(ctx context.Context, in *UpdateNsQuotaRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/UpdateNsQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) UpdateNsRenewal(ctx context.Context, in *UpdateNsRenewalRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/UpdateNsRenewal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) AddNsMember(ctx context.Context, in *NsMemberRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/AddNsMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) RemoveNsMember(ctx context.Context, in *NsMemberRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/RemoveNsMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) ListNsMembers(ctx context.Context, in *ListNsMembersRequest, opts ...grpc.CallOption) (*NsMembers, error) {
+	out := new(NsMembers)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/ListNsMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) ProposeTransfer(ctx context.Context, in *ProposeTransferRequest, opts ...grpc.CallOption) (*ProposeTransferResponse, error) {
+	out := new(ProposeTransferResponse)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/ProposeTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) AcceptTransfer(ctx context.Context, in *AcceptTransferRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/AcceptTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) CancelTransfer(ctx context.Context, in *CancelTransferRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/CancelTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) Handover(ctx context.Context, in *HandoverRequest, opts ...grpc.CallOption) (*HandoverResponse, error) {
+	out := new(HandoverResponse)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/Handover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) CompleteTransfer(ctx context.Context, in *CompleteTransferRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/CompleteTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*Transfers, error) {
+	out := new(Transfers)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/ListTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalancePlan, error) {
+	out := new(RebalancePlan)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/Rebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetBandwidth(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Bandwidth, error) {
+	out := new(Bandwidth)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetBandwidth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) UpdateBandwidth(ctx context.Context, in *UpdateBandwidthRequest, opts ...grpc.CallOption) (*Bandwidth, error) {
+	out := new(Bandwidth)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/UpdateBandwidth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetMaterialStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MaterialReport, error) {
+	out := new(MaterialReport)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetMaterialStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) ListFileNs(ctx context.Context, in *ListNsRequest, opts ...grpc.CallOption) (*Namespaces, error) {
+	out := new(Namespaces)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/ListFileNs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetNsByName(ctx context.Context, in *GetNsRequest, opts ...grpc.CallOption) (*NamespaceH, error) {
+	out := new(NamespaceH)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetNsByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetFileSysHealth(ctx context.Context, in *GetFileSysHealthRequest, opts ...grpc.CallOption) (*FileSysHealth, error) {
+	out := new(FileSysHealth)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetFileSysHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetChallengeByID(ctx context.Context, in *GetChallengeRequest, opts ...grpc.CallOption) (*Challenge, error) {
+	out := new(Challenge)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetChallengeByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) ListChallenges(ctx context.Context, in *ListChallengeRequest, opts ...grpc.CallOption) (*Challenges, error) {
+	out := new(Challenges)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/ListChallenges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) Push(ctx context.Context, opts ...grpc.CallOption) (XuperDB_PushClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_XuperDB_serviceDesc.Streams[3], "/xuperdb.XuperDB/Push", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &xuperDBPushClient{stream}
+	return x, nil
+}
+
+type XuperDB_PushClient interface {
+	Send(*PushRequest) error
+	CloseAndRecv() (*PushResponse, error)
+	grpc.ClientStream
+}
+
+type xuperDBPushClient struct {
+	grpc.ClientStream
+}
+
+func (x *xuperDBPushClient) Send(m *PushRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *xuperDBPushClient) CloseAndRecv() (*PushResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(PushResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *xuperDBClient) Pull(ctx context.Context, in *PullRequest, opts ...grpc.CallOption) (XuperDB_PullClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_XuperDB_serviceDesc.Streams[4], "/xuperdb.XuperDB/Pull", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &xuperDBPullClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type XuperDB_PullClient interface {
+	Recv() (*Chunk, error)
+	grpc.ClientStream
+}
+
+type xuperDBPullClient struct {
+	grpc.ClientStream
+}
+
+func (x *xuperDBPullClient) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *xuperDBClient) AddNode(ctx context.Context, in *AddNodeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/AddNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) ListNodes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Nodes, error) {
+	out := new(Nodes)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/ListNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetNode(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*Node, error) {
+	out := new(Node)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetHeartbeatNum(ctx context.Context, in *GetHeartbeatNumRequest, opts ...grpc.CallOption) (*HeartbeatNum, error) {
+	out := new(HeartbeatNum)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetHeartbeatNum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetNodeHealth(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*NodeHealth, error) {
+	out := new(NodeHealth)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetNodeHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetHealthPolicy(ctx context.Context, in *GetHealthPolicyRequest, opts ...grpc.CallOption) (*HealthPolicy, error) {
+	out := new(HealthPolicy)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetHealthPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) UpdateHealthPolicy(ctx context.Context, in *UpdateHealthPolicyRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/UpdateHealthPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) SimulateNodeHealth(ctx context.Context, in *HealthPolicy, opts ...grpc.CallOption) (*HealthSimulation, error) {
+	out := new(HealthSimulation)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/SimulateNodeHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) ListHeartbeats(ctx context.Context, in *ListHeartbeatsRequest, opts ...grpc.CallOption) (*SignedHeartbeats, error) {
+	out := new(SignedHeartbeats)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/ListHeartbeats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) ListHeartbeatCommits(ctx context.Context, in *ListHeartbeatsRequest, opts ...grpc.CallOption) (*HeartbeatCommits, error) {
+	out := new(HeartbeatCommits)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/ListHeartbeatCommits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) NodeOffline(ctx context.Context, in *NodeOperateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/NodeOffline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) NodeOnline(ctx context.Context, in *NodeOperateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/NodeOnline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) NodeDrain(ctx context.Context, in *NodeOperateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/NodeDrain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetNodeDrainStatus(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*NodeDrainStatus, error) {
+	out := new(NodeDrainStatus)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetNodeDrainStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) GetSliceMigrateRecords(ctx context.Context, in *GetMigrateRecordsRequest, opts ...grpc.CallOption) (*MigrateRecords, error) {
+	out := new(MigrateRecords)
+	err := c.cc.Invoke(ctx, "/xuperdb.XuperDB/GetSliceMigrateRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperDBClient) WatchEvents(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (XuperDB_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_XuperDB_serviceDesc.Streams[5], "/xuperdb.XuperDB/WatchEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &xuperDBWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type XuperDB_WatchEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type xuperDBWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *xuperDBWatchEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// XuperDBServer is the server API for XuperDB service.
+type XuperDBServer interface {
+	// Write is provided by dataOwner node to upload a file,
+	// the first message carries options and the following ones carry file content.
+	Write(XuperDB_WriteServer) error
+	// Append is provided by dataOwner node to append data to an existing file,
+	// the first message carries options and the following ones carry data appended.
+	Append(XuperDB_AppendServer) error
+	// Read is provided by dataOwner node to download a file.
+	Read(*ReadRequest, XuperDB_ReadServer) error
+	// ListFiles is provided by dataOwner node to list unexpired files.
+	ListFiles(context.Context, *ListFileRequest) (*Files, error)
+	// QueryFiles is provided by dataOwner node to query files by tags, name prefix, size range and expire time range.
+	QueryFiles(context.Context, *QueryFileRequest) (*Files, error)
+	// ListExpiredFiles is provided by dataOwner node to list expired but valid files.
+	ListExpiredFiles(context.Context, *ListFileRequest) (*Files, error)
+	// GetFileByID is provided by dataOwner node to get a file by id.
+	GetFileByID(context.Context, *GetFileRequest) (*FileH, error)
+	// GetFileByName is provided by dataOwner node to get a file by owner, namespace and name.
+	GetFileByName(context.Context, *GetFileRequest) (*FileH, error)
+	// UpdateFileExpireTime is provided by dataOwner node to extend expire time of a file.
+	UpdateFileExpireTime(context.Context, *UpdateFileEtimeRequest) (*Empty, error)
+	// AddFileNs is provided by dataOwner node to add a file namespace.
+	AddFileNs(context.Context, *AddNsRequest) (*Empty, error)
+	// UpdateNsReplica is provided by dataOwner node to update replica of a namespace.
+	UpdateNsReplica(context.Context, *UpdateNsReplicaRequest) (*Empty, error)
+	// UpdateNsQuota is provided by dataOwner node to update quota of a namespace.
+	UpdateNsQuota(context.Context, *UpdateNsQuotaRequest) (*Empty, error)
+	// UpdateNsRenewal is provided by dataOwner node to update renewal policy of a namespace.
+	UpdateNsRenewal(context.Context, *UpdateNsRenewalRequest) (*Empty, error)
+	// AddNsMember is provided by dataOwner node to add a member into namespace.
+	AddNsMember(context.Context, *NsMemberRequest) (*Empty, error)
+	// RemoveNsMember is provided by dataOwner node to remove a member from namespace.
+	RemoveNsMember(context.Context, *NsMemberRequest) (*Empty, error)
+	// ListNsMembers is provided by dataOwner node to list members of a namespace.
+	ListNsMembers(context.Context, *ListNsMembersRequest) (*NsMembers, error)
+	// ProposeTransfer is provided by dataOwner node to propose an ownership transfer of a file or a namespace.
+	ProposeTransfer(context.Context, *ProposeTransferRequest) (*ProposeTransferResponse, error)
+	// AcceptTransfer is provided by dataOwner node to accept a transfer proposed to it.
+	AcceptTransfer(context.Context, *AcceptTransferRequest) (*Empty, error)
+	// CancelTransfer is provided by dataOwner node to cancel a transfer not completed yet.
+	CancelTransfer(context.Context, *CancelTransferRequest) (*Empty, error)
+	// Handover is provided by dataOwner node to hand over a file of an accepted transfer to the new owner.
+	Handover(context.Context, *HandoverRequest) (*HandoverResponse, error)
+	// CompleteTransfer is provided by dataOwner node to record a re-uploaded file or finish a namespace transfer.
+	CompleteTransfer(context.Context, *CompleteTransferRequest) (*Empty, error)
+	// GetTransfer is provided by dataOwner node to get a transfer by id.
+	GetTransfer(context.Context, *GetTransferRequest) (*Transfer, error)
+	// ListTransfers is provided by dataOwner node to list transfers proposed by or to a dataOwner.
+	ListTransfers(context.Context, *ListTransfersRequest) (*Transfers, error)
+	// Rebalance is provided by dataOwner node to plan and run slice moves across storage nodes.
+	Rebalance(context.Context, *RebalanceRequest) (*RebalancePlan, error)
+	// GetBandwidth is provided by dataOwner node to get bandwidth limits in effect.
+	GetBandwidth(context.Context, *Empty) (*Bandwidth, error)
+	// UpdateBandwidth is provided by dataOwner node to change bandwidth limits at runtime.
+	UpdateBandwidth(context.Context, *UpdateBandwidthRequest) (*Bandwidth, error)
+	// GetMaterialStats is provided by dataOwner node to report challenge materials of each namespace.
+	GetMaterialStats(context.Context, *Empty) (*MaterialReport, error)
+	// ListFileNs is provided by dataOwner node to list namespaces of an owner.
+	ListFileNs(context.Context, *ListNsRequest) (*Namespaces, error)
+	// GetNsByName is provided by dataOwner node to get a namespace by owner and name.
+	GetNsByName(context.Context, *GetNsRequest) (*NamespaceH, error)
+	// GetFileSysHealth is provided by dataOwner node to get system health status of an owner.
+	GetFileSysHealth(context.Context, *GetFileSysHealthRequest) (*FileSysHealth, error)
+	// GetChallengeByID is provided by dataOwner node to get a challenge by id.
+	GetChallengeByID(context.Context, *GetChallengeRequest) (*Challenge, error)
+	// ListChallenges is provided by dataOwner node to list challenges with a status.
+	ListChallenges(context.Context, *ListChallengeRequest) (*Challenges, error)
+	// Push is provided by storage node to receive a slice,
+	// the first message carries options and the following ones carry slice content.
+	Push(XuperDB_PushServer) error
+	// Pull is provided by storage node to offer a slice to its owner.
+	Pull(*PullRequest, XuperDB_PullServer) error
+	// AddNode is provided by storage node to register itself on blockchain.
+	AddNode(context.Context, *AddNodeRequest) (*Empty, error)
+	// ListNodes lists all storage nodes.
+	ListNodes(context.Context, *Empty) (*Nodes, error)
+	// GetNode gets a storage node by id.
+	GetNode(context.Context, *GetNodeRequest) (*Node, error)
+	// GetHeartbeatNum gets heartbeat number of a storage node in the day of ctime.
+	GetHeartbeatNum(context.Context, *GetHeartbeatNumRequest) (*HeartbeatNum, error)
+	// GetNodeHealth gets health status of a storage node.
+	GetNodeHealth(context.Context, *GetNodeRequest) (*NodeHealth, error)
+	// GetHealthPolicy gets node health policy of a version, the current one if version is 0.
+	GetHealthPolicy(context.Context, *GetHealthPolicyRequest) (*HealthPolicy, error)
+	// UpdateHealthPolicy sets a new version of node health policy signed by the network admin.
+	UpdateHealthPolicy(context.Context, *UpdateHealthPolicyRequest) (*Empty, error)
+	// SimulateNodeHealth scores existing storage nodes by the current and a proposed health policy.
+	SimulateNodeHealth(context.Context, *HealthPolicy) (*HealthSimulation, error)
+	// ListHeartbeats is provided by storage node to list heartbeats kept locally in aggregate mode.
+	ListHeartbeats(context.Context, *ListHeartbeatsRequest) (*SignedHeartbeats, error)
+	// ListHeartbeatCommits lists aggregated heartbeat commits of a storage node on blockchain.
+	ListHeartbeatCommits(context.Context, *ListHeartbeatsRequest) (*HeartbeatCommits, error)
+	// NodeOffline is provided by storage node to set itself offline.
+	NodeOffline(context.Context, *NodeOperateRequest) (*Empty, error)
+	// NodeOnline is provided by storage node to set itself online.
+	NodeOnline(context.Context, *NodeOperateRequest) (*Empty, error)
+	// NodeDrain is provided by storage node to set itself draining.
+	NodeDrain(context.Context, *NodeOperateRequest) (*Empty, error)
+	// GetNodeDrainStatus gets draining progress of a storage node.
+	GetNodeDrainStatus(context.Context, *GetNodeRequest) (*NodeDrainStatus, error)
+	// GetSliceMigrateRecords gets slice migration records of a storage node.
+	GetSliceMigrateRecords(context.Context, *GetMigrateRecordsRequest) (*MigrateRecords, error)
+	// WatchEvents streams lifecycle events of the node.
+	WatchEvents(*WatchRequest, XuperDB_WatchEventsServer) error
+}
+
+// UnimplementedXuperDBServer can be embedded to have forward compatible implementations.
+type UnimplementedXuperDBServer struct {
+}
+
+func (*UnimplementedXuperDBServer) Write(XuperDB_WriteServer) error {
+	return status.Errorf(codes.Unimplemented, "method Write not implemented")
+}
+func (*UnimplementedXuperDBServer) Append(XuperDB_AppendServer) error {
+	return status.Errorf(codes.Unimplemented, "method Append not implemented")
+}
+func (*UnimplementedXuperDBServer) Read(*ReadRequest, XuperDB_ReadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (*UnimplementedXuperDBServer) ListFiles(context.Context, *ListFileRequest) (*Files, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFiles not implemented")
+}
+func (*UnimplementedXuperDBServer) QueryFiles(context.Context, *QueryFileRequest) (*Files, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFiles not implemented")
+}
+func (*UnimplementedXuperDBServer) ListExpiredFiles(context.Context, *ListFileRequest) (*Files, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiredFiles not implemented")
+}
+func (*UnimplementedXuperDBServer) GetFileByID(context.Context, *GetFileRequest) (*FileH, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileByID not implemented")
+}
+func (*UnimplementedXuperDBServer) GetFileByName(context.Context, *GetFileRequest) (*FileH, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileByName not implemented")
+}
+func (*UnimplementedXuperDBServer) UpdateFileExpireTime(context.Context, *UpdateFileEtimeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFileExpireTime not implemented")
+}
+func (*UnimplementedXuperDBServer) AddFileNs(context.Context, *AddNsRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFileNs not implemented")
+}
+func (*UnimplementedXuperDBServer) UpdateNsReplica(context.Context, *UpdateNsReplicaRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNsReplica not implemented")
+}
+func (*UnimplementedXuperDBServer) UpdateNsQuota(context.Context, *UpdateNsQuotaRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNsQuota not implemented")
+}
+func (*UnimplementedXuperDBServer) UpdateNsRenewal(context.Context, *UpdateNsRenewalRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNsRenewal not implemented")
+}
+func (*UnimplementedXuperDBServer) AddNsMember(context.Context, *NsMemberRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNsMember not implemented")
+}
+func (*UnimplementedXuperDBServer) RemoveNsMember(context.Context, *NsMemberRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNsMember not implemented")
+}
+func (*UnimplementedXuperDBServer) ListNsMembers(context.Context, *ListNsMembersRequest) (*NsMembers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNsMembers not implemented")
+}
+func (*UnimplementedXuperDBServer) ProposeTransfer(context.Context, *ProposeTransferRequest) (*ProposeTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeTransfer not implemented")
+}
+func (*UnimplementedXuperDBServer) AcceptTransfer(context.Context, *AcceptTransferRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTransfer not implemented")
+}
+func (*UnimplementedXuperDBServer) CancelTransfer(context.Context, *CancelTransferRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTransfer not implemented")
+}
+func (*UnimplementedXuperDBServer) Handover(context.Context, *HandoverRequest) (*HandoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Handover not implemented")
+}
+func (*UnimplementedXuperDBServer) CompleteTransfer(context.Context, *CompleteTransferRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteTransfer not implemented")
+}
+func (*UnimplementedXuperDBServer) GetTransfer(context.Context, *GetTransferRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfer not implemented")
+}
+func (*UnimplementedXuperDBServer) ListTransfers(context.Context, *ListTransfersRequest) (*Transfers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
+}
+func (*UnimplementedXuperDBServer) Rebalance(context.Context, *RebalanceRequest) (*RebalancePlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rebalance not implemented")
+}
+func (*UnimplementedXuperDBServer) GetBandwidth(context.Context, *Empty) (*Bandwidth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBandwidth not implemented")
+}
+func (*UnimplementedXuperDBServer) UpdateBandwidth(context.Context, *UpdateBandwidthRequest) (*Bandwidth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBandwidth not implemented")
+}
+func (*UnimplementedXuperDBServer) GetMaterialStats(context.Context, *Empty) (*MaterialReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaterialStats not implemented")
+}
+func (*UnimplementedXuperDBServer) ListFileNs(context.Context, *ListNsRequest) (*Namespaces, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFileNs not implemented")
+}
+func (*UnimplementedXuperDBServer) GetNsByName(context.Context, *GetNsRequest) (*NamespaceH, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNsByName not implemented")
+}
+func (*UnimplementedXuperDBServer) GetFileSysHealth(context.Context, *GetFileSysHealthRequest) (*FileSysHealth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileSysHealth not implemented")
+}
+func (*UnimplementedXuperDBServer) GetChallengeByID(context.Context, *GetChallengeRequest) (*Challenge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallengeByID not implemented")
+}
+func (*UnimplementedXuperDBServer) ListChallenges(context.Context, *ListChallengeRequest) (*Challenges, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChallenges not implemented")
+}
+func (*UnimplementedXuperDBServer) Push(XuperDB_PushServer) error {
+	return status.Errorf(codes.Unimplemented, "method Push not implemented")
+}
+func (*UnimplementedXuperDBServer) Pull(*PullRequest, XuperDB_PullServer) error {
+	return status.Errorf(codes.Unimplemented, "method Pull not implemented")
+}
+func (*UnimplementedXuperDBServer) AddNode(context.Context, *AddNodeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNode not implemented")
+}
+func (*UnimplementedXuperDBServer) ListNodes(context.Context, *Empty) (*Nodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodes not implemented")
+}
+func (*UnimplementedXuperDBServer) GetNode(context.Context, *GetNodeRequest) (*Node, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNode not implemented")
+}
+func (*UnimplementedXuperDBServer) GetHeartbeatNum(context.Context, *GetHeartbeatNumRequest) (*HeartbeatNum, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeartbeatNum not implemented")
+}
+func (*UnimplementedXuperDBServer) GetNodeHealth(context.Context, *GetNodeRequest) (*NodeHealth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeHealth not implemented")
+}
+func (*UnimplementedXuperDBServer) GetHealthPolicy(context.Context, *GetHealthPolicyRequest) (*HealthPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealthPolicy not implemented")
+}
+func (*UnimplementedXuperDBServer) UpdateHealthPolicy(context.Context, *UpdateHealthPolicyRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHealthPolicy not implemented")
+}
+func (*UnimplementedXuperDBServer) SimulateNodeHealth(context.Context, *HealthPolicy) (*HealthSimulation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateNodeHealth not implemented")
+}
+func (*UnimplementedXuperDBServer) ListHeartbeats(context.Context, *ListHeartbeatsRequest) (*SignedHeartbeats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHeartbeats not implemented")
+}
+func (*UnimplementedXuperDBServer) ListHeartbeatCommits(context.Context, *ListHeartbeatsRequest) (*HeartbeatCommits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHeartbeatCommits not implemented")
+}
+func (*UnimplementedXuperDBServer) NodeOffline(context.Context, *NodeOperateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeOffline not implemented")
+}
+func (*UnimplementedXuperDBServer) NodeOnline(context.Context, *NodeOperateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeOnline not implemented")
+}
+func (*UnimplementedXuperDBServer) NodeDrain(context.Context, *NodeOperateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeDrain not implemented")
+}
+func (*UnimplementedXuperDBServer) GetNodeDrainStatus(context.Context, *GetNodeRequest) (*NodeDrainStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeDrainStatus not implemented")
+}
+func (*UnimplementedXuperDBServer) GetSliceMigrateRecords(context.Context, *GetMigrateRecordsRequest) (*MigrateRecords, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSliceMigrateRecords not implemented")
+}
+func (*UnimplementedXuperDBServer) WatchEvents(*WatchRequest, XuperDB_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+
+func RegisterXuperDBServer(s *grpc.Server, srv XuperDBServer) {
+	s.RegisterService(&_XuperDB_serviceDesc, srv)
+}
+
+func _XuperDB_Write_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(XuperDBServer).Write(&xuperDBWriteServer{stream})
+}
+
+type XuperDB_WriteServer interface {
+	SendAndClose(*WriteResponse) error
+	Recv() (*WriteRequest, error)
+	grpc.ServerStream
+}
+
+type xuperDBWriteServer struct {
+	grpc.ServerStream
+}
+
+func (x *xuperDBWriteServer) SendAndClose(m *WriteResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *xuperDBWriteServer) Recv() (*WriteRequest, error) {
+	m := new(WriteRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _XuperDB_Append_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(XuperDBServer).Append(&xuperDBAppendServer{stream})
+}
+
+type XuperDB_AppendServer interface {
+	SendAndClose(*AppendResponse) error
+	Recv() (*AppendRequest, error)
+	grpc.ServerStream
+}
+
+type xuperDBAppendServer struct {
+	grpc.ServerStream
+}
+
+func (x *xuperDBAppendServer) SendAndClose(m *AppendResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *xuperDBAppendServer) Recv() (*AppendRequest, error) {
+	m := new(AppendRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _XuperDB_Read_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(XuperDBServer).Read(m, &xuperDBReadServer{stream})
+}
+
+type XuperDB_ReadServer interface {
+	Send(*Chunk) error
+	grpc.ServerStream
+}
+
+type xuperDBReadServer struct {
+	grpc.ServerStream
+}
+
+func (x *xuperDBReadServer) Send(m *Chunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _XuperDB_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).ListFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/ListFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).ListFiles(ctx, req.(*ListFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_QueryFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).QueryFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/QueryFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).QueryFiles(ctx, req.(*QueryFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_ListExpiredFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).ListExpiredFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/ListExpiredFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).ListExpiredFiles(ctx, req.(*ListFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetFileByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetFileByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetFileByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetFileByID(ctx, req.(*GetFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetFileByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetFileByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetFileByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetFileByName(ctx, req.(*GetFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_UpdateFileExpireTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFileEtimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).UpdateFileExpireTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/UpdateFileExpireTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).UpdateFileExpireTime(ctx, req.(*UpdateFileEtimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_AddFileNs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).AddFileNs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/AddFileNs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).AddFileNs(ctx, req.(*AddNsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_UpdateNsReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNsReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).UpdateNsReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/UpdateNsReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).UpdateNsReplica(ctx, req.(*UpdateNsReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_UpdateNsQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNsQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).UpdateNsQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/UpdateNsQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).UpdateNsQuota(ctx, req.(*UpdateNsQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_UpdateNsRenewal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNsRenewalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).UpdateNsRenewal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/UpdateNsRenewal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).UpdateNsRenewal(ctx, req.(*UpdateNsRenewalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_AddNsMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NsMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).AddNsMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/AddNsMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).AddNsMember(ctx, req.(*NsMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_RemoveNsMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NsMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).RemoveNsMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/RemoveNsMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).RemoveNsMember(ctx, req.(*NsMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_ListNsMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNsMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).ListNsMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/ListNsMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).ListNsMembers(ctx, req.(*ListNsMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_ProposeTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).ProposeTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/ProposeTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).ProposeTransfer(ctx, req.(*ProposeTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_AcceptTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).AcceptTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/AcceptTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).AcceptTransfer(ctx, req.(*AcceptTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_CancelTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).CancelTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/CancelTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).CancelTransfer(ctx, req.(*CancelTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_Handover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).Handover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/Handover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).Handover(ctx, req.(*HandoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_CompleteTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).CompleteTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/CompleteTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).CompleteTransfer(ctx, req.(*CompleteTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetTransfer(ctx, req.(*GetTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).ListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/ListTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_Rebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).Rebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/Rebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).Rebalance(ctx, req.(*RebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetBandwidth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetBandwidth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetBandwidth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetBandwidth(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_UpdateBandwidth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBandwidthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).UpdateBandwidth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/UpdateBandwidth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).UpdateBandwidth(ctx, req.(*UpdateBandwidthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetMaterialStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetMaterialStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetMaterialStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetMaterialStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_ListFileNs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).ListFileNs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/ListFileNs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).ListFileNs(ctx, req.(*ListNsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetNsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetNsByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetNsByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetNsByName(ctx, req.(*GetNsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetFileSysHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileSysHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetFileSysHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetFileSysHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetFileSysHealth(ctx, req.(*GetFileSysHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetChallengeByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetChallengeByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetChallengeByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetChallengeByID(ctx, req.(*GetChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_ListChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).ListChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/ListChallenges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).ListChallenges(ctx, req.(*ListChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_Push_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(XuperDBServer).Push(&xuperDBPushServer{stream})
+}
+
+type XuperDB_PushServer interface {
+	SendAndClose(*PushResponse) error
+	Recv() (*PushRequest, error)
+	grpc.ServerStream
+}
+
+type xuperDBPushServer struct {
+	grpc.ServerStream
+}
+
+func (x *xuperDBPushServer) SendAndClose(m *PushResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *xuperDBPushServer) Recv() (*PushRequest, error) {
+	m := new(PushRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _XuperDB_Pull_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PullRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(XuperDBServer).Pull(m, &xuperDBPullServer{stream})
+}
+
+type XuperDB_PullServer interface {
+	Send(*Chunk) error
+	grpc.ServerStream
+}
+
+type xuperDBPullServer struct {
+	grpc.ServerStream
+}
+
+func (x *xuperDBPullServer) Send(m *Chunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _XuperDB_AddNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).AddNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/AddNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).AddNode(ctx, req.(*AddNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).ListNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/ListNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).ListNodes(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetNode(ctx, req.(*GetNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetHeartbeatNum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeartbeatNumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetHeartbeatNum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetHeartbeatNum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetHeartbeatNum(ctx, req.(*GetHeartbeatNumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetNodeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetNodeHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetNodeHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetNodeHealth(ctx, req.(*GetNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetHealthPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetHealthPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetHealthPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetHealthPolicy(ctx, req.(*GetHealthPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_UpdateHealthPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHealthPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).UpdateHealthPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/UpdateHealthPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).UpdateHealthPolicy(ctx, req.(*UpdateHealthPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_SimulateNodeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).SimulateNodeHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/SimulateNodeHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).SimulateNodeHealth(ctx, req.(*HealthPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_ListHeartbeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHeartbeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).ListHeartbeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/ListHeartbeats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).ListHeartbeats(ctx, req.(*ListHeartbeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_ListHeartbeatCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHeartbeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).ListHeartbeatCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/ListHeartbeatCommits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).ListHeartbeatCommits(ctx, req.(*ListHeartbeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_NodeOffline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeOperateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).NodeOffline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/NodeOffline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).NodeOffline(ctx, req.(*NodeOperateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_NodeOnline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeOperateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).NodeOnline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/NodeOnline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).NodeOnline(ctx, req.(*NodeOperateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_NodeDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeOperateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).NodeDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/NodeDrain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).NodeDrain(ctx, req.(*NodeOperateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetNodeDrainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetNodeDrainStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetNodeDrainStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetNodeDrainStatus(ctx, req.(*GetNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_GetSliceMigrateRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMigrateRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperDBServer).GetSliceMigrateRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xuperdb.XuperDB/GetSliceMigrateRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperDBServer).GetSliceMigrateRecords(ctx, req.(*GetMigrateRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperDB_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(XuperDBServer).WatchEvents(m, &xuperDBWatchEventsServer{stream})
+}
+
+type XuperDB_WatchEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type xuperDBWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *xuperDBWatchEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _XuperDB_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "xuperdb.XuperDB",
+	HandlerType: (*XuperDBServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFiles",
+			Handler:    _XuperDB_ListFiles_Handler,
+		},
+		{
+			MethodName: "QueryFiles",
+			Handler:    _XuperDB_QueryFiles_Handler,
+		},
+		{
+			MethodName: "ListExpiredFiles",
+			Handler:    _XuperDB_ListExpiredFiles_Handler,
+		},
+		{
+			MethodName: "GetFileByID",
+			Handler:    _XuperDB_GetFileByID_Handler,
+		},
+		{
+			MethodName: "GetFileByName",
+			Handler:    _XuperDB_GetFileByName_Handler,
+		},
+		{
+			MethodName: "UpdateFileExpireTime",
+			Handler:    _XuperDB_UpdateFileExpireTime_Handler,
+		},
+		{
+			MethodName: "AddFileNs",
+			Handler:    _XuperDB_AddFileNs_Handler,
+		},
+		{
+			MethodName: "UpdateNsReplica",
+			Handler:    _XuperDB_UpdateNsReplica_Handler,
+		},
+		{
+			MethodName: "UpdateNsQuota",
+			Handler:    _XuperDB_UpdateNsQuota_Handler,
+		},
+		{
+			MethodName: "UpdateNsRenewal",
+			Handler:    _XuperDB_UpdateNsRenewal_Handler,
+		},
+		{
+			MethodName: "AddNsMember",
+			Handler:    _XuperDB_AddNsMember_Handler,
+		},
+		{
+			MethodName: "RemoveNsMember",
+			Handler:    _XuperDB_RemoveNsMember_Handler,
+		},
+		{
+			MethodName: "ListNsMembers",
+			Handler:    _XuperDB_ListNsMembers_Handler,
+		},
+		{
+			MethodName: "ProposeTransfer",
+			Handler:    _XuperDB_ProposeTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptTransfer",
+			Handler:    _XuperDB_AcceptTransfer_Handler,
+		},
+		{
+			MethodName: "CancelTransfer",
+			Handler:    _XuperDB_CancelTransfer_Handler,
+		},
+		{
+			MethodName: "Handover",
+			Handler:    _XuperDB_Handover_Handler,
+		},
+		{
+			MethodName: "CompleteTransfer",
+			Handler:    _XuperDB_CompleteTransfer_Handler,
+		},
+		{
+			MethodName: "GetTransfer",
+			Handler:    _XuperDB_GetTransfer_Handler,
+		},
+		{
+			MethodName: "ListTransfers",
+			Handler:    _XuperDB_ListTransfers_Handler,
+		},
+		{
+			MethodName: "Rebalance",
+			Handler:    _XuperDB_Rebalance_Handler,
+		},
+		{
+			MethodName: "GetBandwidth",
+			Handler:    _XuperDB_GetBandwidth_Handler,
+		},
+		{
+			MethodName: "UpdateBandwidth",
+			Handler:    _XuperDB_UpdateBandwidth_Handler,
+		},
+		{
+			MethodName: "GetMaterialStats",
+			Handler:    _XuperDB_GetMaterialStats_Handler,
+		},
+		{
+			MethodName: "ListFileNs",
+			Handler:    _XuperDB_ListFileNs_Handler,
+		},
+		{
+			MethodName: "GetNsByName",
+			Handler:    _XuperDB_GetNsByName_Handler,
+		},
+		{
+			MethodName: "GetFileSysHealth",
+			Handler:    _XuperDB_GetFileSysHealth_Handler,
+		},
+		{
+			MethodName: "GetChallengeByID",
+			Handler:    _XuperDB_GetChallengeByID_Handler,
+		},
+		{
+			MethodName: "ListChallenges",
+			Handler:    _XuperDB_ListChallenges_Handler,
+		},
+		{
+			MethodName: "AddNode",
+			Handler:    _XuperDB_AddNode_Handler,
+		},
+		{
+			MethodName: "ListNodes",
+			Handler:    _XuperDB_ListNodes_Handler,
+		},
+		{
+			MethodName: "GetNode",
+			Handler:    _XuperDB_GetNode_Handler,
+		},
+		{
+			MethodName: "GetHeartbeatNum",
+			Handler:    _XuperDB_GetHeartbeatNum_Handler,
+		},
+		{
+			MethodName: "GetNodeHealth",
+			Handler:    _XuperDB_GetNodeHealth_Handler,
+		},
+		{
+			MethodName: "GetHealthPolicy",
+			Handler:    _XuperDB_GetHealthPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateHealthPolicy",
+			Handler:    _XuperDB_UpdateHealthPolicy_Handler,
+		},
+		{
+			MethodName: "SimulateNodeHealth",
+			Handler:    _XuperDB_SimulateNodeHealth_Handler,
+		},
+		{
+			MethodName: "ListHeartbeats",
+			Handler:    _XuperDB_ListHeartbeats_Handler,
+		},
+		{
+			MethodName: "ListHeartbeatCommits",
+			Handler:    _XuperDB_ListHeartbeatCommits_Handler,
+		},
+		{
+			MethodName: "NodeOffline",
+			Handler:    _XuperDB_NodeOffline_Handler,
+		},
+		{
+			MethodName: "NodeOnline",
+			Handler:    _XuperDB_NodeOnline_Handler,
+		},
+		{
+			MethodName: "NodeDrain",
+			Handler:    _XuperDB_NodeDrain_Handler,
+		},
+		{
+			MethodName: "GetNodeDrainStatus",
+			Handler:    _XuperDB_GetNodeDrainStatus_Handler,
+		},
+		{
+			MethodName: "GetSliceMigrateRecords",
+			Handler:    _XuperDB_GetSliceMigrateRecords_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Write",
+			Handler:       _XuperDB_Write_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Append",
+			Handler:       _XuperDB_Append_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Read",
+			Handler:       _XuperDB_Read_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Push",
+			Handler:       _XuperDB_Push_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Pull",
+			Handler:       _XuperDB_Pull_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _XuperDB_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "xuperdb/xuperdb.proto",
+}
@@ -15,18 +15,29 @@ package server
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kataras/iris/v12"
+	"github.com/sirupsen/logrus"
 
 	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
 	etype "github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/events"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	httpkg "github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/http"
 	"github.com/PaddlePaddle/PaddleDTX/xdb/server/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/tracing"
 )
 
+const eventsPingInterval = 30 * time.Second
+
 // write upload a local file
 func (s *Server) write(ictx iris.Context) {
 	expireTime, err := ictx.URLParamInt64("expireTime")
@@ -43,11 +54,14 @@ func (s *Server) write(ictx iris.Context) {
 		Description: ictx.URLParam("desc"),
 		Extra:       ictx.URLParam("ext"),
 	}
-	if err := req.Valid(); err != nil {
-		responseError(ictx, errorx.Wrap(err, "invalid params"))
+	tags, err := parseTags(ictx)
+	if err != nil {
+		responseError(ictx, err)
 		return
 	}
-	ctx, cancel := context.WithCancel(context.Background())
+	req.Tags = tags
+
+	ctx, cancel := context.WithCancel(tracing.Extract(context.Background(), ictx.Request().Header))
 	defer cancel()
 	ictx.OnConnectionClose(func(iris.Context) { cancel() })
 
@@ -62,6 +76,36 @@ func (s *Server) write(ictx iris.Context) {
 	responseJSON(ictx, resp)
 }
 
+// append appends data to an existing file
+func (s *Server) append(ictx iris.Context) {
+	timestamp, err := ictx.URLParamInt64("timestamp")
+	if err != nil {
+		responseError(ictx, errorx.NewCode(err, errorx.ErrCodeParam, "invalid param timestamp"))
+		return
+	}
+	req := etype.AppendOptions{
+		User:      ictx.URLParam("user"),
+		Token:     ictx.URLParam("token"),
+		FileID:    ictx.URLParam("file_id"),
+		Timestamp: timestamp,
+	}
+
+	ctx, cancel := context.WithCancel(tracing.Extract(context.Background(), ictx.Request().Header))
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	result, err := s.handler.Append(ctx, req, ictx.Request().Body)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to append"))
+		return
+	}
+	resp := types.AppendResponse{
+		FileID: result.FileID,
+		Length: result.Length,
+	}
+	responseJSON(ictx, resp)
+}
+
 // read download a file to local
 func (s *Server) read(ictx iris.Context) {
 	req := etype.ReadOptions{
@@ -72,12 +116,8 @@ func (s *Server) read(ictx iris.Context) {
 		FileID:    ictx.URLParam("file_id"),
 		Timestamp: ictx.URLParamUint64("timestamp"),
 	}
-	if err := req.Valid(); err != nil {
-		responseError(ictx, errorx.Wrap(err, "invalid params"))
-		return
-	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(tracing.Extract(context.Background(), ictx.Request().Header))
 	defer cancel()
 	ictx.OnConnectionClose(func(iris.Context) { cancel() })
 
@@ -95,16 +135,30 @@ func (s *Server) read(ictx iris.Context) {
 func (s *Server) push(ictx iris.Context) {
 	sliceID := ictx.URLParam("slice_id")
 	sourceId := ictx.URLParam("source_id")
-	ctx, cancel := context.WithCancel(context.Background())
+	cipherHash, err := hex.DecodeString(ictx.URLParam("cipher_hash"))
+	if err != nil {
+		responseError(ictx, errorx.NewCode(err, errorx.ErrCodeParam, "invalid cipher_hash params"))
+		return
+	}
+	timestamp, err := ictx.URLParamInt64("timestamp")
+	if err != nil {
+		responseError(ictx, errorx.NewCode(err, errorx.ErrCodeParam, "invalid timestamp params"))
+		return
+	}
+	ctx, cancel := context.WithCancel(tracing.Extract(context.Background(), ictx.Request().Header))
 	defer cancel()
 	ictx.OnConnectionClose(func(iris.Context) { cancel() })
 
 	opt := etype.PushOptions{
-		SliceID:  sliceID,
-		SourceId: sourceId,
+		SliceID:    sliceID,
+		SourceId:   sourceId,
+		CipherHash: cipherHash,
+		Timestamp:  timestamp,
+		Signature:  ictx.URLParam("signature"),
 	}
+	opt.PeerID, _ = httpkg.PeerID(ictx.Request().TLS)
 
-	_, err := s.handler.Push(ctx, opt, ictx.Request().Body)
+	_, err = s.handler.Push(ctx, opt, ictx.Request().Body)
 	if err != nil {
 		responseError(ictx, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to push slice"))
 		return
@@ -121,7 +175,7 @@ func (s *Server) pull(ictx iris.Context) {
 	timestamp := ictx.URLParamUint64("timestamp")
 	sig := ictx.URLParam("signature")
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(tracing.Extract(context.Background(), ictx.Request().Header))
 	defer cancel()
 	ictx.OnConnectionClose(func(iris.Context) { cancel() })
 
@@ -131,6 +185,7 @@ func (s *Server) pull(ictx iris.Context) {
 		Timestamp: timestamp,
 		Signature: sig,
 	}
+	opt.PeerID, _ = httpkg.PeerID(ictx.Request().TLS)
 
 	resultReader, err := s.handler.Pull(ctx, opt)
 	if err != nil {
@@ -216,6 +271,30 @@ func (s *Server) nodeOffline(ictx iris.Context) {
 	responseJSON(ictx, "success")
 }
 
+// nodeDrain set storage node status to draining
+func (s *Server) nodeDrain(ictx iris.Context) {
+	nonce, err := ictx.URLParamInt64("nonce")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid nonce"))
+		return
+	}
+	req := etype.NodeDrainOptions{
+		NodeID: ictx.URLParam("node"),
+		Nonce:  nonce,
+		Token:  ictx.URLParam("token"),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	if err := s.handler.NodeDrain(ctx, req); err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to drain node"))
+		return
+	}
+	responseJSON(ictx, "success")
+}
+
 // nodeOnline set storage node status to online
 func (s *Server) nodeOnline(ictx iris.Context) {
 	nonce, err := ictx.URLParamInt64("nonce")
@@ -285,6 +364,41 @@ func (s *Server) getHeartbeatNum(ictx iris.Context) {
 	responseJSON(ictx, resp)
 }
 
+// listHeartbeats lists heartbeats kept by local storage node, by default heartbeats of the last day
+func (s *Server) listHeartbeats(ictx iris.Context) {
+	end := ictx.URLParamInt64Default("end", time.Now().UnixNano())
+	start := ictx.URLParamInt64Default("start", end-int64(24*time.Hour))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	resp, err := s.handler.ListHeartbeats(ctx, start, end)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to list heartbeats"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
+// listHeartbeatCommits lists heartbeat commits of a storage node on blockchain, by default commits of the last day
+func (s *Server) listHeartbeatCommits(ictx iris.Context) {
+	id := ictx.URLParam("id")
+	end := ictx.URLParamInt64Default("end", time.Now().UnixNano())
+	start := ictx.URLParamInt64Default("start", end-int64(24*time.Hour))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	resp, err := s.handler.ListHeartbeatCommits(ctx, []byte(id), start, end)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to list heartbeat commits"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
 // listFiles list files
 func (s *Server) listFiles(ictx iris.Context) {
 	owner, err := ecdsa.DecodePublicKeyFromString(ictx.URLParam("owner"))
@@ -292,10 +406,6 @@ func (s *Server) listFiles(ictx iris.Context) {
 		responseError(ictx, errorx.Wrap(err, "failed to decode public key"))
 		return
 	}
-	if ictx.URLParam("ns") == "" {
-		responseError(ictx, errorx.New(errorx.ErrCodeParam, "bad params:ns"))
-		return
-	}
 
 	req := etype.ListFileOptions{
 		Owner:       owner[:],
@@ -304,27 +414,78 @@ func (s *Server) listFiles(ictx iris.Context) {
 		TimeEnd:     ictx.URLParamInt64Default("end", time.Now().UnixNano()),
 		CurrentTime: ictx.URLParamInt64Default("ctime", time.Now().UnixNano()),
 		Limit:       ictx.URLParamUint64("limit"),
+		Cursor:      ictx.URLParam("next"),
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	ictx.OnConnectionClose(func(iris.Context) { cancel() })
-	resp, err := s.handler.ListFiles(ctx, req)
+	resp, next, err := s.handler.ListFiles(ctx, req)
 	if err != nil {
 		responseError(ictx, errorx.Wrap(err, "failed to list files"))
 		return
 	}
-	responseJSON(ictx, resp)
+	responsePage(ictx, resp, next)
 }
 
-// listExpiredFiles list expired but valid files
-func (s *Server) listExpiredFiles(ictx iris.Context) {
+// queryFiles query files by tags, name prefix, size range and expire time range
+func (s *Server) queryFiles(ictx iris.Context) {
 	owner, err := ecdsa.DecodePublicKeyFromString(ictx.URLParam("owner"))
 	if err != nil {
 		responseError(ictx, errorx.Wrap(err, "failed to decode public key"))
 		return
 	}
-	if ictx.URLParam("ns") == "" {
-		responseError(ictx, errorx.New(errorx.ErrCodeParam, "bad params:ns is empty"))
+	tags, err := parseTags(ictx)
+	if err != nil {
+		responseError(ictx, err)
+		return
+	}
+
+	req := etype.QueryFileOptions{
+		Owner:           owner[:],
+		Namespace:       ictx.URLParam("ns"),
+		Tags:            tags,
+		NamePrefix:      ictx.URLParam("prefix"),
+		MinSize:         ictx.URLParamUint64("minsize"),
+		MaxSize:         ictx.URLParamUint64("maxsize"),
+		ExpireTimeStart: ictx.URLParamInt64Default("expstart", 0),
+		ExpireTimeEnd:   ictx.URLParamInt64Default("expend", 0),
+		CurrentTime:     ictx.URLParamInt64Default("ctime", time.Now().UnixNano()),
+		Limit:           ictx.URLParamUint64("limit"),
+		Cursor:          ictx.URLParam("next"),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+	resp, next, err := s.handler.QueryFiles(ctx, req)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to query files"))
+		return
+	}
+	responsePage(ictx, resp, next)
+}
+
+// parseTags parses file tags from url params like "tag=key=value"
+func parseTags(ictx iris.Context) (map[string]string, error) {
+	params := ictx.Request().URL.Query()["tag"]
+	if len(params) == 0 {
+		return nil, nil
+	}
+	tags := make(map[string]string, len(params))
+	for _, p := range params {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 {
+			return nil, errorx.New(errorx.ErrCodeParam, "bad params:tag %s, should be key=value", p)
+		}
+		tags[kv[0]] = kv[1]
+	}
+	return tags, nil
+}
+
+// listExpiredFiles list expired but valid files
+func (s *Server) listExpiredFiles(ictx iris.Context) {
+	owner, err := ecdsa.DecodePublicKeyFromString(ictx.URLParam("owner"))
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to decode public key"))
 		return
 	}
 
@@ -335,16 +496,17 @@ func (s *Server) listExpiredFiles(ictx iris.Context) {
 		TimeEnd:     ictx.URLParamInt64Default("end", time.Now().UnixNano()),
 		CurrentTime: ictx.URLParamInt64Default("ctime", time.Now().UnixNano()),
 		Limit:       ictx.URLParamUint64("limit"),
+		Cursor:      ictx.URLParam("next"),
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	ictx.OnConnectionClose(func(iris.Context) { cancel() })
-	resp, err := s.handler.ListExpiredFiles(ctx, req)
+	resp, next, err := s.handler.ListExpiredFiles(ctx, req)
 	if err != nil {
 		responseError(ictx, errorx.Wrap(err, "failed to list expired files"))
 		return
 	}
-	responseJSON(ictx, resp)
+	responsePage(ictx, resp, next)
 }
 
 // getFileByID get file by id
@@ -386,11 +548,6 @@ func (s *Server) updateFileExpireTime(ictx iris.Context) {
 		responseError(ictx, errorx.Wrap(err, "invalid param expireTime"))
 		return
 	}
-	if expireTime <= time.Now().UnixNano() {
-		responseError(ictx, errorx.New(errorx.ErrCodeParam, "invalid param expireTime"))
-		return
-	}
-
 	cTime, err := ictx.URLParamInt64("ctime")
 	if err != nil {
 		responseError(ictx, errorx.Wrap(err, "invalid current time"))
@@ -430,19 +587,6 @@ func (s *Server) addFileNs(ictx iris.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	ictx.OnConnectionClose(func(iris.Context) { cancel() })
-
-	lresp, err := s.handler.ListNodes(ctx)
-	if err != nil {
-		responseError(ictx, errorx.Wrap(err, "failed to list nodes"))
-		return
-	}
-	if replica > len(lresp) {
-		responseError(ictx, errorx.New(errorx.ErrCodeParam, "invalid param: replica must no greater than nodes number"))
-		return
-	}
 	req := etype.AddNsOptions{
 		Owner:       ictx.URLParam("owner"),
 		Namespace:   ictx.URLParam("ns"),
@@ -451,6 +595,10 @@ func (s *Server) addFileNs(ictx iris.Context) {
 		CreateTime:  addTime,
 		Token:       ictx.URLParam("token"),
 	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
 	err = s.handler.AddFileNs(ctx, req)
 	if err != nil {
 		responseError(ictx, errorx.Wrap(err, "failed to add file ns"))
@@ -493,6 +641,410 @@ func (s *Server) updateNsReplica(ictx iris.Context) {
 	responseJSON(ictx, "success")
 }
 
+// updateNsQuota update file namespace quota
+func (s *Server) updateNsQuota(ictx iris.Context) {
+	cTime, err := ictx.URLParamInt64("ctime")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid current time params"))
+		return
+	}
+	maxBytes, err := strconv.ParseUint(ictx.URLParamDefault("maxbytes", "0"), 10, 64)
+	if err != nil {
+		responseError(ictx, errorx.NewCode(err, errorx.ErrCodeParam, "invalid maxbytes params"))
+		return
+	}
+	maxFiles, err := strconv.ParseInt(ictx.URLParamDefault("maxfiles", "0"), 10, 64)
+	if err != nil {
+		responseError(ictx, errorx.NewCode(err, errorx.ErrCodeParam, "invalid maxfiles params"))
+		return
+	}
+	maxFileSize, err := strconv.ParseUint(ictx.URLParamDefault("maxfilesize", "0"), 10, 64)
+	if err != nil {
+		responseError(ictx, errorx.NewCode(err, errorx.ErrCodeParam, "invalid maxfilesize params"))
+		return
+	}
+
+	req := etype.UpdateNsQuotaOptions{
+		Owner:       ictx.URLParam("owner"),
+		Namespace:   ictx.URLParam("ns"),
+		MaxBytes:    maxBytes,
+		MaxFiles:    maxFiles,
+		MaxFileSize: maxFileSize,
+		CurrentTime: cTime,
+		Token:       ictx.URLParam("token"),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	if err := s.handler.UpdateNsQuota(ctx, req); err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to update file ns quota"))
+		return
+	}
+	responseJSON(ictx, "success")
+}
+
+// updateNsRenewal update file namespace renewal policy
+func (s *Server) updateNsRenewal(ictx iris.Context) {
+	cTime, err := ictx.URLParamInt64("ctime")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid current time params"))
+		return
+	}
+	days, err := strconv.Atoi(ictx.URLParamDefault("days", "0"))
+	if err != nil {
+		responseError(ictx, errorx.NewCode(err, errorx.ErrCodeParam, "invalid days params"))
+		return
+	}
+	until, err := strconv.ParseInt(ictx.URLParamDefault("until", "0"), 10, 64)
+	if err != nil {
+		responseError(ictx, errorx.NewCode(err, errorx.ErrCodeParam, "invalid until params"))
+		return
+	}
+
+	req := etype.UpdateNsRenewalOptions{
+		Owner:       ictx.URLParam("owner"),
+		Namespace:   ictx.URLParam("ns"),
+		Policy:      ictx.URLParam("policy"),
+		Days:        days,
+		Until:       until,
+		CurrentTime: cTime,
+		Token:       ictx.URLParam("token"),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	if err := s.handler.UpdateNsRenewal(ctx, req); err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to update file ns renewal"))
+		return
+	}
+	responseJSON(ictx, "success")
+}
+
+// addNsMember add a member into namespace
+func (s *Server) addNsMember(ictx iris.Context) {
+	s.updateNsMember(ictx, s.handler.AddNsMember)
+}
+
+// removeNsMember remove a member from namespace
+func (s *Server) removeNsMember(ictx iris.Context) {
+	s.updateNsMember(ictx, s.handler.RemoveNsMember)
+}
+
+func (s *Server) updateNsMember(ictx iris.Context,
+	update func(context.Context, etype.NsMemberOptions) error) {
+	cTime, err := ictx.URLParamInt64("ctime")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid current time params"))
+		return
+	}
+	req := etype.NsMemberOptions{
+		User:        ictx.URLParam("user"),
+		Namespace:   ictx.URLParam("ns"),
+		Member:      ictx.URLParam("member"),
+		Role:        ictx.URLParam("role"),
+		CurrentTime: cTime,
+		Token:       ictx.URLParam("token"),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	if err := update(ctx, req); err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to update namespace member"))
+		return
+	}
+	responseJSON(ictx, "success")
+}
+
+// listNsMembers list members of namespace
+func (s *Server) listNsMembers(ictx iris.Context) {
+	ns := ictx.URLParam("ns")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+	resp, err := s.handler.ListNsMembers(ctx, ns)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to list namespace members"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
+// proposeTransfer propose to transfer a file or a namespace to another dataOwner
+func (s *Server) proposeTransfer(ictx iris.Context) {
+	cTime, err := ictx.URLParamInt64("ctime")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid current time params"))
+		return
+	}
+	req := etype.ProposeTransferOptions{
+		User:         ictx.URLParam("user"),
+		Namespace:    ictx.URLParam("ns"),
+		FileID:       ictx.URLParam("file_id"),
+		NewOwner:     ictx.URLParam("new_owner"),
+		NewNamespace: ictx.URLParam("new_ns"),
+		CurrentTime:  cTime,
+		Token:        ictx.URLParam("token"),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	id, err := s.handler.ProposeTransfer(ctx, req)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to propose transfer"))
+		return
+	}
+	responseJSON(ictx, id)
+}
+
+// acceptTransfer accept a transfer proposed to the dataOwner
+func (s *Server) acceptTransfer(ictx iris.Context) {
+	cTime, err := ictx.URLParamInt64("ctime")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid current time params"))
+		return
+	}
+	req := etype.AcceptTransferOptions{
+		User:        ictx.URLParam("user"),
+		ID:          ictx.URLParam("id"),
+		CurrentTime: cTime,
+		Token:       ictx.URLParam("token"),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	if err := s.handler.AcceptTransfer(ctx, req); err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to accept transfer"))
+		return
+	}
+	responseJSON(ictx, "success")
+}
+
+// cancelTransfer cancel a transfer not completed yet
+func (s *Server) cancelTransfer(ictx iris.Context) {
+	cTime, err := ictx.URLParamInt64("ctime")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid current time params"))
+		return
+	}
+	req := etype.CancelTransferOptions{
+		User:        ictx.URLParam("user"),
+		ID:          ictx.URLParam("id"),
+		CurrentTime: cTime,
+		Token:       ictx.URLParam("token"),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	if err := s.handler.CancelTransfer(ctx, req); err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to cancel transfer"))
+		return
+	}
+	responseJSON(ictx, "success")
+}
+
+// handover hand over a file of an accepted transfer to the new owner, encrypted for the new owner
+func (s *Server) handover(ictx iris.Context) {
+	cTime, err := ictx.URLParamInt64("ctime")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid current time params"))
+		return
+	}
+	req := etype.HandoverOptions{
+		User:        ictx.URLParam("user"),
+		ID:          ictx.URLParam("id"),
+		FileID:      ictx.URLParam("file_id"),
+		CurrentTime: cTime,
+		Token:       ictx.URLParam("token"),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	resp, err := s.handler.Handover(ctx, req)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to hand over file"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
+// completeTransfer record a file re-uploaded by the new owner, or finish a namespace transfer
+func (s *Server) completeTransfer(ictx iris.Context) {
+	cTime, err := ictx.URLParamInt64("ctime")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid current time params"))
+		return
+	}
+	req := etype.CompleteTransferOptions{
+		User:        ictx.URLParam("user"),
+		ID:          ictx.URLParam("id"),
+		FileID:      ictx.URLParam("file_id"),
+		NewFileID:   ictx.URLParam("new_file_id"),
+		ContentHash: ictx.URLParam("content_hash"),
+		Receipt:     ictx.URLParam("receipt"),
+		CurrentTime: cTime,
+		Token:       ictx.URLParam("token"),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	if err := s.handler.CompleteTransfer(ctx, req); err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to complete transfer"))
+		return
+	}
+	responseJSON(ictx, "success")
+}
+
+// getTransfer get a transfer by id
+func (s *Server) getTransfer(ictx iris.Context) {
+	id := ictx.URLParam("id")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+	resp, err := s.handler.GetTransfer(ctx, id)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to get transfer"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
+// listTransfers list transfers proposed by or to a dataOwner
+func (s *Server) listTransfers(ictx iris.Context) {
+	req := etype.ListTransferOptions{
+		User:  ictx.URLParam("user"),
+		Limit: ictx.URLParamUint64("limit"),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+	resp, err := s.handler.ListTransfers(ctx, req)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to list transfers"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
+// rebalance plan and run slice moves across storage nodes
+func (s *Server) rebalance(ictx iris.Context) {
+	cTime, err := ictx.URLParamInt64("ctime")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid current time params"))
+		return
+	}
+	interval, err := ictx.URLParamInt64("interval")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid interval params"))
+		return
+	}
+	dryRun, err := ictx.URLParamBool("dryrun")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid dryrun params"))
+		return
+	}
+
+	req := etype.RebalanceOptions{
+		Namespace:    ictx.URLParam("ns"),
+		MaxMoves:     ictx.URLParamIntDefault("limit", 0),
+		MoveInterval: time.Duration(interval),
+		DryRun:       dryRun,
+		CurrentTime:  cTime,
+		Token:        ictx.URLParam("token"),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	resp, err := s.handler.Rebalance(ctx, req)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to rebalance"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
+// getBandwidth get bandwidth limits in effect
+func (s *Server) getBandwidth(ictx iris.Context) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	resp, err := s.handler.GetBandwidth(ctx)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to get bandwidth"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
+// getMaterialStats get statistics of challenge materials for each namespace
+func (s *Server) getMaterialStats(ictx iris.Context) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	resp, err := s.handler.GetMaterialStats(ctx)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to get challenge material stats"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
+// updateBandwidth change bandwidth limits at runtime
+func (s *Server) updateBandwidth(ictx iris.Context) {
+	limit, err := ictx.URLParamInt64("limit")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid limit params"))
+		return
+	}
+	nodeLimit, err := ictx.URLParamInt64("nodelimit")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid nodelimit params"))
+		return
+	}
+	cTime, err := ictx.URLParamInt64("ctime")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid current time params"))
+		return
+	}
+
+	req := etype.UpdateBandwidthOptions{
+		Limit:       limit,
+		NodeLimit:   nodeLimit,
+		CurrentTime: cTime,
+		Token:       ictx.URLParam("token"),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+
+	resp, err := s.handler.UpdateBandwidth(ctx, req)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to update bandwidth"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
 // listFileNs list namespaces by owner
 func (s *Server) listFileNs(ictx iris.Context) {
 	owner, err := ecdsa.DecodePublicKeyFromString(ictx.URLParam("owner"))
@@ -506,16 +1058,17 @@ func (s *Server) listFileNs(ictx iris.Context) {
 		TimeStart: ictx.URLParamInt64Default("start", 0),
 		TimeEnd:   ictx.URLParamInt64Default("end", time.Now().UnixNano()),
 		Limit:     ictx.URLParamUint64("limit"),
+		Cursor:    ictx.URLParam("next"),
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	ictx.OnConnectionClose(func(iris.Context) { cancel() })
-	resp, err := s.handler.ListFileNs(ctx, req)
+	resp, next, err := s.handler.ListFileNs(ctx, req)
 	if err != nil {
 		responseError(ictx, errorx.Wrap(err, "failed to listfiles"))
 		return
 	}
-	responseJSON(ictx, resp)
+	responsePage(ictx, resp, next)
 }
 
 // getNsByName get namespace by name
@@ -526,10 +1079,6 @@ func (s *Server) getNsByName(ictx iris.Context) {
 		return
 	}
 	name := ictx.URLParam("name")
-	if name == "" {
-		responseError(ictx, errorx.New(errorx.ErrCodeParam, "bad params:ns is empty"))
-		return
-	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	ictx.OnConnectionClose(func(iris.Context) { cancel() })
@@ -595,16 +1144,17 @@ func (s *Server) getToProveChallenges(ictx iris.Context) {
 		TimeStart:  ictx.URLParamInt64Default("start", 0),
 		TimeEnd:    ictx.URLParamInt64Default("end", time.Now().UnixNano()),
 		Limit:      ictx.URLParamUint64("limit"),
+		Cursor:     ictx.URLParam("next"),
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	ictx.OnConnectionClose(func(iris.Context) { cancel() })
-	resp, err := s.handler.GetChallenges(ctx, opt)
+	resp, next, err := s.handler.GetChallenges(ctx, opt)
 	if err != nil {
 		responseError(ictx, errorx.Wrap(err, "failed to get proves challenge"))
 		return
 	}
-	responseJSON(ictx, resp)
+	responsePage(ictx, resp, next)
 }
 
 // getProvedChallenges get challenges with status "Proved"
@@ -629,17 +1179,18 @@ func (s *Server) getProvedChallenges(ictx iris.Context) {
 		TimeStart:  ictx.URLParamInt64Default("start", 0),
 		TimeEnd:    ictx.URLParamInt64Default("end", time.Now().UnixNano()),
 		Limit:      ictx.URLParamUint64("limit"),
+		Cursor:     ictx.URLParam("next"),
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	ictx.OnConnectionClose(func(iris.Context) { cancel() })
-	resp, err := s.handler.GetChallenges(ctx, opt)
+	resp, next, err := s.handler.GetChallenges(ctx, opt)
 	if err != nil {
 		responseError(ictx, errorx.Wrap(err, "failed to get proves challenge"))
 		return
 	}
-	responseJSON(ictx, resp)
+	responsePage(ictx, resp, next)
 }
 
 // getFailedChallenges get challenges with status "Failed"
@@ -664,16 +1215,17 @@ func (s *Server) getFailedChallenges(ictx iris.Context) {
 		TimeStart:  ictx.URLParamInt64Default("start", 0),
 		TimeEnd:    ictx.URLParamInt64Default("end", time.Now().UnixNano()),
 		Limit:      ictx.URLParamUint64("limit"),
+		Cursor:     ictx.URLParam("next"),
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	ictx.OnConnectionClose(func(iris.Context) { cancel() })
-	resp, err := s.handler.GetChallenges(ctx, opt)
+	resp, next, err := s.handler.GetChallenges(ctx, opt)
 	if err != nil {
 		responseError(ictx, errorx.Wrap(err, "failed to get failed challenge"))
 		return
 	}
-	responseJSON(ictx, resp)
+	responsePage(ictx, resp, next)
 }
 
 // getNodeHealth get storage node health status
@@ -690,3 +1242,141 @@ func (s *Server) getNodeHealth(ictx iris.Context) {
 	}
 	responseJSON(ictx, resp)
 }
+
+// getHealthPolicy get node health policy of a version, the current one if version is 0
+func (s *Server) getHealthPolicy(ictx iris.Context) {
+	version := ictx.URLParamInt64Default("version", 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+	resp, err := s.handler.GetHealthPolicy(ctx, version)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to get health policy"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
+// updateHealthPolicy set a new version of node health policy signed by the network admin
+func (s *Server) updateHealthPolicy(ictx iris.Context) {
+	policy, err := parseHealthPolicy(ictx)
+	if err != nil {
+		responseError(ictx, err)
+		return
+	}
+	cTime, err := ictx.URLParamInt64("ctime")
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "invalid current time params"))
+		return
+	}
+	req := etype.UpdateHealthPolicyOptions{
+		Policy:      policy,
+		CurrentTime: cTime,
+		Token:       ictx.URLParam("token"),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+	if err := s.handler.UpdateHealthPolicy(ctx, req); err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to update health policy"))
+		return
+	}
+	responseJSON(ictx, "success")
+}
+
+// simulateNodeHealth score existing storage nodes by a proposed health policy
+func (s *Server) simulateNodeHealth(ictx iris.Context) {
+	policy, err := parseHealthPolicy(ictx)
+	if err != nil {
+		responseError(ictx, err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+	resp, err := s.handler.SimulateNodeHealth(ctx, policy)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to simulate node health"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
+// parseHealthPolicy parses health policy in json from param "policy"
+func parseHealthPolicy(ictx iris.Context) (policy blockchain.HealthPolicy, err error) {
+	if err := json.Unmarshal([]byte(ictx.URLParam("policy")), &policy); err != nil {
+		return policy, errorx.NewCode(err, errorx.ErrCodeParam, "bad params:policy")
+	}
+	return policy, nil
+}
+
+// getNodeDrainStatus get draining progress of storage node
+func (s *Server) getNodeDrainStatus(ictx iris.Context) {
+	id := []byte(ictx.URLParam("id"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ictx.OnConnectionClose(func(iris.Context) { cancel() })
+	resp, err := s.handler.GetNodeDrainStatus(ctx, id)
+	if err != nil {
+		responseError(ictx, errorx.Wrap(err, "failed to get node drain status"))
+		return
+	}
+	responseJSON(ictx, resp)
+}
+
+// watchEvents streams lifecycle events as server-sent events,
+//  events after "Last-Event-ID" header or "last_id" param are replayed if still kept in memory
+func (s *Server) watchEvents(ictx iris.Context) {
+	lastID := ictx.URLParamUint64("last_id")
+	if h := ictx.GetHeader("Last-Event-ID"); h != "" {
+		id, err := strconv.ParseUint(h, 10, 64)
+		if err != nil {
+			responseError(ictx, errorx.NewCode(err, errorx.ErrCodeParam, "invalid Last-Event-ID"))
+			return
+		}
+		lastID = id
+	}
+	var types []string
+	if t := ictx.URLParam("types"); t != "" {
+		types = strings.Split(t, ",")
+	}
+
+	ec, cancel := events.Subscribe(lastID)
+	defer cancel()
+
+	ictx.ContentType("text/event-stream")
+	ictx.Header("Cache-Control", "no-cache")
+	ictx.StatusCode(http.StatusOK)
+	ictx.ResponseWriter().Flush()
+
+	ping := time.NewTicker(eventsPingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case <-ictx.Request().Context().Done():
+			return
+		case <-ping.C:
+			// comment line keeps the connection alive
+			if _, err := ictx.WriteString(": ping\n\n"); err != nil {
+				return
+			}
+		case e := <-ec:
+			if !events.Match(e.Type, types) {
+				continue
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				logrus.WithError(err).Warn("failed to marshal event")
+				continue
+			}
+			if _, err := fmt.Fprintf(ictx, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data); err != nil {
+				return
+			}
+		}
+		ictx.ResponseWriter().Flush()
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"path"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/config"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	pb "github.com/PaddlePaddle/PaddleDTX/xdb/protos/xuperdb"
+)
+
+// grpcMethods methods of pb.XuperDB allowed for each server type, same as http routes
+var grpcMethods = map[string][]string{
+	config.NodeTypeStorage: {
+		"Push", "Pull",
+		"AddNode", "ListNodes", "GetNode", "GetNodeHealth", "NodeOffline", "NodeOnline", "NodeDrain",
+		"GetHealthPolicy", "UpdateHealthPolicy", "SimulateNodeHealth",
+		"GetNodeDrainStatus", "GetSliceMigrateRecords", "GetHeartbeatNum",
+		"ListHeartbeats", "ListHeartbeatCommits",
+		"WatchEvents",
+	},
+	config.NodeTypeDataOwner: {
+		"Write", "Append", "Read", "ListFiles", "QueryFiles", "ListExpiredFiles", "GetFileByID", "GetFileByName",
+		"UpdateFileExpireTime", "AddFileNs", "UpdateNsReplica", "UpdateNsQuota", "UpdateNsRenewal",
+		"AddNsMember", "RemoveNsMember", "ListNsMembers", "Rebalance", "GetBandwidth", "UpdateBandwidth", "ListFileNs", "GetNsByName",
+		"GetMaterialStats",
+		"ProposeTransfer", "AcceptTransfer", "CancelTransfer", "Handover", "CompleteTransfer", "GetTransfer", "ListTransfers",
+		"GetFileSysHealth",
+		"ListNodes", "GetNode", "GetNodeHealth", "GetNodeDrainStatus", "GetSliceMigrateRecords", "GetHeartbeatNum",
+		"GetHealthPolicy", "UpdateHealthPolicy", "SimulateNodeHealth", "ListHeartbeatCommits",
+		"GetChallengeByID", "ListChallenges",
+		"WatchEvents",
+	},
+}
+
+// GRPCServer grpc server, shares handler with http server
+type GRPCServer struct {
+	listenAddr string
+	handler    Handler
+	tlsConf    *tls.Config // serves without TLS if nil
+}
+
+// NewGRPC initiate GRPCServer
+func NewGRPC(listenAddress string, h Handler, tlsConf *tls.Config) (*GRPCServer, error) {
+	if listenAddress == "" {
+		return nil, errorx.New(errorx.ErrCodeConfig, "misssing config: grpcListenAddress")
+	}
+	server := &GRPCServer{
+		listenAddr: listenAddress,
+		handler:    service{h},
+		tlsConf:    tlsConf,
+	}
+	return server, nil
+}
+
+// Serve runs and blocks current routine
+func (s *GRPCServer) Serve(ctx context.Context) error {
+	serverType := ctx.Value("server-type").(string)
+	methods, ok := grpcMethods[serverType]
+	if !ok {
+		return errorx.New(errorx.ErrCodeConfig, "wrong config: server.server-type")
+	}
+	allowed := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		allowed[m] = true
+	}
+
+	lis, err := net.Listen("tcp", s.listenAddr)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to listen %s", s.listenAddr)
+	}
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+			handler grpc.UnaryHandler) (interface{}, error) {
+			if !allowed[path.Base(info.FullMethod)] {
+				return nil, grpcMethodNotFound()
+			}
+			resp, err := handler(ctx, req)
+			return resp, grpcError(err)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+			handler grpc.StreamHandler) error {
+			if !allowed[path.Base(info.FullMethod)] {
+				return grpcMethodNotFound()
+			}
+			return grpcError(handler(srv, ss))
+		}),
+	}
+	if s.tlsConf != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tlsConf)))
+	}
+	srv := grpc.NewServer(opts...)
+	pb.RegisterXuperDBServer(srv, &grpcService{handler: s.handler})
+
+	go func() {
+		<-ctx.Done()
+		logrus.Info("grpc server stops ...")
+		srv.GracefulStop()
+	}()
+
+	logrus.Infof("grpc server starts, and listens port %s", s.listenAddr)
+	if err := srv.Serve(lis); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// grpcError converts err into grpc status,
+//  the message is an errorx.Error encoded in json, same as the body of http error response
+func grpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	logrus.WithError(err).Warn("error from grpc server")
+	return status.Error(codes.Unknown, errorx.ParseAndWrap(err, "from xdata api").Error())
+}
+
+func grpcMethodNotFound() error {
+	return status.Error(codes.Unimplemented, errorx.New(errorx.ErrCodeNotFound, "request method not found").Error())
+}
@@ -0,0 +1,841 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	etype "github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/events"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/crypto/ecdsa"
+	httpkg "github.com/PaddlePaddle/PaddleDTX/xdb/pkgs/http"
+	pb "github.com/PaddlePaddle/PaddleDTX/xdb/protos/xuperdb"
+)
+
+// grpcService implements pb.XuperDBServer, it decodes requests and calls the handler shared with http server
+type grpcService struct {
+	handler Handler
+}
+
+// Write upload a file, the first message carries options
+func (g *grpcService) Write(stream pb.XuperDB_WriteServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeParam, "failed to receive write options")
+	}
+	o := first.GetOptions()
+	if o == nil {
+		return errorx.New(errorx.ErrCodeParam, "bad params:write options should be sent first")
+	}
+	req := etype.WriteOptions{
+		User:        o.GetUser(),
+		Token:       o.GetToken(),
+		Namespace:   o.GetNamespace(),
+		FileName:    o.GetFileName(),
+		ExpireTime:  o.GetExpireTime(),
+		Description: o.GetDescription(),
+		Extra:       o.GetExtra(),
+		Tags:        o.GetTags(),
+	}
+	r := pb.NewChunkReader(func() ([]byte, error) {
+		m, err := stream.Recv()
+		return m.GetChunk(), err
+	})
+	result, err := g.handler.Write(stream.Context(), req, r)
+	if err != nil {
+		return errorx.Wrap(err, "failed to write")
+	}
+	return stream.SendAndClose(&pb.WriteResponse{FileID: result.FileID})
+}
+
+// Append appends data to an existing file, the first message carries options
+func (g *grpcService) Append(stream pb.XuperDB_AppendServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeParam, "failed to receive append options")
+	}
+	o := first.GetOptions()
+	if o == nil {
+		return errorx.New(errorx.ErrCodeParam, "bad params:append options should be sent first")
+	}
+	req := etype.AppendOptions{
+		User:      o.GetUser(),
+		Token:     o.GetToken(),
+		FileID:    o.GetFileID(),
+		Timestamp: o.GetTimestamp(),
+	}
+	r := pb.NewChunkReader(func() ([]byte, error) {
+		m, err := stream.Recv()
+		return m.GetChunk(), err
+	})
+	result, err := g.handler.Append(stream.Context(), req, r)
+	if err != nil {
+		return errorx.Wrap(err, "failed to append")
+	}
+	return stream.SendAndClose(&pb.AppendResponse{FileID: result.FileID, Length: result.Length})
+}
+
+// Read download a file
+func (g *grpcService) Read(in *pb.ReadRequest, stream pb.XuperDB_ReadServer) error {
+	req := etype.ReadOptions{
+		User:      in.GetUser(),
+		Token:     in.GetToken(),
+		Namespace: in.GetNamespace(),
+		FileName:  in.GetFileName(),
+		FileID:    in.GetFileID(),
+		Timestamp: in.GetTimestamp(),
+	}
+	reader, err := g.handler.Read(stream.Context(), req)
+	if err != nil {
+		return errorx.Wrap(err, "failed to read")
+	}
+	defer reader.Close()
+
+	return pb.SendChunks(reader, func(data []byte) error {
+		return stream.Send(&pb.Chunk{Data: data})
+	})
+}
+
+// Push receives slice from others, the first message carries options
+func (g *grpcService) Push(stream pb.XuperDB_PushServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeParam, "failed to receive push options")
+	}
+	o := first.GetOptions()
+	if o == nil {
+		return errorx.New(errorx.ErrCodeParam, "bad params:push options should be sent first")
+	}
+	opt := etype.PushOptions{
+		SliceID:    o.GetSliceID(),
+		SourceId:   o.GetSourceID(),
+		CipherHash: o.GetCipherHash(),
+		Timestamp:  o.GetTimestamp(),
+		Signature:  o.GetSignature(),
+		PeerID:     peerID(stream.Context()),
+	}
+	r := pb.NewChunkReader(func() ([]byte, error) {
+		m, err := stream.Recv()
+		return m.GetChunk(), err
+	})
+	if _, err := g.handler.Push(stream.Context(), opt, r); err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to push slice")
+	}
+	return stream.SendAndClose(&pb.PushResponse{})
+}
+
+// Pull offers file slices to owner
+func (g *grpcService) Pull(in *pb.PullRequest, stream pb.XuperDB_PullServer) error {
+	opt := etype.PullOptions{
+		SliceID:   in.GetSliceID(),
+		FileID:    in.GetFileID(),
+		Timestamp: in.GetTimestamp(),
+		Signature: in.GetSignature(),
+		PeerID:    peerID(stream.Context()),
+	}
+	reader, err := g.handler.Pull(stream.Context(), opt)
+	if err != nil {
+		return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to pull slice")
+	}
+	defer reader.Close()
+
+	return pb.SendChunks(reader, func(data []byte) error {
+		return stream.Send(&pb.Chunk{Data: data})
+	})
+}
+
+// ListFiles list files
+func (g *grpcService) ListFiles(ctx context.Context, in *pb.ListFileRequest) (*pb.Files, error) {
+	owner, err := decodeOwner(in.GetOwner())
+	if err != nil {
+		return nil, err
+	}
+	req := etype.ListFileOptions{
+		Owner:       owner,
+		Namespace:   in.GetNamespace(),
+		TimeStart:   in.GetTimeStart(),
+		TimeEnd:     orNow(in.GetTimeEnd()),
+		CurrentTime: orNow(in.GetCurrentTime()),
+		Limit:       in.GetLimit(),
+		Cursor:      in.GetCursor(),
+	}
+	files, next, err := g.handler.ListFiles(ctx, req)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list files")
+	}
+	return pb.FromFiles(files, next), nil
+}
+
+// QueryFiles query files by tags, name prefix, size range and expire time range
+func (g *grpcService) QueryFiles(ctx context.Context, in *pb.QueryFileRequest) (*pb.Files, error) {
+	owner, err := decodeOwner(in.GetOwner())
+	if err != nil {
+		return nil, err
+	}
+	req := etype.QueryFileOptions{
+		Owner:           owner,
+		Namespace:       in.GetNamespace(),
+		Tags:            in.GetTags(),
+		NamePrefix:      in.GetNamePrefix(),
+		MinSize:         in.GetMinSize(),
+		MaxSize:         in.GetMaxSize(),
+		ExpireTimeStart: in.GetExpireTimeStart(),
+		ExpireTimeEnd:   in.GetExpireTimeEnd(),
+		CurrentTime:     orNow(in.GetCurrentTime()),
+		Limit:           in.GetLimit(),
+		Cursor:          in.GetCursor(),
+	}
+	files, next, err := g.handler.QueryFiles(ctx, req)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to query files")
+	}
+	return pb.FromFiles(files, next), nil
+}
+
+// ListExpiredFiles list expired but valid files
+func (g *grpcService) ListExpiredFiles(ctx context.Context, in *pb.ListFileRequest) (*pb.Files, error) {
+	owner, err := decodeOwner(in.GetOwner())
+	if err != nil {
+		return nil, err
+	}
+	req := etype.ListFileOptions{
+		Owner:       owner,
+		Namespace:   in.GetNamespace(),
+		TimeStart:   in.GetTimeStart(),
+		TimeEnd:     orNow(in.GetTimeEnd()),
+		CurrentTime: orNow(in.GetCurrentTime()),
+		Limit:       in.GetLimit(),
+		Cursor:      in.GetCursor(),
+	}
+	files, next, err := g.handler.ListExpiredFiles(ctx, req)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list expired files")
+	}
+	return pb.FromFiles(files, next), nil
+}
+
+// GetFileByID get file by id
+func (g *grpcService) GetFileByID(ctx context.Context, in *pb.GetFileRequest) (*pb.FileH, error) {
+	file, err := g.handler.GetFileByID(ctx, in.GetId())
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to get file by id")
+	}
+	return pb.FromFileH(file), nil
+}
+
+// GetFileByName get file by file name and namespace
+func (g *grpcService) GetFileByName(ctx context.Context, in *pb.GetFileRequest) (*pb.FileH, error) {
+	owner, err := decodeOwner(in.GetOwner())
+	if err != nil {
+		return nil, err
+	}
+	file, err := g.handler.GetFileByName(ctx, owner, in.GetNamespace(), in.GetName())
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to get file by name")
+	}
+	return pb.FromFileH(file), nil
+}
+
+// UpdateFileExpireTime update file expire time
+func (g *grpcService) UpdateFileExpireTime(ctx context.Context, in *pb.UpdateFileEtimeRequest) (*pb.Empty, error) {
+	req := etype.UpdateFileEtimeOptions{
+		Owner:       in.GetOwner(),
+		FileID:      in.GetFileID(),
+		ExpireTime:  in.GetExpireTime(),
+		CurrentTime: in.GetCurrentTime(),
+		Token:       in.GetToken(),
+	}
+	if err := g.handler.UpdateFileExpireTime(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to update file expire time")
+	}
+	return &pb.Empty{}, nil
+}
+
+// AddFileNs add a file namespace
+func (g *grpcService) AddFileNs(ctx context.Context, in *pb.AddNsRequest) (*pb.Empty, error) {
+	req := etype.AddNsOptions{
+		Owner:       in.GetOwner(),
+		Namespace:   in.GetNamespace(),
+		Description: in.GetDescription(),
+		Replica:     int(in.GetReplica()),
+		CreateTime:  in.GetCreateTime(),
+		Token:       in.GetToken(),
+	}
+	if err := g.handler.AddFileNs(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to add file ns")
+	}
+	return &pb.Empty{}, nil
+}
+
+// UpdateNsReplica update file namespace replica
+func (g *grpcService) UpdateNsReplica(ctx context.Context, in *pb.UpdateNsReplicaRequest) (*pb.Empty, error) {
+	req := etype.UpdateNsOptions{
+		Owner:       in.GetOwner(),
+		Namespace:   in.GetNamespace(),
+		Replica:     int(in.GetReplica()),
+		CurrentTime: in.GetCurrentTime(),
+		Token:       in.GetToken(),
+	}
+	if err := g.handler.UpdateNsReplica(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to update file ns replica")
+	}
+	return &pb.Empty{}, nil
+}
+
+// UpdateNsQuota update file namespace quota
+func (g *grpcService) UpdateNsQuota(ctx context.Context, in *pb.UpdateNsQuotaRequest) (*pb.Empty, error) {
+	quota := pb.ToNsQuota(in.GetQuota())
+	req := etype.UpdateNsQuotaOptions{
+		Owner:       in.GetOwner(),
+		Namespace:   in.GetNamespace(),
+		MaxBytes:    quota.MaxBytes,
+		MaxFiles:    quota.MaxFiles,
+		MaxFileSize: quota.MaxFileSize,
+		CurrentTime: in.GetCurrentTime(),
+		Token:       in.GetToken(),
+	}
+	if err := g.handler.UpdateNsQuota(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to update file ns quota")
+	}
+	return &pb.Empty{}, nil
+}
+
+// UpdateNsRenewal update file namespace renewal policy
+func (g *grpcService) UpdateNsRenewal(ctx context.Context, in *pb.UpdateNsRenewalRequest) (*pb.Empty, error) {
+	renewal := pb.ToNsRenewal(in.GetRenewal())
+	req := etype.UpdateNsRenewalOptions{
+		Owner:       in.GetOwner(),
+		Namespace:   in.GetNamespace(),
+		Policy:      renewal.Policy,
+		Days:        renewal.Days,
+		Until:       renewal.Until,
+		CurrentTime: in.GetCurrentTime(),
+		Token:       in.GetToken(),
+	}
+	if err := g.handler.UpdateNsRenewal(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to update file ns renewal")
+	}
+	return &pb.Empty{}, nil
+}
+
+// AddNsMember add a member into namespace
+func (g *grpcService) AddNsMember(ctx context.Context, in *pb.NsMemberRequest) (*pb.Empty, error) {
+	if err := g.handler.AddNsMember(ctx, nsMemberOptions(in)); err != nil {
+		return nil, errorx.Wrap(err, "failed to update namespace member")
+	}
+	return &pb.Empty{}, nil
+}
+
+// RemoveNsMember remove a member from namespace
+func (g *grpcService) RemoveNsMember(ctx context.Context, in *pb.NsMemberRequest) (*pb.Empty, error) {
+	if err := g.handler.RemoveNsMember(ctx, nsMemberOptions(in)); err != nil {
+		return nil, errorx.Wrap(err, "failed to update namespace member")
+	}
+	return &pb.Empty{}, nil
+}
+
+// ListNsMembers list members of namespace
+func (g *grpcService) ListNsMembers(ctx context.Context, in *pb.ListNsMembersRequest) (*pb.NsMembers, error) {
+	members, err := g.handler.ListNsMembers(ctx, in.GetNamespace())
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list namespace members")
+	}
+	return pb.FromNsMembers(members), nil
+}
+
+// ProposeTransfer propose to transfer a file or a namespace to another dataOwner
+func (g *grpcService) ProposeTransfer(ctx context.Context, in *pb.ProposeTransferRequest) (
+	*pb.ProposeTransferResponse, error) {
+	req := etype.ProposeTransferOptions{
+		User:         in.GetUser(),
+		Namespace:    in.GetNamespace(),
+		FileID:       in.GetFileID(),
+		NewOwner:     in.GetNewOwner(),
+		NewNamespace: in.GetNewNamespace(),
+		CurrentTime:  in.GetCurrentTime(),
+		Token:        in.GetToken(),
+	}
+	id, err := g.handler.ProposeTransfer(ctx, req)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to propose transfer")
+	}
+	return &pb.ProposeTransferResponse{Id: id}, nil
+}
+
+// AcceptTransfer accept a transfer proposed to the dataOwner
+func (g *grpcService) AcceptTransfer(ctx context.Context, in *pb.AcceptTransferRequest) (*pb.Empty, error) {
+	req := etype.AcceptTransferOptions{
+		User:        in.GetUser(),
+		ID:          in.GetId(),
+		CurrentTime: in.GetCurrentTime(),
+		Token:       in.GetToken(),
+	}
+	if err := g.handler.AcceptTransfer(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to accept transfer")
+	}
+	return &pb.Empty{}, nil
+}
+
+// CancelTransfer cancel a transfer not completed yet
+func (g *grpcService) CancelTransfer(ctx context.Context, in *pb.CancelTransferRequest) (*pb.Empty, error) {
+	req := etype.CancelTransferOptions{
+		User:        in.GetUser(),
+		ID:          in.GetId(),
+		CurrentTime: in.GetCurrentTime(),
+		Token:       in.GetToken(),
+	}
+	if err := g.handler.CancelTransfer(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to cancel transfer")
+	}
+	return &pb.Empty{}, nil
+}
+
+// Handover hand over a file of an accepted transfer to the new owner, encrypted for the new owner
+func (g *grpcService) Handover(ctx context.Context, in *pb.HandoverRequest) (*pb.HandoverResponse, error) {
+	req := etype.HandoverOptions{
+		User:        in.GetUser(),
+		ID:          in.GetId(),
+		FileID:      in.GetFileID(),
+		CurrentTime: in.GetCurrentTime(),
+		Token:       in.GetToken(),
+	}
+	resp, err := g.handler.Handover(ctx, req)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to hand over file")
+	}
+	return &pb.HandoverResponse{
+		TransferID:  resp.TransferID,
+		FileID:      resp.FileID,
+		Length:      resp.Length,
+		ContentHash: resp.ContentHash,
+		Receipt:     resp.Receipt,
+		Ciphertext:  resp.Ciphertext,
+	}, nil
+}
+
+// CompleteTransfer record a file re-uploaded by the new owner, or finish a namespace transfer
+func (g *grpcService) CompleteTransfer(ctx context.Context, in *pb.CompleteTransferRequest) (*pb.Empty, error) {
+	req := etype.CompleteTransferOptions{
+		User:        in.GetUser(),
+		ID:          in.GetId(),
+		FileID:      in.GetFileID(),
+		NewFileID:   in.GetNewFileID(),
+		ContentHash: in.GetContentHash(),
+		Receipt:     in.GetReceipt(),
+		CurrentTime: in.GetCurrentTime(),
+		Token:       in.GetToken(),
+	}
+	if err := g.handler.CompleteTransfer(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to complete transfer")
+	}
+	return &pb.Empty{}, nil
+}
+
+// GetTransfer get a transfer by id
+func (g *grpcService) GetTransfer(ctx context.Context, in *pb.GetTransferRequest) (*pb.Transfer, error) {
+	t, err := g.handler.GetTransfer(ctx, in.GetId())
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to get transfer")
+	}
+	return pb.FromTransfer(t), nil
+}
+
+// ListTransfers list transfers proposed by or to a dataOwner
+func (g *grpcService) ListTransfers(ctx context.Context, in *pb.ListTransfersRequest) (*pb.Transfers, error) {
+	req := etype.ListTransferOptions{
+		User:  in.GetUser(),
+		Limit: in.GetLimit(),
+	}
+	ts, err := g.handler.ListTransfers(ctx, req)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list transfers")
+	}
+	return pb.FromTransfers(ts), nil
+}
+
+// Rebalance plan and run slice moves across storage nodes
+func (g *grpcService) Rebalance(ctx context.Context, in *pb.RebalanceRequest) (*pb.RebalancePlan, error) {
+	req := etype.RebalanceOptions{
+		Namespace:    in.GetNamespace(),
+		MaxMoves:     int(in.GetMaxMoves()),
+		MoveInterval: time.Duration(in.GetMoveInterval()),
+		DryRun:       in.GetDryRun(),
+		CurrentTime:  in.GetCurrentTime(),
+		Token:        in.GetToken(),
+	}
+	plan, err := g.handler.Rebalance(ctx, req)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to rebalance")
+	}
+	return pb.FromRebalancePlan(plan), nil
+}
+
+// GetBandwidth get bandwidth limits in effect
+func (g *grpcService) GetBandwidth(ctx context.Context, in *pb.Empty) (*pb.Bandwidth, error) {
+	b, err := g.handler.GetBandwidth(ctx)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to get bandwidth")
+	}
+	return pb.FromBandwidth(b), nil
+}
+
+// GetMaterialStats get statistics of challenge materials for each namespace
+func (g *grpcService) GetMaterialStats(ctx context.Context, in *pb.Empty) (*pb.MaterialReport, error) {
+	r, err := g.handler.GetMaterialStats(ctx)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to get challenge material stats")
+	}
+	return pb.FromMaterialReport(r), nil
+}
+
+// UpdateBandwidth change bandwidth limits at runtime
+func (g *grpcService) UpdateBandwidth(ctx context.Context, in *pb.UpdateBandwidthRequest) (*pb.Bandwidth, error) {
+	req := etype.UpdateBandwidthOptions{
+		Limit:       in.GetLimit(),
+		NodeLimit:   in.GetNodeLimit(),
+		CurrentTime: in.GetCurrentTime(),
+		Token:       in.GetToken(),
+	}
+	b, err := g.handler.UpdateBandwidth(ctx, req)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to update bandwidth")
+	}
+	return pb.FromBandwidth(b), nil
+}
+
+// ListFileNs list namespaces by owner
+func (g *grpcService) ListFileNs(ctx context.Context, in *pb.ListNsRequest) (*pb.Namespaces, error) {
+	owner, err := decodeOwner(in.GetOwner())
+	if err != nil {
+		return nil, err
+	}
+	req := etype.ListNsOptions{
+		Owner:     owner,
+		TimeStart: in.GetTimeStart(),
+		TimeEnd:   orNow(in.GetTimeEnd()),
+		Limit:     in.GetLimit(),
+		Cursor:    in.GetCursor(),
+	}
+	nss, next, err := g.handler.ListFileNs(ctx, req)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list file ns")
+	}
+	return pb.FromNamespaces(nss, next), nil
+}
+
+// GetNsByName get namespace by name
+func (g *grpcService) GetNsByName(ctx context.Context, in *pb.GetNsRequest) (*pb.NamespaceH, error) {
+	owner, err := decodeOwner(in.GetOwner())
+	if err != nil {
+		return nil, err
+	}
+	nsh, err := g.handler.GetNsByName(ctx, owner, in.GetName())
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to get ns detail")
+	}
+	return pb.FromNamespaceH(nsh), nil
+}
+
+// GetFileSysHealth get file owner system health status
+func (g *grpcService) GetFileSysHealth(ctx context.Context, in *pb.GetFileSysHealthRequest) (*pb.FileSysHealth, error) {
+	owner, err := decodeOwner(in.GetOwner())
+	if err != nil {
+		return nil, err
+	}
+	health, err := g.handler.GetFileSysHealth(ctx, owner)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to get file sys health detail")
+	}
+	return pb.FromFileSysHealth(health), nil
+}
+
+// GetChallengeByID get challenge by challenge id
+func (g *grpcService) GetChallengeByID(ctx context.Context, in *pb.GetChallengeRequest) (*pb.Challenge, error) {
+	c, err := g.handler.GetChallengeById(ctx, in.GetId())
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to get challenge by id")
+	}
+	return pb.FromChallenge(c), nil
+}
+
+// ListChallenges get challenges with a status, owner is optional
+func (g *grpcService) ListChallenges(ctx context.Context, in *pb.ListChallengeRequest) (*pb.Challenges, error) {
+	var owner []byte
+	if len(in.GetOwner()) != 0 {
+		pubkey, err := decodeOwner(in.GetOwner())
+		if err != nil {
+			return nil, err
+		}
+		owner = pubkey
+	}
+	opt := blockchain.ListChallengeOptions{
+		FileOwner:  owner,
+		TargetNode: []byte(in.GetTargetNode()),
+		FileID:     in.GetFileID(),
+		Status:     in.GetStatus(),
+		TimeStart:  in.GetTimeStart(),
+		TimeEnd:    orNow(in.GetTimeEnd()),
+		Limit:      in.GetLimit(),
+		Cursor:     in.GetCursor(),
+	}
+	cs, next, err := g.handler.GetChallenges(ctx, opt)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to get challenges")
+	}
+	return pb.FromChallenges(cs, next), nil
+}
+
+// AddNode adds storage node
+func (g *grpcService) AddNode(ctx context.Context, in *pb.AddNodeRequest) (*pb.Empty, error) {
+	req := etype.AddNodeOptions{
+		NodeID:  in.GetNodeID(),
+		Name:    in.GetName(),
+		Address: in.GetAddress(),
+		Online:  true,
+		Token:   in.GetToken(),
+	}
+	if err := g.handler.AddNode(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to addnode")
+	}
+	return &pb.Empty{}, nil
+}
+
+// ListNodes list storage nodes
+func (g *grpcService) ListNodes(ctx context.Context, in *pb.Empty) (*pb.Nodes, error) {
+	nodes, err := g.handler.ListNodes(ctx)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list nodes")
+	}
+	return pb.FromNodes(nodes), nil
+}
+
+// GetNode get storage node info
+func (g *grpcService) GetNode(ctx context.Context, in *pb.GetNodeRequest) (*pb.Node, error) {
+	node, err := g.handler.GetNode(ctx, []byte(in.GetId()))
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to getnode")
+	}
+	return pb.FromNode(node), nil
+}
+
+// GetHeartbeatNum get storage node heartbeat number
+func (g *grpcService) GetHeartbeatNum(ctx context.Context, in *pb.GetHeartbeatNumRequest) (*pb.HeartbeatNum, error) {
+	total, max, err := g.handler.GetHeartbeatNum(ctx, []byte(in.GetId()), in.GetCurrentTime())
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to getnode heartbeat num")
+	}
+	return &pb.HeartbeatNum{HeartBeatTotal: int64(total), HeartBeatMax: int64(max)}, nil
+}
+
+// GetNodeHealth get storage node health status
+func (g *grpcService) GetNodeHealth(ctx context.Context, in *pb.GetNodeRequest) (*pb.NodeHealth, error) {
+	health, err := g.handler.GetNodeHealth(ctx, []byte(in.GetId()))
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to get node health status")
+	}
+	return &pb.NodeHealth{Status: health}, nil
+}
+
+// GetHealthPolicy get node health policy of a version, the current one if version is 0
+func (g *grpcService) GetHealthPolicy(ctx context.Context, in *pb.GetHealthPolicyRequest) (*pb.HealthPolicy, error) {
+	policy, err := g.handler.GetHealthPolicy(ctx, in.GetVersion())
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to get health policy")
+	}
+	return pb.FromHealthPolicy(policy), nil
+}
+
+// UpdateHealthPolicy set a new version of node health policy signed by the network admin
+func (g *grpcService) UpdateHealthPolicy(ctx context.Context, in *pb.UpdateHealthPolicyRequest) (*pb.Empty, error) {
+	req := etype.UpdateHealthPolicyOptions{
+		Policy:      pb.ToHealthPolicy(in.GetPolicy()),
+		CurrentTime: in.GetCurrentTime(),
+		Token:       in.GetToken(),
+	}
+	if err := g.handler.UpdateHealthPolicy(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to update health policy")
+	}
+	return &pb.Empty{}, nil
+}
+
+// SimulateNodeHealth score existing storage nodes by a proposed health policy
+func (g *grpcService) SimulateNodeHealth(ctx context.Context, in *pb.HealthPolicy) (*pb.HealthSimulation, error) {
+	sim, err := g.handler.SimulateNodeHealth(ctx, pb.ToHealthPolicy(in))
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to simulate node health")
+	}
+	return pb.FromHealthSimulation(sim), nil
+}
+
+// ListHeartbeats list heartbeats kept by local storage node, by default heartbeats of the last day
+func (g *grpcService) ListHeartbeats(ctx context.Context, in *pb.ListHeartbeatsRequest) (*pb.SignedHeartbeats, error) {
+	start, end := heartbeatRange(in)
+	beats, err := g.handler.ListHeartbeats(ctx, start, end)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list heartbeats")
+	}
+	return pb.FromSignedHeartbeats(beats), nil
+}
+
+// ListHeartbeatCommits list heartbeat commits of a storage node, by default commits of the last day
+func (g *grpcService) ListHeartbeatCommits(ctx context.Context, in *pb.ListHeartbeatsRequest) (*pb.HeartbeatCommits, error) {
+	start, end := heartbeatRange(in)
+	commits, err := g.handler.ListHeartbeatCommits(ctx, []byte(in.GetId()), start, end)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list heartbeat commits")
+	}
+	return pb.FromHeartbeatCommits(commits), nil
+}
+
+// heartbeatRange returns time period of heartbeats queried, the last day if not set
+func heartbeatRange(in *pb.ListHeartbeatsRequest) (int64, int64) {
+	end := orNow(in.GetEnd())
+	start := in.GetStart()
+	if start == 0 {
+		start = end - int64(24*time.Hour)
+	}
+	return start, end
+}
+
+// NodeOffline set storage node status to offline
+func (g *grpcService) NodeOffline(ctx context.Context, in *pb.NodeOperateRequest) (*pb.Empty, error) {
+	req := etype.NodeOfflineOptions{
+		NodeID: in.GetNodeID(),
+		Nonce:  in.GetNonce(),
+		Token:  in.GetToken(),
+	}
+	if err := g.handler.NodeOffline(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to take node offline")
+	}
+	return &pb.Empty{}, nil
+}
+
+// NodeOnline set storage node status to online
+func (g *grpcService) NodeOnline(ctx context.Context, in *pb.NodeOperateRequest) (*pb.Empty, error) {
+	req := etype.NodeOnlineOptions{
+		NodeID: in.GetNodeID(),
+		Nonce:  in.GetNonce(),
+		Token:  in.GetToken(),
+	}
+	if err := g.handler.NodeOnline(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to take node online")
+	}
+	return &pb.Empty{}, nil
+}
+
+// NodeDrain set storage node status to draining
+func (g *grpcService) NodeDrain(ctx context.Context, in *pb.NodeOperateRequest) (*pb.Empty, error) {
+	req := etype.NodeDrainOptions{
+		NodeID: in.GetNodeID(),
+		Nonce:  in.GetNonce(),
+		Token:  in.GetToken(),
+	}
+	if err := g.handler.NodeDrain(ctx, req); err != nil {
+		return nil, errorx.Wrap(err, "failed to drain node")
+	}
+	return &pb.Empty{}, nil
+}
+
+// GetNodeDrainStatus get draining progress of storage node
+func (g *grpcService) GetNodeDrainStatus(ctx context.Context, in *pb.GetNodeRequest) (*pb.NodeDrainStatus, error) {
+	status, err := g.handler.GetNodeDrainStatus(ctx, []byte(in.GetId()))
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to get node drain status")
+	}
+	return pb.FromNodeDrainStatus(status), nil
+}
+
+// GetSliceMigrateRecords get storage node migration records
+func (g *grpcService) GetSliceMigrateRecords(ctx context.Context, in *pb.GetMigrateRecordsRequest) (*pb.MigrateRecords, error) {
+	opt := &blockchain.NodeSliceMigrateOptions{
+		Target:    []byte(in.GetId()),
+		StartTime: in.GetStartTime(),
+		EndTime:   orNow(in.GetEndTime()),
+		Limit:     in.GetLimit(),
+	}
+	records, err := g.handler.GetSliceMigrateRecords(ctx, opt)
+	if err != nil {
+		return nil, errorx.Wrap(err, "failed to list slice migrate records")
+	}
+	return &pb.MigrateRecords{Records: records}, nil
+}
+
+// WatchEvents streams lifecycle events,
+//  events after lastID are replayed if still kept in memory
+func (g *grpcService) WatchEvents(in *pb.WatchRequest, stream pb.XuperDB_WatchEventsServer) error {
+	ec, cancel := events.Subscribe(in.GetLastID())
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e := <-ec:
+			if !events.Match(e.Type, in.GetTypes()) {
+				continue
+			}
+			if err := stream.Send(pb.FromEvent(e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func nsMemberOptions(in *pb.NsMemberRequest) etype.NsMemberOptions {
+	return etype.NsMemberOptions{
+		User:        in.GetUser(),
+		Namespace:   in.GetNamespace(),
+		Member:      in.GetMember(),
+		Role:        in.GetRole(),
+		CurrentTime: in.GetCurrentTime(),
+		Token:       in.GetToken(),
+	}
+}
+
+// decodeOwner decodes public key in hex into owner bytes
+func decodeOwner(owner string) ([]byte, error) {
+	pubkey, err := ecdsa.DecodePublicKeyFromString(owner)
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeParam, "failed to decode public key")
+	}
+	return pubkey[:], nil
+}
+
+// orNow returns current time if t is not set
+func orNow(t int64) int64 {
+	if t == 0 {
+		return time.Now().UnixNano()
+	}
+	return t
+}
+
+// peerID returns node id bound to TLS certificate of the client, nil if not presented
+func peerID(ctx context.Context) []byte {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	id, _ := httpkg.PeerID(&info.State)
+	return id
+}
@@ -34,6 +34,7 @@ type response struct {
 	Code    string      `json:"code"`
 	Message string      `json:"message"`
 	Data    interface{} `json:"data"`
+	Next    string      `json:"next,omitempty"` // cursor of the next page for listings
 }
 
 func responseJSON(ctx iris.Context, o interface{}) {
@@ -43,6 +44,15 @@ func responseJSON(ctx iris.Context, o interface{}) {
 	})
 }
 
+// responsePage responds a page of a listing along with the cursor of the next page
+func responsePage(ctx iris.Context, o interface{}, next string) {
+	ctx.StatusCode(http.StatusOK)
+	ctx.JSON(response{
+		Data: o,
+		Next: next,
+	})
+}
+
 func responseBytes(ctx iris.Context, bs []byte) {
 	ctx.StatusCode(http.StatusOK)
 	ctx.Binary(bs)
@@ -15,7 +15,9 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"io"
+	"net"
 
 	"github.com/kataras/iris/v12"
 	"github.com/sirupsen/logrus"
@@ -29,20 +31,38 @@ import (
 // Handler defines all apis exposed
 type Handler interface {
 	Write(context.Context, etype.WriteOptions, io.Reader) (etype.WriteResponse, error)
+	Append(context.Context, etype.AppendOptions, io.Reader) (etype.AppendResponse, error)
 	Read(context.Context, etype.ReadOptions) (io.ReadCloser, error)
 
-	ListFiles(context.Context, etype.ListFileOptions) ([]blockchain.File, error)
-	ListExpiredFiles(context.Context, etype.ListFileOptions) ([]blockchain.File, error)
+	ListFiles(context.Context, etype.ListFileOptions) ([]blockchain.File, string, error)
+	QueryFiles(context.Context, etype.QueryFileOptions) ([]blockchain.File, string, error)
+	ListExpiredFiles(context.Context, etype.ListFileOptions) ([]blockchain.File, string, error)
 	GetFileByID(ctx context.Context, id string) (file blockchain.FileH, err error)
 	GetFileByName(ctx context.Context, owner []byte, ns, name string) (file blockchain.FileH, err error)
 	UpdateFileExpireTime(ctx context.Context, opt etype.UpdateFileEtimeOptions) error
 	AddFileNs(ctx context.Context, opt etype.AddNsOptions) error
 	UpdateNsReplica(ctx context.Context, opt etype.UpdateNsOptions) error
-	ListFileNs(ctx context.Context, opt etype.ListNsOptions) ([]blockchain.Namespace, error)
+	UpdateNsQuota(ctx context.Context, opt etype.UpdateNsQuotaOptions) error
+	UpdateNsRenewal(ctx context.Context, opt etype.UpdateNsRenewalOptions) error
+	AddNsMember(ctx context.Context, opt etype.NsMemberOptions) error
+	RemoveNsMember(ctx context.Context, opt etype.NsMemberOptions) error
+	ListNsMembers(ctx context.Context, ns string) ([]blockchain.NsMember, error)
+	ProposeTransfer(ctx context.Context, opt etype.ProposeTransferOptions) (string, error)
+	AcceptTransfer(ctx context.Context, opt etype.AcceptTransferOptions) error
+	CancelTransfer(ctx context.Context, opt etype.CancelTransferOptions) error
+	Handover(ctx context.Context, opt etype.HandoverOptions) (etype.HandoverResponse, error)
+	CompleteTransfer(ctx context.Context, opt etype.CompleteTransferOptions) error
+	GetTransfer(ctx context.Context, id string) (blockchain.Transfer, error)
+	ListTransfers(ctx context.Context, opt etype.ListTransferOptions) ([]blockchain.Transfer, error)
+	Rebalance(ctx context.Context, opt etype.RebalanceOptions) (etype.RebalancePlan, error)
+	GetBandwidth(ctx context.Context) (etype.Bandwidth, error)
+	GetMaterialStats(ctx context.Context) (etype.MaterialReport, error)
+	UpdateBandwidth(ctx context.Context, opt etype.UpdateBandwidthOptions) (etype.Bandwidth, error)
+	ListFileNs(ctx context.Context, opt etype.ListNsOptions) ([]blockchain.Namespace, string, error)
 	GetNsByName(ctx context.Context, owner []byte, name string) (blockchain.NamespaceH, error)
 	GetFileSysHealth(ctx context.Context, owner []byte) (blockchain.FileSysHealth, error)
 	GetChallengeById(ctx context.Context, id string) (blockchain.Challenge, error)
-	GetChallenges(ctx context.Context, opt blockchain.ListChallengeOptions) ([]blockchain.Challenge, error)
+	GetChallenges(ctx context.Context, opt blockchain.ListChallengeOptions) ([]blockchain.Challenge, string, error)
 
 	Push(context.Context, etype.PushOptions, io.Reader) (etype.PushResponse, error)
 	Pull(context.Context, etype.PullOptions) (io.ReadCloser, error)
@@ -52,8 +72,15 @@ type Handler interface {
 	GetNode(context.Context, []byte) (blockchain.Node, error)
 	GetHeartbeatNum(context.Context, []byte, int64) (int, int, error)
 	GetNodeHealth(context.Context, []byte) (string, error)
+	GetHealthPolicy(context.Context, int64) (blockchain.HealthPolicy, error)
+	UpdateHealthPolicy(context.Context, etype.UpdateHealthPolicyOptions) error
+	SimulateNodeHealth(context.Context, blockchain.HealthPolicy) (etype.HealthSimulation, error)
+	ListHeartbeats(context.Context, int64, int64) ([]blockchain.SignedHeartbeat, error)
+	ListHeartbeatCommits(context.Context, []byte, int64, int64) ([]blockchain.HeartbeatCommit, error)
 	NodeOffline(context.Context, etype.NodeOfflineOptions) error
 	NodeOnline(context.Context, etype.NodeOnlineOptions) error
+	NodeDrain(context.Context, etype.NodeDrainOptions) error
+	GetNodeDrainStatus(context.Context, []byte) (blockchain.NodeDrainStatus, error)
 	GetSliceMigrateRecords(ctx context.Context, opt *blockchain.NodeSliceMigrateOptions) (string, error)
 }
 
@@ -63,10 +90,11 @@ type Server struct {
 
 	listenAddr string
 	handler    Handler
+	tlsConf    *tls.Config // serves plain http if nil
 }
 
-// New initiate Server
-func New(listenAddress string, h Handler) (*Server, error) {
+// New initiate Server, it serves https if tlsConf is not nil
+func New(listenAddress string, h Handler, tlsConf *tls.Config) (*Server, error) {
 	app := iris.New()
 	if listenAddress == "" {
 		return nil, errorx.New(errorx.ErrCodeConfig, "misssing config: listenAddress")
@@ -75,13 +103,15 @@ func New(listenAddress string, h Handler) (*Server, error) {
 	server := &Server{
 		app:        app,
 		listenAddr: listenAddress,
-		handler:    h,
+		handler:    service{h},
+		tlsConf:    tlsConf,
 	}
 	return server, nil
 }
 
 func (s *Server) setRoute(serverType string) (err error) {
 	v1 := s.app.Party("/v1")
+	v1.Get("/events", s.watchEvents)
 	nodeParty := v1.Party("/node")
 	switch serverType {
 	// storage
@@ -94,22 +124,47 @@ func (s *Server) setRoute(serverType string) (err error) {
 		nodeParty.Get("/list", s.listNodes)
 		nodeParty.Get("/get", s.getNode)
 		nodeParty.Get("/health", s.getNodeHealth)
+		nodeParty.Get("/healthpolicy", s.getHealthPolicy)
+		nodeParty.Post("/uhealthpolicy", s.updateHealthPolicy)
+		nodeParty.Get("/simhealth", s.simulateNodeHealth)
 		nodeParty.Post("/offline", s.nodeOffline)
 		nodeParty.Post("/online", s.nodeOnline)
+		nodeParty.Post("/drain", s.nodeDrain)
+		nodeParty.Get("/drainstatus", s.getNodeDrainStatus)
 		nodeParty.Get("/getmrecord", s.getMRecord)
 		nodeParty.Get("/gethbnum", s.getHeartbeatNum)
+		nodeParty.Get("/heartbeats", s.listHeartbeats)
+		nodeParty.Get("/hbcommits", s.listHeartbeatCommits)
 	// dataOwner
 	case config.NodeTypeDataOwner:
 		fileParty := v1.Party("/file")
 		fileParty.Post("/write", s.write)
+		fileParty.Post("/append", s.append)
 		fileParty.Get("/read", s.read)
 		fileParty.Get("/list", s.listFiles)
+		fileParty.Get("/query", s.queryFiles)
 		fileParty.Get("/listexp", s.listExpiredFiles)
 		fileParty.Get("/getbyid", s.getFileByID)
 		fileParty.Get("/getbyname", s.getFileByName)
 		fileParty.Post("/updatexptime", s.updateFileExpireTime)
 		fileParty.Post("/addns", s.addFileNs)
 		fileParty.Post("/ureplica", s.updateNsReplica)
+		fileParty.Post("/uquota", s.updateNsQuota)
+		fileParty.Post("/urenewal", s.updateNsRenewal)
+		fileParty.Post("/addnsmember", s.addNsMember)
+		fileParty.Post("/removensmember", s.removeNsMember)
+		fileParty.Get("/listnsmembers", s.listNsMembers)
+		fileParty.Post("/proposetransfer", s.proposeTransfer)
+		fileParty.Post("/accepttransfer", s.acceptTransfer)
+		fileParty.Post("/canceltransfer", s.cancelTransfer)
+		fileParty.Post("/handover", s.handover)
+		fileParty.Post("/completetransfer", s.completeTransfer)
+		fileParty.Get("/gettransfer", s.getTransfer)
+		fileParty.Get("/listtransfers", s.listTransfers)
+		fileParty.Post("/rebalance", s.rebalance)
+		fileParty.Get("/getbandwidth", s.getBandwidth)
+		fileParty.Post("/ubandwidth", s.updateBandwidth)
+		fileParty.Get("/materials", s.getMaterialStats)
 		fileParty.Get("/listns", s.listFileNs)
 		fileParty.Get("/getns", s.getNsByName)
 		fileParty.Get("/getsyshealth", s.getSysHealth)
@@ -117,8 +172,13 @@ func (s *Server) setRoute(serverType string) (err error) {
 		nodeParty.Get("/list", s.listNodes)
 		nodeParty.Get("/get", s.getNode)
 		nodeParty.Get("/health", s.getNodeHealth)
+		nodeParty.Get("/healthpolicy", s.getHealthPolicy)
+		nodeParty.Post("/uhealthpolicy", s.updateHealthPolicy)
+		nodeParty.Get("/simhealth", s.simulateNodeHealth)
+		nodeParty.Get("/drainstatus", s.getNodeDrainStatus)
 		nodeParty.Get("/getmrecord", s.getMRecord)
 		nodeParty.Get("/gethbnum", s.getHeartbeatNum)
+		nodeParty.Get("/hbcommits", s.listHeartbeatCommits)
 
 		challParty := v1.Party("/challenge")
 		challParty.Get("/getbyid", s.getChallengeById)
@@ -147,6 +207,16 @@ func (s *Server) Serve(ctx context.Context) error {
 	}()
 
 	logrus.Infof("server starts, and listens port %s", s.listenAddr)
+	if s.tlsConf != nil {
+		ln, err := net.Listen("tcp", s.listenAddr)
+		if err != nil {
+			return errorx.NewCode(err, errorx.ErrCodeInternal, "failed to listen %s", s.listenAddr)
+		}
+		if err := s.app.Run(iris.Listener(tls.NewListener(ln, s.tlsConf))); err != nil {
+			return err
+		}
+		return ctx.Err()
+	}
 	if err := s.app.Listen(s.listenAddr); err != nil {
 		//error occurs when start server
 		return err
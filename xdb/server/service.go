@@ -0,0 +1,212 @@
+// Copyright (c) 2021 PaddlePaddle Authors. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/PaddlePaddle/PaddleDTX/xdb/blockchain"
+	etype "github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+	"github.com/PaddlePaddle/PaddleDTX/xdb/errorx"
+)
+
+// maxHeartbeatRange is the longest time period of heartbeats queried at a time, same as kept by storage nodes
+const maxHeartbeatRange = int64(90 * 24 * time.Hour)
+
+// service wraps Handler with params checking shared by http and grpc apis,
+//  so that both transports behave the same after requests are decoded
+type service struct {
+	Handler
+}
+
+func (s service) Write(ctx context.Context, opt etype.WriteOptions, r io.Reader) (etype.WriteResponse, error) {
+	if err := opt.Valid(); err != nil {
+		return etype.WriteResponse{}, errorx.Wrap(err, "invalid params")
+	}
+	return s.Handler.Write(ctx, opt, r)
+}
+
+func (s service) Append(ctx context.Context, opt etype.AppendOptions, r io.Reader) (etype.AppendResponse, error) {
+	if err := opt.Valid(); err != nil {
+		return etype.AppendResponse{}, errorx.Wrap(err, "invalid params")
+	}
+	return s.Handler.Append(ctx, opt, r)
+}
+
+func (s service) Read(ctx context.Context, opt etype.ReadOptions) (io.ReadCloser, error) {
+	if err := opt.Valid(); err != nil {
+		return nil, errorx.Wrap(err, "invalid params")
+	}
+	return s.Handler.Read(ctx, opt)
+}
+
+func (s service) ListFiles(ctx context.Context, opt etype.ListFileOptions) ([]blockchain.File, string, error) {
+	if opt.Namespace == "" {
+		return nil, "", errorx.New(errorx.ErrCodeParam, "bad params:ns")
+	}
+	return s.Handler.ListFiles(ctx, opt)
+}
+
+func (s service) QueryFiles(ctx context.Context, opt etype.QueryFileOptions) ([]blockchain.File, string, error) {
+	if opt.Namespace == "" && len(opt.Tags) == 0 {
+		return nil, "", errorx.New(errorx.ErrCodeParam, "bad params:ns and tags are both empty")
+	}
+	return s.Handler.QueryFiles(ctx, opt)
+}
+
+func (s service) ListExpiredFiles(ctx context.Context, opt etype.ListFileOptions) ([]blockchain.File, string, error) {
+	if opt.Namespace == "" {
+		return nil, "", errorx.New(errorx.ErrCodeParam, "bad params:ns is empty")
+	}
+	return s.Handler.ListExpiredFiles(ctx, opt)
+}
+
+func (s service) UpdateFileExpireTime(ctx context.Context, opt etype.UpdateFileEtimeOptions) error {
+	if opt.ExpireTime <= time.Now().UnixNano() {
+		return errorx.New(errorx.ErrCodeParam, "invalid param expireTime")
+	}
+	return s.Handler.UpdateFileExpireTime(ctx, opt)
+}
+
+func (s service) AddFileNs(ctx context.Context, opt etype.AddNsOptions) error {
+	nodes, err := s.Handler.ListNodes(ctx)
+	if err != nil {
+		return errorx.Wrap(err, "failed to list nodes")
+	}
+	if opt.Replica > len(nodes) {
+		return errorx.New(errorx.ErrCodeParam, "invalid param: replica must no greater than nodes number")
+	}
+	return s.Handler.AddFileNs(ctx, opt)
+}
+
+func (s service) UpdateNsRenewal(ctx context.Context, opt etype.UpdateNsRenewalOptions) error {
+	if opt.Policy == blockchain.NsRenewalUntil && opt.Until <= time.Now().UnixNano() {
+		return errorx.New(errorx.ErrCodeParam, "invalid param: renew until should be in the future")
+	}
+	return s.Handler.UpdateNsRenewal(ctx, opt)
+}
+
+func (s service) ListNsMembers(ctx context.Context, ns string) ([]blockchain.NsMember, error) {
+	if ns == "" {
+		return nil, errorx.New(errorx.ErrCodeParam, "bad params:ns is empty")
+	}
+	return s.Handler.ListNsMembers(ctx, ns)
+}
+
+func (s service) GetNsByName(ctx context.Context, owner []byte, name string) (blockchain.NamespaceH, error) {
+	if name == "" {
+		return blockchain.NamespaceH{}, errorx.New(errorx.ErrCodeParam, "bad params:ns is empty")
+	}
+	return s.Handler.GetNsByName(ctx, owner, name)
+}
+
+func (s service) UpdateBandwidth(ctx context.Context, opt etype.UpdateBandwidthOptions) (etype.Bandwidth, error) {
+	if opt.Limit < 0 || opt.NodeLimit < 0 {
+		return etype.Bandwidth{}, errorx.New(errorx.ErrCodeParam, "invalid param: bandwidth limits should not be negative")
+	}
+	return s.Handler.UpdateBandwidth(ctx, opt)
+}
+
+func (s service) GetHealthPolicy(ctx context.Context, version int64) (blockchain.HealthPolicy, error) {
+	if version < 0 {
+		return blockchain.HealthPolicy{}, errorx.New(errorx.ErrCodeParam, "invalid param: version should not be negative")
+	}
+	return s.Handler.GetHealthPolicy(ctx, version)
+}
+
+func (s service) UpdateHealthPolicy(ctx context.Context, opt etype.UpdateHealthPolicyOptions) error {
+	if opt.Token == "" {
+		return errorx.New(errorx.ErrCodeParam, "bad params:token is empty")
+	}
+	return s.Handler.UpdateHealthPolicy(ctx, opt)
+}
+
+func (s service) ListHeartbeats(ctx context.Context, start, end int64) ([]blockchain.SignedHeartbeat, error) {
+	if err := checkHeartbeatRange(start, end); err != nil {
+		return nil, err
+	}
+	return s.Handler.ListHeartbeats(ctx, start, end)
+}
+
+func (s service) ListHeartbeatCommits(ctx context.Context, id []byte, start, end int64) (
+	[]blockchain.HeartbeatCommit, error) {
+	if len(id) == 0 {
+		return nil, errorx.New(errorx.ErrCodeParam, "bad params:id is empty")
+	}
+	if err := checkHeartbeatRange(start, end); err != nil {
+		return nil, err
+	}
+	return s.Handler.ListHeartbeatCommits(ctx, id, start, end)
+}
+
+// checkHeartbeatRange limits the time period of heartbeats queried at a time
+func checkHeartbeatRange(start, end int64) error {
+	if start < 0 || start > end {
+		return errorx.New(errorx.ErrCodeParam, "invalid param: start should not be later than end")
+	}
+	if end-start > maxHeartbeatRange {
+		return errorx.New(errorx.ErrCodeParam, "invalid param: time period should be no longer than 90 days")
+	}
+	return nil
+}
+
+func (s service) ProposeTransfer(ctx context.Context, opt etype.ProposeTransferOptions) (string, error) {
+	if opt.Namespace == "" || opt.NewNamespace == "" || opt.NewOwner == "" {
+		return "", errorx.New(errorx.ErrCodeParam, "bad params:ns, new owner and new ns are required")
+	}
+	return s.Handler.ProposeTransfer(ctx, opt)
+}
+
+func (s service) AcceptTransfer(ctx context.Context, opt etype.AcceptTransferOptions) error {
+	if opt.ID == "" {
+		return errorx.New(errorx.ErrCodeParam, "bad params:id is empty")
+	}
+	return s.Handler.AcceptTransfer(ctx, opt)
+}
+
+func (s service) CancelTransfer(ctx context.Context, opt etype.CancelTransferOptions) error {
+	if opt.ID == "" {
+		return errorx.New(errorx.ErrCodeParam, "bad params:id is empty")
+	}
+	return s.Handler.CancelTransfer(ctx, opt)
+}
+
+func (s service) Handover(ctx context.Context, opt etype.HandoverOptions) (etype.HandoverResponse, error) {
+	if opt.ID == "" || opt.FileID == "" {
+		return etype.HandoverResponse{}, errorx.New(errorx.ErrCodeParam, "bad params:id and file id are required")
+	}
+	return s.Handler.Handover(ctx, opt)
+}
+
+func (s service) CompleteTransfer(ctx context.Context, opt etype.CompleteTransferOptions) error {
+	if opt.ID == "" {
+		return errorx.New(errorx.ErrCodeParam, "bad params:id is empty")
+	}
+	if (opt.FileID == "") != (opt.NewFileID == "") {
+		return errorx.New(errorx.ErrCodeParam, "bad params:file id and new file id should be given together")
+	}
+	if opt.FileID != "" && (opt.ContentHash == "" || opt.Receipt == "") {
+		return errorx.New(errorx.ErrCodeParam, "bad params:content hash and receipt are required by a file")
+	}
+	return s.Handler.CompleteTransfer(ctx, opt)
+}
+
+func (s service) GetTransfer(ctx context.Context, id string) (blockchain.Transfer, error) {
+	if id == "" {
+		return blockchain.Transfer{}, errorx.New(errorx.ErrCodeParam, "bad params:id is empty")
+	}
+	return s.Handler.GetTransfer(ctx, id)
+}
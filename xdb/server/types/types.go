@@ -13,8 +13,31 @@
 
 package types
 
+import (
+	etype "github.com/PaddlePaddle/PaddleDTX/xdb/engine/types"
+)
+
 type WriteResponse struct {
 	FileID string `json:"file_id"`
 }
 
+type AppendResponse struct {
+	FileID string `json:"file_id"`
+	Length uint64 `json:"length"`
+}
+
 type PushResponse struct{}
+
+// Responses of rebalance, bandwidth, material, health policy simulation and handover are
+//  returned by engine as is, aliases keep server and clients in step with engine types
+type (
+	SliceMove        = etype.SliceMove
+	NodeUsage        = etype.NodeUsage
+	RebalancePlan    = etype.RebalancePlan
+	Bandwidth        = etype.Bandwidth
+	NsMaterialStats  = etype.NsMaterialStats
+	MaterialReport   = etype.MaterialReport
+	NodeHealthScore  = etype.NodeHealthScore
+	HealthSimulation = etype.HealthSimulation
+	HandoverResponse = etype.HandoverResponse
+)
@@ -19,6 +19,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -176,6 +177,22 @@ func (s *Storage) LoadStr(ctx context.Context, key string) (string, error) {
 	return string(content), nil
 }
 
+// List lists keys of all targets in local with their modification time
+func (s *Storage) List(ctx context.Context) (map[string]time.Time, error) {
+	infos, err := ioutil.ReadDir(s.RootPath)
+	if err != nil {
+		return nil, errorx.NewCode(err, errorx.ErrCodeInternal, "failed to read dir")
+	}
+	keys := make(map[string]time.Time)
+	for _, info := range infos {
+		if info.IsDir() || !isValidKey(info.Name()) {
+			continue
+		}
+		keys[info.Name()] = info.ModTime()
+	}
+	return keys, nil
+}
+
 func isValidKey(key string) bool {
 	// we know the key(slice id) is a uuid, use uuid.Parse to defend path attacking
 	_, err := uuid.Parse(key)